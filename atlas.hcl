@@ -24,6 +24,14 @@ env "test" {
   exclude = ["*.sqlite_autoindex*[type=index]"]
 }
 
+env "archive" {
+  src = "file://internal/db/schema.sql"
+  url = "sqlite:///${var.home}/.orc/archive.db"
+  dev = "sqlite://dev?mode=memory"
+
+  exclude = ["*.sqlite_autoindex*[type=index]"]
+}
+
 env "workbench" {
   src = "file://internal/db/schema.sql"
   url = "sqlite://.orc/workbench.db"
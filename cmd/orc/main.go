@@ -41,6 +41,8 @@ It coordinates IMPs (Implementation Agents) working in isolated workbenches (wor
 			cli.DetectAndStoreActor()
 			// Apply global tmux bindings (idempotent, no-op if tmux not running)
 			cli.ApplyGlobalBindings()
+			// Apply retention policy if enabled (throttled, no-op by default)
+			cli.RunStartupMaintenance()
 		},
 	}
 
@@ -68,11 +70,14 @@ It coordinates IMPs (Implementation Agents) working in isolated workbenches (wor
 	// Repository and PR commands
 	rootCmd.AddCommand(cli.RepoCmd())
 	rootCmd.AddCommand(cli.PRCmd())
+	rootCmd.AddCommand(cli.CommitCmd())
+	rootCmd.AddCommand(cli.ChangelogCmd())
 
 	// Infrastructure commands (Factory/Workshop/Workbench hierarchy)
 	rootCmd.AddCommand(cli.FactoryCmd())
 	rootCmd.AddCommand(cli.WorkshopCmd())
 	rootCmd.AddCommand(cli.WorkbenchCmd())
+	rootCmd.AddCommand(cli.ReconcileCmd())
 	rootCmd.AddCommand(cli.TmuxCmd())
 	rootCmd.AddCommand(cli.DeskCmd())
 
@@ -80,6 +85,7 @@ It coordinates IMPs (Implementation Agents) working in isolated workbenches (wor
 	rootCmd.AddCommand(cli.ScaffoldCmd())
 	rootCmd.AddCommand(cli.DebugCmd())
 	rootCmd.AddCommand(cli.EventsCmd())
+	rootCmd.AddCommand(cli.MaintenanceCmd())
 
 	// Claude Code integration
 	rootCmd.AddCommand(cli.HookCmd())
@@ -7,6 +7,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/example/orc/internal/ports/primary"
 )
@@ -54,10 +57,21 @@ func (a *CommissionAdapter) List(ctx context.Context, status string) error {
 		return nil
 	}
 
-	fmt.Fprintf(a.out, "\n%-15s %-10s %s\n", "ID", "STATUS", "TITLE")
-	fmt.Fprintln(a.out, "────────────────────────────────────────────────────────────────")
+	fmt.Fprintf(a.out, "\n%-15s %-10s %-10s %-6s %-12s %s\n", "ID", "STATUS", "SHIPPED", "NOTES", "ACTIVITY", "TITLE")
+	fmt.Fprintln(a.out, "──────────────────────────────────────────────────────────────────────────────")
 	for _, c := range commissions {
-		fmt.Fprintf(a.out, "%-15s %-10s %s\n", c.ID, c.Status, c.Title)
+		shipped, notes, activity := "-", "-", "-"
+		title := c.Title
+		if rollup, err := a.service.GetCommissionRollup(ctx, c.ID); err == nil && rollup != nil {
+			closed, total := countClosed(rollup.ShipmentsByStatus)
+			shipped = fmt.Sprintf("%d/%d", closed, total)
+			notes = fmt.Sprintf("%d", sumCounts(rollup.OpenNotesByType))
+			activity = formatLastActivity(rollup.LastActivity, time.Now())
+			if rollup.ReadyToComplete {
+				title += " (ready to complete)"
+			}
+		}
+		fmt.Fprintf(a.out, "%-15s %-10s %-10s %-6s %-12s %s\n", c.ID, c.Status, shipped, notes, activity, title)
 	}
 	fmt.Fprintln(a.out)
 
@@ -82,11 +96,96 @@ func (a *CommissionAdapter) Show(ctx context.Context, commissionID string) (*pri
 	if commission.CompletedAt != "" {
 		fmt.Fprintf(a.out, "Completed: %s\n", commission.CompletedAt)
 	}
+
+	if rollup, err := a.service.GetCommissionRollup(ctx, commissionID); err == nil && rollup != nil {
+		a.printRollup(rollup)
+	}
 	fmt.Fprintln(a.out)
 
 	return commission, nil
 }
 
+// printRollup prints aggregate metrics for a commission.
+func (a *CommissionAdapter) printRollup(rollup *primary.CommissionRollup) {
+	closed, total := countClosed(rollup.ShipmentsByStatus)
+	fmt.Fprintf(a.out, "Shipments: %d/%d closed", closed, total)
+	if total > 0 {
+		fmt.Fprintf(a.out, " (%s)", formatCounts(rollup.ShipmentsByStatus, []string{"draft", "ready", "in-progress", "closed"}))
+	}
+	fmt.Fprintln(a.out)
+
+	if tomes := sumCounts(rollup.TomesByStatus); tomes > 0 {
+		fmt.Fprintf(a.out, "Tomes:     %d/%d closed\n", rollup.TomesByStatus["closed"], tomes)
+	}
+	if openNotes := sumCounts(rollup.OpenNotesByType); openNotes > 0 {
+		fmt.Fprintf(a.out, "Open notes: %d (%s)\n", openNotes, formatCounts(rollup.OpenNotesByType, nil))
+	}
+	if rollup.LastActivity != "" {
+		fmt.Fprintf(a.out, "Last activity: %s (%s)\n", rollup.LastActivity, formatLastActivity(rollup.LastActivity, time.Now()))
+	}
+	if rollup.ReadyToComplete {
+		fmt.Fprintf(a.out, "💡 All shipments and tomes are closed. Complete with: orc commission complete %s\n", rollup.CommissionID)
+	}
+}
+
+// countClosed returns the closed and total counts from a status -> count map.
+func countClosed(byStatus map[string]int) (closed, total int) {
+	return byStatus["closed"], sumCounts(byStatus)
+}
+
+// sumCounts returns the sum of all counts in a map.
+func sumCounts(counts map[string]int) int {
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	return total
+}
+
+// formatCounts renders counts as "key: n, key: n".
+// Keys listed in order come first in that order; remaining keys follow alphabetically.
+func formatCounts(counts map[string]int, order []string) string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, k := range order {
+		if counts[k] > 0 {
+			keys = append(keys, k)
+		}
+		seen[k] = true
+	}
+	var rest []string
+	for k := range counts {
+		if !seen[k] {
+			rest = append(rest, k)
+		}
+	}
+	sort.Strings(rest)
+	keys = append(keys, rest...)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = fmt.Sprintf("%s: %d", k, counts[k])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatLastActivity renders an RFC3339 timestamp as a coarse relative age (e.g., "3d ago").
+func formatLastActivity(timestamp string, now time.Time) string {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return "-"
+	}
+	age := now.Sub(t)
+	switch {
+	case age < time.Hour:
+		return "just now"
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(age.Hours()/24))
+	}
+}
+
 // Update updates a commission's title and/or description.
 func (a *CommissionAdapter) Update(ctx context.Context, commissionID, title, description string) error {
 	if title == "" && description == "" {
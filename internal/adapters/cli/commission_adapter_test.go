@@ -21,6 +21,7 @@ type mockCommissionService struct {
 	deleteCommissionFn   func(ctx context.Context, req primary.DeleteCommissionRequest) error
 	pinCommissionFn      func(ctx context.Context, commissionID string) error
 	unpinCommissionFn    func(ctx context.Context, commissionID string) error
+	getRollupFn          func(ctx context.Context, commissionID string) (*primary.CommissionRollup, error)
 
 	// Track calls for verification
 	lastCreateReq primary.CreateCommissionRequest
@@ -105,6 +106,17 @@ func (m *mockCommissionService) UnpinCommission(ctx context.Context, commissionI
 	return nil
 }
 
+func (m *mockCommissionService) GetCommissionRollup(ctx context.Context, commissionID string) (*primary.CommissionRollup, error) {
+	if m.getRollupFn != nil {
+		return m.getRollupFn(ctx, commissionID)
+	}
+	return nil, nil
+}
+
+func (m *mockCommissionService) SyncCommissionLifecycle(ctx context.Context, commissionID string) (*primary.CommissionLifecycleResult, error) {
+	return nil, nil
+}
+
 // ============================================================================
 // Create Tests
 // ============================================================================
@@ -179,6 +191,35 @@ func TestCommissionAdapter_List_WithResults(t *testing.T) {
 	}
 }
 
+func TestCommissionAdapter_List_WithRollup(t *testing.T) {
+	mock := &mockCommissionService{
+		listCommissionsFn: func(ctx context.Context, filters primary.CommissionFilters) ([]*primary.Commission, error) {
+			return []*primary.Commission{{ID: "COMM-001", Title: "First", Status: "active"}}, nil
+		},
+		getRollupFn: func(ctx context.Context, commissionID string) (*primary.CommissionRollup, error) {
+			return &primary.CommissionRollup{
+				CommissionID:      commissionID,
+				ShipmentsByStatus: map[string]int{"closed": 3},
+				OpenNotesByType:   map[string]int{"idea": 2},
+				ReadyToComplete:   true,
+			}, nil
+		},
+	}
+	var buf bytes.Buffer
+	adapter := NewCommissionAdapter(mock, &buf)
+
+	if err := adapter.List(context.Background(), ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	output := buf.String()
+	if !strings.Contains(output, "3/3") {
+		t.Errorf("expected shipped count '3/3', got '%s'", output)
+	}
+	if !strings.Contains(output, "ready to complete") {
+		t.Errorf("expected ready-to-complete marker, got '%s'", output)
+	}
+}
+
 func TestCommissionAdapter_List_Empty(t *testing.T) {
 	mock := &mockCommissionService{
 		listCommissionsFn: func(ctx context.Context, filters primary.CommissionFilters) ([]*primary.Commission, error) {
@@ -252,6 +293,38 @@ func TestCommissionAdapter_Show_Success(t *testing.T) {
 	}
 }
 
+func TestCommissionAdapter_Show_WithRollup(t *testing.T) {
+	mock := &mockCommissionService{
+		getCommissionFn: func(ctx context.Context, commissionID string) (*primary.Commission, error) {
+			return &primary.Commission{ID: commissionID, Title: "Test Commission", Status: "active"}, nil
+		},
+		getRollupFn: func(ctx context.Context, commissionID string) (*primary.CommissionRollup, error) {
+			return &primary.CommissionRollup{
+				CommissionID:      commissionID,
+				ShipmentsByStatus: map[string]int{"in-progress": 1, "closed": 2},
+				OpenNotesByType:   map[string]int{"idea": 2, "bug": 1},
+				LastActivity:      "2026-01-19T10:00:00Z",
+			}, nil
+		},
+	}
+	var buf bytes.Buffer
+	adapter := NewCommissionAdapter(mock, &buf)
+
+	if _, err := adapter.Show(context.Background(), "COMM-001"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	output := buf.String()
+	for _, want := range []string{
+		"Shipments: 2/3 closed (in-progress: 1, closed: 2)",
+		"Open notes: 3 (bug: 1, idea: 2)",
+		"Last activity: 2026-01-19T10:00:00Z",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got '%s'", want, output)
+		}
+	}
+}
+
 func TestCommissionAdapter_Show_NotFound(t *testing.T) {
 	mock := &mockCommissionService{
 		getCommissionFn: func(ctx context.Context, commissionID string) (*primary.Commission, error) {
@@ -0,0 +1,58 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/example/orc/internal/ports/secondary"
+	"github.com/example/orc/internal/templates"
+)
+
+// TemplateLoaderAdapter implements secondary.TemplateLoader with the embedded
+// templates, overridden by same-named files in a user template directory.
+type TemplateLoaderAdapter struct {
+	overrideDir string // e.g. ~/.orc/templates; empty disables overrides
+}
+
+// NewTemplateLoaderAdapter creates a template loader reading overrides from overrideDir.
+func NewTemplateLoaderAdapter(overrideDir string) *TemplateLoaderAdapter {
+	return &TemplateLoaderAdapter{overrideDir: overrideDir}
+}
+
+// LoadTemplate returns the user's override of a template, else the built-in one.
+func (a *TemplateLoaderAdapter) LoadTemplate(ctx context.Context, name string) (string, error) {
+	if a.overrideDir != "" {
+		content, err := os.ReadFile(filepath.Join(a.overrideDir, name))
+		if err == nil {
+			return string(content), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", fmt.Errorf("failed to read template override: %w", err)
+		}
+	}
+
+	switch name {
+	case "shipment-report.md.tmpl":
+		return templates.GetShipmentReport("md")
+	case "shipment-report.html.tmpl":
+		return templates.GetShipmentReport("html")
+	case "pr-body.md.tmpl":
+		return templates.GetPRBody()
+	default:
+		return "", fmt.Errorf("no built-in template %s", name)
+	}
+}
+
+// ReadTemplateFile reads a template from an explicit path.
+func (a *TemplateLoaderAdapter) ReadTemplateFile(ctx context.Context, path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read template: %w", err)
+	}
+	return string(content), nil
+}
+
+// Ensure TemplateLoaderAdapter implements the interface
+var _ secondary.TemplateLoader = (*TemplateLoaderAdapter)(nil)
@@ -0,0 +1,43 @@
+package filesystem_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/filesystem"
+)
+
+func TestTemplateLoaderAdapter(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pr-body.md.tmpl"), []byte("Closes {{ .Shipment.ID }}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loader := filesystem.NewTemplateLoaderAdapter(dir)
+
+	// A user override wins over the built-in template
+	content, err := loader.LoadTemplate(ctx, "pr-body.md.tmpl")
+	if err != nil || content != "Closes {{ .Shipment.ID }}" {
+		t.Errorf("LoadTemplate(pr-body) = %q, %v; want the override", content, err)
+	}
+
+	// Without one, the built-in template is used
+	content, err = loader.LoadTemplate(ctx, "shipment-report.html.tmpl")
+	if err != nil || !strings.Contains(content, "<html") {
+		t.Errorf("LoadTemplate(shipment-report.html) = %q, %v; want the built-in", content, err)
+	}
+	if _, err := loader.LoadTemplate(ctx, "unknown.tmpl"); err == nil {
+		t.Error("expected an error for a template without a built-in")
+	}
+
+	content, err = loader.ReadTemplateFile(ctx, filepath.Join(dir, "pr-body.md.tmpl"))
+	if err != nil || content != "Closes {{ .Shipment.ID }}" {
+		t.Errorf("ReadTemplateFile = %q, %v", content, err)
+	}
+	if _, err := loader.ReadTemplateFile(ctx, filepath.Join(dir, "missing.tmpl")); err == nil {
+		t.Error("expected an error for a missing template file")
+	}
+}
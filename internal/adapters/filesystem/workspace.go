@@ -3,10 +3,13 @@ package filesystem
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
+	"syscall"
 
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -41,13 +44,45 @@ func NewWorkspaceAdapter(worktreesBasePath, reposBasePath string) (*WorkspaceAda
 // CreateWorktree creates a git worktree for a repository.
 // repoPath should be the absolute path to the repository (from repo.LocalPath in DB).
 func (a *WorkspaceAdapter) CreateWorktree(ctx context.Context, repoPath, branchName, targetPath string) error {
+	return a.addWorktree(ctx, repoPath, branchName, targetPath)
+}
+
+// CreateSparseWorktree creates a git worktree that only checks out the given directories
+// (cone-mode sparse checkout). Files are never written outside the cone, so large
+// monorepos do not pay for a full checkout first.
+func (a *WorkspaceAdapter) CreateSparseWorktree(ctx context.Context, repoPath, branchName, targetPath string, paths []string) error {
+	if err := a.addWorktree(ctx, repoPath, branchName, targetPath, "--no-checkout"); err != nil {
+		return err
+	}
+	if err := a.SetSparseCheckout(ctx, targetPath, paths); err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "read-tree", "-mu", "HEAD")
+	cmd.Dir = targetPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("sparse checkout failed: %w: %s", err, string(output))
+	}
+	return nil
+}
+
+// addWorktree runs git worktree add with a new branch, or checks out the branch if it
+// already exists (e.g., recreating a workbench whose directory was deleted).
+func (a *WorkspaceAdapter) addWorktree(ctx context.Context, repoPath, branchName, targetPath string, flags ...string) error {
 	// Check if repo exists
 	if _, err := os.Stat(repoPath); os.IsNotExist(err) {
 		return fmt.Errorf("repo not found at %s", repoPath)
 	}
 
-	// Create worktree with new branch
-	cmd := exec.CommandContext(ctx, "git", "worktree", "add", targetPath, "-b", branchName)
+	args := append([]string{"worktree", "add"}, flags...)
+	verify := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", "refs/heads/"+branchName)
+	verify.Dir = repoPath
+	if verify.Run() == nil {
+		args = append(args, targetPath, branchName)
+	} else {
+		args = append(args, targetPath, "-b", branchName)
+	}
+	cmd := exec.CommandContext(ctx, "git", args...)
 	cmd.Dir = repoPath
 
 	output, err := cmd.CombinedOutput()
@@ -58,10 +93,28 @@ func (a *WorkspaceAdapter) CreateWorktree(ctx context.Context, repoPath, branchN
 	return nil
 }
 
+// SetSparseCheckout restricts a worktree to the given directories (cone mode), updating
+// the checked-out files. No paths disables sparse checkout and restores a full checkout.
+// The .orc directory is always part of the cone so the checked-in .orc/repo.json is there.
+// The setting is stored per worktree, so the main clone and other worktrees are unaffected.
+func (a *WorkspaceAdapter) SetSparseCheckout(ctx context.Context, worktreePath string, paths []string) error {
+	args := []string{"sparse-checkout", "disable"}
+	if len(paths) > 0 {
+		args = append([]string{"sparse-checkout", "set", "--cone", "--", ".orc"}, paths...)
+	}
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = worktreePath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git %s failed: %w: %s", strings.Join(args[:2], " "), err, string(output))
+	}
+	return nil
+}
+
 // RemoveWorktree removes a git worktree.
 func (a *WorkspaceAdapter) RemoveWorktree(ctx context.Context, path string) error {
-	// Try git worktree remove first
+	// Try git worktree remove first (run from inside the worktree so git can find its repo)
 	cmd := exec.CommandContext(ctx, "git", "worktree", "remove", path, "--force")
+	cmd.Dir = path
 	if err := cmd.Run(); err != nil {
 		// Fall back to direct directory removal
 		if err := os.RemoveAll(path); err != nil {
@@ -84,6 +137,51 @@ func (a *WorkspaceAdapter) WorktreeExists(ctx context.Context, path string) (boo
 	return info.IsDir(), nil
 }
 
+// ListWorktrees returns the linked worktrees of a repository, parsed from `git worktree list --porcelain`.
+// The main worktree (the repository itself) is excluded.
+func (a *WorkspaceAdapter) ListWorktrees(ctx context.Context, repoPath string) ([]secondary.WorktreeInfo, error) {
+	cmd := exec.CommandContext(ctx, "git", "worktree", "list", "--porcelain")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git worktree list failed in %s: %w", repoPath, err)
+	}
+
+	var worktrees []secondary.WorktreeInfo
+	// Blocks are separated by blank lines; the first block is the main worktree
+	for i, block := range strings.Split(strings.TrimSpace(string(output)), "\n\n") {
+		if i == 0 {
+			continue
+		}
+		var wt secondary.WorktreeInfo
+		for _, line := range strings.Split(block, "\n") {
+			switch {
+			case strings.HasPrefix(line, "worktree "):
+				wt.Path = strings.TrimPrefix(line, "worktree ")
+			case strings.HasPrefix(line, "branch "):
+				wt.Branch = strings.TrimPrefix(strings.TrimPrefix(line, "branch "), "refs/heads/")
+			case line == "prunable" || strings.HasPrefix(line, "prunable "):
+				wt.Prunable = true
+			}
+		}
+		if wt.Path != "" {
+			worktrees = append(worktrees, wt)
+		}
+	}
+	return worktrees, nil
+}
+
+// PruneWorktrees removes stale worktree entries whose directories no longer exist.
+func (a *WorkspaceAdapter) PruneWorktrees(ctx context.Context, repoPath string) error {
+	cmd := exec.CommandContext(ctx, "git", "worktree", "prune")
+	cmd.Dir = repoPath
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git worktree prune failed: %w: %s", err, string(output))
+	}
+	return nil
+}
+
 // CreateDirectory creates a directory with all parent directories.
 func (a *WorkspaceAdapter) CreateDirectory(ctx context.Context, path string) error {
 	if err := os.MkdirAll(path, 0755); err != nil {
@@ -100,6 +198,25 @@ func (a *WorkspaceAdapter) RemoveDirectory(ctx context.Context, path string) err
 	return nil
 }
 
+// RemoveEmptyDirectory removes a directory if it is empty. A missing or non-empty
+// directory is left in place.
+func (a *WorkspaceAdapter) RemoveEmptyDirectory(ctx context.Context, path string) error {
+	entries, err := os.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read directory: %w", err)
+	}
+	if len(entries) > 0 {
+		return nil
+	}
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove directory: %w", err)
+	}
+	return nil
+}
+
 // DirectoryExists checks if a directory exists.
 func (a *WorkspaceAdapter) DirectoryExists(ctx context.Context, path string) (bool, error) {
 	info, err := os.Stat(path)
@@ -112,6 +229,101 @@ func (a *WorkspaceAdapter) DirectoryExists(ctx context.Context, path string) (bo
 	return info.IsDir(), nil
 }
 
+// ListWorkbenchDirs returns the directories depth levels below a worktree root
+// (1 for the flat layout, 2 for by-repo). A missing root yields an empty list.
+func (a *WorkspaceAdapter) ListWorkbenchDirs(ctx context.Context, root string, depth int) ([]string, error) {
+	entries, err := os.ReadDir(root)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", root, err)
+	}
+
+	var dirs []string
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		path := filepath.Join(root, e.Name())
+		if depth <= 1 {
+			dirs = append(dirs, path)
+			continue
+		}
+		nested, err := a.ListWorkbenchDirs(ctx, path, depth-1)
+		if err != nil {
+			return nil, err
+		}
+		dirs = append(dirs, nested...)
+	}
+	return dirs, nil
+}
+
+// MoveWorktree moves a linked worktree of a repository with git worktree move.
+// Across filesystems, where git cannot rename, the worktree is copied and its
+// link repaired with git worktree repair before the old copy is removed.
+func (a *WorkspaceAdapter) MoveWorktree(ctx context.Context, repoPath, from, to string) error {
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(to), err)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "worktree", "move", from, to)
+	cmd.Dir = repoPath
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	if !strings.Contains(string(output), "cross-device") {
+		return fmt.Errorf("git worktree move failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	if err := copyTree(ctx, from, to); err != nil {
+		return err
+	}
+	repair := exec.CommandContext(ctx, "git", "worktree", "repair", to)
+	repair.Dir = repoPath
+	if output, err := repair.CombinedOutput(); err != nil {
+		return fmt.Errorf("git worktree repair failed (copy left at %s): %w: %s", to, err, strings.TrimSpace(string(output)))
+	}
+	if err := os.RemoveAll(from); err != nil {
+		return fmt.Errorf("moved to %s but failed to remove %s: %w", to, from, err)
+	}
+	return nil
+}
+
+// MoveDirectory moves a plain directory, copying it when source and target are on
+// different filesystems.
+func (a *WorkspaceAdapter) MoveDirectory(ctx context.Context, from, to string) error {
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(to), err)
+	}
+
+	err := os.Rename(from, to)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, syscall.EXDEV) {
+		return fmt.Errorf("failed to move directory: %w", err)
+	}
+
+	if err := copyTree(ctx, from, to); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(from); err != nil {
+		return fmt.Errorf("moved to %s but failed to remove %s: %w", to, from, err)
+	}
+	return nil
+}
+
+// copyTree copies a directory tree, preserving modes, times and symlinks.
+func copyTree(ctx context.Context, from, to string) error {
+	cmd := exec.CommandContext(ctx, "cp", "-a", from, to)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to copy %s to %s: %w: %s", from, to, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
 // GetWorktreesBasePath returns the base path for worktrees (e.g., ~/wb).
 func (a *WorkspaceAdapter) GetWorktreesBasePath() string {
 	return a.worktreesBasePath
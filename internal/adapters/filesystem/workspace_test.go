@@ -3,6 +3,7 @@ package filesystem_test
 import (
 	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"testing"
 
@@ -57,6 +58,25 @@ func TestWorkspaceAdapter_DirectoryOperations(t *testing.T) {
 	if exists {
 		t.Error("expected directory to not exist after removal")
 	}
+
+	// RemoveEmptyDirectory keeps a directory with content, and tolerates a missing one
+	if err := adapter.CreateDirectory(ctx, filepath.Join(testDir, "child")); err != nil {
+		t.Fatalf("CreateDirectory failed: %v", err)
+	}
+	if err := adapter.RemoveEmptyDirectory(ctx, testDir); err != nil {
+		t.Fatalf("RemoveEmptyDirectory (non-empty) failed: %v", err)
+	}
+	if exists, _ := adapter.DirectoryExists(ctx, testDir); !exists {
+		t.Error("expected non-empty directory to be kept")
+	}
+	for _, dir := range []string{filepath.Join(testDir, "child"), testDir, testDir} {
+		if err := adapter.RemoveEmptyDirectory(ctx, dir); err != nil {
+			t.Fatalf("RemoveEmptyDirectory(%s) failed: %v", dir, err)
+		}
+	}
+	if exists, _ := adapter.DirectoryExists(ctx, testDir); exists {
+		t.Error("expected empty directory to be removed")
+	}
 }
 
 func TestWorkspaceAdapter_PathResolution(t *testing.T) {
@@ -141,3 +161,237 @@ func TestWorkspaceAdapter_WorktreeExists(t *testing.T) {
 		t.Error("expected worktree to exist")
 	}
 }
+
+// initGitRepo creates a repository with one commit, skipping the test if git is unavailable.
+func initGitRepo(t *testing.T, dir string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	for _, args := range [][]string{
+		{"init", "-q", dir},
+		{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "init"},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, out)
+		}
+	}
+}
+
+func TestWorkspaceAdapter_ListAndPruneWorktrees(t *testing.T) {
+	tmpDir := t.TempDir()
+	repoPath := filepath.Join(tmpDir, "repo")
+	wbBase := filepath.Join(tmpDir, "wb")
+	initGitRepo(t, repoPath)
+
+	adapter, err := filesystem.NewWorkspaceAdapter(wbBase, tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+	ctx := context.Background()
+
+	livePath := filepath.Join(wbBase, "live")
+	stalePath := filepath.Join(wbBase, "stale")
+	if err := adapter.CreateWorktree(ctx, repoPath, "live-branch", livePath); err != nil {
+		t.Fatalf("CreateWorktree failed: %v", err)
+	}
+	if err := adapter.CreateWorktree(ctx, repoPath, "stale-branch", stalePath); err != nil {
+		t.Fatalf("CreateWorktree failed: %v", err)
+	}
+	os.RemoveAll(stalePath)
+
+	worktrees, err := adapter.ListWorktrees(ctx, repoPath)
+	if err != nil {
+		t.Fatalf("ListWorktrees failed: %v", err)
+	}
+	if len(worktrees) != 2 {
+		t.Fatalf("expected 2 linked worktrees, got %d: %+v", len(worktrees), worktrees)
+	}
+	for _, wt := range worktrees {
+		switch filepath.Base(wt.Path) {
+		case "live":
+			if wt.Branch != "live-branch" || wt.Prunable {
+				t.Errorf("live worktree = %+v, want branch live-branch, not prunable", wt)
+			}
+		case "stale":
+			if !wt.Prunable {
+				t.Errorf("stale worktree = %+v, want prunable", wt)
+			}
+		}
+	}
+
+	dirs, err := adapter.ListWorkbenchDirs(ctx, wbBase, 1)
+	if err != nil {
+		t.Fatalf("ListWorkbenchDirs failed: %v", err)
+	}
+	if len(dirs) != 1 || filepath.Base(dirs[0]) != "live" {
+		t.Errorf("ListWorkbenchDirs = %v, want [live]", dirs)
+	}
+
+	if err := adapter.PruneWorktrees(ctx, repoPath); err != nil {
+		t.Fatalf("PruneWorktrees failed: %v", err)
+	}
+	worktrees, _ = adapter.ListWorktrees(ctx, repoPath)
+	if len(worktrees) != 1 {
+		t.Errorf("expected 1 worktree after prune, got %d", len(worktrees))
+	}
+
+	// Recreating on an existing branch checks it out instead of failing
+	if err := adapter.CreateWorktree(ctx, repoPath, "stale-branch", stalePath); err != nil {
+		t.Errorf("CreateWorktree with existing branch failed: %v", err)
+	}
+
+	if err := adapter.RemoveWorktree(ctx, livePath); err != nil {
+		t.Fatalf("RemoveWorktree failed: %v", err)
+	}
+	worktrees, _ = adapter.ListWorktrees(ctx, repoPath)
+	for _, wt := range worktrees {
+		if wt.Path == livePath {
+			t.Error("expected removed worktree to be unregistered from git")
+		}
+	}
+}
+
+func TestWorkspaceAdapter_RemoveWorktree(t *testing.T) {
+	tmpDir := t.TempDir()
+	repoPath := filepath.Join(tmpDir, "repo")
+	wbBase := filepath.Join(tmpDir, "wb")
+	initGitRepo(t, repoPath)
+
+	adapter, err := filesystem.NewWorkspaceAdapter(wbBase, tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+	ctx := context.Background()
+
+	path := filepath.Join(wbBase, "bench")
+	if err := adapter.CreateWorktree(ctx, repoPath, "bench-branch", path); err != nil {
+		t.Fatalf("CreateWorktree failed: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(path, "wip.txt"), []byte("wip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Removal must not depend on the caller's working directory being inside the repo
+	t.Chdir(t.TempDir())
+	if err := adapter.RemoveWorktree(ctx, path); err != nil {
+		t.Fatalf("RemoveWorktree failed: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected %s to be removed, got %v", path, err)
+	}
+	worktrees, err := adapter.ListWorktrees(ctx, repoPath)
+	if err != nil {
+		t.Fatalf("ListWorktrees failed: %v", err)
+	}
+	if len(worktrees) != 0 {
+		t.Errorf("expected the worktree to be unregistered from git, got %+v", worktrees)
+	}
+}
+
+func TestWorkspaceAdapter_MoveWorktreeAndDirectory(t *testing.T) {
+	tmpDir := t.TempDir()
+	repoPath := filepath.Join(tmpDir, "repo")
+	wbBase := filepath.Join(tmpDir, "wb")
+	initGitRepo(t, repoPath)
+
+	adapter, err := filesystem.NewWorkspaceAdapter(wbBase, tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+	ctx := context.Background()
+
+	from := filepath.Join(wbBase, "app-001")
+	if err := adapter.CreateWorktree(ctx, repoPath, "app-branch", from); err != nil {
+		t.Fatalf("CreateWorktree failed: %v", err)
+	}
+	to := filepath.Join(wbBase, "app", "app-001")
+	if err := adapter.MoveWorktree(ctx, repoPath, from, to); err != nil {
+		t.Fatalf("MoveWorktree failed: %v", err)
+	}
+	worktrees, _ := adapter.ListWorktrees(ctx, repoPath)
+	if len(worktrees) != 1 || worktrees[0].Path != to || worktrees[0].Prunable {
+		t.Errorf("worktrees after move = %+v, want one live worktree at %s", worktrees, to)
+	}
+
+	plainFrom := filepath.Join(wbBase, "scratch")
+	if err := os.MkdirAll(plainFrom, 0755); err != nil {
+		t.Fatal(err)
+	}
+	plainTo := filepath.Join(wbBase, "no-repo", "scratch")
+	if err := adapter.MoveDirectory(ctx, plainFrom, plainTo); err != nil {
+		t.Fatalf("MoveDirectory failed: %v", err)
+	}
+
+	// The by-repo layout keeps workbenches two levels below the root
+	dirs, err := adapter.ListWorkbenchDirs(ctx, wbBase, 2)
+	if err != nil {
+		t.Fatalf("ListWorkbenchDirs failed: %v", err)
+	}
+	if len(dirs) != 2 || dirs[0] != to || dirs[1] != plainTo {
+		t.Errorf("ListWorkbenchDirs = %v, want [%s %s]", dirs, to, plainTo)
+	}
+}
+
+func TestWorkspaceAdapter_SparseWorktree(t *testing.T) {
+	tmpDir := t.TempDir()
+	repoPath := filepath.Join(tmpDir, "repo")
+	initGitRepo(t, repoPath)
+	for _, f := range []string{"README", ".orc/repo.json", "services/api/main.go", "services/web/app.js", "libs/core/core.go"} {
+		path := filepath.Join(repoPath, f)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(f), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, args := range [][]string{
+		{"-C", repoPath, "add", "."},
+		{"-C", repoPath, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "monorepo"},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, out)
+		}
+	}
+
+	adapter, err := filesystem.NewWorkspaceAdapter(filepath.Join(tmpDir, "wb"), tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+	ctx := context.Background()
+
+	wbPath := filepath.Join(tmpDir, "wb", "mono-001")
+	if err := adapter.CreateSparseWorktree(ctx, repoPath, "mono-branch", wbPath, []string{"services/api"}); err != nil {
+		t.Fatalf("CreateSparseWorktree failed: %v", err)
+	}
+	assertCheckedOut := func(want map[string]bool) {
+		t.Helper()
+		for f, present := range want {
+			_, err := os.Stat(filepath.Join(wbPath, f))
+			if (err == nil) != present {
+				t.Errorf("%s present = %v, want %v", f, err == nil, present)
+			}
+		}
+	}
+	assertCheckedOut(map[string]bool{"README": true, ".orc/repo.json": true, "services/api/main.go": true, "services/web/app.js": false, "libs/core/core.go": false})
+
+	if out, _ := exec.Command("git", "-C", wbPath, "status", "--porcelain").Output(); len(out) != 0 {
+		t.Errorf("sparse worktree is not clean: %s", out)
+	}
+
+	if err := adapter.SetSparseCheckout(ctx, wbPath, []string{"libs/core", "services/api"}); err != nil {
+		t.Fatalf("SetSparseCheckout failed: %v", err)
+	}
+	assertCheckedOut(map[string]bool{".orc/repo.json": true, "services/api/main.go": true, "services/web/app.js": false, "libs/core/core.go": true})
+
+	// The main clone stays a full checkout
+	if _, err := os.Stat(filepath.Join(repoPath, "services/web/app.js")); err != nil {
+		t.Errorf("main clone lost files outside the cone: %v", err)
+	}
+
+	if err := adapter.SetSparseCheckout(ctx, wbPath, nil); err != nil {
+		t.Fatalf("SetSparseCheckout (disable) failed: %v", err)
+	}
+	assertCheckedOut(map[string]bool{"services/api/main.go": true, "services/web/app.js": true, "libs/core/core.go": true})
+}
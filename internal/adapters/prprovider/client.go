@@ -0,0 +1,154 @@
+// Package prprovider contains REST implementations of the PR provider port
+// for GitHub, GitLab and Gitea.
+package prprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// defaultTimeout bounds a single provider request.
+const defaultTimeout = 30 * time.Second
+
+// client performs authenticated JSON requests against one provider API.
+type client struct {
+	http    *http.Client
+	baseURL string // API root, e.g., https://api.github.com
+	header  string // Authentication header name
+	token   string // Header value; empty means anonymous
+}
+
+// getJSON fetches path (relative to the API root) and decodes the JSON response into out.
+func (c *client) getJSON(ctx context.Context, path string, out any) error {
+	return c.do(ctx, http.MethodGet, path, nil, out)
+}
+
+// postJSON sends in as JSON to path and decodes the JSON response into out (if non-nil).
+func (c *client) postJSON(ctx context.Context, path string, in, out any) error {
+	return c.do(ctx, http.MethodPost, path, in, out)
+}
+
+// putJSON sends in as JSON to path with PUT and decodes the JSON response into out (if non-nil).
+func (c *client) putJSON(ctx context.Context, path string, in, out any) error {
+	return c.do(ctx, http.MethodPut, path, in, out)
+}
+
+// patchJSON sends in as JSON to path with PATCH and decodes the JSON response into out (if non-nil).
+func (c *client) patchJSON(ctx context.Context, path string, in, out any) error {
+	return c.do(ctx, http.MethodPatch, path, in, out)
+}
+
+// do sends a request with an optional JSON body and decodes a JSON response into out.
+func (c *client) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set(c.header, c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %w", req.URL.Host, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &statusError{Method: method, Code: resp.StatusCode, URL: req.URL.Redacted(), Body: strings.TrimSpace(string(msg))}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", req.URL.Redacted(), err)
+	}
+	return nil
+}
+
+// statusError is a non-2xx provider response.
+type statusError struct {
+	Method string
+	Code   int
+	URL    string
+	Body   string
+}
+
+func (e *statusError) Error() string {
+	msg := fmt.Sprintf("%s %s: %d %s", e.Method, e.URL, e.Code, http.StatusText(e.Code))
+	switch e.Code {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		msg += " (check the provider token)"
+	}
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+	return msg
+}
+
+// reviewDecision folds each reviewer's latest verdict into one decision: any
+// outstanding change request wins over approvals, and pending reviewer requests
+// mean a review is still required.
+func reviewDecision(latest map[string]string, pendingReviewers int) string {
+	approved := false
+	for _, verdict := range latest {
+		switch verdict {
+		case "changes_requested":
+			return "changes_requested"
+		case "approved":
+			approved = true
+		}
+	}
+	switch {
+	case approved:
+		return "approved"
+	case pendingReviewers > 0:
+		return "review_required"
+	}
+	return ""
+}
+
+// statusCheck normalizes a commit status state (GitHub status contexts, Gitea
+// statuses) to a check status and conclusion.
+func statusCheck(state string) (string, string) {
+	switch state {
+	case "success":
+		return secondary.ProviderCheckCompleted, "success"
+	case "failure", "error":
+		return secondary.ProviderCheckCompleted, "failure"
+	case "warning":
+		return secondary.ProviderCheckCompleted, "neutral"
+	}
+	return secondary.ProviderCheckQueued, "" // pending, expected
+}
+
+// timestamp normalizes an optional provider timestamp to RFC3339 in UTC.
+func timestamp(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,299 @@
+package prprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// findPageSize is how many recent pull requests Gitea's FindPullRequest scans,
+// since its API cannot filter by head branch.
+const findPageSize = 50
+
+// Gitea implements secondary.PRProvider against the Gitea (and Forgejo) REST API.
+type Gitea struct {
+	client
+	owner string
+	repo  string
+}
+
+type giteaUser struct {
+	Login string `json:"login"`
+}
+
+type giteaRef struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+type giteaPull struct {
+	Number             int         `json:"number"`
+	HTMLURL            string      `json:"html_url"`
+	Title              string      `json:"title"`
+	State              string      `json:"state"` // open or closed
+	Draft              bool        `json:"draft"`
+	Merged             bool        `json:"merged"`
+	Mergeable          bool        `json:"mergeable"`
+	MergedAt           *time.Time  `json:"merged_at"`
+	ClosedAt           *time.Time  `json:"closed_at"`
+	Head               giteaRef    `json:"head"`
+	Base               giteaRef    `json:"base"`
+	RequestedReviewers []giteaUser `json:"requested_reviewers"`
+}
+
+type giteaReview struct {
+	ID        int64     `json:"id"`
+	User      giteaUser `json:"user"`
+	State     string    `json:"state"` // APPROVED, REQUEST_CHANGES, COMMENT, PENDING, REQUEST_REVIEW
+	Dismissed bool      `json:"dismissed"`
+}
+
+// Name returns the provider type.
+func (g *Gitea) Name() string { return TypeGitea }
+
+// GetPullRequest fetches a pull request and its review decision.
+func (g *Gitea) GetPullRequest(ctx context.Context, number int) (*secondary.ProviderPR, error) {
+	var pull giteaPull
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/pulls/%d", g.repoPath(), number), &pull); err != nil {
+		return nil, err
+	}
+
+	var reviews []giteaReview
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/pulls/%d/reviews", g.repoPath(), number), &reviews); err != nil {
+		return nil, err
+	}
+	latest := make(map[string]string)
+	for _, r := range reviews { // oldest first
+		switch {
+		case r.Dismissed:
+			continue
+		case r.State == "APPROVED":
+			latest[r.User.Login] = "approved"
+		case r.State == "REQUEST_CHANGES":
+			latest[r.User.Login] = "changes_requested"
+		}
+	}
+
+	return pull.toProviderPR(reviewDecision(latest, len(pull.RequestedReviewers))), nil
+}
+
+// toProviderPR maps a Gitea pull onto the port type.
+func (pull giteaPull) toProviderPR(decision string) *secondary.ProviderPR {
+	title := strings.ToUpper(pull.Title)
+	pr := &secondary.ProviderPR{
+		Number:         pull.Number,
+		URL:            pull.HTMLURL,
+		Title:          pull.Title,
+		State:          secondary.ProviderPRStateOpen,
+		Draft:          pull.Draft || strings.HasPrefix(title, "WIP:") || strings.HasPrefix(title, "[WIP]"),
+		ReviewDecision: decision,
+		HeadBranch:     pull.Head.Ref,
+		BaseBranch:     pull.Base.Ref,
+		HeadSHA:        pull.Head.SHA,
+		MergedAt:       timestamp(pull.MergedAt),
+		ClosedAt:       timestamp(pull.ClosedAt),
+	}
+	switch {
+	case pull.Merged:
+		pr.State = secondary.ProviderPRStateMerged
+	case pull.State == "closed":
+		pr.State = secondary.ProviderPRStateClosed
+	case pull.Mergeable:
+		pr.Mergeable = "mergeable"
+	default:
+		pr.Mergeable = "conflicting"
+	}
+	return pr
+}
+
+// FindPullRequest returns the most recently updated pull request from branch
+// among the repository's latest pull requests.
+func (g *Gitea) FindPullRequest(ctx context.Context, branch string) (*secondary.ProviderPR, error) {
+	query := url.Values{
+		"state": {"all"},
+		"sort":  {"recentupdate"},
+		"limit": {fmt.Sprint(findPageSize)},
+	}
+	var pulls []giteaPull
+	if err := g.getJSON(ctx, g.repoPath()+"/pulls?"+query.Encode(), &pulls); err != nil {
+		return nil, err
+	}
+	for _, p := range pulls {
+		if p.Head.Ref == branch {
+			return g.GetPullRequest(ctx, p.Number)
+		}
+	}
+	return nil, nil
+}
+
+// CreatePullRequest opens a pull request with its labels, then requests reviewers.
+// Drafts are marked with Gitea's "WIP:" title prefix. If requesting reviewers fails,
+// the opened PR is returned along with the error.
+func (g *Gitea) CreatePullRequest(ctx context.Context, req secondary.ProviderPRCreate) (*secondary.ProviderPR, error) {
+	// Labels are attached by ID; resolve them before opening anything
+	labelIDs := make([]int, 0, len(req.Labels))
+	if len(req.Labels) > 0 {
+		var labels []struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		}
+		if err := g.getJSON(ctx, g.repoPath()+"/labels?limit=100", &labels); err != nil {
+			return nil, err
+		}
+		for _, name := range req.Labels {
+			id := 0
+			for _, l := range labels {
+				if strings.EqualFold(l.Name, name) {
+					id = l.ID
+				}
+			}
+			if id == 0 {
+				return nil, fmt.Errorf("unknown Gitea label %q", name)
+			}
+			labelIDs = append(labelIDs, id)
+		}
+	}
+
+	title := req.Title
+	if req.Draft {
+		title = "WIP: " + title
+	}
+	var pull giteaPull
+	err := g.postJSON(ctx, g.repoPath()+"/pulls", map[string]any{
+		"head":   req.HeadBranch,
+		"base":   req.BaseBranch,
+		"title":  title,
+		"body":   req.Body,
+		"labels": labelIDs,
+	}, &pull)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := ""
+	if len(req.Reviewers) > 0 {
+		decision = "review_required"
+	}
+	pr := pull.toProviderPR(decision)
+
+	if len(req.Reviewers) > 0 {
+		path := fmt.Sprintf("%s/pulls/%d/requested_reviewers", g.repoPath(), pull.Number)
+		if err := g.postJSON(ctx, path, map[string]any{"reviewers": req.Reviewers}, nil); err != nil {
+			return pr, fmt.Errorf("failed to request reviewers: %w", err)
+		}
+	}
+	return pr, nil
+}
+
+// UpdatePullRequestBase changes the branch a pull request targets.
+func (g *Gitea) UpdatePullRequestBase(ctx context.Context, number int, base string) error {
+	return g.patchJSON(ctx, fmt.Sprintf("%s/pulls/%d", g.repoPath(), number), map[string]any{"base": base}, nil)
+}
+
+type giteaReviewComment struct {
+	ID               int64      `json:"id"`
+	Body             string     `json:"body"`
+	User             giteaUser  `json:"user"`
+	Path             string     `json:"path"`
+	Position         int        `json:"position"`          // Line on the new side; 0 for removed lines
+	OriginalPosition int        `json:"original_position"` // Line on the old side
+	HTMLURL          string     `json:"html_url"`
+	CreatedAt        *time.Time `json:"created_at"`
+	Resolver         *giteaUser `json:"resolver"` // Set once the conversation is resolved
+}
+
+// ListReviewThreads returns the review conversations of a pull request. Gitea has
+// no thread objects, so comments on the same file and line form one conversation,
+// identified by its first comment.
+func (g *Gitea) ListReviewThreads(ctx context.Context, number int) ([]*secondary.ProviderReviewThread, error) {
+	var reviews []giteaReview
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/pulls/%d/reviews", g.repoPath(), number), &reviews); err != nil {
+		return nil, err
+	}
+
+	var threads []*secondary.ProviderReviewThread
+	byLine := make(map[string]*secondary.ProviderReviewThread)
+	for _, r := range reviews { // oldest first
+		if r.State == "PENDING" {
+			continue
+		}
+		var comments []giteaReviewComment
+		if err := g.getJSON(ctx, fmt.Sprintf("%s/pulls/%d/reviews/%d/comments", g.repoPath(), number, r.ID), &comments); err != nil {
+			return nil, err
+		}
+		for _, c := range comments {
+			line := c.Position
+			if line == 0 {
+				line = c.OriginalPosition
+			}
+			key := fmt.Sprintf("%s:%d", c.Path, line)
+			thread, ok := byLine[key]
+			if !ok {
+				thread = &secondary.ProviderReviewThread{ID: fmt.Sprint(c.ID), Path: c.Path, Line: line}
+				byLine[key] = thread
+				threads = append(threads, thread)
+			}
+			if c.Resolver != nil {
+				thread.Resolved = true
+			}
+			thread.Comments = append(thread.Comments, secondary.ProviderReviewComment{
+				Author:    c.User.Login,
+				Body:      c.Body,
+				URL:       c.HTMLURL,
+				CreatedAt: timestamp(c.CreatedAt),
+			})
+		}
+	}
+	return threads, nil
+}
+
+// SetReviewThreadResolved is not available: Gitea only resolves conversations in its web UI.
+func (g *Gitea) SetReviewThreadResolved(ctx context.Context, number int, threadID string, resolved bool) error {
+	return fmt.Errorf("resolving review conversations through the Gitea API: %w", errors.ErrUnsupported)
+}
+
+type giteaCombinedStatus struct {
+	Statuses []struct {
+		Context   string     `json:"context"`
+		Status    string     `json:"status"` // pending, success, error, failure, warning
+		TargetURL string     `json:"target_url"`
+		UpdatedAt *time.Time `json:"updated_at"`
+	} `json:"statuses"`
+}
+
+// ListChecks returns the commit statuses of headSHA. Gitea's API does not say
+// which contexts branch protection requires, so every status counts as required.
+func (g *Gitea) ListChecks(ctx context.Context, number int, headSHA string) ([]*secondary.ProviderCheck, error) {
+	if headSHA == "" {
+		return nil, fmt.Errorf("pull request #%d has no head commit", number)
+	}
+	var combined giteaCombinedStatus
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/commits/%s/status", g.repoPath(), url.PathEscape(headSHA)), &combined); err != nil {
+		return nil, err
+	}
+
+	checks := make([]*secondary.ProviderCheck, 0, len(combined.Statuses))
+	for _, st := range combined.Statuses {
+		check := &secondary.ProviderCheck{
+			Name:      st.Context,
+			URL:       st.TargetURL,
+			Required:  true,
+			UpdatedAt: timestamp(st.UpdatedAt),
+		}
+		check.Status, check.Conclusion = statusCheck(st.Status)
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+func (g *Gitea) repoPath() string {
+	return "/repos/" + url.PathEscape(g.owner) + "/" + url.PathEscape(g.repo)
+}
+
+// Ensure Gitea implements the interface
+var _ secondary.PRProvider = (*Gitea)(nil)
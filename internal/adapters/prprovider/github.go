@@ -0,0 +1,400 @@
+package prprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// GitHub implements secondary.PRProvider against the GitHub REST API
+// (github.com and GitHub Enterprise Server).
+type GitHub struct {
+	client
+	owner string
+	repo  string
+}
+
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+type githubRef struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+type githubPull struct {
+	Number             int          `json:"number"`
+	HTMLURL            string       `json:"html_url"`
+	Title              string       `json:"title"`
+	State              string       `json:"state"` // open or closed
+	Draft              bool         `json:"draft"`
+	Merged             bool         `json:"merged"`
+	Mergeable          *bool        `json:"mergeable"` // null while GitHub computes it
+	MergedAt           *time.Time   `json:"merged_at"`
+	ClosedAt           *time.Time   `json:"closed_at"`
+	Head               githubRef    `json:"head"`
+	Base               githubRef    `json:"base"`
+	RequestedReviewers []githubUser `json:"requested_reviewers"`
+}
+
+type githubReview struct {
+	User  githubUser `json:"user"`
+	State string     `json:"state"` // APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED, PENDING
+}
+
+// Name returns the provider type.
+func (g *GitHub) Name() string { return TypeGitHub }
+
+// GetPullRequest fetches a pull request and its review decision.
+func (g *GitHub) GetPullRequest(ctx context.Context, number int) (*secondary.ProviderPR, error) {
+	var pull githubPull
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/pulls/%d", g.repoPath(), number), &pull); err != nil {
+		return nil, err
+	}
+
+	var reviews []githubReview
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/pulls/%d/reviews?per_page=100", g.repoPath(), number), &reviews); err != nil {
+		return nil, err
+	}
+	latest := make(map[string]string)
+	for _, r := range reviews { // oldest first
+		switch r.State {
+		case "APPROVED":
+			latest[r.User.Login] = "approved"
+		case "CHANGES_REQUESTED":
+			latest[r.User.Login] = "changes_requested"
+		case "DISMISSED":
+			delete(latest, r.User.Login)
+		}
+	}
+
+	return pull.toProviderPR(reviewDecision(latest, len(pull.RequestedReviewers))), nil
+}
+
+// toProviderPR maps a GitHub pull onto the port type.
+func (pull githubPull) toProviderPR(decision string) *secondary.ProviderPR {
+	pr := &secondary.ProviderPR{
+		Number:         pull.Number,
+		URL:            pull.HTMLURL,
+		Title:          pull.Title,
+		State:          secondary.ProviderPRStateOpen,
+		Draft:          pull.Draft,
+		ReviewDecision: decision,
+		HeadBranch:     pull.Head.Ref,
+		BaseBranch:     pull.Base.Ref,
+		HeadSHA:        pull.Head.SHA,
+		MergedAt:       timestamp(pull.MergedAt),
+		ClosedAt:       timestamp(pull.ClosedAt),
+	}
+	switch {
+	case pull.Merged || pull.MergedAt != nil:
+		pr.State = secondary.ProviderPRStateMerged
+	case pull.State == "closed":
+		pr.State = secondary.ProviderPRStateClosed
+	case pull.Mergeable == nil:
+		pr.Mergeable = "unknown"
+	case *pull.Mergeable:
+		pr.Mergeable = "mergeable"
+	default:
+		pr.Mergeable = "conflicting"
+	}
+	return pr
+}
+
+// FindPullRequest returns the most recently created pull request from branch.
+func (g *GitHub) FindPullRequest(ctx context.Context, branch string) (*secondary.ProviderPR, error) {
+	query := url.Values{
+		"head":      {g.owner + ":" + branch},
+		"state":     {"all"},
+		"sort":      {"created"},
+		"direction": {"desc"},
+		"per_page":  {"1"},
+	}
+	var pulls []githubPull
+	if err := g.getJSON(ctx, g.repoPath()+"/pulls?"+query.Encode(), &pulls); err != nil {
+		return nil, err
+	}
+	if len(pulls) == 0 {
+		return nil, nil
+	}
+	return g.GetPullRequest(ctx, pulls[0].Number)
+}
+
+// CreatePullRequest opens a pull request, then adds labels and requests reviewers.
+// If either follow-up fails, the opened PR is returned along with the error.
+func (g *GitHub) CreatePullRequest(ctx context.Context, req secondary.ProviderPRCreate) (*secondary.ProviderPR, error) {
+	var pull githubPull
+	err := g.postJSON(ctx, g.repoPath()+"/pulls", map[string]any{
+		"title": req.Title,
+		"body":  req.Body,
+		"head":  req.HeadBranch,
+		"base":  req.BaseBranch,
+		"draft": req.Draft,
+	}, &pull)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := ""
+	if len(req.Reviewers) > 0 {
+		decision = "review_required"
+	}
+	pr := pull.toProviderPR(decision)
+
+	if len(req.Labels) > 0 {
+		path := fmt.Sprintf("%s/issues/%d/labels", g.repoPath(), pull.Number)
+		if err := g.postJSON(ctx, path, map[string]any{"labels": req.Labels}, nil); err != nil {
+			return pr, fmt.Errorf("failed to add labels: %w", err)
+		}
+	}
+	if len(req.Reviewers) > 0 {
+		path := fmt.Sprintf("%s/pulls/%d/requested_reviewers", g.repoPath(), pull.Number)
+		if err := g.postJSON(ctx, path, map[string]any{"reviewers": req.Reviewers}, nil); err != nil {
+			return pr, fmt.Errorf("failed to request reviewers: %w", err)
+		}
+	}
+	return pr, nil
+}
+
+// githubThreadsQuery fetches the review threads of a pull request. The REST API
+// does not expose thread resolution, so threads go through GraphQL.
+// UpdatePullRequestBase changes the branch a pull request targets.
+func (g *GitHub) UpdatePullRequestBase(ctx context.Context, number int, base string) error {
+	return g.patchJSON(ctx, fmt.Sprintf("%s/pulls/%d", g.repoPath(), number), map[string]any{"base": base}, nil)
+}
+
+const githubThreadsQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      reviewThreads(first: 100) {
+        nodes {
+          id isResolved isOutdated path line originalLine
+          comments(first: 50) { nodes { url body createdAt author { login } } }
+        }
+      }
+    }
+  }
+}`
+
+type githubThread struct {
+	ID           string `json:"id"`
+	IsResolved   bool   `json:"isResolved"`
+	IsOutdated   bool   `json:"isOutdated"`
+	Path         string `json:"path"`
+	Line         *int   `json:"line"`         // null once outdated
+	OriginalLine *int   `json:"originalLine"` // Line when the thread was started
+	Comments     struct {
+		Nodes []struct {
+			URL       string      `json:"url"`
+			Body      string      `json:"body"`
+			CreatedAt *time.Time  `json:"createdAt"`
+			Author    *githubUser `json:"author"` // null for deleted accounts
+		} `json:"nodes"`
+	} `json:"comments"`
+}
+
+// ListReviewThreads returns the review threads of a pull request.
+func (g *GitHub) ListReviewThreads(ctx context.Context, number int) ([]*secondary.ProviderReviewThread, error) {
+	var data struct {
+		Repository struct {
+			PullRequest *struct {
+				ReviewThreads struct {
+					Nodes []githubThread `json:"nodes"`
+				} `json:"reviewThreads"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}
+	vars := map[string]any{"owner": g.owner, "repo": g.repo, "number": number}
+	if err := g.graphql(ctx, githubThreadsQuery, vars, &data); err != nil {
+		return nil, err
+	}
+	if data.Repository.PullRequest == nil {
+		return nil, fmt.Errorf("pull request #%d not found", number)
+	}
+
+	var threads []*secondary.ProviderReviewThread
+	for _, t := range data.Repository.PullRequest.ReviewThreads.Nodes {
+		thread := &secondary.ProviderReviewThread{
+			ID:       t.ID,
+			Path:     t.Path,
+			Resolved: t.IsResolved,
+			Outdated: t.IsOutdated,
+		}
+		switch {
+		case t.Line != nil:
+			thread.Line = *t.Line
+		case t.OriginalLine != nil:
+			thread.Line = *t.OriginalLine
+		}
+		for _, c := range t.Comments.Nodes {
+			comment := secondary.ProviderReviewComment{Body: c.Body, URL: c.URL, CreatedAt: timestamp(c.CreatedAt)}
+			if c.Author != nil {
+				comment.Author = c.Author.Login
+			}
+			thread.Comments = append(thread.Comments, comment)
+		}
+		threads = append(threads, thread)
+	}
+	return threads, nil
+}
+
+// SetReviewThreadResolved resolves or reopens a review thread.
+func (g *GitHub) SetReviewThreadResolved(ctx context.Context, number int, threadID string, resolved bool) error {
+	mutation := "resolveReviewThread"
+	if !resolved {
+		mutation = "unresolveReviewThread"
+	}
+	query := "mutation($id: ID!) { " + mutation + "(input: {threadId: $id}) { thread { id } } }"
+	return g.graphql(ctx, query, map[string]any{"id": threadID}, nil)
+}
+
+const githubChecksQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      commits(last: 1) {
+        nodes {
+          commit {
+            statusCheckRollup {
+              contexts(first: 100) {
+                nodes {
+                  __typename
+                  ... on CheckRun { name status conclusion detailsUrl startedAt completedAt isRequired(pullRequestNumber: $number) }
+                  ... on StatusContext { context state targetUrl createdAt isRequired(pullRequestNumber: $number) }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// githubCheck is a check run or a commit status context of a status check rollup.
+type githubCheck struct {
+	Typename    string     `json:"__typename"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  string     `json:"conclusion"`
+	DetailsURL  string     `json:"detailsUrl"`
+	StartedAt   *time.Time `json:"startedAt"`
+	CompletedAt *time.Time `json:"completedAt"`
+	Context     string     `json:"context"`
+	State       string     `json:"state"`
+	TargetURL   string     `json:"targetUrl"`
+	CreatedAt   *time.Time `json:"createdAt"`
+	IsRequired  bool       `json:"isRequired"`
+}
+
+// ListChecks returns the check runs and commit statuses of the pull request's
+// latest commit. Required means required by branch protection.
+func (g *GitHub) ListChecks(ctx context.Context, number int, headSHA string) ([]*secondary.ProviderCheck, error) {
+	var data struct {
+		Repository struct {
+			PullRequest *struct {
+				Commits struct {
+					Nodes []struct {
+						Commit struct {
+							StatusCheckRollup *struct {
+								Contexts struct {
+									Nodes []githubCheck `json:"nodes"`
+								} `json:"contexts"`
+							} `json:"statusCheckRollup"` // null when nothing reported
+						} `json:"commit"`
+					} `json:"nodes"`
+				} `json:"commits"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}
+	vars := map[string]any{"owner": g.owner, "repo": g.repo, "number": number}
+	if err := g.graphql(ctx, githubChecksQuery, vars, &data); err != nil {
+		return nil, err
+	}
+	pull := data.Repository.PullRequest
+	if pull == nil {
+		return nil, fmt.Errorf("pull request #%d not found", number)
+	}
+	if len(pull.Commits.Nodes) == 0 || pull.Commits.Nodes[0].Commit.StatusCheckRollup == nil {
+		return nil, nil
+	}
+
+	var checks []*secondary.ProviderCheck
+	for _, c := range pull.Commits.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes {
+		check := &secondary.ProviderCheck{Required: c.IsRequired}
+		if c.Typename == "StatusContext" {
+			check.Name = c.Context
+			check.URL = c.TargetURL
+			check.UpdatedAt = timestamp(c.CreatedAt)
+			check.Status, check.Conclusion = statusCheck(strings.ToLower(c.State))
+		} else {
+			check.Name = c.Name
+			check.URL = c.DetailsURL
+			check.UpdatedAt = timestamp(c.CompletedAt)
+			if check.UpdatedAt == "" {
+				check.UpdatedAt = timestamp(c.StartedAt)
+			}
+			check.Status, check.Conclusion = githubCheckRun(c.Status, c.Conclusion)
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+// githubCheckRun normalizes the status and conclusion of a GitHub check run.
+func githubCheckRun(status, conclusion string) (string, string) {
+	switch strings.ToLower(status) {
+	case "completed":
+	case "in_progress":
+		return secondary.ProviderCheckInProgress, ""
+	default: // queued, requested, waiting, pending
+		return secondary.ProviderCheckQueued, ""
+	}
+
+	switch conclusion = strings.ToLower(conclusion); conclusion {
+	case "startup_failure":
+		conclusion = "failure"
+	case "stale":
+		conclusion = "neutral"
+	}
+	return secondary.ProviderCheckCompleted, conclusion
+}
+
+// graphql runs a GraphQL request. The endpoint sits next to the REST root:
+// /graphql on api.github.com, /api/graphql on GitHub Enterprise (REST at /api/v3).
+func (g *GitHub) graphql(ctx context.Context, query string, vars map[string]any, out any) error {
+	gql := g.client
+	gql.baseURL = strings.TrimSuffix(g.baseURL, "/v3")
+
+	var resp struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := gql.postJSON(ctx, "/graphql", map[string]any{"query": query, "variables": vars}, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return fmt.Errorf("GitHub GraphQL: %s", resp.Errors[0].Message)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(resp.Data, out); err != nil {
+		return fmt.Errorf("failed to decode GraphQL response: %w", err)
+	}
+	return nil
+}
+
+func (g *GitHub) repoPath() string {
+	return "/repos/" + url.PathEscape(g.owner) + "/" + url.PathEscape(g.repo)
+}
+
+// Ensure GitHub implements the interface
+var _ secondary.PRProvider = (*GitHub)(nil)
@@ -0,0 +1,313 @@
+package prprovider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// GitLab implements secondary.PRProvider against the GitLab REST API,
+// mapping merge requests onto pull requests (IID as number).
+type GitLab struct {
+	client
+	project string // Full project path, e.g., group/subgroup/project
+}
+
+type gitlabMergeRequest struct {
+	IID                 int        `json:"iid"`
+	WebURL              string     `json:"web_url"`
+	Title               string     `json:"title"`
+	State               string     `json:"state"` // opened, closed, locked or merged
+	Draft               bool       `json:"draft"`
+	WorkInProgress      bool       `json:"work_in_progress"` // Pre-15.0 name of draft
+	HasConflicts        bool       `json:"has_conflicts"`
+	DetailedMergeStatus string     `json:"detailed_merge_status"`
+	MergedAt            *time.Time `json:"merged_at"`
+	ClosedAt            *time.Time `json:"closed_at"`
+	SourceBranch        string     `json:"source_branch"`
+	TargetBranch        string     `json:"target_branch"`
+	SHA                 string     `json:"sha"`
+}
+
+type gitlabApprovals struct {
+	ApprovalsLeft int `json:"approvals_left"`
+	ApprovedBy    []struct {
+		User struct {
+			Username string `json:"username"`
+		} `json:"user"`
+	} `json:"approved_by"`
+}
+
+// Name returns the provider type.
+func (g *GitLab) Name() string { return TypeGitLab }
+
+// GetPullRequest fetches a merge request by IID and its approval state.
+func (g *GitLab) GetPullRequest(ctx context.Context, number int) (*secondary.ProviderPR, error) {
+	var mr gitlabMergeRequest
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/merge_requests/%d", g.projectPath(), number), &mr); err != nil {
+		return nil, err
+	}
+
+	var approvals gitlabApprovals
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/merge_requests/%d/approvals", g.projectPath(), number), &approvals); err != nil {
+		return nil, err
+	}
+	// GitLab has no change requests; required approvals still missing outrank partial ones.
+	decision := ""
+	switch {
+	case approvals.ApprovalsLeft > 0:
+		decision = "review_required"
+	case len(approvals.ApprovedBy) > 0:
+		decision = "approved"
+	}
+
+	return mr.toProviderPR(decision), nil
+}
+
+// toProviderPR maps a GitLab merge request onto the port type.
+func (mr gitlabMergeRequest) toProviderPR(decision string) *secondary.ProviderPR {
+	pr := &secondary.ProviderPR{
+		Number:         mr.IID,
+		URL:            mr.WebURL,
+		Title:          mr.Title,
+		State:          secondary.ProviderPRStateOpen,
+		Draft:          mr.Draft || mr.WorkInProgress,
+		ReviewDecision: decision,
+		HeadBranch:     mr.SourceBranch,
+		BaseBranch:     mr.TargetBranch,
+		HeadSHA:        mr.SHA,
+		MergedAt:       timestamp(mr.MergedAt),
+		ClosedAt:       timestamp(mr.ClosedAt),
+	}
+	switch {
+	case mr.State == "merged":
+		pr.State = secondary.ProviderPRStateMerged
+	case mr.State == "closed" || mr.State == "locked":
+		pr.State = secondary.ProviderPRStateClosed
+	case mr.HasConflicts:
+		pr.Mergeable = "conflicting"
+	case mr.DetailedMergeStatus == "unchecked" || mr.DetailedMergeStatus == "checking" || mr.DetailedMergeStatus == "preparing":
+		pr.Mergeable = "unknown"
+	default:
+		pr.Mergeable = "mergeable"
+	}
+	return pr
+}
+
+// FindPullRequest returns the most recently created merge request from branch.
+func (g *GitLab) FindPullRequest(ctx context.Context, branch string) (*secondary.ProviderPR, error) {
+	query := url.Values{
+		"source_branch": {branch},
+		"state":         {"all"},
+		"order_by":      {"created_at"},
+		"sort":          {"desc"},
+		"per_page":      {"1"},
+	}
+	var mrs []gitlabMergeRequest
+	if err := g.getJSON(ctx, g.projectPath()+"/merge_requests?"+query.Encode(), &mrs); err != nil {
+		return nil, err
+	}
+	if len(mrs) == 0 {
+		return nil, nil
+	}
+	return g.GetPullRequest(ctx, mrs[0].IID)
+}
+
+// CreatePullRequest opens a merge request with its labels and reviewers. Drafts
+// are marked with GitLab's "Draft:" title prefix.
+func (g *GitLab) CreatePullRequest(ctx context.Context, req secondary.ProviderPRCreate) (*secondary.ProviderPR, error) {
+	// Reviewers are assigned by user ID; resolve them before opening anything
+	reviewerIDs := make([]int, 0, len(req.Reviewers))
+	for _, username := range req.Reviewers {
+		var users []struct {
+			ID int `json:"id"`
+		}
+		if err := g.getJSON(ctx, "/users?"+url.Values{"username": {username}}.Encode(), &users); err != nil {
+			return nil, err
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("unknown GitLab reviewer %q", username)
+		}
+		reviewerIDs = append(reviewerIDs, users[0].ID)
+	}
+
+	title := req.Title
+	if req.Draft {
+		title = "Draft: " + title
+	}
+	var mr gitlabMergeRequest
+	err := g.postJSON(ctx, g.projectPath()+"/merge_requests", map[string]any{
+		"source_branch": req.HeadBranch,
+		"target_branch": req.BaseBranch,
+		"title":         title,
+		"description":   req.Body,
+		"labels":        strings.Join(req.Labels, ","),
+		"reviewer_ids":  reviewerIDs,
+	}, &mr)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := ""
+	if len(reviewerIDs) > 0 {
+		decision = "review_required"
+	}
+	return mr.toProviderPR(decision), nil
+}
+
+// UpdatePullRequestBase changes the branch a merge request targets.
+func (g *GitLab) UpdatePullRequestBase(ctx context.Context, number int, base string) error {
+	return g.putJSON(ctx, fmt.Sprintf("%s/merge_requests/%d", g.projectPath(), number), map[string]any{"target_branch": base}, nil)
+}
+
+type gitlabDiscussion struct {
+	ID    string `json:"id"`
+	Notes []struct {
+		ID     int    `json:"id"`
+		Body   string `json:"body"`
+		Author struct {
+			Username string `json:"username"`
+		} `json:"author"`
+		CreatedAt  *time.Time `json:"created_at"`
+		System     bool       `json:"system"`
+		Resolvable bool       `json:"resolvable"`
+		Resolved   bool       `json:"resolved"`
+		Position   *struct {
+			NewPath string `json:"new_path"`
+			OldPath string `json:"old_path"`
+			NewLine *int   `json:"new_line"`
+			OldLine *int   `json:"old_line"`
+		} `json:"position"`
+	} `json:"notes"`
+}
+
+// ListReviewThreads returns the resolvable discussions of a merge request.
+func (g *GitLab) ListReviewThreads(ctx context.Context, number int) ([]*secondary.ProviderReviewThread, error) {
+	var mr gitlabMergeRequest
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/merge_requests/%d", g.projectPath(), number), &mr); err != nil {
+		return nil, err
+	}
+	var discussions []gitlabDiscussion
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/merge_requests/%d/discussions?per_page=100", g.projectPath(), number), &discussions); err != nil {
+		return nil, err
+	}
+
+	var threads []*secondary.ProviderReviewThread
+	for _, d := range discussions {
+		if len(d.Notes) == 0 || d.Notes[0].System || !d.Notes[0].Resolvable {
+			continue
+		}
+		thread := &secondary.ProviderReviewThread{ID: d.ID, Resolved: true}
+		if pos := d.Notes[0].Position; pos != nil {
+			thread.Path = pos.NewPath
+			switch {
+			case pos.NewLine != nil:
+				thread.Line = *pos.NewLine
+			case pos.OldLine != nil:
+				thread.Path = pos.OldPath
+				thread.Line = *pos.OldLine
+			}
+		}
+		for _, n := range d.Notes {
+			if n.System {
+				continue
+			}
+			if n.Resolvable && !n.Resolved {
+				thread.Resolved = false
+			}
+			thread.Comments = append(thread.Comments, secondary.ProviderReviewComment{
+				Author:    n.Author.Username,
+				Body:      n.Body,
+				URL:       fmt.Sprintf("%s#note_%d", mr.WebURL, n.ID),
+				CreatedAt: timestamp(n.CreatedAt),
+			})
+		}
+		threads = append(threads, thread)
+	}
+	return threads, nil
+}
+
+// SetReviewThreadResolved resolves or reopens a merge request discussion.
+func (g *GitLab) SetReviewThreadResolved(ctx context.Context, number int, threadID string, resolved bool) error {
+	path := fmt.Sprintf("%s/merge_requests/%d/discussions/%s", g.projectPath(), number, url.PathEscape(threadID))
+	return g.putJSON(ctx, path, map[string]any{"resolved": resolved}, nil)
+}
+
+type gitlabPipeline struct {
+	ID  int    `json:"id"`
+	SHA string `json:"sha"`
+}
+
+type gitlabJob struct {
+	Name         string     `json:"name"`
+	Status       string     `json:"status"`
+	WebURL       string     `json:"web_url"`
+	AllowFailure bool       `json:"allow_failure"`
+	CreatedAt    *time.Time `json:"created_at"`
+	StartedAt    *time.Time `json:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at"`
+}
+
+// ListChecks returns the jobs of the merge request's latest pipeline for headSHA
+// (the latest pipeline at all when headSHA is empty). Jobs not allowed to fail are required.
+func (g *GitLab) ListChecks(ctx context.Context, number int, headSHA string) ([]*secondary.ProviderCheck, error) {
+	var pipelines []gitlabPipeline // Newest first
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/merge_requests/%d/pipelines", g.projectPath(), number), &pipelines); err != nil {
+		return nil, err
+	}
+	pipeline := -1
+	for i, p := range pipelines {
+		if headSHA == "" || p.SHA == headSHA {
+			pipeline = i
+			break
+		}
+	}
+	if pipeline < 0 {
+		return nil, nil
+	}
+
+	var jobs []gitlabJob
+	if err := g.getJSON(ctx, fmt.Sprintf("%s/pipelines/%d/jobs?per_page=100", g.projectPath(), pipelines[pipeline].ID), &jobs); err != nil {
+		return nil, err
+	}
+
+	checks := make([]*secondary.ProviderCheck, 0, len(jobs))
+	for _, j := range jobs {
+		check := &secondary.ProviderCheck{Name: j.Name, URL: j.WebURL, Required: !j.AllowFailure}
+		switch j.Status {
+		case "running":
+			check.Status = secondary.ProviderCheckInProgress
+		case "success":
+			check.Status, check.Conclusion = secondary.ProviderCheckCompleted, "success"
+		case "failed":
+			check.Status, check.Conclusion = secondary.ProviderCheckCompleted, "failure"
+		case "canceled":
+			check.Status, check.Conclusion = secondary.ProviderCheckCompleted, "cancelled"
+		case "skipped":
+			check.Status, check.Conclusion = secondary.ProviderCheckCompleted, "skipped"
+		case "manual":
+			check.Status, check.Conclusion = secondary.ProviderCheckCompleted, "action_required"
+		default: // created, pending, preparing, scheduled, waiting_for_resource
+			check.Status = secondary.ProviderCheckQueued
+		}
+		for _, t := range []*time.Time{j.FinishedAt, j.StartedAt, j.CreatedAt} {
+			if check.UpdatedAt = timestamp(t); check.UpdatedAt != "" {
+				break
+			}
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+// projectPath addresses the project by its URL-encoded full path.
+func (g *GitLab) projectPath() string {
+	return "/projects/" + url.PathEscape(g.project)
+}
+
+// Ensure GitLab implements the interface
+var _ secondary.PRProvider = (*GitLab)(nil)
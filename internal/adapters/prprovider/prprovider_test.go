@@ -0,0 +1,569 @@
+package prprovider_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/prprovider"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// fakeAPI serves canned JSON bodies keyed by request URI ("METHOD URI" for writes)
+// and records auth headers and request bodies.
+type fakeAPI struct {
+	routes  map[string]string
+	headers []http.Header
+	bodies  map[string]map[string]any
+}
+
+func newFakeAPI(t *testing.T, routes map[string]string) (*fakeAPI, *httptest.Server) {
+	t.Helper()
+	api := &fakeAPI{routes: routes, bodies: make(map[string]map[string]any)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		api.headers = append(api.headers, r.Header.Clone())
+		key := r.URL.RequestURI()
+		if r.Method != http.MethodGet {
+			key = r.Method + " " + key
+			var in map[string]any
+			json.NewDecoder(r.Body).Decode(&in)
+			api.bodies[key] = in
+		}
+		body, ok := api.routes[key]
+		if !ok {
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return api, srv
+}
+
+func providerFor(t *testing.T, host prprovider.Host, remote string) secondary.PRProvider {
+	t.Helper()
+	p, err := prprovider.NewRegistry([]prprovider.Host{host}).ForRemote(remote)
+	if err != nil {
+		t.Fatalf("ForRemote failed: %v", err)
+	}
+	return p
+}
+
+func TestParseRemoteURL(t *testing.T) {
+	tests := []struct {
+		remote   string
+		wantHost string
+		wantPath string
+		wantErr  bool
+	}{
+		{"https://github.com/acme/app.git", "github.com", "acme/app", false},
+		{"https://token@GitHub.com/acme/app/", "github.com", "acme/app", false},
+		{"git@github.com:acme/app.git", "github.com", "acme/app", false},
+		{"ssh://git@git.example.com:2222/acme/app.git", "git.example.com", "acme/app", false},
+		{"https://gitlab.com/group/sub/project.git", "gitlab.com", "group/sub/project", false},
+		{"", "", "", true},
+		{"/srv/git/app.git", "", "", true},
+		{"https://github.com/acme", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.remote, func(t *testing.T) {
+			host, path, err := prprovider.ParseRemoteURL(tt.remote)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRemoteURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if host != tt.wantHost || path != tt.wantPath {
+				t.Errorf("ParseRemoteURL() = %q, %q, want %q, %q", host, path, tt.wantHost, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestRegistry_UnknownHost(t *testing.T) {
+	_, err := prprovider.NewRegistry(nil).ForRemote("https://git.example.com/acme/app.git")
+	if err == nil || !strings.Contains(err.Error(), "pr.json") {
+		t.Errorf("expected a configuration hint, got %v", err)
+	}
+}
+
+func TestGitHub_GetPullRequest(t *testing.T) {
+	api, srv := newFakeAPI(t, map[string]string{
+		"/repos/acme/app/pulls/7": `{"number":7,"html_url":"https://github.com/acme/app/pull/7","title":"Add login","state":"open",
+			"draft":false,"merged":false,"mergeable":false,"head":{"ref":"feature/login","sha":"abc"},"base":{"ref":"main"},
+			"requested_reviewers":[{"login":"carol"}]}`,
+		"/repos/acme/app/pulls/7/reviews?per_page=100": `[
+			{"user":{"login":"alice"},"state":"CHANGES_REQUESTED"},
+			{"user":{"login":"alice"},"state":"APPROVED"},
+			{"user":{"login":"bob"},"state":"COMMENTED"}]`,
+		"/repos/acme/app/pulls/8": `{"number":8,"html_url":"https://github.com/acme/app/pull/8","state":"closed","merged":true,
+			"merged_at":"2026-03-01T10:00:00Z","closed_at":"2026-03-01T10:00:00Z","head":{"ref":"feature/x"},"base":{"ref":"main"}}`,
+		"/repos/acme/app/pulls/8/reviews?per_page=100": `[{"user":{"login":"alice"},"state":"CHANGES_REQUESTED"}]`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "github.com", Type: prprovider.TypeGitHub, APIURL: srv.URL, Token: "secret"}, "git@github.com:acme/app.git")
+
+	pr, err := p.GetPullRequest(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GetPullRequest failed: %v", err)
+	}
+	if pr.State != secondary.ProviderPRStateOpen || pr.ReviewDecision != "approved" || pr.Mergeable != "conflicting" || pr.HeadBranch != "feature/login" {
+		t.Errorf("got %+v", pr)
+	}
+	if got := api.headers[0].Get("Authorization"); got != "Bearer secret" {
+		t.Errorf("Authorization = %q, want Bearer secret", got)
+	}
+
+	merged, err := p.GetPullRequest(context.Background(), 8)
+	if err != nil {
+		t.Fatalf("GetPullRequest failed: %v", err)
+	}
+	if merged.State != secondary.ProviderPRStateMerged || merged.MergedAt != "2026-03-01T10:00:00Z" || merged.Mergeable != "" || merged.ReviewDecision != "changes_requested" {
+		t.Errorf("got %+v", merged)
+	}
+}
+
+func TestGitHub_FindPullRequest(t *testing.T) {
+	_, srv := newFakeAPI(t, map[string]string{
+		"/repos/acme/app/pulls?direction=desc&head=acme%3Afeature%2Flogin&per_page=1&sort=created&state=all": `[{"number":7}]`,
+		"/repos/acme/app/pulls?direction=desc&head=acme%3Afeature%2Fnone&per_page=1&sort=created&state=all":  `[]`,
+		"/repos/acme/app/pulls/7":                      `{"number":7,"state":"open","draft":true,"head":{"ref":"feature/login"}}`,
+		"/repos/acme/app/pulls/7/reviews?per_page=100": `[]`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "github.com", Type: prprovider.TypeGitHub, APIURL: srv.URL}, "https://github.com/acme/app")
+
+	pr, err := p.FindPullRequest(context.Background(), "feature/login")
+	if err != nil {
+		t.Fatalf("FindPullRequest failed: %v", err)
+	}
+	if pr == nil || pr.Number != 7 || !pr.Draft || pr.Mergeable != "unknown" || pr.ReviewDecision != "" {
+		t.Errorf("got %+v", pr)
+	}
+
+	none, err := p.FindPullRequest(context.Background(), "feature/none")
+	if err != nil || none != nil {
+		t.Errorf("expected nil, nil for a branch without PRs, got %+v, %v", none, err)
+	}
+}
+
+func TestGitHub_ErrorStatus(t *testing.T) {
+	_, srv := newFakeAPI(t, map[string]string{})
+	p := providerFor(t, prprovider.Host{Host: "github.com", Type: prprovider.TypeGitHub, APIURL: srv.URL}, "https://github.com/acme/app")
+
+	_, err := p.GetPullRequest(context.Background(), 99)
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected a 404 error, got %v", err)
+	}
+}
+
+func TestGitLab_GetPullRequest(t *testing.T) {
+	api, srv := newFakeAPI(t, map[string]string{
+		"/projects/group%2Fsub%2Fapp/merge_requests/3": `{"iid":3,"web_url":"https://gitlab.com/group/sub/app/-/merge_requests/3","state":"opened",
+			"draft":false,"has_conflicts":false,"detailed_merge_status":"not_approved","source_branch":"feature/login","target_branch":"main"}`,
+		"/projects/group%2Fsub%2Fapp/merge_requests/3/approvals": `{"approvals_left":1,"approved_by":[{"user":{"username":"alice"}}]}`,
+		"/projects/group%2Fsub%2Fapp/merge_requests/4":           `{"iid":4,"state":"merged","merged_at":"2026-03-01T10:00:00.123Z","source_branch":"feature/x"}`,
+		"/projects/group%2Fsub%2Fapp/merge_requests/4/approvals": `{"approvals_left":0,"approved_by":[{"user":{"username":"alice"}}]}`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "gitlab.com", Type: prprovider.TypeGitLab, APIURL: srv.URL, Token: "glpat"}, "https://gitlab.com/group/sub/app.git")
+
+	pr, err := p.GetPullRequest(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("GetPullRequest failed: %v", err)
+	}
+	if pr.State != secondary.ProviderPRStateOpen || pr.ReviewDecision != "review_required" || pr.Mergeable != "mergeable" {
+		t.Errorf("got %+v", pr)
+	}
+	if got := api.headers[0].Get("PRIVATE-TOKEN"); got != "glpat" {
+		t.Errorf("PRIVATE-TOKEN = %q, want glpat", got)
+	}
+
+	merged, err := p.GetPullRequest(context.Background(), 4)
+	if err != nil {
+		t.Fatalf("GetPullRequest failed: %v", err)
+	}
+	if merged.State != secondary.ProviderPRStateMerged || merged.MergedAt != "2026-03-01T10:00:00Z" || merged.ReviewDecision != "approved" {
+		t.Errorf("got %+v", merged)
+	}
+}
+
+func TestGitLab_FindPullRequest(t *testing.T) {
+	_, srv := newFakeAPI(t, map[string]string{
+		"/projects/acme%2Fapp/merge_requests?order_by=created_at&per_page=1&sort=desc&source_branch=feature%2Flogin&state=all": `[{"iid":3}]`,
+		"/projects/acme%2Fapp/merge_requests/3":           `{"iid":3,"state":"opened","draft":true,"has_conflicts":true}`,
+		"/projects/acme%2Fapp/merge_requests/3/approvals": `{"approvals_left":0,"approved_by":[]}`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "gitlab.com", Type: prprovider.TypeGitLab, APIURL: srv.URL}, "git@gitlab.com:acme/app.git")
+
+	pr, err := p.FindPullRequest(context.Background(), "feature/login")
+	if err != nil {
+		t.Fatalf("FindPullRequest failed: %v", err)
+	}
+	if pr == nil || pr.Number != 3 || !pr.Draft || pr.Mergeable != "conflicting" || pr.ReviewDecision != "" {
+		t.Errorf("got %+v", pr)
+	}
+}
+
+func TestGitea_GetAndFindPullRequest(t *testing.T) {
+	api, srv := newFakeAPI(t, map[string]string{
+		"/repos/acme/app/pulls?limit=50&sort=recentupdate&state=all": `[
+			{"number":5,"head":{"ref":"feature/other"}},
+			{"number":2,"head":{"ref":"feature/login"}}]`,
+		"/repos/acme/app/pulls/2": `{"number":2,"html_url":"https://git.example.com/acme/app/pulls/2","title":"WIP: login","state":"open",
+			"merged":false,"mergeable":true,"head":{"ref":"feature/login"},"base":{"ref":"main"}}`,
+		"/repos/acme/app/pulls/2/reviews": `[
+			{"user":{"login":"alice"},"state":"REQUEST_CHANGES","dismissed":true},
+			{"user":{"login":"bob"},"state":"APPROVED"}]`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "git.example.com", Type: prprovider.TypeGitea, APIURL: srv.URL, Token: "tea"}, "ssh://git@git.example.com:2222/acme/app.git")
+
+	pr, err := p.FindPullRequest(context.Background(), "feature/login")
+	if err != nil {
+		t.Fatalf("FindPullRequest failed: %v", err)
+	}
+	if pr == nil || pr.Number != 2 || !pr.Draft || pr.ReviewDecision != "approved" || pr.Mergeable != "mergeable" {
+		t.Errorf("got %+v", pr)
+	}
+	if got := api.headers[0].Get("Authorization"); got != "token tea" {
+		t.Errorf("Authorization = %q, want token tea", got)
+	}
+
+	none, err := p.FindPullRequest(context.Background(), "feature/none")
+	if err != nil || none != nil {
+		t.Errorf("expected nil, nil for a branch without PRs, got %+v, %v", none, err)
+	}
+}
+
+func TestGitHub_CreatePullRequest(t *testing.T) {
+	api, srv := newFakeAPI(t, map[string]string{
+		"POST /repos/acme/app/pulls":                       `{"number":9,"html_url":"https://github.com/acme/app/pull/9","state":"open","draft":true,"head":{"ref":"feature/login"},"base":{"ref":"main"}}`,
+		"POST /repos/acme/app/issues/9/labels":             `[]`,
+		"POST /repos/acme/app/pulls/9/requested_reviewers": `{}`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "github.com", Type: prprovider.TypeGitHub, APIURL: srv.URL}, "https://github.com/acme/app")
+
+	pr, err := p.CreatePullRequest(context.Background(), secondary.ProviderPRCreate{
+		Title: "Add login", Body: "spec", HeadBranch: "feature/login", BaseBranch: "main", Draft: true,
+		Labels: []string{"orc"}, Reviewers: []string{"alice"},
+	})
+	if err != nil {
+		t.Fatalf("CreatePullRequest failed: %v", err)
+	}
+	if pr.Number != 9 || pr.URL != "https://github.com/acme/app/pull/9" || !pr.Draft || pr.ReviewDecision != "review_required" {
+		t.Errorf("got %+v", pr)
+	}
+	if body := api.bodies["POST /repos/acme/app/pulls"]; body["head"] != "feature/login" || body["base"] != "main" || body["draft"] != true {
+		t.Errorf("create body = %v", body)
+	}
+	if body := api.bodies["POST /repos/acme/app/issues/9/labels"]; body == nil {
+		t.Error("labels were not added")
+	}
+}
+
+func TestGitHub_CreatePullRequestPartialFailure(t *testing.T) {
+	_, srv := newFakeAPI(t, map[string]string{
+		"POST /repos/acme/app/pulls": `{"number":9,"html_url":"https://github.com/acme/app/pull/9","state":"open"}`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "github.com", Type: prprovider.TypeGitHub, APIURL: srv.URL}, "https://github.com/acme/app")
+
+	pr, err := p.CreatePullRequest(context.Background(), secondary.ProviderPRCreate{Title: "x", HeadBranch: "b", BaseBranch: "main", Labels: []string{"orc"}})
+	if err == nil {
+		t.Fatal("expected the label failure to be reported")
+	}
+	if pr == nil || pr.Number != 9 {
+		t.Errorf("the opened PR should be returned with the error, got %+v", pr)
+	}
+}
+
+func TestGitLab_CreatePullRequest(t *testing.T) {
+	api, srv := newFakeAPI(t, map[string]string{
+		"/users?username=alice":                    `[{"id":42}]`,
+		"/users?username=nobody":                   `[]`,
+		"POST /projects/acme%2Fapp/merge_requests": `{"iid":4,"web_url":"https://gitlab.com/acme/app/-/merge_requests/4","state":"opened","draft":true}`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "gitlab.com", Type: prprovider.TypeGitLab, APIURL: srv.URL}, "https://gitlab.com/acme/app")
+
+	pr, err := p.CreatePullRequest(context.Background(), secondary.ProviderPRCreate{
+		Title: "Add login", HeadBranch: "feature/login", BaseBranch: "main", Draft: true,
+		Labels: []string{"orc", "backend"}, Reviewers: []string{"alice"},
+	})
+	if err != nil {
+		t.Fatalf("CreatePullRequest failed: %v", err)
+	}
+	if pr.Number != 4 || !pr.Draft || pr.ReviewDecision != "review_required" {
+		t.Errorf("got %+v", pr)
+	}
+	body := api.bodies["POST /projects/acme%2Fapp/merge_requests"]
+	if body["title"] != "Draft: Add login" || body["labels"] != "orc,backend" || body["source_branch"] != "feature/login" {
+		t.Errorf("create body = %v", body)
+	}
+
+	if _, err := p.CreatePullRequest(context.Background(), secondary.ProviderPRCreate{Title: "x", Reviewers: []string{"nobody"}}); err == nil {
+		t.Error("expected error for an unknown reviewer")
+	}
+}
+
+func TestGitea_CreatePullRequest(t *testing.T) {
+	api, srv := newFakeAPI(t, map[string]string{
+		"/repos/acme/app/labels?limit=100":                 `[{"id":3,"name":"orc"}]`,
+		"POST /repos/acme/app/pulls":                       `{"number":6,"html_url":"https://git.example.com/acme/app/pulls/6","title":"WIP: Add login","state":"open","mergeable":true}`,
+		"POST /repos/acme/app/pulls/6/requested_reviewers": `[]`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "git.example.com", Type: prprovider.TypeGitea, APIURL: srv.URL}, "https://git.example.com/acme/app")
+
+	pr, err := p.CreatePullRequest(context.Background(), secondary.ProviderPRCreate{
+		Title: "Add login", HeadBranch: "feature/login", BaseBranch: "main", Draft: true,
+		Labels: []string{"ORC"}, Reviewers: []string{"bob"},
+	})
+	if err != nil {
+		t.Fatalf("CreatePullRequest failed: %v", err)
+	}
+	if pr.Number != 6 || !pr.Draft {
+		t.Errorf("got %+v", pr)
+	}
+	if body := api.bodies["POST /repos/acme/app/pulls"]; body["title"] != "WIP: Add login" || len(body["labels"].([]any)) != 1 {
+		t.Errorf("create body = %v", body)
+	}
+
+	if _, err := p.CreatePullRequest(context.Background(), secondary.ProviderPRCreate{Title: "x", Labels: []string{"missing"}}); err == nil {
+		t.Error("expected error for an unknown label")
+	}
+}
+
+func TestGitHub_ReviewThreads(t *testing.T) {
+	api, srv := newFakeAPI(t, map[string]string{
+		"POST /graphql": `{"data":{"repository":{"pullRequest":{"reviewThreads":{"nodes":[
+			{"id":"PRRT_1","isResolved":false,"isOutdated":false,"path":"auth.go","line":42,"originalLine":40,
+			 "comments":{"nodes":[{"url":"https://github.com/acme/app/pull/7#discussion_r1","body":"Check the error","createdAt":"2026-03-01T10:00:00Z","author":{"login":"bob"}},
+			                      {"url":"https://github.com/acme/app/pull/7#discussion_r2","body":"Done?","author":null}]}},
+			{"id":"PRRT_2","isResolved":true,"isOutdated":true,"path":"old.go","line":null,"originalLine":3,"comments":{"nodes":[]}}
+		]}}}}}`,
+		"POST /api/graphql": `{"data":{"resolveReviewThread":{"thread":{"id":"PRRT_1"}}}}`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "github.com", Type: prprovider.TypeGitHub, APIURL: srv.URL}, "https://github.com/acme/app")
+	ctx := context.Background()
+
+	threads, err := p.ListReviewThreads(ctx, 7)
+	if err != nil {
+		t.Fatalf("ListReviewThreads failed: %v", err)
+	}
+	if len(threads) != 2 {
+		t.Fatalf("got %d threads, want 2", len(threads))
+	}
+	first := threads[0]
+	if first.ID != "PRRT_1" || first.Path != "auth.go" || first.Line != 42 || first.Resolved || len(first.Comments) != 2 {
+		t.Errorf("threads[0] = %+v", first)
+	}
+	if c := first.Comments[0]; c.Author != "bob" || c.Body != "Check the error" || c.CreatedAt != "2026-03-01T10:00:00Z" || !strings.HasSuffix(c.URL, "#discussion_r1") {
+		t.Errorf("first comment = %+v", c)
+	}
+	if first.Comments[1].Author != "" {
+		t.Errorf("deleted author = %q, want empty", first.Comments[1].Author)
+	}
+	if second := threads[1]; !second.Resolved || !second.Outdated || second.Line != 3 {
+		t.Errorf("threads[1] = %+v", second)
+	}
+	if vars, _ := api.bodies["POST /graphql"]["variables"].(map[string]any); vars["owner"] != "acme" || vars["number"] != float64(7) {
+		t.Errorf("variables = %v", vars)
+	}
+
+	// GitHub Enterprise serves GraphQL at /api/graphql next to the /api/v3 REST root
+	ghe := providerFor(t, prprovider.Host{Host: "ghe.example.com", Type: prprovider.TypeGitHub, APIURL: srv.URL + "/api/v3"}, "git@ghe.example.com:acme/app.git")
+	if err := ghe.SetReviewThreadResolved(ctx, 7, "PRRT_1", true); err != nil {
+		t.Fatalf("SetReviewThreadResolved failed: %v", err)
+	}
+	body := api.bodies["POST /api/graphql"]
+	if q, _ := body["query"].(string); !strings.Contains(q, "resolveReviewThread") || strings.Contains(q, "unresolve") {
+		t.Errorf("mutation = %q", q)
+	}
+}
+
+func TestGitLab_ReviewThreads(t *testing.T) {
+	api, srv := newFakeAPI(t, map[string]string{
+		"/projects/acme%2Fapp/merge_requests/4": `{"iid":4,"web_url":"https://gitlab.com/acme/app/-/merge_requests/4","state":"opened"}`,
+		"/projects/acme%2Fapp/merge_requests/4/discussions?per_page=100": `[
+			{"id":"d1","notes":[{"id":11,"body":"Rename this","author":{"username":"carol"},"resolvable":true,"resolved":false,"position":{"new_path":"a.go","new_line":8}},
+			                    {"id":12,"body":"ok","author":{"username":"dave"},"resolvable":true,"resolved":false}]},
+			{"id":"d2","notes":[{"id":21,"body":"added 1 commit","system":true,"resolvable":false}]},
+			{"id":"d3","notes":[{"id":31,"body":"Looks good","author":{"username":"carol"},"resolvable":false}]},
+			{"id":"d4","notes":[{"id":41,"body":"Drop this","author":{"username":"carol"},"resolvable":true,"resolved":true,"position":{"old_path":"b.go","new_path":"b.go","old_line":5}}]}
+		]`,
+		"PUT /projects/acme%2Fapp/merge_requests/4/discussions/d1": `{}`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "gitlab.com", Type: prprovider.TypeGitLab, APIURL: srv.URL}, "https://gitlab.com/acme/app")
+	ctx := context.Background()
+
+	threads, err := p.ListReviewThreads(ctx, 4)
+	if err != nil {
+		t.Fatalf("ListReviewThreads failed: %v", err)
+	}
+	if len(threads) != 2 {
+		t.Fatalf("got %d threads, want the 2 resolvable ones", len(threads))
+	}
+	if th := threads[0]; th.ID != "d1" || th.Path != "a.go" || th.Line != 8 || th.Resolved || len(th.Comments) != 2 || th.Comments[0].URL != "https://gitlab.com/acme/app/-/merge_requests/4#note_11" {
+		t.Errorf("threads[0] = %+v", th)
+	}
+	if th := threads[1]; th.ID != "d4" || th.Line != 5 || !th.Resolved {
+		t.Errorf("threads[1] = %+v", th)
+	}
+
+	if err := p.SetReviewThreadResolved(ctx, 4, "d1", true); err != nil {
+		t.Fatalf("SetReviewThreadResolved failed: %v", err)
+	}
+	if body := api.bodies["PUT /projects/acme%2Fapp/merge_requests/4/discussions/d1"]; body["resolved"] != true {
+		t.Errorf("resolve body = %v", body)
+	}
+}
+
+func TestGitea_ReviewThreads(t *testing.T) {
+	_, srv := newFakeAPI(t, map[string]string{
+		"/api/v1/repos/acme/app/pulls/3/reviews": `[{"id":1,"state":"REQUEST_CHANGES"},{"id":2,"state":"PENDING"},{"id":3,"state":"COMMENT"}]`,
+		"/api/v1/repos/acme/app/pulls/3/reviews/1/comments": `[
+			{"id":101,"body":"Missing test","user":{"login":"erin"},"path":"a.go","position":10,"html_url":"https://git.example.com/acme/app/pulls/3#issuecomment-101"},
+			{"id":102,"body":"Typo","user":{"login":"erin"},"path":"b.go","position":0,"original_position":4,"resolver":{"login":"erin"}}
+		]`,
+		"/api/v1/repos/acme/app/pulls/3/reviews/3/comments": `[{"id":103,"body":"Added","user":{"login":"frank"},"path":"a.go","position":10}]`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "git.example.com", Type: prprovider.TypeGitea, APIURL: srv.URL + "/api/v1"}, "https://git.example.com/acme/app")
+	ctx := context.Background()
+
+	threads, err := p.ListReviewThreads(ctx, 3)
+	if err != nil {
+		t.Fatalf("ListReviewThreads failed: %v", err)
+	}
+	if len(threads) != 2 {
+		t.Fatalf("got %d threads, want 2", len(threads))
+	}
+	if th := threads[0]; th.ID != "101" || th.Path != "a.go" || th.Line != 10 || th.Resolved || len(th.Comments) != 2 {
+		t.Errorf("threads[0] = %+v", th)
+	}
+	if th := threads[1]; th.ID != "102" || th.Line != 4 || !th.Resolved {
+		t.Errorf("threads[1] = %+v", th)
+	}
+
+	if err := p.SetReviewThreadResolved(ctx, 3, "101", true); !errors.Is(err, errors.ErrUnsupported) {
+		t.Errorf("SetReviewThreadResolved error = %v, want ErrUnsupported", err)
+	}
+}
+
+func TestGitHub_Checks(t *testing.T) {
+	_, srv := newFakeAPI(t, map[string]string{
+		"POST /graphql": `{"data":{"repository":{"pullRequest":{"commits":{"nodes":[{"commit":{"statusCheckRollup":{"contexts":{"nodes":[
+			{"__typename":"CheckRun","name":"test","status":"COMPLETED","conclusion":"FAILURE","detailsUrl":"https://ci/1","startedAt":"2026-03-01T10:00:00Z","completedAt":"2026-03-01T10:05:00Z","isRequired":true},
+			{"__typename":"CheckRun","name":"lint","status":"IN_PROGRESS","conclusion":null,"detailsUrl":"https://ci/2","startedAt":"2026-03-01T10:00:00Z","isRequired":false},
+			{"__typename":"StatusContext","context":"ci/legacy","state":"SUCCESS","targetUrl":"https://ci/3","createdAt":"2026-03-01T09:00:00Z","isRequired":true}
+		]}}}}]}}}}}`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "github.com", Type: prprovider.TypeGitHub, APIURL: srv.URL}, "https://github.com/acme/app")
+
+	checks, err := p.ListChecks(context.Background(), 7, "abc123")
+	if err != nil {
+		t.Fatalf("ListChecks failed: %v", err)
+	}
+	if len(checks) != 3 {
+		t.Fatalf("got %d checks, want 3", len(checks))
+	}
+	if c := checks[0]; c.Name != "test" || c.Status != "completed" || c.Conclusion != "failure" || !c.Required || c.UpdatedAt != "2026-03-01T10:05:00Z" || c.URL != "https://ci/1" {
+		t.Errorf("checks[0] = %+v", c)
+	}
+	if c := checks[1]; c.Status != "in_progress" || c.Conclusion != "" || c.Required || c.UpdatedAt != "2026-03-01T10:00:00Z" {
+		t.Errorf("checks[1] = %+v", c)
+	}
+	if c := checks[2]; c.Name != "ci/legacy" || c.Status != "completed" || c.Conclusion != "success" || c.URL != "https://ci/3" {
+		t.Errorf("checks[2] = %+v", c)
+	}
+}
+
+func TestGitLab_Checks(t *testing.T) {
+	_, srv := newFakeAPI(t, map[string]string{
+		"/projects/acme%2Fapp/merge_requests/4/pipelines": `[{"id":9,"sha":"new"},{"id":8,"sha":"abc123"}]`,
+		"/projects/acme%2Fapp/pipelines/8/jobs?per_page=100": `[
+			{"name":"test","status":"failed","web_url":"https://gitlab.com/j/1","allow_failure":false,"finished_at":"2026-03-01T10:05:00Z"},
+			{"name":"flaky","status":"failed","allow_failure":true},
+			{"name":"deploy","status":"manual","allow_failure":false},
+			{"name":"build","status":"pending","created_at":"2026-03-01T10:00:00Z"}
+		]`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "gitlab.com", Type: prprovider.TypeGitLab, APIURL: srv.URL}, "https://gitlab.com/acme/app")
+
+	checks, err := p.ListChecks(context.Background(), 4, "abc123")
+	if err != nil {
+		t.Fatalf("ListChecks failed: %v", err)
+	}
+	if len(checks) != 4 {
+		t.Fatalf("got %d checks, want the 4 jobs of the head pipeline", len(checks))
+	}
+	if c := checks[0]; c.Name != "test" || c.Conclusion != "failure" || !c.Required || c.UpdatedAt != "2026-03-01T10:05:00Z" {
+		t.Errorf("checks[0] = %+v", c)
+	}
+	if c := checks[1]; c.Conclusion != "failure" || c.Required {
+		t.Errorf("allowed failure = %+v", c)
+	}
+	if c := checks[2]; c.Status != "completed" || c.Conclusion != "action_required" {
+		t.Errorf("manual job = %+v", c)
+	}
+	if c := checks[3]; c.Status != "queued" || c.UpdatedAt != "2026-03-01T10:00:00Z" {
+		t.Errorf("pending job = %+v", c)
+	}
+
+	// No pipeline for the head commit yet
+	checks, err = p.ListChecks(context.Background(), 4, "unknown")
+	if err != nil || len(checks) != 0 {
+		t.Errorf("ListChecks without pipeline = %v, %v", checks, err)
+	}
+}
+
+func TestGitea_Checks(t *testing.T) {
+	_, srv := newFakeAPI(t, map[string]string{
+		"/repos/acme/app/commits/abc123/status": `{"state":"failure","statuses":[
+			{"context":"ci/test","status":"error","target_url":"https://ci/1","updated_at":"2026-03-01T10:05:00Z"},
+			{"context":"ci/lint","status":"pending"}
+		]}`,
+	})
+	p := providerFor(t, prprovider.Host{Host: "git.example.com", Type: prprovider.TypeGitea, APIURL: srv.URL}, "https://git.example.com/acme/app")
+
+	checks, err := p.ListChecks(context.Background(), 3, "abc123")
+	if err != nil {
+		t.Fatalf("ListChecks failed: %v", err)
+	}
+	if len(checks) != 2 {
+		t.Fatalf("got %d checks, want 2", len(checks))
+	}
+	if c := checks[0]; c.Name != "ci/test" || c.Status != "completed" || c.Conclusion != "failure" || !c.Required || c.URL != "https://ci/1" {
+		t.Errorf("checks[0] = %+v", c)
+	}
+	if c := checks[1]; c.Status != "queued" || c.Conclusion != "" {
+		t.Errorf("checks[1] = %+v", c)
+	}
+}
+
+func TestUpdatePullRequestBase(t *testing.T) {
+	tests := []struct {
+		name   string
+		typ    string
+		host   string
+		route  string
+		field  string
+		remote string
+	}{
+		{"github", prprovider.TypeGitHub, "github.com", "PATCH /repos/acme/app/pulls/7", "base", "https://github.com/acme/app"},
+		{"gitlab", prprovider.TypeGitLab, "gitlab.com", "PUT /projects/acme%2Fapp/merge_requests/7", "target_branch", "https://gitlab.com/acme/app"},
+		{"gitea", prprovider.TypeGitea, "git.example.com", "PATCH /repos/acme/app/pulls/7", "base", "https://git.example.com/acme/app"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api, srv := newFakeAPI(t, map[string]string{tt.route: `{}`})
+			p := providerFor(t, prprovider.Host{Host: tt.host, Type: tt.typ, APIURL: srv.URL}, tt.remote)
+
+			if err := p.UpdatePullRequestBase(context.Background(), 7, "main"); err != nil {
+				t.Fatalf("UpdatePullRequestBase failed: %v", err)
+			}
+			if body := api.bodies[tt.route]; body[tt.field] != "main" {
+				t.Errorf("request body = %v, want %s=main", body, tt.field)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package prprovider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// Provider types.
+const (
+	TypeGitHub = "github"
+	TypeGitLab = "gitlab"
+	TypeGitea  = "gitea"
+)
+
+// Host describes how to reach the provider serving one remote host.
+type Host struct {
+	Host   string // Remote hostname, e.g., github.com
+	Type   string // TypeGitHub, TypeGitLab or TypeGitea
+	APIURL string // REST API root, e.g., https://api.github.com
+	Token  string // Empty means anonymous access
+}
+
+// Registry implements secondary.PRProviders by matching a remote's host against
+// the configured hosts.
+type Registry struct {
+	hosts  map[string]Host
+	client *http.Client
+}
+
+// NewRegistry creates a provider registry for the given hosts.
+func NewRegistry(hosts []Host) *Registry {
+	r := &Registry{
+		hosts:  make(map[string]Host, len(hosts)),
+		client: &http.Client{Timeout: defaultTimeout},
+	}
+	for _, h := range hosts {
+		r.hosts[strings.ToLower(h.Host)] = h
+	}
+	return r
+}
+
+// ForRemote returns the provider for the repository at remoteURL.
+func (r *Registry) ForRemote(remoteURL string) (secondary.PRProvider, error) {
+	host, path, err := ParseRemoteURL(remoteURL)
+	if err != nil {
+		return nil, err
+	}
+	h, ok := r.hosts[host]
+	if !ok {
+		return nil, fmt.Errorf("no PR provider configured for %s (add it to pr.json)", host)
+	}
+
+	switch h.Type {
+	case TypeGitHub:
+		owner, name, err := splitOwner(path)
+		if err != nil {
+			return nil, err
+		}
+		return &GitHub{client: client{http: r.client, baseURL: h.APIURL, header: "Authorization", token: authValue("Bearer ", h.Token)}, owner: owner, repo: name}, nil
+	case TypeGitLab:
+		return &GitLab{client: client{http: r.client, baseURL: h.APIURL, header: "PRIVATE-TOKEN", token: h.Token}, project: path}, nil
+	case TypeGitea:
+		owner, name, err := splitOwner(path)
+		if err != nil {
+			return nil, err
+		}
+		return &Gitea{client: client{http: r.client, baseURL: h.APIURL, header: "Authorization", token: authValue("token ", h.Token)}, owner: owner, repo: name}, nil
+	}
+	return nil, fmt.Errorf("unknown PR provider type %q for %s", h.Type, host)
+}
+
+// ParseRemoteURL splits a git remote into its lowercase hostname (without port)
+// and repository path (e.g., "owner/repo" or "group/subgroup/project").
+// Accepts https://, ssh://, git:// and scp-like git@host:owner/repo.git remotes.
+func ParseRemoteURL(remote string) (host, path string, err error) {
+	remote = strings.TrimSpace(remote)
+	if remote == "" {
+		return "", "", fmt.Errorf("repository has no remote URL")
+	}
+
+	if strings.Contains(remote, "://") {
+		u, err := url.Parse(remote)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid remote URL %q: %w", remote, err)
+		}
+		host, path = u.Hostname(), u.Path
+	} else {
+		// scp-like: [user@]host:path
+		at := strings.LastIndex(remote, "@")
+		colon := strings.Index(remote, ":")
+		if colon < 0 || colon < at {
+			return "", "", fmt.Errorf("unsupported remote URL %q", remote)
+		}
+		host, path = remote[at+1:colon], remote[colon+1:]
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if host == "" || !strings.Contains(path, "/") {
+		return "", "", fmt.Errorf("remote URL %q does not name a hosted repository", remote)
+	}
+	return strings.ToLower(host), path, nil
+}
+
+// splitOwner splits an owner/repo path; GitHub and Gitea have no nested groups.
+func splitOwner(path string) (owner, repo string, err error) {
+	owner, repo, ok := strings.Cut(path, "/")
+	if !ok || strings.Contains(repo, "/") {
+		return "", "", fmt.Errorf("repository path %q is not owner/repo", path)
+	}
+	return owner, repo, nil
+}
+
+// authValue prefixes a token for an Authorization header; no token means anonymous.
+func authValue(scheme, token string) string {
+	if token == "" {
+		return ""
+	}
+	return scheme + token
+}
+
+// Ensure Registry implements the interface
+var _ secondary.PRProviders = (*Registry)(nil)
@@ -0,0 +1,276 @@
+// Package sqlite contains SQLite implementations of repository interfaces.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ArchiveRepository implements secondary.ArchiveRepository with two SQLite databases:
+// the main ledger and an archive ledger sharing the same schema.
+type ArchiveRepository struct {
+	db        *sql.DB
+	archiveDB *sql.DB
+	shipments *ShipmentRepository // Reads against the archive ledger
+	tasks     *TaskRepository     // Reads against the archive ledger
+}
+
+// NewArchiveRepository creates a new SQLite archive repository.
+func NewArchiveRepository(db, archiveDB *sql.DB) *ArchiveRepository {
+	return &ArchiveRepository{
+		db:        db,
+		archiveDB: archiveDB,
+		shipments: NewShipmentRepository(archiveDB, nil),
+		tasks:     NewTaskRepository(archiveDB, nil),
+	}
+}
+
+// newestIDQuery selects the row holding the highest sequential ID in a table.
+// Mirrors the MAX+1 allocation used by the GetNextID methods.
+func newestIDQuery(table string, prefixLen int) string {
+	return fmt.Sprintf("(SELECT id FROM %s ORDER BY CAST(SUBSTR(id, %d) AS INTEGER) DESC LIMIT 1)", table, prefixLen+1)
+}
+
+// ListArchiveCandidates returns closed shipments completed more than the given number of days ago.
+func (r *ArchiveRepository) ListArchiveCandidates(ctx context.Context, olderThanDays int) ([]*secondary.ArchiveCandidateRecord, error) {
+	query := `SELECT s.id, s.status, s.pinned, COALESCE(s.completed_at, s.updated_at),
+		EXISTS (SELECT 1 FROM prs p WHERE p.shipment_id = s.id AND p.status IN ('draft', 'open', 'approved')),
+		s.id = ` + newestIDQuery("shipments", 5) + `
+			OR EXISTS (SELECT 1 FROM tasks t WHERE t.shipment_id = s.id AND t.id = ` + newestIDQuery("tasks", 5) + `)
+			OR EXISTS (SELECT 1 FROM notes n WHERE n.shipment_id = s.id AND n.id = ` + newestIDQuery("notes", 5) + `)
+			OR EXISTS (SELECT 1 FROM plans pl JOIN tasks t ON pl.task_id = t.id WHERE t.shipment_id = s.id AND pl.id = ` + newestIDQuery("plans", 5) + `)
+			OR EXISTS (SELECT 1 FROM prs p WHERE p.shipment_id = s.id AND p.id = ` + newestIDQuery("prs", 3) + `)
+		FROM shipments s
+		WHERE s.status = 'closed' AND COALESCE(s.completed_at, s.updated_at) < datetime('now', ?)
+		ORDER BY s.id`
+
+	rows, err := r.db.QueryContext(ctx, query, fmt.Sprintf("-%d days", olderThanDays))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archive candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []*secondary.ArchiveCandidateRecord
+	for rows.Next() {
+		var (
+			record      secondary.ArchiveCandidateRecord
+			completedAt string
+		)
+		if err := rows.Scan(&record.ShipmentID, &record.Status, &record.Pinned, &completedAt, &record.HasOpenPR, &record.HoldsNewestID); err != nil {
+			return nil, fmt.Errorf("failed to scan archive candidate: %w", err)
+		}
+		record.CompletedAt = completedAt
+		candidates = append(candidates, &record)
+	}
+
+	return candidates, rows.Err()
+}
+
+// ArchiveShipment copies a shipment with its tasks, plans, notes, and PR into the archive,
+// then deletes them from the main database.
+// The archive is written first with INSERT OR REPLACE, so a failure between the two steps
+// leaves the main ledger intact and a rerun is safe.
+func (r *ArchiveRepository) ArchiveShipment(ctx context.Context, shipmentID string) (*secondary.ArchivedCountsRecord, error) {
+	var commissionID string
+	err := r.db.QueryRowContext(ctx, "SELECT commission_id FROM shipments WHERE id = ?", shipmentID).Scan(&commissionID)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("shipment %s not found", shipmentID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipment: %w", err)
+	}
+
+	taskFilter := "task_id IN (SELECT id FROM tasks WHERE shipment_id = ?)"
+	tagFilter := "entity_id = ? OR entity_id IN (SELECT id FROM tasks WHERE shipment_id = ?)"
+	commitFilter := "shipment_id = ? OR task_id IN (SELECT id FROM tasks WHERE shipment_id = ?)"
+	prFilter := "pr_id IN (SELECT id FROM prs WHERE shipment_id = ?)"
+
+	// Step 1: copy into the archive. Parent rows first so the archive reads like the main ledger.
+	src, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer src.Rollback() //nolint:errcheck
+
+	dst, err := r.archiveDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin archive transaction: %w", err)
+	}
+	defer dst.Rollback() //nolint:errcheck
+
+	counts := &secondary.ArchivedCountsRecord{}
+	copies := []struct {
+		table string
+		where string
+		args  []any
+		count *int
+	}{
+		{"commissions", "id = ?", []any{commissionID}, nil},
+		{"shipments", "id = ?", []any{shipmentID}, nil},
+		{"shipment_repos", "shipment_id = ?", []any{shipmentID}, nil},
+		{"tasks", "shipment_id = ?", []any{shipmentID}, &counts.Tasks},
+		{"plans", taskFilter, []any{shipmentID}, &counts.Plans},
+		{"notes", "shipment_id = ?", []any{shipmentID}, &counts.Notes},
+		{"prs", "shipment_id = ?", []any{shipmentID}, &counts.PRs},
+		{"pr_review_threads", prFilter, []any{shipmentID}, nil},
+		{"entity_tags", tagFilter, []any{shipmentID, shipmentID}, nil},
+		{"commits", commitFilter, []any{shipmentID, shipmentID}, nil},
+	}
+	for _, c := range copies {
+		n, err := copyRows(ctx, src, dst, c.table, c.where, c.args...)
+		if err != nil {
+			return nil, err
+		}
+		if c.count != nil {
+			*c.count = n
+		}
+	}
+
+	if err := dst.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit archive: %w", err)
+	}
+	src.Rollback() //nolint:errcheck
+
+	// Step 2: delete from the main ledger. Children first so foreign keys hold
+	// whether or not they are enforced on this connection.
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	deletes := []struct {
+		query string
+		args  []any
+	}{
+		{"DELETE FROM entity_tags WHERE " + tagFilter, []any{shipmentID, shipmentID}},
+		{"DELETE FROM commits WHERE " + commitFilter, []any{shipmentID, shipmentID}},
+		{"DELETE FROM shipment_diff_files WHERE shipment_id = ?", []any{shipmentID}}, // Cached, not archived
+		{"DELETE FROM shipment_diff_stats WHERE shipment_id = ?", []any{shipmentID}},
+		{"DELETE FROM plans WHERE " + taskFilter, []any{shipmentID}},
+		{"DELETE FROM tasks WHERE shipment_id = ?", []any{shipmentID}},
+		{"DELETE FROM pr_review_threads WHERE " + prFilter, []any{shipmentID}},
+		{"DELETE FROM pr_checks WHERE " + prFilter, []any{shipmentID}}, // Synced from the provider, not archived
+		{"DELETE FROM prs WHERE shipment_id = ?", []any{shipmentID}},
+		{"DELETE FROM shipment_repos WHERE shipment_id = ?", []any{shipmentID}},
+		{"UPDATE notes SET closed_by_note_id = NULL WHERE closed_by_note_id IN (SELECT id FROM notes WHERE shipment_id = ?)", []any{shipmentID}},
+		{"DELETE FROM notes WHERE shipment_id = ?", []any{shipmentID}},
+		{"DELETE FROM shipments WHERE id = ?", []any{shipmentID}},
+	}
+	for _, d := range deletes {
+		if _, err := tx.ExecContext(ctx, d.query, d.args...); err != nil {
+			return nil, fmt.Errorf("failed to remove archived rows: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return counts, nil
+}
+
+// copyRows copies the matching rows from src to dst.
+// Columns are taken from the result set, so the copy follows schema changes automatically.
+// Only columns the archive table has are copied: archive.db is not migrated with the
+// main ledger unless make schema-apply-archive is run, and may lag behind schema.sql.
+func copyRows(ctx context.Context, src, dst *sql.Tx, table, where string, args ...any) (int, error) {
+	archived, err := tableColumns(ctx, dst, table)
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := src.QueryContext(ctx, fmt.Sprintf("SELECT * FROM %s WHERE %s", table, where), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s columns: %w", table, err)
+	}
+	var (
+		keep     []int
+		keptCols []string
+	)
+	for i, col := range cols {
+		if archived[col] {
+			keep = append(keep, i)
+			keptCols = append(keptCols, col)
+		}
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(keptCols)), ", ")
+	insert := fmt.Sprintf("INSERT OR REPLACE INTO %s (%s) VALUES (%s)", table, strings.Join(keptCols, ", "), placeholders)
+
+	count := 0
+	for rows.Next() {
+		values := make([]any, len(cols))
+		ptrs := make([]any, len(cols))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return 0, fmt.Errorf("failed to scan %s: %w", table, err)
+		}
+		kept := make([]any, len(keep))
+		for i, j := range keep {
+			kept[i] = values[j]
+			// Store timestamps in SQLite's own format so archived rows sort and compare like live ones
+			if ts, ok := values[j].(time.Time); ok {
+				kept[i] = ts.UTC().Format("2006-01-02 15:04:05")
+			}
+		}
+		if _, err := dst.ExecContext(ctx, insert, kept...); err != nil {
+			return 0, fmt.Errorf("failed to archive %s: %w", table, err)
+		}
+		count++
+	}
+
+	return count, rows.Err()
+}
+
+// tableColumns returns the column names of a table.
+func tableColumns(ctx context.Context, tx *sql.Tx, table string) (map[string]bool, error) {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT name FROM pragma_table_info('%s')", table))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive %s columns: %w", table, err)
+	}
+	defer rows.Close()
+
+	cols := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan archive %s columns: %w", table, err)
+		}
+		cols[name] = true
+	}
+	if len(cols) == 0 {
+		return nil, fmt.Errorf("archive has no %s table", table)
+	}
+	return cols, rows.Err()
+}
+
+// ListShipments retrieves archived shipments matching the given filters.
+func (r *ArchiveRepository) ListShipments(ctx context.Context, filters secondary.ShipmentFilters) ([]*secondary.ShipmentRecord, error) {
+	return r.shipments.List(ctx, filters)
+}
+
+// GetShipment retrieves an archived shipment by its ID.
+func (r *ArchiveRepository) GetShipment(ctx context.Context, id string) (*secondary.ShipmentRecord, error) {
+	return r.shipments.GetByID(ctx, id)
+}
+
+// GetShipmentTasks retrieves the archived tasks of a shipment.
+func (r *ArchiveRepository) GetShipmentTasks(ctx context.Context, shipmentID string) ([]*secondary.TaskRecord, error) {
+	return r.tasks.GetByShipment(ctx, shipmentID)
+}
+
+// Ensure ArchiveRepository implements the interface
+var _ secondary.ArchiveRepository = (*ArchiveRepository)(nil)
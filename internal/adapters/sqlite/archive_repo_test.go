@@ -0,0 +1,193 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// seedClosedShipment inserts a shipment closed the given number of days ago.
+func seedClosedShipment(t *testing.T, db *sql.DB, id string, daysAgo int) {
+	t.Helper()
+	seedShipment(t, db, id, "", "")
+	_, err := db.Exec("UPDATE shipments SET status = 'closed', completed_at = datetime('now', ?) WHERE id = ?",
+		fmt.Sprintf("-%d days", daysAgo), id)
+	if err != nil {
+		t.Fatalf("failed to close shipment: %v", err)
+	}
+}
+
+func TestArchiveRepository_ListArchiveCandidates(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewArchiveRepository(db, setupTestDB(t))
+	ctx := context.Background()
+
+	seedCommission(t, db, "", "")
+	seedClosedShipment(t, db, "SHIP-001", 90)
+	seedClosedShipment(t, db, "SHIP-002", 90)
+	db.Exec("UPDATE shipments SET pinned = 1 WHERE id = 'SHIP-002'")
+	seedClosedShipment(t, db, "SHIP-003", 5)
+	seedShipment(t, db, "SHIP-004", "", "")
+	seedClosedShipment(t, db, "SHIP-005", 90)
+
+	candidates, err := repo.ListArchiveCandidates(ctx, 60)
+	if err != nil {
+		t.Fatalf("ListArchiveCandidates failed: %v", err)
+	}
+
+	got := map[string]*secondary.ArchiveCandidateRecord{}
+	for _, c := range candidates {
+		got[c.ShipmentID] = c
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 candidates (SHIP-001, SHIP-002, SHIP-005), got %d", len(got))
+	}
+	if got["SHIP-001"].HoldsNewestID {
+		t.Error("SHIP-001 should not hold the newest ID")
+	}
+	if !got["SHIP-002"].Pinned {
+		t.Error("SHIP-002 should be reported as pinned")
+	}
+	if !got["SHIP-005"].HoldsNewestID {
+		t.Error("SHIP-005 should hold the newest shipment ID")
+	}
+}
+
+func TestArchiveRepository_ArchiveShipment(t *testing.T) {
+	db := setupTestDB(t)
+	archiveDB := setupTestDB(t)
+	repo := sqlite.NewArchiveRepository(db, archiveDB)
+	ctx := context.Background()
+
+	seedCommission(t, db, "", "")
+	seedClosedShipment(t, db, "SHIP-001", 90)
+	seedShipment(t, db, "SHIP-002", "", "")
+	seedTask(t, db, "TASK-001", "", "Archived task")
+	seedTask(t, db, "TASK-002", "", "Live task")
+	db.Exec("UPDATE tasks SET shipment_id = 'SHIP-001', status = 'closed' WHERE id = 'TASK-001'")
+	db.Exec("UPDATE tasks SET shipment_id = 'SHIP-002' WHERE id = 'TASK-002'")
+	db.Exec("INSERT INTO plans (id, commission_id, task_id, title) VALUES ('PLAN-001', 'COMM-001', 'TASK-001', 'Plan')")
+	db.Exec("INSERT INTO notes (id, commission_id, shipment_id, title) VALUES ('NOTE-001', 'COMM-001', 'SHIP-001', 'Spec')")
+	db.Exec("INSERT INTO notes (id, commission_id, title, closed_by_note_id) VALUES ('NOTE-002', 'COMM-001', 'Other', 'NOTE-001')")
+	db.Exec("INSERT INTO commits (sha, task_id, author, subject) VALUES ('abc123', 'TASK-001', 'A', 'Task commit')")
+	db.Exec("INSERT INTO shipment_diff_stats (shipment_id, base_ref, merge_base, head_commit) VALUES ('SHIP-001', 'main', 'aaa', 'bbb')")
+	db.Exec("INSERT INTO shipment_diff_files (shipment_id, path) VALUES ('SHIP-001', 'a.go')")
+	db.Exec("INSERT INTO repos (id, name) VALUES ('REPO-001', 'app')")
+	db.Exec("INSERT INTO prs (id, shipment_id, repo_id, commission_id, title, branch, status) VALUES ('PR-001', 'SHIP-001', 'REPO-001', 'COMM-001', 'PR', 'b', 'merged')")
+	db.Exec("INSERT INTO pr_review_threads (pr_id, thread_id, note_id) VALUES ('PR-001', 'T1', 'NOTE-001')")
+
+	counts, err := repo.ArchiveShipment(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("ArchiveShipment failed: %v", err)
+	}
+	if counts.Tasks != 1 || counts.Plans != 1 || counts.Notes != 1 || counts.PRs != 1 {
+		t.Errorf("counts = %+v, want 1 task, 1 plan, 1 note, 1 PR", counts)
+	}
+
+	// Main ledger no longer has the shipment or its children
+	for _, q := range []string{
+		"SELECT COUNT(*) FROM shipments WHERE id = 'SHIP-001'",
+		"SELECT COUNT(*) FROM tasks WHERE id = 'TASK-001'",
+		"SELECT COUNT(*) FROM plans WHERE id = 'PLAN-001'",
+		"SELECT COUNT(*) FROM notes WHERE id = 'NOTE-001'",
+		"SELECT COUNT(*) FROM notes WHERE closed_by_note_id = 'NOTE-001'",
+		"SELECT COUNT(*) FROM commits WHERE sha = 'abc123'",
+		"SELECT COUNT(*) FROM shipment_diff_stats WHERE shipment_id = 'SHIP-001'",
+		"SELECT COUNT(*) FROM shipment_diff_files WHERE shipment_id = 'SHIP-001'",
+		"SELECT COUNT(*) FROM pr_review_threads WHERE pr_id = 'PR-001'",
+	} {
+		var n int
+		db.QueryRow(q).Scan(&n)
+		if n != 0 {
+			t.Errorf("%s = %d, want 0", q, n)
+		}
+	}
+
+	// Unrelated rows stay put
+	var live int
+	db.QueryRow("SELECT COUNT(*) FROM tasks WHERE id = 'TASK-002'").Scan(&live)
+	if live != 1 {
+		t.Error("expected TASK-002 to remain in the main ledger")
+	}
+
+	// Archive ledger is queryable through the repository
+	shipment, err := repo.GetShipment(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("GetShipment failed: %v", err)
+	}
+	if shipment.Status != "closed" || shipment.CompletedAt == "" {
+		t.Errorf("archived shipment = %+v, want closed with completed_at", shipment)
+	}
+
+	tasks, err := repo.GetShipmentTasks(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("GetShipmentTasks failed: %v", err)
+	}
+	if len(tasks) != 1 || tasks[0].Title != "Archived task" {
+		t.Errorf("archived tasks = %v, want TASK-001", tasks)
+	}
+
+	list, err := repo.ListShipments(ctx, secondary.ShipmentFilters{CommissionID: "COMM-001"})
+	if err != nil {
+		t.Fatalf("ListShipments failed: %v", err)
+	}
+	if len(list) != 1 {
+		t.Errorf("expected 1 archived shipment, got %d", len(list))
+	}
+}
+
+func TestArchiveRepository_ArchiveShipment_OlderArchiveSchema(t *testing.T) {
+	db := setupTestDB(t)
+	archiveDB := setupTestDB(t)
+	repo := sqlite.NewArchiveRepository(db, archiveDB)
+	ctx := context.Background()
+
+	// An archive.db created before these columns were added to schema.sql
+	for _, q := range []string{
+		"ALTER TABLE tasks DROP COLUMN start_commit",
+		"ALTER TABLE tasks DROP COLUMN end_commit",
+		"ALTER TABLE prs DROP COLUMN synced_at",
+	} {
+		if _, err := archiveDB.Exec(q); err != nil {
+			t.Fatalf("%s: %v", q, err)
+		}
+	}
+
+	seedCommission(t, db, "", "")
+	seedClosedShipment(t, db, "SHIP-001", 90)
+	seedTask(t, db, "TASK-001", "", "Archived task")
+	db.Exec("UPDATE tasks SET shipment_id = 'SHIP-001', status = 'closed', start_commit = 'aaa', end_commit = 'bbb' WHERE id = 'TASK-001'")
+	db.Exec("INSERT INTO repos (id, name) VALUES ('REPO-001', 'app')")
+	db.Exec("INSERT INTO prs (id, shipment_id, repo_id, commission_id, title, branch, status, synced_at) VALUES ('PR-001', 'SHIP-001', 'REPO-001', 'COMM-001', 'PR', 'b', 'merged', CURRENT_TIMESTAMP)")
+
+	counts, err := repo.ArchiveShipment(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("ArchiveShipment failed: %v", err)
+	}
+	if counts.Tasks != 1 || counts.PRs != 1 {
+		t.Errorf("counts = %+v, want 1 task and 1 PR", counts)
+	}
+
+	var title string
+	if err := archiveDB.QueryRow("SELECT title FROM tasks WHERE id = 'TASK-001'").Scan(&title); err != nil || title != "Archived task" {
+		t.Errorf("expected TASK-001 in the archive, got %q (%v)", title, err)
+	}
+	var n int
+	db.QueryRow("SELECT COUNT(*) FROM tasks WHERE id = 'TASK-001'").Scan(&n)
+	if n != 0 {
+		t.Error("expected TASK-001 removed from the main ledger")
+	}
+}
+
+func TestArchiveRepository_ArchiveShipment_NotFound(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewArchiveRepository(db, setupTestDB(t))
+
+	if _, err := repo.ArchiveShipment(context.Background(), "SHIP-999"); err == nil {
+		t.Error("expected error for missing shipment")
+	}
+}
@@ -200,7 +200,7 @@ func (r *CommissionRepository) Update(ctx context.Context, commission *secondary
 	query += " WHERE id = ?"
 	args = append(args, commission.ID)
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update commission: %w", err)
 	}
@@ -257,6 +257,78 @@ func (r *CommissionRepository) CountShipments(ctx context.Context, commissionID
 	return count, nil
 }
 
+// GetRollup returns aggregate container and note counts for a commission.
+func (r *CommissionRepository) GetRollup(ctx context.Context, commissionID string) (*secondary.CommissionRollupRecord, error) {
+	rollup := &secondary.CommissionRollupRecord{}
+	var err error
+
+	rollup.ShipmentsByStatus, err = r.countGrouped(ctx,
+		"SELECT status, COUNT(*) FROM shipments WHERE commission_id = ? GROUP BY status", commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count shipments by status: %w", err)
+	}
+
+	rollup.TomesByStatus, err = r.countGrouped(ctx,
+		"SELECT status, COUNT(*) FROM tomes WHERE commission_id = ? GROUP BY status", commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tomes by status: %w", err)
+	}
+
+	rollup.OpenNotesByType, err = r.countGrouped(ctx,
+		"SELECT COALESCE(NULLIF(type, ''), 'untyped'), COUNT(*) FROM notes WHERE commission_id = ? AND status IN ('open', 'in_flight') GROUP BY 1", commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count open notes by type: %w", err)
+	}
+
+	// Last activity: newest update across the commission and everything it contains
+	var latest time.Time
+	for _, query := range []string{
+		"SELECT created_at FROM commissions WHERE id = ?",
+		"SELECT updated_at FROM commissions WHERE id = ?",
+		"SELECT updated_at FROM shipments WHERE commission_id = ? ORDER BY updated_at DESC LIMIT 1",
+		"SELECT updated_at FROM tomes WHERE commission_id = ? ORDER BY updated_at DESC LIMIT 1",
+		"SELECT updated_at FROM tasks WHERE commission_id = ? ORDER BY updated_at DESC LIMIT 1",
+		"SELECT updated_at FROM notes WHERE commission_id = ? ORDER BY updated_at DESC LIMIT 1",
+	} {
+		var ts sql.NullTime
+		err := r.conn(ctx).QueryRowContext(ctx, query, commissionID).Scan(&ts)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get last activity: %w", err)
+		}
+		if ts.Valid && ts.Time.After(latest) {
+			latest = ts.Time
+		}
+	}
+	if !latest.IsZero() {
+		rollup.LastActivity = latest.Format(time.RFC3339)
+	}
+
+	return rollup, nil
+}
+
+// countGrouped runs a "SELECT key, COUNT(*) ... GROUP BY key" query and returns the counts by key.
+func (r *CommissionRepository) countGrouped(ctx context.Context, query string, args ...any) (map[string]int, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var key string
+		var count int
+		if err := rows.Scan(&key, &count); err != nil {
+			return nil, err
+		}
+		counts[key] = count
+	}
+	return counts, rows.Err()
+}
+
 // Pin pins a commission to keep it visible.
 func (r *CommissionRepository) Pin(ctx context.Context, id string) error {
 	result, err := r.db.ExecContext(ctx,
@@ -400,3 +400,61 @@ func TestCommissionRepository_CountShipments(t *testing.T) {
 		t.Errorf("expected 2 shipments, got %d", count)
 	}
 }
+
+func TestCommissionRepository_GetRollup(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewCommissionRepository(db, nil)
+	ctx := context.Background()
+
+	commission := createTestCommission(t, repo, ctx, "Test", "")
+
+	_, _ = db.Exec("INSERT INTO shipments (id, commission_id, title, status) VALUES (?, ?, ?, ?)", "SHIP-001", commission.ID, "Ship 1", "in-progress")
+	_, _ = db.Exec("INSERT INTO shipments (id, commission_id, title, status) VALUES (?, ?, ?, ?)", "SHIP-002", commission.ID, "Ship 2", "closed")
+	_, _ = db.Exec("INSERT INTO shipments (id, commission_id, title, status) VALUES (?, ?, ?, ?)", "SHIP-003", commission.ID, "Ship 3", "closed")
+	_, _ = db.Exec("INSERT INTO tomes (id, commission_id, title, status) VALUES (?, ?, ?, ?)", "TOME-001", commission.ID, "Tome 1", "open")
+	_, _ = db.Exec("INSERT INTO notes (id, commission_id, title, type, status) VALUES (?, ?, ?, ?, ?)", "NOTE-001", commission.ID, "Idea", "idea", "open")
+	_, _ = db.Exec("INSERT INTO notes (id, commission_id, title, type, status) VALUES (?, ?, ?, ?, ?)", "NOTE-002", commission.ID, "Idea 2", "idea", "in_flight")
+	_, _ = db.Exec("INSERT INTO notes (id, commission_id, title, type, status) VALUES (?, ?, ?, ?, ?)", "NOTE-003", commission.ID, "Done", "bug", "closed")
+	_, _ = db.Exec("INSERT INTO notes (id, commission_id, title, status) VALUES (?, ?, ?, ?)", "NOTE-004", commission.ID, "Untyped", "open")
+	_, _ = db.Exec("UPDATE notes SET updated_at = ? WHERE id = ?", "2099-01-02 03:04:05", "NOTE-004")
+
+	rollup, err := repo.GetRollup(ctx, commission.ID)
+	if err != nil {
+		t.Fatalf("GetRollup failed: %v", err)
+	}
+
+	if rollup.ShipmentsByStatus["in-progress"] != 1 || rollup.ShipmentsByStatus["closed"] != 2 {
+		t.Errorf("unexpected shipment counts: %v", rollup.ShipmentsByStatus)
+	}
+	if rollup.TomesByStatus["open"] != 1 {
+		t.Errorf("unexpected tome counts: %v", rollup.TomesByStatus)
+	}
+	if rollup.OpenNotesByType["idea"] != 2 || rollup.OpenNotesByType["untyped"] != 1 {
+		t.Errorf("unexpected open note counts: %v", rollup.OpenNotesByType)
+	}
+	if _, ok := rollup.OpenNotesByType["bug"]; ok {
+		t.Error("expected closed notes to be excluded")
+	}
+	if rollup.LastActivity != "2099-01-02T03:04:05Z" {
+		t.Errorf("expected last activity from newest note, got %q", rollup.LastActivity)
+	}
+}
+
+func TestCommissionRepository_GetRollup_Empty(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewCommissionRepository(db, nil)
+	ctx := context.Background()
+
+	commission := createTestCommission(t, repo, ctx, "Empty", "")
+
+	rollup, err := repo.GetRollup(ctx, commission.ID)
+	if err != nil {
+		t.Fatalf("GetRollup failed: %v", err)
+	}
+	if len(rollup.ShipmentsByStatus) != 0 || len(rollup.OpenNotesByType) != 0 {
+		t.Errorf("expected empty rollup, got %+v", rollup)
+	}
+	if rollup.LastActivity == "" {
+		t.Error("expected last activity to fall back to commission creation time")
+	}
+}
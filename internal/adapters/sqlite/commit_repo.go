@@ -0,0 +1,139 @@
+// Package sqlite contains SQLite implementations of repository interfaces.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// CommitRepository implements secondary.CommitRepository with SQLite.
+type CommitRepository struct {
+	db *sql.DB
+}
+
+// NewCommitRepository creates a new SQLite commit repository.
+func NewCommitRepository(db *sql.DB) *CommitRepository {
+	return &CommitRepository{db: db}
+}
+
+// conn returns the context-carried transaction if present, otherwise r.db.
+func (r *CommitRepository) conn(ctx context.Context) db.DBTX {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+	return r.db
+}
+
+// Upsert stores a commit, replacing any existing row with the same SHA.
+// A rescan refreshes links and stats but keeps the original created_at.
+func (r *CommitRepository) Upsert(ctx context.Context, commit *secondary.CommitRecord) error {
+	var repoID, taskID, shipmentID, message, committedAt sql.NullString
+
+	if commit.RepoID != "" {
+		repoID = sql.NullString{String: commit.RepoID, Valid: true}
+	}
+	if commit.TaskID != "" {
+		taskID = sql.NullString{String: commit.TaskID, Valid: true}
+	}
+	if commit.ShipmentID != "" {
+		shipmentID = sql.NullString{String: commit.ShipmentID, Valid: true}
+	}
+	if commit.Message != "" {
+		message = sql.NullString{String: commit.Message, Valid: true}
+	}
+	if commit.CommittedAt != "" {
+		committedAt = sql.NullString{String: commit.CommittedAt, Valid: true}
+	}
+
+	_, err := r.conn(ctx).ExecContext(ctx,
+		`INSERT INTO commits (sha, repo_id, task_id, shipment_id, author, subject, message, files_changed, insertions, deletions, committed_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT(sha) DO UPDATE SET
+			repo_id = excluded.repo_id,
+			task_id = excluded.task_id,
+			shipment_id = excluded.shipment_id,
+			author = excluded.author,
+			subject = excluded.subject,
+			message = excluded.message,
+			files_changed = excluded.files_changed,
+			insertions = excluded.insertions,
+			deletions = excluded.deletions,
+			committed_at = excluded.committed_at`,
+		commit.SHA,
+		repoID,
+		taskID,
+		shipmentID,
+		commit.Author,
+		commit.Subject,
+		message,
+		commit.FilesChanged,
+		commit.Insertions,
+		commit.Deletions,
+		committedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to store commit %s: %w", commit.SHA, err)
+	}
+	return nil
+}
+
+// List retrieves commits matching the given filters, oldest first.
+func (r *CommitRepository) List(ctx context.Context, filters secondary.CommitFilters) ([]*secondary.CommitRecord, error) {
+	query := `SELECT sha, repo_id, task_id, shipment_id, author, subject, message, files_changed, insertions, deletions, committed_at, created_at
+			  FROM commits WHERE 1=1`
+	args := []any{}
+
+	if filters.TaskID != "" {
+		query += " AND task_id = ?"
+		args = append(args, filters.TaskID)
+	}
+
+	if filters.ShipmentID != "" {
+		query += " AND shipment_id = ?"
+		args = append(args, filters.ShipmentID)
+	}
+
+	query += " ORDER BY committed_at ASC, sha ASC"
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits: %w", err)
+	}
+	defer rows.Close()
+
+	var commits []*secondary.CommitRecord
+	for rows.Next() {
+		var (
+			repoID      sql.NullString
+			taskID      sql.NullString
+			shipmentID  sql.NullString
+			message     sql.NullString
+			committedAt sql.NullTime
+			createdAt   time.Time
+		)
+		record := &secondary.CommitRecord{}
+		if err := rows.Scan(&record.SHA, &repoID, &taskID, &shipmentID, &record.Author, &record.Subject, &message,
+			&record.FilesChanged, &record.Insertions, &record.Deletions, &committedAt, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan commit: %w", err)
+		}
+		record.RepoID = repoID.String
+		record.TaskID = taskID.String
+		record.ShipmentID = shipmentID.String
+		record.Message = message.String
+		if committedAt.Valid {
+			record.CommittedAt = committedAt.Time.Format(time.RFC3339)
+		}
+		record.CreatedAt = createdAt.Format(time.RFC3339)
+		commits = append(commits, record)
+	}
+
+	return commits, rows.Err()
+}
+
+// Ensure CommitRepository implements the interface
+var _ secondary.CommitRepository = (*CommitRepository)(nil)
@@ -0,0 +1,63 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestCommitRepository_UpsertAndList(t *testing.T) {
+	db := setupTestDB(t)
+	seedCommission(t, db, "COMM-001", "Test Commission")
+	seedShipment(t, db, "SHIP-001", "COMM-001", "Test Shipment")
+	seedTask(t, db, "TASK-001", "COMM-001", "Test Task")
+	repo := sqlite.NewCommitRepository(db)
+	ctx := context.Background()
+
+	commits := []*secondary.CommitRecord{
+		{SHA: "bbb", TaskID: "TASK-001", ShipmentID: "SHIP-001", Author: "A", Subject: "Second", CommittedAt: "2026-01-02T10:00:00Z"},
+		{SHA: "aaa", TaskID: "TASK-001", ShipmentID: "SHIP-001", Author: "A", Subject: "First", Message: "First\n\nOrc-Task: TASK-001", FilesChanged: 2, Insertions: 10, Deletions: 1, CommittedAt: "2026-01-01T10:00:00Z"},
+		{SHA: "ccc", ShipmentID: "SHIP-001", Author: "B", Subject: "Shipment only", CommittedAt: "2026-01-03T10:00:00Z"},
+	}
+	for _, c := range commits {
+		if err := repo.Upsert(ctx, c); err != nil {
+			t.Fatalf("Upsert failed: %v", err)
+		}
+	}
+
+	byTask, err := repo.List(ctx, secondary.CommitFilters{TaskID: "TASK-001"})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(byTask) != 2 || byTask[0].SHA != "aaa" {
+		t.Fatalf("expected 2 task commits oldest first, got %d", len(byTask))
+	}
+	if byTask[0].Insertions != 10 || byTask[0].Message == "" || byTask[0].CommittedAt == "" {
+		t.Errorf("commit fields not round-tripped: %+v", byTask[0])
+	}
+
+	byShipment, _ := repo.List(ctx, secondary.CommitFilters{ShipmentID: "SHIP-001"})
+	if len(byShipment) != 3 {
+		t.Errorf("expected 3 shipment commits, got %d", len(byShipment))
+	}
+}
+
+func TestCommitRepository_UpsertReplacesLinks(t *testing.T) {
+	db := setupTestDB(t)
+	seedCommission(t, db, "COMM-001", "Test Commission")
+	seedTask(t, db, "TASK-001", "COMM-001", "Test Task")
+	repo := sqlite.NewCommitRepository(db)
+	ctx := context.Background()
+
+	_ = repo.Upsert(ctx, &secondary.CommitRecord{SHA: "aaa", Author: "A", Subject: "Amended"})
+	if err := repo.Upsert(ctx, &secondary.CommitRecord{SHA: "aaa", TaskID: "TASK-001", Author: "A", Subject: "Amended"}); err != nil {
+		t.Fatalf("second Upsert failed: %v", err)
+	}
+
+	all, _ := repo.List(ctx, secondary.CommitFilters{})
+	if len(all) != 1 || all[0].TaskID != "TASK-001" {
+		t.Errorf("expected one commit linked to TASK-001, got %+v", all)
+	}
+}
@@ -454,3 +454,129 @@ func TestConcurrentWritesSucceedWithBusyTimeout(t *testing.T) {
 		t.Errorf("expected %d notes, got %d", goroutines, noteCount)
 	}
 }
+
+// TestPRMergeAndShipmentCloseShareTransaction verifies that marking a PR merged,
+// closing its shipment, and completing the commission all run on the transaction
+// carried in the context: a failure rolls every write back, and a success
+// commits them without waiting on the transaction's own write lock.
+func TestPRMergeAndShipmentCloseShareTransaction(t *testing.T) {
+	testDB := setupFileDB(t)
+	ctx := context.Background()
+
+	seedCommission(t, testDB, "COMM-001", "Test")
+	seedShipment(t, testDB, "SHIP-001", "COMM-001", "Test")
+	if _, err := testDB.Exec("INSERT INTO repos (id, name) VALUES ('REPO-001', 'app')"); err != nil {
+		t.Fatalf("failed to seed repo: %v", err)
+	}
+	if _, err := testDB.Exec("INSERT INTO prs (id, shipment_id, repo_id, commission_id, title, branch, status) VALUES ('PR-001', 'SHIP-001', 'REPO-001', 'COMM-001', 'PR', 'b', 'open')"); err != nil {
+		t.Fatalf("failed to seed PR: %v", err)
+	}
+
+	prRepo := sqlite.NewPRRepository(testDB)
+	shipmentRepo := sqlite.NewShipmentRepository(testDB, nil)
+	commissionRepo := sqlite.NewCommissionRepository(testDB, nil)
+	transactor := sqlite.NewTransactor(testDB)
+
+	merge := func(txCtx context.Context) error {
+		if err := prRepo.UpdateStatus(txCtx, "PR-001", "merged", true, false); err != nil {
+			return err
+		}
+		if err := shipmentRepo.UpdateStatus(txCtx, "SHIP-001", "closed", true); err != nil {
+			return err
+		}
+		rollup, err := commissionRepo.GetRollup(txCtx, "COMM-001")
+		if err != nil {
+			return err
+		}
+		if rollup.ShipmentsByStatus["closed"] != 1 {
+			return fmt.Errorf("rollup does not see the closed shipment: %+v", rollup.ShipmentsByStatus)
+		}
+		return commissionRepo.Update(txCtx, &secondary.CommissionRecord{ID: "COMM-001", Status: "complete"})
+	}
+
+	status := func(query string) string {
+		t.Helper()
+		var s string
+		if err := testDB.QueryRow(query).Scan(&s); err != nil {
+			t.Fatalf("query failed: %v", err)
+		}
+		return s
+	}
+
+	// A failure after the writes rolls them all back
+	err := transactor.WithImmediateTx(ctx, func(txCtx context.Context) error {
+		if err := merge(txCtx); err != nil {
+			return err
+		}
+		return fmt.Errorf("failed to complete shipment SHIP-001")
+	})
+	if err == nil {
+		t.Fatal("expected the transaction to fail")
+	}
+	if got := status("SELECT status FROM prs WHERE id = 'PR-001'"); got != "open" {
+		t.Errorf("PR status = %q, want open", got)
+	}
+	if got := status("SELECT status FROM shipments WHERE id = 'SHIP-001'"); got != "draft" {
+		t.Errorf("shipment status = %q, want draft", got)
+	}
+
+	if err := transactor.WithImmediateTx(ctx, merge); err != nil {
+		t.Fatalf("merge transaction failed: %v", err)
+	}
+	if got := status("SELECT status FROM prs WHERE id = 'PR-001'"); got != "merged" {
+		t.Errorf("PR status = %q, want merged", got)
+	}
+	if got := status("SELECT status FROM commissions WHERE id = 'COMM-001'"); got != "complete" {
+		t.Errorf("commission status = %q, want complete", got)
+	}
+}
+
+// TestNestedTransactionJoinsOuter verifies that a WithImmediateTx call inside
+// another joins the outer transaction instead of blocking on the write lock,
+// and that the outer rollback undoes the inner writes.
+func TestNestedTransactionJoinsOuter(t *testing.T) {
+	testDB := setupFileDB(t)
+	ctx := context.Background()
+	seedCommission(t, testDB, "COMM-001", "Test Commission")
+
+	noteRepo := sqlite.NewNoteRepository(testDB, nil)
+	transactor := sqlite.NewTransactor(testDB)
+
+	createNote := func(ctx context.Context) error {
+		return transactor.WithImmediateTx(ctx, func(txCtx context.Context) error {
+			nextID, err := noteRepo.GetNextID(txCtx)
+			if err != nil {
+				return err
+			}
+			return noteRepo.Create(txCtx, &secondary.NoteRecord{ID: nextID, CommissionID: "COMM-001", Title: "Review"})
+		})
+	}
+	countNotes := func() int {
+		t.Helper()
+		var n int
+		if err := testDB.QueryRow("SELECT COUNT(*) FROM notes").Scan(&n); err != nil {
+			t.Fatalf("query failed: %v", err)
+		}
+		return n
+	}
+
+	err := transactor.WithImmediateTx(ctx, func(txCtx context.Context) error {
+		if err := createNote(txCtx); err != nil {
+			return err
+		}
+		return fmt.Errorf("failed to link note to review thread")
+	})
+	if err == nil || err.Error() != "failed to link note to review thread" {
+		t.Fatalf("error = %v, want the outer failure", err)
+	}
+	if got := countNotes(); got != 0 {
+		t.Errorf("notes = %d after rollback, want 0", got)
+	}
+
+	if err := transactor.WithImmediateTx(ctx, createNote); err != nil {
+		t.Fatalf("nested transaction failed: %v", err)
+	}
+	if got := countNotes(); got != 1 {
+		t.Errorf("notes = %d after commit, want 1", got)
+	}
+}
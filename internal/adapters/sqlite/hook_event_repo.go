@@ -255,5 +255,19 @@ func (r *HookEventRepository) GetNextID(ctx context.Context) (string, error) {
 	return fmt.Sprintf("HEV-%04d", maxID+1), nil
 }
 
+// CompactPayloadsOlderThan drops payload_json from hook events older than the given number of days.
+func (r *HookEventRepository) CompactPayloadsOlderThan(ctx context.Context, days int) (int, error) {
+	result, err := r.conn(ctx).ExecContext(ctx,
+		"UPDATE hook_events SET payload_json = NULL WHERE payload_json IS NOT NULL AND timestamp < datetime('now', ?)",
+		fmt.Sprintf("-%d days", days),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compact hook payloads: %w", err)
+	}
+
+	count, _ := result.RowsAffected()
+	return int(count), nil
+}
+
 // Ensure HookEventRepository implements the interface
 var _ secondary.HookEventRepository = (*HookEventRepository)(nil)
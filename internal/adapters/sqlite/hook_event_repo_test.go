@@ -269,3 +269,38 @@ func TestHookEventRepository_GetNextID(t *testing.T) {
 		}
 	})
 }
+
+func TestHookEventRepository_CompactPayloadsOlderThan(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewHookEventRepository(db)
+	ctx := context.Background()
+
+	db.ExecContext(ctx, "INSERT INTO factories (id, name, status) VALUES (?, ?, ?)", "FACT-001", "Test Factory", "active")
+	db.ExecContext(ctx, "INSERT INTO workshops (id, factory_id, name, status) VALUES (?, ?, ?, ?)", "WORK-001", "FACT-001", "Test Workshop", "active")
+	db.ExecContext(ctx, "INSERT INTO workbenches (id, workshop_id, name, status) VALUES (?, ?, ?, ?)", "BENCH-001", "WORK-001", "Test Workbench", "active")
+	db.ExecContext(ctx, "INSERT INTO hook_events (id, workbench_id, hook_type, timestamp, payload_json, decision, reason) VALUES (?, ?, ?, datetime('now', '-10 days'), ?, ?, ?)",
+		"HEV-0001", "BENCH-001", "Stop", `{"old":true}`, "block", "Incomplete tasks")
+	db.ExecContext(ctx, "INSERT INTO hook_events (id, workbench_id, hook_type, payload_json, decision) VALUES (?, ?, ?, ?, ?)",
+		"HEV-0002", "BENCH-001", "Stop", `{"new":true}`, "allow")
+
+	count, err := repo.CompactPayloadsOlderThan(ctx, 7)
+	if err != nil {
+		t.Fatalf("CompactPayloadsOlderThan failed: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("count = %d, want 1", count)
+	}
+
+	old, _ := repo.GetByID(ctx, "HEV-0001")
+	if old.PayloadJSON != "" {
+		t.Errorf("old PayloadJSON = %q, want empty", old.PayloadJSON)
+	}
+	if old.Reason != "Incomplete tasks" {
+		t.Errorf("old Reason = %q, want summary kept", old.Reason)
+	}
+
+	recent, _ := repo.GetByID(ctx, "HEV-0002")
+	if recent.PayloadJSON != `{"new":true}` {
+		t.Errorf("recent PayloadJSON = %q, want untouched", recent.PayloadJSON)
+	}
+}
@@ -468,7 +468,7 @@ func (r *NoteRepository) CloseWithReason(ctx context.Context, id, reason, byNote
 		closed_by_note_id = ?
 		WHERE id = ?`
 
-	result, err := r.db.ExecContext(ctx, query, reason, closedByNoteID, id)
+	result, err := r.conn(ctx).ExecContext(ctx, query, reason, closedByNoteID, id)
 	if err != nil {
 		return fmt.Errorf("failed to close note with reason: %w", err)
 	}
@@ -31,7 +31,7 @@ func (r *PRRepository) conn(ctx context.Context) db.DBTX {
 
 // Create persists a new pull request.
 func (r *PRRepository) Create(ctx context.Context, pr *secondary.PRRecord) error {
-	var description, targetBranch, url sql.NullString
+	var description, targetBranch, url, parentPRID sql.NullString
 	var number sql.NullInt64
 
 	if pr.Description != "" {
@@ -46,6 +46,9 @@ func (r *PRRepository) Create(ctx context.Context, pr *secondary.PRRecord) error
 	if pr.Number > 0 {
 		number = sql.NullInt64{Int64: int64(pr.Number), Valid: true}
 	}
+	if pr.ParentPRID != "" {
+		parentPRID = sql.NullString{String: pr.ParentPRID, Valid: true}
+	}
 
 	status := pr.Status
 	if status == "" {
@@ -53,9 +56,9 @@ func (r *PRRepository) Create(ctx context.Context, pr *secondary.PRRecord) error
 	}
 
 	_, err := r.conn(ctx).ExecContext(ctx,
-		`INSERT INTO prs (id, shipment_id, repo_id, commission_id, number, title, description, branch, target_branch, url, status)
-		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		pr.ID, pr.ShipmentID, pr.RepoID, pr.CommissionID, number, pr.Title, description, pr.Branch, targetBranch, url, status,
+		`INSERT INTO prs (id, shipment_id, repo_id, commission_id, number, title, description, branch, target_branch, url, status, parent_pr_id)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		pr.ID, pr.ShipmentID, pr.RepoID, pr.CommissionID, number, pr.Title, description, pr.Branch, targetBranch, url, status, parentPRID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create PR: %w", err)
@@ -67,23 +70,27 @@ func (r *PRRepository) Create(ctx context.Context, pr *secondary.PRRecord) error
 // GetByID retrieves a pull request by its ID.
 func (r *PRRepository) GetByID(ctx context.Context, id string) (*secondary.PRRecord, error) {
 	var (
-		number       sql.NullInt64
-		description  sql.NullString
-		targetBranch sql.NullString
-		url          sql.NullString
-		status       string
-		createdAt    time.Time
-		updatedAt    time.Time
-		mergedAt     sql.NullTime
-		closedAt     sql.NullTime
+		number         sql.NullInt64
+		description    sql.NullString
+		targetBranch   sql.NullString
+		url            sql.NullString
+		status         string
+		createdAt      time.Time
+		updatedAt      time.Time
+		mergedAt       sql.NullTime
+		closedAt       sql.NullTime
+		reviewDecision sql.NullString
+		mergeable      sql.NullString
+		syncedAt       sql.NullTime
+		parentPRID     sql.NullString
 	)
 
 	record := &secondary.PRRecord{}
 	err := r.db.QueryRowContext(ctx,
-		`SELECT id, shipment_id, repo_id, commission_id, number, title, description, branch, target_branch, url, status, created_at, updated_at, merged_at, closed_at
+		`SELECT id, shipment_id, repo_id, commission_id, number, title, description, branch, target_branch, url, status, created_at, updated_at, merged_at, closed_at, review_decision, mergeable, synced_at, parent_pr_id
 		 FROM prs WHERE id = ?`,
 		id,
-	).Scan(&record.ID, &record.ShipmentID, &record.RepoID, &record.CommissionID, &number, &record.Title, &description, &record.Branch, &targetBranch, &url, &status, &createdAt, &updatedAt, &mergedAt, &closedAt)
+	).Scan(&record.ID, &record.ShipmentID, &record.RepoID, &record.CommissionID, &number, &record.Title, &description, &record.Branch, &targetBranch, &url, &status, &createdAt, &updatedAt, &mergedAt, &closedAt, &reviewDecision, &mergeable, &syncedAt, &parentPRID)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("PR %s not found", id)
@@ -107,30 +114,41 @@ func (r *PRRepository) GetByID(ctx context.Context, id string) (*secondary.PRRec
 	if closedAt.Valid {
 		record.ClosedAt = closedAt.Time.Format(time.RFC3339)
 	}
+	record.ReviewDecision = reviewDecision.String
+	record.Mergeable = mergeable.String
+	if syncedAt.Valid {
+		record.SyncedAt = syncedAt.Time.Format(time.RFC3339)
+	}
+	record.ParentPRID = parentPRID.String
 
 	return record, nil
 }
 
-// GetByShipment retrieves a pull request by shipment ID.
+// GetByShipment retrieves the first pull request of a shipment.
+// Returns nil, nil if the shipment has no PR.
 func (r *PRRepository) GetByShipment(ctx context.Context, shipmentID string) (*secondary.PRRecord, error) {
 	var (
-		number       sql.NullInt64
-		description  sql.NullString
-		targetBranch sql.NullString
-		url          sql.NullString
-		status       string
-		createdAt    time.Time
-		updatedAt    time.Time
-		mergedAt     sql.NullTime
-		closedAt     sql.NullTime
+		number         sql.NullInt64
+		description    sql.NullString
+		targetBranch   sql.NullString
+		url            sql.NullString
+		status         string
+		createdAt      time.Time
+		updatedAt      time.Time
+		mergedAt       sql.NullTime
+		closedAt       sql.NullTime
+		reviewDecision sql.NullString
+		mergeable      sql.NullString
+		syncedAt       sql.NullTime
+		parentPRID     sql.NullString
 	)
 
 	record := &secondary.PRRecord{}
 	err := r.db.QueryRowContext(ctx,
-		`SELECT id, shipment_id, repo_id, commission_id, number, title, description, branch, target_branch, url, status, created_at, updated_at, merged_at, closed_at
-		 FROM prs WHERE shipment_id = ?`,
+		`SELECT id, shipment_id, repo_id, commission_id, number, title, description, branch, target_branch, url, status, created_at, updated_at, merged_at, closed_at, review_decision, mergeable, synced_at, parent_pr_id
+		 FROM prs WHERE shipment_id = ? ORDER BY created_at, id LIMIT 1`,
 		shipmentID,
-	).Scan(&record.ID, &record.ShipmentID, &record.RepoID, &record.CommissionID, &number, &record.Title, &description, &record.Branch, &targetBranch, &url, &status, &createdAt, &updatedAt, &mergedAt, &closedAt)
+	).Scan(&record.ID, &record.ShipmentID, &record.RepoID, &record.CommissionID, &number, &record.Title, &description, &record.Branch, &targetBranch, &url, &status, &createdAt, &updatedAt, &mergedAt, &closedAt, &reviewDecision, &mergeable, &syncedAt, &parentPRID)
 
 	if err == sql.ErrNoRows {
 		return nil, nil // Return nil, nil for "not found"
@@ -154,13 +172,19 @@ func (r *PRRepository) GetByShipment(ctx context.Context, shipmentID string) (*s
 	if closedAt.Valid {
 		record.ClosedAt = closedAt.Time.Format(time.RFC3339)
 	}
+	record.ReviewDecision = reviewDecision.String
+	record.Mergeable = mergeable.String
+	if syncedAt.Valid {
+		record.SyncedAt = syncedAt.Time.Format(time.RFC3339)
+	}
+	record.ParentPRID = parentPRID.String
 
 	return record, nil
 }
 
 // List retrieves pull requests matching the given filters.
 func (r *PRRepository) List(ctx context.Context, filters secondary.PRFilters) ([]*secondary.PRRecord, error) {
-	query := `SELECT id, shipment_id, repo_id, commission_id, number, title, description, branch, target_branch, url, status, created_at, updated_at, merged_at, closed_at
+	query := `SELECT id, shipment_id, repo_id, commission_id, number, title, description, branch, target_branch, url, status, created_at, updated_at, merged_at, closed_at, review_decision, mergeable, synced_at, parent_pr_id
 			  FROM prs WHERE 1=1`
 	args := []any{}
 
@@ -195,19 +219,23 @@ func (r *PRRepository) List(ctx context.Context, filters secondary.PRFilters) ([
 	var prs []*secondary.PRRecord
 	for rows.Next() {
 		var (
-			number       sql.NullInt64
-			description  sql.NullString
-			targetBranch sql.NullString
-			url          sql.NullString
-			status       string
-			createdAt    time.Time
-			updatedAt    time.Time
-			mergedAt     sql.NullTime
-			closedAt     sql.NullTime
+			number         sql.NullInt64
+			description    sql.NullString
+			targetBranch   sql.NullString
+			url            sql.NullString
+			status         string
+			createdAt      time.Time
+			updatedAt      time.Time
+			mergedAt       sql.NullTime
+			closedAt       sql.NullTime
+			reviewDecision sql.NullString
+			mergeable      sql.NullString
+			syncedAt       sql.NullTime
+			parentPRID     sql.NullString
 		)
 
 		record := &secondary.PRRecord{}
-		err := rows.Scan(&record.ID, &record.ShipmentID, &record.RepoID, &record.CommissionID, &number, &record.Title, &description, &record.Branch, &targetBranch, &url, &status, &createdAt, &updatedAt, &mergedAt, &closedAt)
+		err := rows.Scan(&record.ID, &record.ShipmentID, &record.RepoID, &record.CommissionID, &number, &record.Title, &description, &record.Branch, &targetBranch, &url, &status, &createdAt, &updatedAt, &mergedAt, &closedAt, &reviewDecision, &mergeable, &syncedAt, &parentPRID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan PR: %w", err)
 		}
@@ -227,6 +255,12 @@ func (r *PRRepository) List(ctx context.Context, filters secondary.PRFilters) ([
 		if closedAt.Valid {
 			record.ClosedAt = closedAt.Time.Format(time.RFC3339)
 		}
+		record.ReviewDecision = reviewDecision.String
+		record.Mergeable = mergeable.String
+		if syncedAt.Valid {
+			record.SyncedAt = syncedAt.Time.Format(time.RFC3339)
+		}
+		record.ParentPRID = parentPRID.String
 
 		prs = append(prs, record)
 	}
@@ -319,7 +353,7 @@ func (r *PRRepository) UpdateStatus(ctx context.Context, id, status string, setM
 	query += " WHERE id = ?"
 	args = append(args, id)
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update PR status: %w", err)
 	}
@@ -332,6 +366,85 @@ func (r *PRRepository) UpdateStatus(ctx context.Context, id, status string, setM
 	return nil
 }
 
+// UpdateSync stores the provider-owned fields of a PR and stamps synced_at.
+// Unlike Update, empty fields are written as NULL so the ledger mirrors the provider.
+func (r *PRRepository) UpdateSync(ctx context.Context, pr *secondary.PRRecord) error {
+	var url, reviewDecision, mergeable sql.NullString
+	var number sql.NullInt64
+	var mergedAt, closedAt sql.NullTime
+
+	if pr.Number > 0 {
+		number = sql.NullInt64{Int64: int64(pr.Number), Valid: true}
+	}
+	if pr.URL != "" {
+		url = sql.NullString{String: pr.URL, Valid: true}
+	}
+	if pr.ReviewDecision != "" {
+		reviewDecision = sql.NullString{String: pr.ReviewDecision, Valid: true}
+	}
+	if pr.Mergeable != "" {
+		mergeable = sql.NullString{String: pr.Mergeable, Valid: true}
+	}
+	if pr.MergedAt != "" {
+		t, err := time.Parse(time.RFC3339, pr.MergedAt)
+		if err != nil {
+			return fmt.Errorf("invalid merged_at %q: %w", pr.MergedAt, err)
+		}
+		mergedAt = sql.NullTime{Time: t.UTC(), Valid: true}
+	}
+	if pr.ClosedAt != "" {
+		t, err := time.Parse(time.RFC3339, pr.ClosedAt)
+		if err != nil {
+			return fmt.Errorf("invalid closed_at %q: %w", pr.ClosedAt, err)
+		}
+		closedAt = sql.NullTime{Time: t.UTC(), Valid: true}
+	}
+
+	result, err := r.conn(ctx).ExecContext(ctx,
+		`UPDATE prs SET number = ?, url = ?, status = ?, review_decision = ?, mergeable = ?,
+		 merged_at = ?, closed_at = ?, synced_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		 WHERE id = ?`,
+		number, url, pr.Status, reviewDecision, mergeable,
+		mergedAt, closedAt, pr.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to sync PR: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("PR %s not found", pr.ID)
+	}
+
+	return nil
+}
+
+// UpdateStack sets the PR a PR is stacked on (empty means none) and its target branch.
+func (r *PRRepository) UpdateStack(ctx context.Context, id, parentPRID, targetBranch string) error {
+	var parent, target sql.NullString
+	if parentPRID != "" {
+		parent = sql.NullString{String: parentPRID, Valid: true}
+	}
+	if targetBranch != "" {
+		target = sql.NullString{String: targetBranch, Valid: true}
+	}
+
+	result, err := r.conn(ctx).ExecContext(ctx,
+		"UPDATE prs SET parent_pr_id = ?, target_branch = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		parent, target, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update PR stack: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("PR %s not found", id)
+	}
+
+	return nil
+}
+
 // ShipmentExists checks if a shipment exists.
 func (r *PRRepository) ShipmentExists(ctx context.Context, shipmentID string) (bool, error) {
 	var count int
@@ -352,10 +465,10 @@ func (r *PRRepository) RepoExists(ctx context.Context, repoID string) (bool, err
 	return count > 0, nil
 }
 
-// ShipmentHasPR checks if a shipment already has a PR.
-func (r *PRRepository) ShipmentHasPR(ctx context.Context, shipmentID string) (bool, error) {
+// ShipmentHasPR checks if a shipment already has a PR for a repository.
+func (r *PRRepository) ShipmentHasPR(ctx context.Context, shipmentID, repoID string) (bool, error) {
 	var count int
-	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM prs WHERE shipment_id = ?", shipmentID).Scan(&count)
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM prs WHERE shipment_id = ? AND repo_id = ?", shipmentID, repoID).Scan(&count)
 	if err != nil {
 		return false, fmt.Errorf("failed to check shipment PR: %w", err)
 	}
@@ -375,5 +488,151 @@ func (r *PRRepository) GetShipmentStatus(ctx context.Context, shipmentID string)
 	return status, nil
 }
 
+// CreateReviewThread links a provider review thread to the note it was imported as.
+func (r *PRRepository) CreateReviewThread(ctx context.Context, thread *secondary.PRReviewThreadRecord) error {
+	var taskID, url, path sql.NullString
+	var line sql.NullInt64
+	if thread.TaskID != "" {
+		taskID = sql.NullString{String: thread.TaskID, Valid: true}
+	}
+	if thread.URL != "" {
+		url = sql.NullString{String: thread.URL, Valid: true}
+	}
+	if thread.Path != "" {
+		path = sql.NullString{String: thread.Path, Valid: true}
+	}
+	if thread.Line > 0 {
+		line = sql.NullInt64{Int64: int64(thread.Line), Valid: true}
+	}
+
+	_, err := r.conn(ctx).ExecContext(ctx,
+		`INSERT INTO pr_review_threads (pr_id, thread_id, note_id, task_id, url, path, line, resolved)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		thread.PRID, thread.ThreadID, thread.NoteID, taskID, url, path, line, thread.Resolved,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create review thread: %w", err)
+	}
+	return nil
+}
+
+// ListReviewThreads retrieves the imported review threads of a PR, oldest first.
+func (r *PRRepository) ListReviewThreads(ctx context.Context, prID string) ([]*secondary.PRReviewThreadRecord, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx,
+		`SELECT pr_id, thread_id, note_id, task_id, url, path, line, resolved, created_at
+		 FROM pr_review_threads WHERE pr_id = ? ORDER BY created_at, rowid`,
+		prID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list review threads: %w", err)
+	}
+	defer rows.Close()
+
+	var threads []*secondary.PRReviewThreadRecord
+	for rows.Next() {
+		var (
+			taskID, url, path sql.NullString
+			line              sql.NullInt64
+			createdAt         time.Time
+		)
+		t := &secondary.PRReviewThreadRecord{}
+		if err := rows.Scan(&t.PRID, &t.ThreadID, &t.NoteID, &taskID, &url, &path, &line, &t.Resolved, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan review thread: %w", err)
+		}
+		t.TaskID = taskID.String
+		t.URL = url.String
+		t.Path = path.String
+		t.Line = int(line.Int64)
+		t.CreatedAt = createdAt.Format(time.RFC3339)
+		threads = append(threads, t)
+	}
+	return threads, rows.Err()
+}
+
+// UpdateReviewThreadResolved records the resolution state both sides agreed on.
+func (r *PRRepository) UpdateReviewThreadResolved(ctx context.Context, prID, threadID string, resolved bool) error {
+	result, err := r.conn(ctx).ExecContext(ctx,
+		"UPDATE pr_review_threads SET resolved = ? WHERE pr_id = ? AND thread_id = ?",
+		resolved, prID, threadID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update review thread: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("review thread %s of %s not found", threadID, prID)
+	}
+	return nil
+}
+
+// ListChecks retrieves the CI checks last synced for a PR, ordered by name.
+func (r *PRRepository) ListChecks(ctx context.Context, prID string) ([]*secondary.PRCheckRecord, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx,
+		`SELECT pr_id, name, status, conclusion, url, required, updated_at
+		 FROM pr_checks WHERE pr_id = ? ORDER BY name`,
+		prID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checks: %w", err)
+	}
+	defer rows.Close()
+
+	var checks []*secondary.PRCheckRecord
+	for rows.Next() {
+		var (
+			conclusion, url sql.NullString
+			updatedAt       sql.NullTime
+		)
+		c := &secondary.PRCheckRecord{}
+		if err := rows.Scan(&c.PRID, &c.Name, &c.Status, &conclusion, &url, &c.Required, &updatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan check: %w", err)
+		}
+		c.Conclusion = conclusion.String
+		c.URL = url.String
+		if updatedAt.Valid {
+			c.UpdatedAt = updatedAt.Time.Format(time.RFC3339)
+		}
+		checks = append(checks, c)
+	}
+	return checks, rows.Err()
+}
+
+// ReplaceChecks replaces the CI checks of a PR with those from a sync.
+func (r *PRRepository) ReplaceChecks(ctx context.Context, prID string, checks []*secondary.PRCheckRecord) error {
+	conn := r.conn(ctx)
+	if _, err := conn.ExecContext(ctx, "DELETE FROM pr_checks WHERE pr_id = ?", prID); err != nil {
+		return fmt.Errorf("failed to clear checks: %w", err)
+	}
+
+	for _, c := range checks {
+		var conclusion, url sql.NullString
+		var updatedAt sql.NullTime
+		if c.Conclusion != "" {
+			conclusion = sql.NullString{String: c.Conclusion, Valid: true}
+		}
+		if c.URL != "" {
+			url = sql.NullString{String: c.URL, Valid: true}
+		}
+		if c.UpdatedAt != "" {
+			t, err := time.Parse(time.RFC3339, c.UpdatedAt)
+			if err != nil {
+				return fmt.Errorf("invalid updated_at %q for check %s: %w", c.UpdatedAt, c.Name, err)
+			}
+			updatedAt = sql.NullTime{Time: t.UTC(), Valid: true}
+		}
+
+		_, err := conn.ExecContext(ctx,
+			`INSERT INTO pr_checks (pr_id, name, status, conclusion, url, required, updated_at)
+			 VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			prID, c.Name, c.Status, conclusion, url, c.Required, updatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save check %s: %w", c.Name, err)
+		}
+	}
+	return nil
+}
+
 // Ensure PRRepository implements the interface
 var _ secondary.PRRepository = (*PRRepository)(nil)
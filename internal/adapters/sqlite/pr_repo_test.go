@@ -200,6 +200,165 @@ func TestPRRepository_UpdateStatus(t *testing.T) {
 	})
 }
 
+func TestPRRepository_UpdateSync(t *testing.T) {
+	db := setupTestDB(t)
+	prRepo := sqlite.NewPRRepository(db)
+	repoRepo := sqlite.NewRepoRepository(db)
+	ctx := context.Background()
+
+	// Setup
+	repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "test-repo"})
+	db.ExecContext(ctx, "INSERT OR IGNORE INTO commissions (id, title, status) VALUES (?, ?, ?)", "COMM-001", "Test", "active")
+	db.ExecContext(ctx, "INSERT INTO shipments (id, commission_id, title, status) VALUES (?, ?, ?, ?)", "SHIP-001", "COMM-001", "Test", "draft")
+
+	prRepo.Create(ctx, &secondary.PRRecord{
+		ID:           "PR-001",
+		ShipmentID:   "SHIP-001",
+		RepoID:       "REPO-001",
+		CommissionID: "COMM-001",
+		Title:        "Test PR",
+		Branch:       "feature/test",
+		Status:       "open",
+	})
+
+	t.Run("stores provider fields", func(t *testing.T) {
+		err := prRepo.UpdateSync(ctx, &secondary.PRRecord{
+			ID:             "PR-001",
+			Number:         42,
+			URL:            "https://github.com/o/r/pull/42",
+			Status:         "merged",
+			ReviewDecision: "approved",
+			MergedAt:       "2026-03-01T10:00:00Z",
+			ClosedAt:       "2026-03-01T10:00:00Z",
+		})
+		if err != nil {
+			t.Fatalf("UpdateSync failed: %v", err)
+		}
+
+		got, _ := prRepo.GetByID(ctx, "PR-001")
+		if got.Number != 42 || got.Status != "merged" || got.ReviewDecision != "approved" || got.Mergeable != "" {
+			t.Errorf("got %+v", got)
+		}
+		if got.MergedAt != "2026-03-01T10:00:00Z" {
+			t.Errorf("MergedAt = %q, want 2026-03-01T10:00:00Z", got.MergedAt)
+		}
+		if got.SyncedAt == "" {
+			t.Error("SyncedAt should be set")
+		}
+	})
+
+	t.Run("clears fields the provider no longer reports", func(t *testing.T) {
+		err := prRepo.UpdateSync(ctx, &secondary.PRRecord{ID: "PR-001", Number: 42, Status: "open", Mergeable: "conflicting"})
+		if err != nil {
+			t.Fatalf("UpdateSync failed: %v", err)
+		}
+
+		got, _ := prRepo.GetByID(ctx, "PR-001")
+		if got.MergedAt != "" || got.ClosedAt != "" || got.ReviewDecision != "" || got.URL != "" {
+			t.Errorf("expected cleared fields, got %+v", got)
+		}
+		if got.Mergeable != "conflicting" {
+			t.Errorf("Mergeable = %q, want conflicting", got.Mergeable)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		if err := prRepo.UpdateSync(ctx, &secondary.PRRecord{ID: "PR-999", Status: "open"}); err == nil {
+			t.Error("expected error for unknown PR")
+		}
+	})
+}
+
+func TestPRRepository_ReviewThreads(t *testing.T) {
+	db := setupTestDB(t)
+	prRepo := sqlite.NewPRRepository(db)
+	repoRepo := sqlite.NewRepoRepository(db)
+	ctx := context.Background()
+
+	// Setup
+	repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "test-repo"})
+	db.ExecContext(ctx, "INSERT OR IGNORE INTO commissions (id, title, status) VALUES (?, ?, ?)", "COMM-001", "Test", "active")
+	db.ExecContext(ctx, "INSERT INTO shipments (id, commission_id, title, status) VALUES (?, ?, ?, ?)", "SHIP-001", "COMM-001", "Test", "draft")
+	db.ExecContext(ctx, "INSERT INTO notes (id, commission_id, title) VALUES (?, ?, ?)", "NOTE-001", "COMM-001", "Review")
+	db.ExecContext(ctx, "INSERT INTO notes (id, commission_id, title) VALUES (?, ?, ?)", "NOTE-002", "COMM-001", "Review")
+	prRepo.Create(ctx, &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", CommissionID: "COMM-001", Title: "Test PR", Branch: "feature/test", Status: "open"})
+
+	if err := prRepo.CreateReviewThread(ctx, &secondary.PRReviewThreadRecord{PRID: "PR-001", ThreadID: "T1", NoteID: "NOTE-001", URL: "https://x/1", Path: "a.go", Line: 12}); err != nil {
+		t.Fatalf("CreateReviewThread failed: %v", err)
+	}
+	if err := prRepo.CreateReviewThread(ctx, &secondary.PRReviewThreadRecord{PRID: "PR-001", ThreadID: "T2", NoteID: "NOTE-002"}); err != nil {
+		t.Fatalf("CreateReviewThread failed: %v", err)
+	}
+	if err := prRepo.CreateReviewThread(ctx, &secondary.PRReviewThreadRecord{PRID: "PR-001", ThreadID: "T1", NoteID: "NOTE-002"}); err == nil {
+		t.Error("expected duplicate thread to be rejected")
+	}
+
+	if err := prRepo.UpdateReviewThreadResolved(ctx, "PR-001", "T1", true); err != nil {
+		t.Fatalf("UpdateReviewThreadResolved failed: %v", err)
+	}
+	if err := prRepo.UpdateReviewThreadResolved(ctx, "PR-001", "T9", true); err == nil {
+		t.Error("expected error for unknown thread")
+	}
+
+	threads, err := prRepo.ListReviewThreads(ctx, "PR-001")
+	if err != nil {
+		t.Fatalf("ListReviewThreads failed: %v", err)
+	}
+	if len(threads) != 2 {
+		t.Fatalf("got %d threads, want 2", len(threads))
+	}
+	if got := threads[0]; got.ThreadID != "T1" || got.NoteID != "NOTE-001" || got.Path != "a.go" || got.Line != 12 || got.URL != "https://x/1" || !got.Resolved {
+		t.Errorf("threads[0] = %+v", got)
+	}
+	if got := threads[1]; got.ThreadID != "T2" || got.Path != "" || got.Line != 0 || got.TaskID != "" || got.Resolved {
+		t.Errorf("threads[1] = %+v", got)
+	}
+}
+
+func TestPRRepository_Checks(t *testing.T) {
+	db := setupTestDB(t)
+	prRepo := sqlite.NewPRRepository(db)
+	repoRepo := sqlite.NewRepoRepository(db)
+	ctx := context.Background()
+
+	// Setup
+	repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "test-repo"})
+	db.ExecContext(ctx, "INSERT OR IGNORE INTO commissions (id, title, status) VALUES (?, ?, ?)", "COMM-001", "Test", "active")
+	db.ExecContext(ctx, "INSERT INTO shipments (id, commission_id, title, status) VALUES (?, ?, ?, ?)", "SHIP-001", "COMM-001", "Test", "draft")
+	prRepo.Create(ctx, &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", CommissionID: "COMM-001", Title: "Test PR", Branch: "feature/test", Status: "open"})
+
+	err := prRepo.ReplaceChecks(ctx, "PR-001", []*secondary.PRCheckRecord{
+		{Name: "test", Status: "completed", Conclusion: "failure", URL: "https://ci/1", Required: true, UpdatedAt: "2026-03-01T10:00:00Z"},
+		{Name: "lint", Status: "in_progress"},
+	})
+	if err != nil {
+		t.Fatalf("ReplaceChecks failed: %v", err)
+	}
+
+	checks, err := prRepo.ListChecks(ctx, "PR-001")
+	if err != nil {
+		t.Fatalf("ListChecks failed: %v", err)
+	}
+	if len(checks) != 2 {
+		t.Fatalf("got %d checks, want 2", len(checks))
+	}
+	if got := checks[0]; got.Name != "lint" || got.Status != "in_progress" || got.Conclusion != "" || got.URL != "" || got.Required || got.UpdatedAt != "" {
+		t.Errorf("checks[0] = %+v", got)
+	}
+	if got := checks[1]; got.Name != "test" || got.Conclusion != "failure" || got.URL != "https://ci/1" || !got.Required || got.UpdatedAt != "2026-03-01T10:00:00Z" {
+		t.Errorf("checks[1] = %+v", got)
+	}
+
+	// A later sync replaces the set
+	if err := prRepo.ReplaceChecks(ctx, "PR-001", []*secondary.PRCheckRecord{{Name: "test", Status: "completed", Conclusion: "success"}}); err != nil {
+		t.Fatalf("ReplaceChecks failed: %v", err)
+	}
+	checks, _ = prRepo.ListChecks(ctx, "PR-001")
+	if len(checks) != 1 || checks[0].Conclusion != "success" {
+		t.Errorf("checks after resync = %+v", checks)
+	}
+}
+
 func TestPRRepository_ShipmentHasPR(t *testing.T) {
 	db := setupTestDB(t)
 	prRepo := sqlite.NewPRRepository(db)
@@ -221,8 +380,8 @@ func TestPRRepository_ShipmentHasPR(t *testing.T) {
 		Branch:       "feature/test",
 	})
 
-	t.Run("returns true when shipment has PR", func(t *testing.T) {
-		has, err := prRepo.ShipmentHasPR(ctx, "SHIP-001")
+	t.Run("returns true when shipment has PR for the repo", func(t *testing.T) {
+		has, err := prRepo.ShipmentHasPR(ctx, "SHIP-001", "REPO-001")
 		if err != nil {
 			t.Fatalf("ShipmentHasPR failed: %v", err)
 		}
@@ -232,7 +391,17 @@ func TestPRRepository_ShipmentHasPR(t *testing.T) {
 	})
 
 	t.Run("returns false when shipment has no PR", func(t *testing.T) {
-		has, err := prRepo.ShipmentHasPR(ctx, "SHIP-002")
+		has, err := prRepo.ShipmentHasPR(ctx, "SHIP-002", "REPO-001")
+		if err != nil {
+			t.Fatalf("ShipmentHasPR failed: %v", err)
+		}
+		if has {
+			t.Error("expected false, got true")
+		}
+	})
+
+	t.Run("returns false when shipment only has a PR for another repo", func(t *testing.T) {
+		has, err := prRepo.ShipmentHasPR(ctx, "SHIP-001", "REPO-002")
 		if err != nil {
 			t.Fatalf("ShipmentHasPR failed: %v", err)
 		}
@@ -242,6 +411,36 @@ func TestPRRepository_ShipmentHasPR(t *testing.T) {
 	})
 }
 
+func TestPRRepository_OnePRPerShipmentRepo(t *testing.T) {
+	db := setupTestDB(t)
+	prRepo := sqlite.NewPRRepository(db)
+	repoRepo := sqlite.NewRepoRepository(db)
+	ctx := context.Background()
+
+	repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "api"})
+	repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-002", Name: "client"})
+	db.ExecContext(ctx, "INSERT OR IGNORE INTO commissions (id, title, status) VALUES (?, ?, ?)", "COMM-001", "Test", "active")
+	db.ExecContext(ctx, "INSERT INTO shipments (id, commission_id, title, status) VALUES (?, ?, ?, ?)", "SHIP-001", "COMM-001", "Cross-service", "draft")
+
+	pr := func(id, repoID string) *secondary.PRRecord {
+		return &secondary.PRRecord{ID: id, ShipmentID: "SHIP-001", RepoID: repoID, CommissionID: "COMM-001", Title: "Change", Branch: "ml/SHIP-001"}
+	}
+	if err := prRepo.Create(ctx, pr("PR-001", "REPO-001")); err != nil {
+		t.Fatalf("Create PR-001 failed: %v", err)
+	}
+	if err := prRepo.Create(ctx, pr("PR-002", "REPO-002")); err != nil {
+		t.Fatalf("expected a second PR in another repo to be allowed: %v", err)
+	}
+	if err := prRepo.Create(ctx, pr("PR-003", "REPO-001")); err == nil {
+		t.Error("expected a second PR in the same repo to be rejected")
+	}
+
+	first, err := prRepo.GetByShipment(ctx, "SHIP-001")
+	if err != nil || first == nil || first.ID != "PR-001" {
+		t.Errorf("GetByShipment = %+v, %v, want PR-001", first, err)
+	}
+}
+
 func TestPRRepository_GetNextID(t *testing.T) {
 	db := setupTestDB(t)
 	prRepo := sqlite.NewPRRepository(db)
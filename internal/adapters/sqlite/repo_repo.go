@@ -62,6 +62,9 @@ func (r *RepoRepository) GetByID(ctx context.Context, id string) (*secondary.Rep
 		url           sql.NullString
 		localPath     sql.NullString
 		defaultBranch string
+		bootstrapJSON sql.NullString
+		sparseJSON    sql.NullString
+		mergeJSON     sql.NullString
 		status        string
 		createdAt     time.Time
 		updatedAt     time.Time
@@ -69,9 +72,9 @@ func (r *RepoRepository) GetByID(ctx context.Context, id string) (*secondary.Rep
 
 	record := &secondary.RepoRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, name, url, local_path, default_branch, status, created_at, updated_at FROM repos WHERE id = ?",
+		"SELECT id, name, url, local_path, default_branch, bootstrap_json, sparse_json, merge_json, status, created_at, updated_at FROM repos WHERE id = ?",
 		id,
-	).Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &status, &createdAt, &updatedAt)
+	).Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &bootstrapJSON, &sparseJSON, &mergeJSON, &status, &createdAt, &updatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("repository %s not found", id)
@@ -83,6 +86,9 @@ func (r *RepoRepository) GetByID(ctx context.Context, id string) (*secondary.Rep
 	record.URL = url.String
 	record.LocalPath = localPath.String
 	record.DefaultBranch = defaultBranch
+	record.BootstrapJSON = bootstrapJSON.String
+	record.SparseJSON = sparseJSON.String
+	record.MergeJSON = mergeJSON.String
 	record.Status = status
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -96,6 +102,9 @@ func (r *RepoRepository) GetByName(ctx context.Context, name string) (*secondary
 		url           sql.NullString
 		localPath     sql.NullString
 		defaultBranch string
+		bootstrapJSON sql.NullString
+		sparseJSON    sql.NullString
+		mergeJSON     sql.NullString
 		status        string
 		createdAt     time.Time
 		updatedAt     time.Time
@@ -103,9 +112,9 @@ func (r *RepoRepository) GetByName(ctx context.Context, name string) (*secondary
 
 	record := &secondary.RepoRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, name, url, local_path, default_branch, status, created_at, updated_at FROM repos WHERE name = ?",
+		"SELECT id, name, url, local_path, default_branch, bootstrap_json, sparse_json, merge_json, status, created_at, updated_at FROM repos WHERE name = ?",
 		name,
-	).Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &status, &createdAt, &updatedAt)
+	).Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &bootstrapJSON, &sparseJSON, &mergeJSON, &status, &createdAt, &updatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil // Return nil, nil for "not found" to distinguish from errors
@@ -117,6 +126,9 @@ func (r *RepoRepository) GetByName(ctx context.Context, name string) (*secondary
 	record.URL = url.String
 	record.LocalPath = localPath.String
 	record.DefaultBranch = defaultBranch
+	record.BootstrapJSON = bootstrapJSON.String
+	record.SparseJSON = sparseJSON.String
+	record.MergeJSON = mergeJSON.String
 	record.Status = status
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -126,7 +138,7 @@ func (r *RepoRepository) GetByName(ctx context.Context, name string) (*secondary
 
 // List retrieves repositories matching the given filters.
 func (r *RepoRepository) List(ctx context.Context, filters secondary.RepoFilters) ([]*secondary.RepoRecord, error) {
-	query := "SELECT id, name, url, local_path, default_branch, status, created_at, updated_at FROM repos WHERE 1=1"
+	query := "SELECT id, name, url, local_path, default_branch, bootstrap_json, sparse_json, merge_json, status, created_at, updated_at FROM repos WHERE 1=1"
 	args := []any{}
 
 	if filters.Status != "" {
@@ -148,13 +160,16 @@ func (r *RepoRepository) List(ctx context.Context, filters secondary.RepoFilters
 			url           sql.NullString
 			localPath     sql.NullString
 			defaultBranch string
+			bootstrapJSON sql.NullString
+			sparseJSON    sql.NullString
+			mergeJSON     sql.NullString
 			status        string
 			createdAt     time.Time
 			updatedAt     time.Time
 		)
 
 		record := &secondary.RepoRecord{}
-		err := rows.Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &status, &createdAt, &updatedAt)
+		err := rows.Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &bootstrapJSON, &sparseJSON, &mergeJSON, &status, &createdAt, &updatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan repository: %w", err)
 		}
@@ -162,6 +177,9 @@ func (r *RepoRepository) List(ctx context.Context, filters secondary.RepoFilters
 		record.URL = url.String
 		record.LocalPath = localPath.String
 		record.DefaultBranch = defaultBranch
+		record.BootstrapJSON = bootstrapJSON.String
+		record.SparseJSON = sparseJSON.String
+		record.MergeJSON = mergeJSON.String
 		record.Status = status
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -254,6 +272,75 @@ func (r *RepoRepository) UpdateStatus(ctx context.Context, id, status string) er
 	return nil
 }
 
+// UpdateBootstrap replaces a repository's worktree bootstrap steps.
+func (r *RepoRepository) UpdateBootstrap(ctx context.Context, id, bootstrapJSON string) error {
+	var steps sql.NullString
+	if bootstrapJSON != "" {
+		steps = sql.NullString{String: bootstrapJSON, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE repos SET bootstrap_json = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		steps, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update repository bootstrap: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("repository %s not found", id)
+	}
+
+	return nil
+}
+
+// UpdateSparse replaces the sparse checkout paths used for a repository's new worktrees.
+func (r *RepoRepository) UpdateSparse(ctx context.Context, id, sparseJSON string) error {
+	var paths sql.NullString
+	if sparseJSON != "" {
+		paths = sql.NullString{String: sparseJSON, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE repos SET sparse_json = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		paths, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update repository sparse paths: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("repository %s not found", id)
+	}
+
+	return nil
+}
+
+// UpdateMerge replaces a repository's local merge config.
+func (r *RepoRepository) UpdateMerge(ctx context.Context, id, mergeJSON string) error {
+	var config sql.NullString
+	if mergeJSON != "" {
+		config = sql.NullString{String: mergeJSON, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE repos SET merge_json = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		config, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update repository merge config: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("repository %s not found", id)
+	}
+
+	return nil
+}
+
 // HasActivePRs checks if a repository has active (non-terminal) PRs.
 func (r *RepoRepository) HasActivePRs(ctx context.Context, repoID string) (bool, error) {
 	var count int
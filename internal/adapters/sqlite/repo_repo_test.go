@@ -287,3 +287,94 @@ func TestRepoRepository_UpdateStatus(t *testing.T) {
 		}
 	})
 }
+
+func TestRepoRepository_UpdateBootstrap(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewRepoRepository(db)
+	ctx := context.Background()
+
+	if err := repo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "bootstrap-test"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if err := repo.UpdateBootstrap(ctx, "REPO-001", `["make deps"]`); err != nil {
+		t.Fatalf("UpdateBootstrap failed: %v", err)
+	}
+	got, _ := repo.GetByID(ctx, "REPO-001")
+	if got.BootstrapJSON != `["make deps"]` {
+		t.Errorf("BootstrapJSON = %q, want the stored steps", got.BootstrapJSON)
+	}
+
+	if err := repo.UpdateBootstrap(ctx, "REPO-001", ""); err != nil {
+		t.Fatalf("UpdateBootstrap (clear) failed: %v", err)
+	}
+	got, _ = repo.GetByName(ctx, "bootstrap-test")
+	if got.BootstrapJSON != "" {
+		t.Errorf("expected cleared steps, got %q", got.BootstrapJSON)
+	}
+
+	if err := repo.UpdateBootstrap(ctx, "REPO-999", ""); err == nil {
+		t.Error("expected error for unknown repository")
+	}
+}
+
+func TestRepoRepository_UpdateSparse(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewRepoRepository(db)
+	ctx := context.Background()
+
+	if err := repo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "monorepo"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if err := repo.UpdateSparse(ctx, "REPO-001", `["services/api"]`); err != nil {
+		t.Fatalf("UpdateSparse failed: %v", err)
+	}
+	got, _ := repo.GetByID(ctx, "REPO-001")
+	if got.SparseJSON != `["services/api"]` {
+		t.Errorf("SparseJSON = %q, want the stored paths", got.SparseJSON)
+	}
+
+	if err := repo.UpdateSparse(ctx, "REPO-001", ""); err != nil {
+		t.Fatalf("UpdateSparse (clear) failed: %v", err)
+	}
+	got, _ = repo.GetByName(ctx, "monorepo")
+	if got.SparseJSON != "" {
+		t.Errorf("expected cleared paths, got %q", got.SparseJSON)
+	}
+
+	if err := repo.UpdateSparse(ctx, "REPO-999", ""); err == nil {
+		t.Error("expected error for unknown repository")
+	}
+}
+
+func TestRepoRepository_UpdateMerge(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewRepoRepository(db)
+	ctx := context.Background()
+
+	if err := repo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "solo"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	config := `{"strategy":"squash","verify":["make test"]}`
+	if err := repo.UpdateMerge(ctx, "REPO-001", config); err != nil {
+		t.Fatalf("UpdateMerge failed: %v", err)
+	}
+	got, _ := repo.GetByID(ctx, "REPO-001")
+	if got.MergeJSON != config {
+		t.Errorf("MergeJSON = %q, want the stored config", got.MergeJSON)
+	}
+
+	if err := repo.UpdateMerge(ctx, "REPO-001", ""); err != nil {
+		t.Fatalf("UpdateMerge (clear) failed: %v", err)
+	}
+	list, _ := repo.List(ctx, secondary.RepoFilters{})
+	if len(list) != 1 || list[0].MergeJSON != "" {
+		t.Errorf("expected cleared config, got %+v", list)
+	}
+
+	if err := repo.UpdateMerge(ctx, "REPO-999", ""); err == nil {
+		t.Error("expected error for unknown repository")
+	}
+}
@@ -0,0 +1,123 @@
+// Package sqlite contains SQLite implementations of repository interfaces.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ShipmentDiffStatRepository implements secondary.ShipmentDiffStatRepository with SQLite.
+type ShipmentDiffStatRepository struct {
+	db *sql.DB
+}
+
+// NewShipmentDiffStatRepository creates a new SQLite shipment diff stat repository.
+func NewShipmentDiffStatRepository(db *sql.DB) *ShipmentDiffStatRepository {
+	return &ShipmentDiffStatRepository{db: db}
+}
+
+// Save replaces the cached stats and file lists of a shipment in one transaction.
+func (r *ShipmentDiffStatRepository) Save(ctx context.Context, shipmentID string, stats []*secondary.ShipmentDiffStatRecord, files []*secondary.ShipmentDiffFileRecord) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	// Clear every repo's rows, including those of repos no longer in the shipment
+	if _, err := tx.ExecContext(ctx, "DELETE FROM shipment_diff_files WHERE shipment_id = ?", shipmentID); err != nil {
+		return fmt.Errorf("failed to clear diff files: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM shipment_diff_stats WHERE shipment_id = ?", shipmentID); err != nil {
+		return fmt.Errorf("failed to clear diff stats: %w", err)
+	}
+
+	for _, stat := range stats {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO shipment_diff_stats (shipment_id, repo_id, base_ref, merge_base, head_commit, files_changed, insertions, deletions, computed_at)
+			 VALUES (?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)`,
+			shipmentID,
+			stat.RepoID,
+			stat.BaseRef,
+			stat.MergeBase,
+			stat.HeadCommit,
+			stat.FilesChanged,
+			stat.Insertions,
+			stat.Deletions,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to store diff stats for %s in %s: %w", shipmentID, stat.RepoID, err)
+		}
+	}
+
+	for _, f := range files {
+		if _, err := tx.ExecContext(ctx,
+			"INSERT INTO shipment_diff_files (shipment_id, repo_id, path, insertions, deletions) VALUES (?, ?, ?, ?, ?)",
+			shipmentID, f.RepoID, f.Path, f.Insertions, f.Deletions,
+		); err != nil {
+			return fmt.Errorf("failed to store diff file %s: %w", f.Path, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// List retrieves the cached per-repo stats of a shipment, ordered by repo.
+func (r *ShipmentDiffStatRepository) List(ctx context.Context, shipmentID string) ([]*secondary.ShipmentDiffStatRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT shipment_id, repo_id, base_ref, merge_base, head_commit, files_changed, insertions, deletions, computed_at
+		 FROM shipment_diff_stats WHERE shipment_id = ? ORDER BY repo_id`,
+		shipmentID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get diff stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []*secondary.ShipmentDiffStatRecord
+	for rows.Next() {
+		var computedAt time.Time
+		record := &secondary.ShipmentDiffStatRecord{}
+		if err := rows.Scan(&record.ShipmentID, &record.RepoID, &record.BaseRef, &record.MergeBase, &record.HeadCommit,
+			&record.FilesChanged, &record.Insertions, &record.Deletions, &computedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan diff stats: %w", err)
+		}
+		record.ComputedAt = computedAt.Format(time.RFC3339)
+		stats = append(stats, record)
+	}
+
+	return stats, rows.Err()
+}
+
+// ListFiles retrieves the cached per-file churn of a shipment, ordered by repo and path.
+func (r *ShipmentDiffStatRepository) ListFiles(ctx context.Context, shipmentID string) ([]*secondary.ShipmentDiffFileRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT shipment_id, repo_id, path, insertions, deletions FROM shipment_diff_files WHERE shipment_id = ? ORDER BY repo_id, path",
+		shipmentID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list diff files: %w", err)
+	}
+	defer rows.Close()
+
+	var files []*secondary.ShipmentDiffFileRecord
+	for rows.Next() {
+		record := &secondary.ShipmentDiffFileRecord{}
+		if err := rows.Scan(&record.ShipmentID, &record.RepoID, &record.Path, &record.Insertions, &record.Deletions); err != nil {
+			return nil, fmt.Errorf("failed to scan diff file: %w", err)
+		}
+		files = append(files, record)
+	}
+
+	return files, rows.Err()
+}
+
+// Ensure ShipmentDiffStatRepository implements the interface
+var _ secondary.ShipmentDiffStatRepository = (*ShipmentDiffStatRepository)(nil)
@@ -0,0 +1,87 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestShipmentDiffStatRepository_SaveAndList(t *testing.T) {
+	db := setupTestDB(t)
+	seedCommission(t, db, "COMM-001", "Test Commission")
+	seedShipment(t, db, "SHIP-001", "COMM-001", "Test Shipment")
+	repo := sqlite.NewShipmentDiffStatRepository(db)
+	ctx := context.Background()
+
+	stats := []*secondary.ShipmentDiffStatRecord{
+		{RepoID: "REPO-002", BaseRef: "main", MergeBase: "ccc", HeadCommit: "ddd", FilesChanged: 1, Insertions: 7},
+		{RepoID: "REPO-001", BaseRef: "origin/main", MergeBase: "aaa", HeadCommit: "bbb", FilesChanged: 2, Insertions: 30, Deletions: 5},
+	}
+	files := []*secondary.ShipmentDiffFileRecord{
+		{RepoID: "REPO-001", Path: "internal/app/login.go", Insertions: 25, Deletions: 5},
+		{RepoID: "REPO-001", Path: "README.md", Insertions: 5},
+		{RepoID: "REPO-002", Path: "README.md", Insertions: 7},
+	}
+	if err := repo.Save(ctx, "SHIP-001", stats, files); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	got, err := repo.List(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(got) != 2 || got[0].RepoID != "REPO-001" || got[1].RepoID != "REPO-002" {
+		t.Fatalf("expected stats for REPO-001 and REPO-002 ordered by repo, got %+v", got)
+	}
+	if got[0].ShipmentID != "SHIP-001" || got[0].BaseRef != "origin/main" || got[0].HeadCommit != "bbb" || got[0].Insertions != 30 || got[0].ComputedAt == "" {
+		t.Errorf("stats not round-tripped: %+v", got[0])
+	}
+
+	gotFiles, err := repo.ListFiles(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("ListFiles failed: %v", err)
+	}
+	if len(gotFiles) != 3 || gotFiles[0].Path != "README.md" || gotFiles[0].RepoID != "REPO-001" || gotFiles[2].RepoID != "REPO-002" {
+		t.Fatalf("expected 3 files ordered by repo and path, got %+v", gotFiles)
+	}
+}
+
+func TestShipmentDiffStatRepository_SaveReplaces(t *testing.T) {
+	db := setupTestDB(t)
+	seedCommission(t, db, "COMM-001", "Test Commission")
+	seedShipment(t, db, "SHIP-001", "COMM-001", "Test Shipment")
+	repo := sqlite.NewShipmentDiffStatRepository(db)
+	ctx := context.Background()
+
+	_ = repo.Save(ctx, "SHIP-001", []*secondary.ShipmentDiffStatRecord{
+		{RepoID: "REPO-001", BaseRef: "main", MergeBase: "aaa", HeadCommit: "bbb", FilesChanged: 2},
+		{RepoID: "REPO-002", BaseRef: "main", MergeBase: "aaa", HeadCommit: "bbb", FilesChanged: 1},
+	}, []*secondary.ShipmentDiffFileRecord{{RepoID: "REPO-001", Path: "a.go"}, {RepoID: "REPO-001", Path: "b.go"}, {RepoID: "REPO-002", Path: "a.go"}})
+	err := repo.Save(ctx, "SHIP-001", []*secondary.ShipmentDiffStatRecord{
+		{RepoID: "REPO-001", BaseRef: "main", MergeBase: "aaa", HeadCommit: "ccc", FilesChanged: 1},
+	}, []*secondary.ShipmentDiffFileRecord{{RepoID: "REPO-001", Path: "c.go", Insertions: 3}})
+	if err != nil {
+		t.Fatalf("second Save failed: %v", err)
+	}
+
+	got, _ := repo.List(ctx, "SHIP-001")
+	if len(got) != 1 || got[0].HeadCommit != "ccc" || got[0].FilesChanged != 1 {
+		t.Errorf("expected only the refreshed stats, got %+v", got)
+	}
+	files, _ := repo.ListFiles(ctx, "SHIP-001")
+	if len(files) != 1 || files[0].Path != "c.go" {
+		t.Errorf("expected only the refreshed file list, got %+v", files)
+	}
+}
+
+func TestShipmentDiffStatRepository_ListNotComputed(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewShipmentDiffStatRepository(db)
+
+	got, err := repo.List(context.Background(), "SHIP-404")
+	if err != nil || len(got) != 0 {
+		t.Errorf("expected no stats for a shipment without stats, got %+v, %v", got, err)
+	}
+}
@@ -190,6 +190,11 @@ func (r *ShipmentRepository) Update(ctx context.Context, shipment *secondary.Shi
 		args = append(args, sql.NullString{String: shipment.Description, Valid: true})
 	}
 
+	if shipment.RepoID != "" {
+		query += ", repo_id = ?"
+		args = append(args, sql.NullString{String: shipment.RepoID, Valid: true})
+	}
+
 	if shipment.Branch != "" {
 		query += ", branch = ?"
 		args = append(args, sql.NullString{String: shipment.Branch, Valid: true})
@@ -277,8 +282,9 @@ func (r *ShipmentRepository) GetNextID(ctx context.Context) (string, error) {
 
 // GetByWorkbench retrieves shipments assigned to a workbench.
 func (r *ShipmentRepository) GetByWorkbench(ctx context.Context, workbenchID string) ([]*secondary.ShipmentRecord, error) {
-	query := "SELECT id, commission_id, title, description, status, assigned_workbench_id, repo_id, branch, pinned, created_at, updated_at, completed_at FROM shipments WHERE assigned_workbench_id = ?"
-	rows, err := r.db.QueryContext(ctx, query, workbenchID)
+	query := "SELECT id, commission_id, title, description, status, assigned_workbench_id, repo_id, branch, pinned, created_at, updated_at, completed_at FROM shipments " +
+		"WHERE assigned_workbench_id = ? OR id IN (SELECT shipment_id FROM shipment_repos WHERE assigned_workbench_id = ?)"
+	rows, err := r.db.QueryContext(ctx, query, workbenchID, workbenchID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get shipments by workbench: %w", err)
 	}
@@ -355,7 +361,7 @@ func (r *ShipmentRepository) UpdateStatus(ctx context.Context, id, status string
 		args = []any{status, id}
 	}
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update shipment status: %w", err)
 	}
@@ -384,8 +390,10 @@ func (r *ShipmentRepository) CommissionExists(ctx context.Context, commissionID
 func (r *ShipmentRepository) WorkbenchAssignedToOther(ctx context.Context, workbenchID, excludeShipmentID string) (string, error) {
 	var shipmentID string
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id FROM shipments WHERE assigned_workbench_id = ? AND id != ? AND status NOT IN ('closed') LIMIT 1",
-		workbenchID, excludeShipmentID,
+		`SELECT id FROM shipments
+		 WHERE (assigned_workbench_id = ? OR id IN (SELECT shipment_id FROM shipment_repos WHERE assigned_workbench_id = ?))
+		   AND id != ? AND status NOT IN ('closed') LIMIT 1`,
+		workbenchID, workbenchID, excludeShipmentID,
 	).Scan(&shipmentID)
 
 	if err == sql.ErrNoRows {
@@ -457,5 +465,119 @@ func (r *ShipmentRepository) MoveToCommission(ctx context.Context, shipmentID, t
 	return int(tasks), int(notes), int(prs), nil
 }
 
+// RepoExists checks if a repository exists.
+func (r *ShipmentRepository) RepoExists(ctx context.Context, repoID string) (bool, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM repos WHERE id = ?", repoID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check repo existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+// AddRepo links an additional repository to a shipment.
+func (r *ShipmentRepository) AddRepo(ctx context.Context, link *secondary.ShipmentRepoRecord) error {
+	var workbenchID sql.NullString
+	if link.AssignedWorkbenchID != "" {
+		workbenchID = sql.NullString{String: link.AssignedWorkbenchID, Valid: true}
+	}
+
+	_, err := r.conn(ctx).ExecContext(ctx,
+		"INSERT INTO shipment_repos (shipment_id, repo_id, branch, assigned_workbench_id) VALUES (?, ?, ?, ?)",
+		link.ShipmentID, link.RepoID, link.Branch, workbenchID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add repo to shipment: %w", err)
+	}
+	return nil
+}
+
+// RemoveRepo unlinks an additional repository from a shipment.
+func (r *ShipmentRepository) RemoveRepo(ctx context.Context, shipmentID, repoID string) error {
+	result, err := r.conn(ctx).ExecContext(ctx,
+		"DELETE FROM shipment_repos WHERE shipment_id = ? AND repo_id = ?",
+		shipmentID, repoID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove repo from shipment: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("repository %s is not an additional repo of shipment %s", repoID, shipmentID)
+	}
+	return nil
+}
+
+// ListRepos retrieves the additional repositories of a shipment, oldest first.
+func (r *ShipmentRepository) ListRepos(ctx context.Context, shipmentID string) ([]*secondary.ShipmentRepoRecord, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx,
+		`SELECT shipment_id, repo_id, branch, assigned_workbench_id, created_at
+		 FROM shipment_repos WHERE shipment_id = ? ORDER BY created_at, repo_id`,
+		shipmentID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipment repos: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*secondary.ShipmentRepoRecord
+	for rows.Next() {
+		var (
+			workbenchID sql.NullString
+			createdAt   time.Time
+		)
+		link := &secondary.ShipmentRepoRecord{}
+		if err := rows.Scan(&link.ShipmentID, &link.RepoID, &link.Branch, &workbenchID, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan shipment repo: %w", err)
+		}
+		link.AssignedWorkbenchID = workbenchID.String
+		link.CreatedAt = createdAt.Format(time.RFC3339)
+		links = append(links, link)
+	}
+
+	return links, rows.Err()
+}
+
+// AssignRepoWorkbench assigns the workbench working on one additional repository of a shipment.
+func (r *ShipmentRepository) AssignRepoWorkbench(ctx context.Context, shipmentID, repoID, workbenchID string) error {
+	result, err := r.conn(ctx).ExecContext(ctx,
+		"UPDATE shipment_repos SET assigned_workbench_id = ? WHERE shipment_id = ? AND repo_id = ?",
+		workbenchID, shipmentID, repoID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to assign workbench to shipment repo: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("repository %s is not an additional repo of shipment %s", repoID, shipmentID)
+	}
+	return nil
+}
+
+// ListPRSummaries retrieves the ID, repo, and status of every PR of a shipment.
+func (r *ShipmentRepository) ListPRSummaries(ctx context.Context, shipmentID string) ([]*secondary.ShipmentPRSummaryRecord, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx,
+		"SELECT id, repo_id, status FROM prs WHERE shipment_id = ? ORDER BY id",
+		shipmentID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipment PRs: %w", err)
+	}
+	defer rows.Close()
+
+	var prs []*secondary.ShipmentPRSummaryRecord
+	for rows.Next() {
+		pr := &secondary.ShipmentPRSummaryRecord{}
+		if err := rows.Scan(&pr.ID, &pr.RepoID, &pr.Status); err != nil {
+			return nil, fmt.Errorf("failed to scan shipment PR: %w", err)
+		}
+		prs = append(prs, pr)
+	}
+
+	return prs, rows.Err()
+}
+
 // Ensure ShipmentRepository implements the interface
 var _ secondary.ShipmentRepository = (*ShipmentRepository)(nil)
@@ -483,3 +483,73 @@ func TestShipmentRepository_WorkbenchAssignedToOther(t *testing.T) {
 		t.Errorf("expected s1 to have the workbench, got '%s'", otherID)
 	}
 }
+
+func TestShipmentRepository_Repos(t *testing.T) {
+	db := setupShipmentTestDB(t)
+	repo := sqlite.NewShipmentRepository(db, nil)
+	ctx := context.Background()
+
+	_, _ = db.Exec("INSERT INTO repos (id, name) VALUES ('REPO-001', 'api'), ('REPO-002', 'client')")
+	seedWorkbench(t, db, "BENCH-002", "", "client-001")
+	s1 := createTestShipment(t, repo, ctx, "COMM-001", "Cross-service", "")
+	s2 := createTestShipment(t, repo, ctx, "COMM-001", "Other", "")
+
+	exists, err := repo.RepoExists(ctx, "REPO-002")
+	if err != nil || !exists {
+		t.Fatalf("RepoExists(REPO-002) = %v, %v, want true", exists, err)
+	}
+
+	if err := repo.AddRepo(ctx, &secondary.ShipmentRepoRecord{ShipmentID: s1.ID, RepoID: "REPO-002", Branch: "ml/SHIP-001-cross"}); err != nil {
+		t.Fatalf("AddRepo failed: %v", err)
+	}
+	if err := repo.AddRepo(ctx, &secondary.ShipmentRepoRecord{ShipmentID: s1.ID, RepoID: "REPO-002", Branch: "dup"}); err == nil {
+		t.Error("expected adding the same repo twice to fail")
+	}
+
+	if err := repo.AssignRepoWorkbench(ctx, s1.ID, "REPO-002", "BENCH-002"); err != nil {
+		t.Fatalf("AssignRepoWorkbench failed: %v", err)
+	}
+	links, err := repo.ListRepos(ctx, s1.ID)
+	if err != nil {
+		t.Fatalf("ListRepos failed: %v", err)
+	}
+	if len(links) != 1 || links[0].Branch != "ml/SHIP-001-cross" || links[0].AssignedWorkbenchID != "BENCH-002" {
+		t.Fatalf("links = %+v, want REPO-002 on BENCH-002", links)
+	}
+
+	// A workbench on an additional repo counts as assigned to the shipment
+	byWorkbench, _ := repo.GetByWorkbench(ctx, "BENCH-002")
+	if len(byWorkbench) != 1 || byWorkbench[0].ID != s1.ID {
+		t.Errorf("GetByWorkbench = %+v, want %s", byWorkbench, s1.ID)
+	}
+	otherID, _ := repo.WorkbenchAssignedToOther(ctx, "BENCH-002", s2.ID)
+	if otherID != s1.ID {
+		t.Errorf("WorkbenchAssignedToOther = %q, want %s", otherID, s1.ID)
+	}
+
+	if err := repo.RemoveRepo(ctx, s1.ID, "REPO-002"); err != nil {
+		t.Fatalf("RemoveRepo failed: %v", err)
+	}
+	if err := repo.RemoveRepo(ctx, s1.ID, "REPO-002"); err == nil {
+		t.Error("expected removing an unlinked repo to fail")
+	}
+}
+
+func TestShipmentRepository_ListPRSummaries(t *testing.T) {
+	db := setupShipmentTestDB(t)
+	repo := sqlite.NewShipmentRepository(db, nil)
+	ctx := context.Background()
+
+	_, _ = db.Exec("INSERT INTO repos (id, name) VALUES ('REPO-001', 'api'), ('REPO-002', 'client')")
+	s1 := createTestShipment(t, repo, ctx, "COMM-001", "Cross-service", "")
+	_, _ = db.Exec("INSERT INTO prs (id, shipment_id, repo_id, commission_id, title, branch, status) VALUES (?, ?, 'REPO-001', 'COMM-001', 'API', 'b', 'merged')", "PR-001", s1.ID)
+	_, _ = db.Exec("INSERT INTO prs (id, shipment_id, repo_id, commission_id, title, branch, status) VALUES (?, ?, 'REPO-002', 'COMM-001', 'Client', 'b', 'open')", "PR-002", s1.ID)
+
+	prs, err := repo.ListPRSummaries(ctx, s1.ID)
+	if err != nil {
+		t.Fatalf("ListPRSummaries failed: %v", err)
+	}
+	if len(prs) != 2 || prs[0].Status != "merged" || prs[1].RepoID != "REPO-002" || prs[1].Status != "open" {
+		t.Errorf("prs = %+v, want PR-001 merged and PR-002 open", prs)
+	}
+}
@@ -49,6 +49,8 @@ func scanTask(scanner interface {
 		updatedAt           time.Time
 		claimedAt           sql.NullTime
 		completedAt         sql.NullTime
+		startCommit         sql.NullString
+		endCommit           sql.NullString
 	)
 
 	record := &secondary.TaskRecord{}
@@ -56,6 +58,7 @@ func scanTask(scanner interface {
 		&record.ID, &shipmentID, &record.CommissionID, &tomeID, &record.Title, &desc,
 		&taskType, &record.Status, &priority, &assignedWorkbenchID,
 		&pinned, &dependsOn, &createdAt, &updatedAt, &claimedAt, &completedAt,
+		&startCommit, &endCommit,
 	)
 	if err != nil {
 		return nil, err
@@ -69,6 +72,8 @@ func scanTask(scanner interface {
 	record.AssignedWorkbenchID = assignedWorkbenchID.String
 	record.Pinned = pinned
 	record.DependsOn = dependsOn.String
+	record.StartCommit = startCommit.String
+	record.EndCommit = endCommit.String
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
 
@@ -82,11 +87,11 @@ func scanTask(scanner interface {
 	return record, nil
 }
 
-const taskSelectCols = "id, shipment_id, commission_id, tome_id, title, description, type, status, priority, assigned_workbench_id, pinned, depends_on, created_at, updated_at, claimed_at, completed_at"
+const taskSelectCols = "id, shipment_id, commission_id, tome_id, title, description, type, status, priority, assigned_workbench_id, pinned, depends_on, created_at, updated_at, claimed_at, completed_at, start_commit, end_commit"
 
 // Create persists a new task.
 func (r *TaskRepository) Create(ctx context.Context, task *secondary.TaskRecord) error {
-	var shipmentID, desc, taskType, dependsOn sql.NullString
+	var shipmentID, desc, taskType, priority, dependsOn sql.NullString
 
 	if task.ShipmentID != "" {
 		shipmentID = sql.NullString{String: task.ShipmentID, Valid: true}
@@ -97,6 +102,9 @@ func (r *TaskRepository) Create(ctx context.Context, task *secondary.TaskRecord)
 	if task.Type != "" {
 		taskType = sql.NullString{String: task.Type, Valid: true}
 	}
+	if task.Priority != "" {
+		priority = sql.NullString{String: task.Priority, Valid: true}
+	}
 	if task.DependsOn != "" {
 		dependsOn = sql.NullString{String: task.DependsOn, Valid: true}
 	}
@@ -106,9 +114,10 @@ func (r *TaskRepository) Create(ctx context.Context, task *secondary.TaskRecord)
 		status = "open"
 	}
 
+	// Tasks created closed (checked items of an imported plan) are completed now
 	_, err := r.conn(ctx).ExecContext(ctx,
-		"INSERT INTO tasks (id, shipment_id, commission_id, title, description, type, status, depends_on) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
-		task.ID, shipmentID, task.CommissionID, task.Title, desc, taskType, status, dependsOn,
+		"INSERT INTO tasks (id, shipment_id, commission_id, title, description, type, status, priority, depends_on, completed_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CASE WHEN ? = 'closed' THEN CURRENT_TIMESTAMP END)",
+		task.ID, shipmentID, task.CommissionID, task.Title, desc, taskType, status, priority, dependsOn, status,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create task: %w", err)
@@ -162,6 +171,11 @@ func (r *TaskRepository) List(ctx context.Context, filters secondary.TaskFilters
 		args = append(args, filters.CommissionID)
 	}
 
+	if filters.WorkbenchID != "" {
+		query += " AND assigned_workbench_id = ?"
+		args = append(args, filters.WorkbenchID)
+	}
+
 	query += " ORDER BY created_at ASC"
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
@@ -400,6 +414,29 @@ func (r *TaskRepository) Claim(ctx context.Context, id, workbenchID string) erro
 	return nil
 }
 
+// UpdateDependsOn replaces the depends_on JSON array for a task.
+func (r *TaskRepository) UpdateDependsOn(ctx context.Context, id, dependsOn string) error {
+	var value sql.NullString
+	if dependsOn != "" {
+		value = sql.NullString{String: dependsOn, Valid: true}
+	}
+
+	result, err := r.conn(ctx).ExecContext(ctx,
+		"UPDATE tasks SET depends_on = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		value, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update task dependencies: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("task %s not found", id)
+	}
+
+	return nil
+}
+
 // AssignWorkbenchByShipment assigns all tasks of a shipment to a workbench.
 func (r *TaskRepository) AssignWorkbenchByShipment(ctx context.Context, shipmentID, workbenchID string) error {
 	_, err := r.db.ExecContext(ctx,
@@ -413,6 +450,32 @@ func (r *TaskRepository) AssignWorkbenchByShipment(ctx context.Context, shipment
 	return nil
 }
 
+// UpdateCommits replaces the git commit range recorded for a task.
+func (r *TaskRepository) UpdateCommits(ctx context.Context, id, startCommit, endCommit string) error {
+	var start, end sql.NullString
+	if startCommit != "" {
+		start = sql.NullString{String: startCommit, Valid: true}
+	}
+	if endCommit != "" {
+		end = sql.NullString{String: endCommit, Valid: true}
+	}
+
+	result, err := r.conn(ctx).ExecContext(ctx,
+		"UPDATE tasks SET start_commit = ?, end_commit = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		start, end, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update task commits: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("task %s not found", id)
+	}
+
+	return nil
+}
+
 // CommissionExists checks if a commission exists.
 func (r *TaskRepository) CommissionExists(ctx context.Context, commissionID string) (bool, error) {
 	var count int
@@ -498,7 +561,8 @@ func (r *TaskRepository) ListByTag(ctx context.Context, tagID string) ([]*second
 	query := `
 		SELECT t.id, t.shipment_id, t.commission_id, t.tome_id, t.title, t.description,
 		       t.type, t.status, t.priority, t.assigned_workbench_id,
-		       t.pinned, t.depends_on, t.created_at, t.updated_at, t.claimed_at, t.completed_at
+		       t.pinned, t.depends_on, t.created_at, t.updated_at, t.claimed_at, t.completed_at,
+		       t.start_commit, t.end_commit
 		FROM tasks t
 		INNER JOIN entity_tags et ON t.id = et.entity_id AND et.entity_type = 'task'
 		WHERE et.tag_id = ?
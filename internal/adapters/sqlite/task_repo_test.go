@@ -77,6 +77,56 @@ func TestTaskRepository_Create(t *testing.T) {
 	}
 }
 
+func TestTaskRepository_Create_WithPriority(t *testing.T) {
+	db := setupTaskTestDB(t)
+	repo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	err := repo.Create(ctx, &secondary.TaskRecord{
+		ID:           "TASK-001",
+		CommissionID: "COMM-001",
+		ShipmentID:   "SHIP-001",
+		Title:        "Urgent Task",
+		Priority:     "high",
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	retrieved, err := repo.GetByID(ctx, "TASK-001")
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if retrieved.Priority != "high" {
+		t.Errorf("expected priority 'high', got '%s'", retrieved.Priority)
+	}
+}
+
+func TestTaskRepository_Create_Closed(t *testing.T) {
+	db := setupTaskTestDB(t)
+	repo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	err := repo.Create(ctx, &secondary.TaskRecord{
+		ID:           "TASK-001",
+		CommissionID: "COMM-001",
+		ShipmentID:   "SHIP-001",
+		Title:        "Done Task",
+		Status:       "closed",
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	retrieved, err := repo.GetByID(ctx, "TASK-001")
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if retrieved.Status != "closed" || retrieved.CompletedAt == "" {
+		t.Errorf("expected a closed task with completed_at, got status %q completed_at %q", retrieved.Status, retrieved.CompletedAt)
+	}
+}
+
 func TestTaskRepository_Create_WithoutShipment(t *testing.T) {
 	db := setupTaskTestDB(t)
 	repo := sqlite.NewTaskRepository(db, nil)
@@ -213,6 +263,25 @@ func TestTaskRepository_List_FilterByCommission(t *testing.T) {
 	}
 }
 
+func TestTaskRepository_List_FilterByWorkbench(t *testing.T) {
+	db := setupTaskTestDB(t)
+	repo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	task1 := createTestTask(t, repo, ctx, "COMM-001", "", "Claimed Task")
+	createTestTask(t, repo, ctx, "COMM-001", "", "Unclaimed Task")
+	_ = repo.Claim(ctx, task1.ID, "BENCH-001")
+
+	tasks, err := repo.List(ctx, secondary.TaskFilters{WorkbenchID: "BENCH-001"})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	if len(tasks) != 1 || tasks[0].ID != task1.ID {
+		t.Errorf("expected only %s for BENCH-001, got %d tasks", task1.ID, len(tasks))
+	}
+}
+
 func TestTaskRepository_Update(t *testing.T) {
 	db := setupTaskTestDB(t)
 	repo := sqlite.NewTaskRepository(db, nil)
@@ -405,6 +474,35 @@ func TestTaskRepository_Claim(t *testing.T) {
 	}
 }
 
+func TestTaskRepository_UpdateCommits(t *testing.T) {
+	db := setupTaskTestDB(t)
+	repo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	task := createTestTask(t, repo, ctx, "COMM-001", "", "Checkpoint Test")
+
+	if err := repo.UpdateCommits(ctx, task.ID, "abc123", "def456"); err != nil {
+		t.Fatalf("UpdateCommits failed: %v", err)
+	}
+	retrieved, _ := repo.GetByID(ctx, task.ID)
+	if retrieved.StartCommit != "abc123" || retrieved.EndCommit != "def456" {
+		t.Errorf("commits = %q..%q, want abc123..def456", retrieved.StartCommit, retrieved.EndCommit)
+	}
+
+	// Empty end clears it
+	if err := repo.UpdateCommits(ctx, task.ID, "abc123", ""); err != nil {
+		t.Fatalf("UpdateCommits failed: %v", err)
+	}
+	retrieved, _ = repo.GetByID(ctx, task.ID)
+	if retrieved.EndCommit != "" {
+		t.Errorf("expected EndCommit to be cleared, got %q", retrieved.EndCommit)
+	}
+
+	if err := repo.UpdateCommits(ctx, "TASK-999", "abc123", ""); err == nil {
+		t.Error("expected error for non-existent task")
+	}
+}
+
 func TestTaskRepository_Claim_NotFound(t *testing.T) {
 	db := setupTaskTestDB(t)
 	repo := sqlite.NewTaskRepository(db, nil)
@@ -0,0 +1,127 @@
+// Package sqlite contains SQLite implementations of repository interfaces.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// TmuxSnapshotRepository implements secondary.TmuxSnapshotRepository with SQLite.
+type TmuxSnapshotRepository struct {
+	db *sql.DB
+}
+
+// NewTmuxSnapshotRepository creates a new SQLite tmux snapshot repository.
+func NewTmuxSnapshotRepository(db *sql.DB) *TmuxSnapshotRepository {
+	return &TmuxSnapshotRepository{db: db}
+}
+
+// conn returns the context-carried transaction if present, otherwise r.db.
+func (r *TmuxSnapshotRepository) conn(ctx context.Context) db.DBTX {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+	return r.db
+}
+
+const tmuxSnapshotColumns = "id, workshop_id, session_name, window_count, pane_count, content_json, created_at"
+
+// Create persists a new snapshot.
+func (r *TmuxSnapshotRepository) Create(ctx context.Context, snapshot *secondary.TmuxSnapshotRecord) error {
+	_, err := r.conn(ctx).ExecContext(ctx,
+		`INSERT INTO tmux_snapshots (id, workshop_id, session_name, window_count, pane_count, content_json)
+		 VALUES (?, ?, ?, ?, ?, ?)`,
+		snapshot.ID,
+		snapshot.WorkshopID,
+		snapshot.SessionName,
+		snapshot.WindowCount,
+		snapshot.PaneCount,
+		snapshot.ContentJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create tmux snapshot: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a snapshot by its ID.
+func (r *TmuxSnapshotRepository) GetByID(ctx context.Context, id string) (*secondary.TmuxSnapshotRecord, error) {
+	record, err := scanTmuxSnapshot(r.conn(ctx).QueryRowContext(ctx,
+		"SELECT "+tmuxSnapshotColumns+" FROM tmux_snapshots WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("tmux snapshot %s not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tmux snapshot: %w", err)
+	}
+	return record, nil
+}
+
+// GetLatest retrieves the newest snapshot of a workshop. Returns nil, nil if there is none.
+func (r *TmuxSnapshotRepository) GetLatest(ctx context.Context, workshopID string) (*secondary.TmuxSnapshotRecord, error) {
+	record, err := scanTmuxSnapshot(r.conn(ctx).QueryRowContext(ctx,
+		"SELECT "+tmuxSnapshotColumns+" FROM tmux_snapshots WHERE workshop_id = ? ORDER BY created_at DESC, id DESC LIMIT 1",
+		workshopID))
+	if err == sql.ErrNoRows {
+		return nil, nil // No snapshot yet
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest tmux snapshot: %w", err)
+	}
+	return record, nil
+}
+
+// List retrieves the snapshots of a workshop, newest first.
+func (r *TmuxSnapshotRepository) List(ctx context.Context, workshopID string) ([]*secondary.TmuxSnapshotRecord, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx,
+		"SELECT "+tmuxSnapshotColumns+" FROM tmux_snapshots WHERE workshop_id = ? ORDER BY created_at DESC, id DESC",
+		workshopID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tmux snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []*secondary.TmuxSnapshotRecord
+	for rows.Next() {
+		record, err := scanTmuxSnapshot(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tmux snapshot: %w", err)
+		}
+		snapshots = append(snapshots, record)
+	}
+	return snapshots, rows.Err()
+}
+
+// GetNextID returns the next available snapshot ID.
+func (r *TmuxSnapshotRepository) GetNextID(ctx context.Context) (string, error) {
+	var maxID int
+	err := r.conn(ctx).QueryRowContext(ctx,
+		"SELECT COALESCE(MAX(CAST(SUBSTR(id, 6) AS INTEGER)), 0) FROM tmux_snapshots",
+	).Scan(&maxID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get next tmux snapshot ID: %w", err)
+	}
+
+	return fmt.Sprintf("SNAP-%03d", maxID+1), nil
+}
+
+// scanTmuxSnapshot scans a tmux_snapshots row selected with tmuxSnapshotColumns.
+func scanTmuxSnapshot(row interface{ Scan(...any) error }) (*secondary.TmuxSnapshotRecord, error) {
+	var createdAt time.Time
+	record := &secondary.TmuxSnapshotRecord{}
+	if err := row.Scan(&record.ID, &record.WorkshopID, &record.SessionName, &record.WindowCount,
+		&record.PaneCount, &record.ContentJSON, &createdAt); err != nil {
+		return nil, err
+	}
+	record.CreatedAt = createdAt.Format(time.RFC3339)
+	return record, nil
+}
+
+// Ensure TmuxSnapshotRepository implements the interface
+var _ secondary.TmuxSnapshotRepository = (*TmuxSnapshotRepository)(nil)
@@ -0,0 +1,84 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestTmuxSnapshotRepository_CreateAndGet(t *testing.T) {
+	db := setupTestDB(t)
+	seedFactory(t, db, "FACT-001", "test-factory")
+	seedWorkshop(t, db, "WORK-001", "FACT-001", "test-workshop")
+	repo := sqlite.NewTmuxSnapshotRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.GetNextID(ctx)
+	if err != nil {
+		t.Fatalf("GetNextID failed: %v", err)
+	}
+	if id != "SNAP-001" {
+		t.Errorf("expected SNAP-001, got %s", id)
+	}
+
+	err = repo.Create(ctx, &secondary.TmuxSnapshotRecord{
+		ID: id, WorkshopID: "WORK-001", SessionName: "orc-test", WindowCount: 2, PaneCount: 5, ContentJSON: `{"session":"orc-test"}`,
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	got, err := repo.GetByID(ctx, "SNAP-001")
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if got.SessionName != "orc-test" || got.PaneCount != 5 || got.ContentJSON != `{"session":"orc-test"}` || got.CreatedAt == "" {
+		t.Errorf("snapshot not round-tripped: %+v", got)
+	}
+
+	if _, err := repo.GetByID(ctx, "SNAP-999"); err == nil {
+		t.Error("expected an error for a missing snapshot")
+	}
+}
+
+func TestTmuxSnapshotRepository_LatestAndList(t *testing.T) {
+	db := setupTestDB(t)
+	seedFactory(t, db, "FACT-001", "test-factory")
+	seedWorkshop(t, db, "WORK-001", "FACT-001", "test-workshop")
+	seedWorkshop(t, db, "WORK-002", "FACT-001", "other-workshop")
+	repo := sqlite.NewTmuxSnapshotRepository(db)
+	ctx := context.Background()
+
+	latest, err := repo.GetLatest(ctx, "WORK-001")
+	if err != nil || latest != nil {
+		t.Fatalf("expected no snapshot yet, got %+v, %v", latest, err)
+	}
+
+	for _, rec := range []*secondary.TmuxSnapshotRecord{
+		{ID: "SNAP-001", WorkshopID: "WORK-001", SessionName: "a", ContentJSON: "{}"},
+		{ID: "SNAP-002", WorkshopID: "WORK-002", SessionName: "b", ContentJSON: "{}"},
+		{ID: "SNAP-003", WorkshopID: "WORK-001", SessionName: "a", ContentJSON: "{}"},
+	} {
+		if err := repo.Create(ctx, rec); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	latest, err = repo.GetLatest(ctx, "WORK-001")
+	if err != nil {
+		t.Fatalf("GetLatest failed: %v", err)
+	}
+	if latest == nil || latest.ID != "SNAP-003" {
+		t.Errorf("expected SNAP-003 as latest, got %+v", latest)
+	}
+
+	list, err := repo.List(ctx, "WORK-001")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(list) != 2 || list[0].ID != "SNAP-003" || list[1].ID != "SNAP-001" {
+		t.Errorf("expected SNAP-003, SNAP-001, got %+v", list)
+	}
+}
@@ -22,7 +22,13 @@ func NewTransactor(database *sql.DB) *Transactor {
 // WithImmediateTx executes fn within a BEGIN IMMEDIATE transaction.
 // The transaction is carried in the context so repositories can detect it
 // via db.TxFromContext and run queries on the same transaction.
+// If ctx already carries a transaction, fn joins it instead of waiting on a
+// second BEGIN IMMEDIATE that could never acquire the write lock.
 func (t *Transactor) WithImmediateTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if db.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
 	conn, err := t.db.Conn(ctx)
 	if err != nil {
 		return fmt.Errorf("acquire conn: %w", err)
@@ -70,7 +70,10 @@ func (r *WorkbenchRepository) Create(ctx context.Context, workbench *secondary.W
 		repoID = sql.NullString{String: workbench.RepoID, Valid: true}
 	}
 
-	var homeBranch, currentBranch sql.NullString
+	var homeBranch, currentBranch, sparseJSON sql.NullString
+	if workbench.SparseJSON != "" {
+		sparseJSON = sql.NullString{String: workbench.SparseJSON, Valid: true}
+	}
 	if workbench.HomeBranch != "" {
 		homeBranch = sql.NullString{String: workbench.HomeBranch, Valid: true}
 	}
@@ -79,8 +82,8 @@ func (r *WorkbenchRepository) Create(ctx context.Context, workbench *secondary.W
 	}
 
 	_, err = c.ExecContext(ctx,
-		"INSERT INTO workbenches (id, workshop_id, name, repo_id, status, home_branch, current_branch) VALUES (?, ?, ?, ?, ?, ?, ?)",
-		id, workbench.WorkshopID, workbench.Name, repoID, status, homeBranch, currentBranch,
+		"INSERT INTO workbenches (id, workshop_id, name, repo_id, status, home_branch, current_branch, sparse_json) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		id, workbench.WorkshopID, workbench.Name, repoID, status, homeBranch, currentBranch, sparseJSON,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create workbench: %w", err)
@@ -108,13 +111,14 @@ func (r *WorkbenchRepository) GetByID(ctx context.Context, id string) (*secondar
 		homeBranch    sql.NullString
 		currentBranch sql.NullString
 		focusedID     sql.NullString
+		sparseJSON    sql.NullString
 	)
 
 	record := &secondary.WorkbenchRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, created_at, updated_at FROM workbenches WHERE id = ?",
+		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, sparse_json, created_at, updated_at FROM workbenches WHERE id = ?",
 		id,
-	).Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &createdAt, &updatedAt)
+	).Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &sparseJSON, &createdAt, &updatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("workbench %s not found", id)
@@ -133,6 +137,7 @@ func (r *WorkbenchRepository) GetByID(ctx context.Context, id string) (*secondar
 		record.CurrentBranch = currentBranch.String
 	}
 	record.FocusedID = focusedID.String
+	record.SparseJSON = sparseJSON.String
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
 	return record, nil
@@ -154,13 +159,14 @@ func (r *WorkbenchRepository) GetByPath(ctx context.Context, path string) (*seco
 		homeBranch    sql.NullString
 		currentBranch sql.NullString
 		focusedID     sql.NullString
+		sparseJSON    sql.NullString
 	)
 
 	record := &secondary.WorkbenchRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, created_at, updated_at FROM workbenches WHERE name = ? AND status = 'active'",
+		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, sparse_json, created_at, updated_at FROM workbenches WHERE name = ? AND status = 'active'",
 		name,
-	).Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &createdAt, &updatedAt)
+	).Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &sparseJSON, &createdAt, &updatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("workbench with path %s not found", path)
@@ -179,6 +185,7 @@ func (r *WorkbenchRepository) GetByPath(ctx context.Context, path string) (*seco
 		record.CurrentBranch = currentBranch.String
 	}
 	record.FocusedID = focusedID.String
+	record.SparseJSON = sparseJSON.String
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
 	return record, nil
@@ -187,7 +194,7 @@ func (r *WorkbenchRepository) GetByPath(ctx context.Context, path string) (*seco
 // GetByWorkshop retrieves all workbenches for a workshop.
 func (r *WorkbenchRepository) GetByWorkshop(ctx context.Context, workshopID string) ([]*secondary.WorkbenchRecord, error) {
 	rows, err := r.db.QueryContext(ctx,
-		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, created_at, updated_at FROM workbenches WHERE workshop_id = ? AND status = 'active' ORDER BY created_at DESC",
+		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, sparse_json, created_at, updated_at FROM workbenches WHERE workshop_id = ? AND status = 'active' ORDER BY created_at DESC",
 		workshopID,
 	)
 	if err != nil {
@@ -204,10 +211,11 @@ func (r *WorkbenchRepository) GetByWorkshop(ctx context.Context, workshopID stri
 			homeBranch    sql.NullString
 			currentBranch sql.NullString
 			focusedID     sql.NullString
+			sparseJSON    sql.NullString
 		)
 
 		record := &secondary.WorkbenchRecord{}
-		err := rows.Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &createdAt, &updatedAt)
+		err := rows.Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &sparseJSON, &createdAt, &updatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan workbench: %w", err)
 		}
@@ -222,6 +230,7 @@ func (r *WorkbenchRepository) GetByWorkshop(ctx context.Context, workshopID stri
 			record.CurrentBranch = currentBranch.String
 		}
 		record.FocusedID = focusedID.String
+		record.SparseJSON = sparseJSON.String
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
 		workbenches = append(workbenches, record)
@@ -232,7 +241,7 @@ func (r *WorkbenchRepository) GetByWorkshop(ctx context.Context, workshopID stri
 
 // List retrieves all workbenches, optionally filtered by workshop.
 func (r *WorkbenchRepository) List(ctx context.Context, workshopID string) ([]*secondary.WorkbenchRecord, error) {
-	query := "SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, created_at, updated_at FROM workbenches WHERE 1=1"
+	query := "SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, sparse_json, created_at, updated_at FROM workbenches WHERE 1=1"
 	args := []any{}
 
 	if workshopID != "" {
@@ -257,10 +266,11 @@ func (r *WorkbenchRepository) List(ctx context.Context, workshopID string) ([]*s
 			homeBranch    sql.NullString
 			currentBranch sql.NullString
 			focusedID     sql.NullString
+			sparseJSON    sql.NullString
 		)
 
 		record := &secondary.WorkbenchRecord{}
-		err := rows.Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &createdAt, &updatedAt)
+		err := rows.Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &sparseJSON, &createdAt, &updatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan workbench: %w", err)
 		}
@@ -275,6 +285,7 @@ func (r *WorkbenchRepository) List(ctx context.Context, workshopID string) ([]*s
 			record.CurrentBranch = currentBranch.String
 		}
 		record.FocusedID = focusedID.String
+		record.SparseJSON = sparseJSON.String
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
 		workbenches = append(workbenches, record)
@@ -390,6 +401,29 @@ func (r *WorkbenchRepository) UpdateFocusedID(ctx context.Context, id, focusedID
 	return nil
 }
 
+// UpdateSparse replaces a workbench's sparse checkout paths.
+func (r *WorkbenchRepository) UpdateSparse(ctx context.Context, id, sparseJSON string) error {
+	var paths sql.NullString
+	if sparseJSON != "" {
+		paths = sql.NullString{String: sparseJSON, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE workbenches SET sparse_json = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		paths, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update workbench sparse paths: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("workbench %s not found", id)
+	}
+
+	return nil
+}
+
 // GetByFocusedID retrieves all active workbenches focusing a specific container.
 func (r *WorkbenchRepository) GetByFocusedID(ctx context.Context, focusedID string) ([]*secondary.WorkbenchRecord, error) {
 	if focusedID == "" {
@@ -397,7 +431,7 @@ func (r *WorkbenchRepository) GetByFocusedID(ctx context.Context, focusedID stri
 	}
 
 	rows, err := r.db.QueryContext(ctx,
-		`SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, created_at, updated_at
+		`SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, sparse_json, created_at, updated_at
 		FROM workbenches WHERE focused_id = ? AND status = 'active'`,
 		focusedID,
 	)
@@ -413,6 +447,7 @@ func (r *WorkbenchRepository) GetByFocusedID(ctx context.Context, focusedID stri
 			homeBranch    sql.NullString
 			currentBranch sql.NullString
 			focusedIDVal  sql.NullString
+			sparseJSON    sql.NullString
 			createdAt     time.Time
 			updatedAt     time.Time
 		)
@@ -420,7 +455,7 @@ func (r *WorkbenchRepository) GetByFocusedID(ctx context.Context, focusedID stri
 		record := &secondary.WorkbenchRecord{}
 		err := rows.Scan(
 			&record.ID, &record.WorkshopID, &record.Name,
-			&repoID, &record.Status, &homeBranch, &currentBranch, &focusedIDVal,
+			&repoID, &record.Status, &homeBranch, &currentBranch, &focusedIDVal, &sparseJSON,
 			&createdAt, &updatedAt,
 		)
 		if err != nil {
@@ -431,6 +466,7 @@ func (r *WorkbenchRepository) GetByFocusedID(ctx context.Context, focusedID stri
 		record.HomeBranch = homeBranch.String
 		record.CurrentBranch = currentBranch.String
 		record.FocusedID = focusedIDVal.String
+		record.SparseJSON = sparseJSON.String
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
 
@@ -376,6 +376,48 @@ func TestWorkbenchRepository_UpdatePath_IsNoOp(t *testing.T) {
 	}
 }
 
+func TestWorkbenchRepository_SparsePaths(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewWorkbenchRepository(db, nil)
+	ctx := context.Background()
+
+	seedFactory(t, db, "FACT-001", "test-factory")
+	seedWorkshop(t, db, "SHOP-001", "FACT-001", "test-workshop")
+
+	workbench := &secondary.WorkbenchRecord{
+		WorkshopID: "SHOP-001",
+		Name:       "mono-001",
+		SparseJSON: `["services/api"]`,
+	}
+	if err := repo.Create(ctx, workbench); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	got, _ := repo.GetByID(ctx, workbench.ID)
+	if got.SparseJSON != `["services/api"]` {
+		t.Errorf("SparseJSON = %q, want the created paths", got.SparseJSON)
+	}
+
+	if err := repo.UpdateSparse(ctx, workbench.ID, `["services/api","services/web"]`); err != nil {
+		t.Fatalf("UpdateSparse failed: %v", err)
+	}
+	list, _ := repo.List(ctx, "SHOP-001")
+	if len(list) != 1 || list[0].SparseJSON != `["services/api","services/web"]` {
+		t.Errorf("List() SparseJSON = %v, want updated paths", list)
+	}
+
+	if err := repo.UpdateSparse(ctx, workbench.ID, ""); err != nil {
+		t.Fatalf("UpdateSparse (full checkout) failed: %v", err)
+	}
+	got, _ = repo.GetByID(ctx, workbench.ID)
+	if got.SparseJSON != "" {
+		t.Errorf("expected full checkout, got %q", got.SparseJSON)
+	}
+
+	if err := repo.UpdateSparse(ctx, "BENCH-999", ""); err == nil {
+		t.Error("expected error for unknown workbench")
+	}
+}
+
 func TestWorkbenchRepository_GetNextID(t *testing.T) {
 	db := setupTestDB(t)
 	repo := sqlite.NewWorkbenchRepository(db, nil)
@@ -268,6 +268,21 @@ type DesiredWorkbench = tmuxpkg.DesiredWorkbench
 // ApplyPlan re-exports the reconciliation plan type.
 type ApplyPlan = tmuxpkg.ApplyPlan
 
+// SessionSnapshot re-exports the session snapshot type.
+type SessionSnapshot = tmuxpkg.SessionSnapshot
+
+// RestorePlan re-exports the snapshot restore plan type.
+type RestorePlan = tmuxpkg.RestorePlan
+
+// PaneInspection re-exports the pane poll type of the pane monitor.
+type PaneInspection = tmuxpkg.PaneInspection
+
+// PaneHealthUpdate re-exports the pane state type written by the pane monitor.
+type PaneHealthUpdate = tmuxpkg.PaneHealthUpdate
+
+// WindowAlert re-exports the window alert type written by the pane monitor.
+type WindowAlert = tmuxpkg.WindowAlert
+
 // NewGotmuxAdapter creates a new gotmux adapter targeting the given socket.
 func NewGotmuxAdapter(socket string) (*GotmuxAdapter, error) {
 	return tmuxpkg.NewGotmuxAdapter(socket)
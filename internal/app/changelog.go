@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/example/orc/internal/core/changelog"
+	"github.com/example/orc/internal/models"
+	"github.com/example/orc/internal/ports/primary"
+)
+
+// changelogFile is the changelog PrependChangelog writes to, at the workbench root.
+const changelogFile = "CHANGELOG.md"
+
+// GetChangelog gathers the work shipped by merged PRs and closed shipments,
+// grouped by task type.
+func (s *ReportServiceImpl) GetChangelog(ctx context.Context, req primary.ChangelogRequest) (*primary.Changelog, error) {
+	cl, date, err := s.buildChangelog(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &primary.Changelog{
+		CommissionID: req.CommissionID,
+		Since:        req.Since,
+		Version:      req.Version,
+		Date:         date,
+		Shipments:    cl.Shipments,
+	}
+	for _, section := range cl.Sections {
+		out := &primary.ChangelogSection{Type: section.Type, Heading: section.Heading}
+		for _, entry := range section.Entries {
+			e := &primary.ChangelogEntry{Title: entry.Title, TaskID: entry.TaskID, ShipmentID: entry.ShipmentID}
+			for _, pr := range entry.PRs {
+				e.PRs = append(e.PRs, &primary.ChangelogPR{ID: pr.ID, Number: pr.Number, URL: pr.URL})
+			}
+			out.Entries = append(out.Entries, e)
+		}
+		result.Sections = append(result.Sections, out)
+	}
+	return result, nil
+}
+
+// RenderChangelog renders a changelog as Keep a Changelog markdown or JSON.
+func (s *ReportServiceImpl) RenderChangelog(ctx context.Context, req primary.ChangelogRequest) (string, error) {
+	switch req.Format {
+	case "", primary.ReportFormatMarkdown:
+		cl, date, err := s.buildChangelog(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		return changelog.RenderMarkdown(cl, req.Version, date), nil
+	case primary.ReportFormatJSON:
+		cl, err := s.GetChangelog(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		data, err := json.MarshalIndent(cl, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to encode changelog: %w", err)
+		}
+		return string(data) + "\n", nil
+	default:
+		return "", fmt.Errorf("unsupported changelog format %q (must be markdown or json)", req.Format)
+	}
+}
+
+// PrependChangelog renders a changelog as markdown and prepends it to the
+// CHANGELOG.md in a workbench, replacing a release with the same heading.
+func (s *ReportServiceImpl) PrependChangelog(ctx context.Context, req primary.ChangelogRequest, workbenchID string) (string, error) {
+	if req.Format != "" && req.Format != primary.ReportFormatMarkdown {
+		return "", fmt.Errorf("only markdown changelogs can be prepended to %s", changelogFile)
+	}
+	wb, err := s.workbenchService.GetWorkbench(ctx, workbenchID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get workbench: %w", err)
+	}
+	if wb == nil {
+		return "", fmt.Errorf("workbench %s not found", workbenchID)
+	}
+	if wb.Path == "" {
+		return "", fmt.Errorf("workbench %s has no path", workbenchID)
+	}
+
+	release, err := s.RenderChangelog(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(wb.Path, changelogFile)
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, []byte(changelog.Prepend(string(existing), release)), 0644); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// buildChangelog collects shipments, their PRs and tasks from the ledger and
+// groups what shipped. It also returns the release date.
+func (s *ReportServiceImpl) buildChangelog(ctx context.Context, req primary.ChangelogRequest) (*changelog.Changelog, string, error) {
+	if req.CommissionID == "" && req.Since == "" {
+		return nil, "", fmt.Errorf("a changelog needs a commission or a --since date")
+	}
+	var since time.Time
+	if req.Since != "" {
+		var err error
+		if since, err = changelog.ParseSince(req.Since); err != nil {
+			return nil, "", err
+		}
+	}
+
+	shipments, err := s.shipmentService.ListShipments(ctx, primary.ShipmentFilters{CommissionID: req.CommissionID})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list shipments: %w", err)
+	}
+	prs, err := s.prService.ListPRs(ctx, primary.PRFilters{CommissionID: req.CommissionID})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list PRs: %w", err)
+	}
+	prsByShipment := make(map[string][]changelog.PR)
+	for _, pr := range prs {
+		prsByShipment[pr.ShipmentID] = append(prsByShipment[pr.ShipmentID], changelog.PR{
+			ID:       pr.ID,
+			Number:   pr.Number,
+			URL:      pr.URL,
+			Merged:   pr.Status == primary.PRStatusMerged,
+			MergedAt: pr.MergedAt,
+		})
+	}
+
+	var inputs []changelog.Shipment
+	for _, shipment := range shipments {
+		input := changelog.Shipment{
+			ID:          shipment.ID,
+			Title:       shipment.Title,
+			Closed:      shipment.Status == models.ShipmentStatusClosed,
+			CompletedAt: shipment.CompletedAt,
+			PRs:         prsByShipment[shipment.ID],
+		}
+		// Only shipments that shipped need their tasks
+		if _, ok := changelog.Shipped(input); !ok {
+			continue
+		}
+		tasks, err := s.shipmentService.GetShipmentTasks(ctx, shipment.ID)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get tasks for %s: %w", shipment.ID, err)
+		}
+		for _, task := range tasks {
+			input.Tasks = append(input.Tasks, changelog.Task{
+				ID:     task.ID,
+				Title:  task.Title,
+				Type:   task.Type,
+				Closed: task.Status == models.TaskStatusClosed,
+			})
+		}
+		inputs = append(inputs, input)
+	}
+
+	return changelog.Build(inputs, since), time.Now().Format("2006-01-02"), nil
+}
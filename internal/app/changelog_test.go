@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestReportService_Changelog(t *testing.T) {
+	service, shipments, prs, _ := newTestReportService(nil)
+	ctx := context.Background()
+
+	shipments.shipments["SHIP-001"].Status = "closed"
+	shipments.shipments["SHIP-001"].CompletedAt = "2026-03-05T00:00:00Z"
+	shipments.shipmentTasks["SHIP-001"] = []*primary.Task{
+		{ID: "TASK-001", Title: "Add OAuth2 login", Type: "implementation", Status: "closed"},
+		{ID: "TASK-002", Title: "Fix token refresh", Type: "fix", Status: "closed"},
+		{ID: "TASK-003", Title: "Compare providers", Type: "research", Status: "closed"},
+	}
+	prs.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", CommissionID: "COMM-001", Number: 41,
+		URL: "https://example.com/pull/41", Status: "merged", MergedAt: "2026-03-04T00:00:00Z"}
+	shipments.shipments["SHIP-002"] = &primary.Shipment{ID: "SHIP-002", CommissionID: "COMM-001", Title: "Unmerged", Status: "closed", CompletedAt: "2026-03-05T00:00:00Z"}
+	prs.prs["PR-002"] = &secondary.PRRecord{ID: "PR-002", ShipmentID: "SHIP-002", CommissionID: "COMM-001", Status: "closed"}
+
+	out, err := service.RenderChangelog(ctx, primary.ChangelogRequest{CommissionID: "COMM-001", Version: "1.2.0"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"## [1.2.0] - ",
+		"### Added\n\n- Add OAuth2 login (TASK-001, SHIP-001, [#41](https://example.com/pull/41))",
+		"### Fixed\n\n- Fix token refresh (TASK-002, SHIP-001, [#41](https://example.com/pull/41))",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected changelog to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Compare providers") || strings.Contains(out, "Unmerged") {
+		t.Errorf("expected research tasks and unmerged shipments to be left out, got:\n%s", out)
+	}
+
+	out, err = service.RenderChangelog(ctx, primary.ChangelogRequest{CommissionID: "COMM-001", Format: "json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var cl primary.Changelog
+	if err := json.Unmarshal([]byte(out), &cl); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if len(cl.Shipments) != 1 || len(cl.Sections) != 2 || cl.Sections[0].Entries[0].PRs[0].Number != 41 {
+		t.Errorf("unexpected json changelog: %+v", cl)
+	}
+
+	if out, err := service.RenderChangelog(ctx, primary.ChangelogRequest{Since: "2026-04-01"}); err != nil || out != "## [Unreleased]\n" {
+		t.Errorf("expected an empty changelog after --since, got %q (%v)", out, err)
+	}
+	if _, err := service.RenderChangelog(ctx, primary.ChangelogRequest{}); err == nil {
+		t.Error("expected error without a commission or --since")
+	}
+}
+
+func TestReportService_PrependChangelog(t *testing.T) {
+	service, shipments, _, _ := newTestReportService(nil)
+	ctx := context.Background()
+	dir := t.TempDir()
+	workbenches := newMockWorkbenchServiceForSummary()
+	workbenches.workbenches["BENCH-001"] = &primary.Workbench{ID: "BENCH-001", Path: dir}
+	service.workbenchService = workbenches
+
+	shipments.shipments["SHIP-001"].Status = "closed"
+	shipments.shipments["SHIP-001"].CompletedAt = "2026-03-05T00:00:00Z"
+	existing := "# Changelog\n\n## [1.0.0] - 2026-01-01\n\n- Initial release\n"
+	if err := os.WriteFile(filepath.Join(dir, "CHANGELOG.md"), []byte(existing), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	req := primary.ChangelogRequest{CommissionID: "COMM-001"}
+	for i := 0; i < 2; i++ {
+		if _, err := service.PrependChangelog(ctx, req, "BENCH-001"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "CHANGELOG.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "# Changelog\n\n## [Unreleased]\n\n### Changed\n\n- Design schema (TASK-001, SHIP-001)\n\n## [1.0.0] - 2026-01-01\n\n- Initial release\n"
+	if string(data) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", data, want)
+	}
+
+	if _, err := service.PrependChangelog(ctx, primary.ChangelogRequest{CommissionID: "COMM-001", Format: "json"}, "BENCH-001"); err == nil {
+		t.Error("expected error prepending json")
+	}
+	if _, err := service.PrependChangelog(ctx, req, "BENCH-999"); err == nil {
+		t.Error("expected error for a missing workbench")
+	}
+}
@@ -259,8 +259,112 @@ func (s *CommissionServiceImpl) UnpinCommission(ctx context.Context, commissionI
 	return s.commissionRepo.Unpin(ctx, commissionID)
 }
 
+// GetCommissionRollup returns aggregate metrics for a commission.
+func (s *CommissionServiceImpl) GetCommissionRollup(ctx context.Context, commissionID string) (*primary.CommissionRollup, error) {
+	record, err := s.commissionRepo.GetByID(ctx, commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("commission not found: %w", err)
+	}
+
+	rollup, err := s.commissionRepo.GetRollup(ctx, commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commission rollup: %w", err)
+	}
+
+	action := corecommission.EvaluateLifecycle(lifecycleContext(record, rollup))
+	return &primary.CommissionRollup{
+		CommissionID:      commissionID,
+		ShipmentsByStatus: rollup.ShipmentsByStatus,
+		TomesByStatus:     rollup.TomesByStatus,
+		OpenNotesByType:   rollup.OpenNotesByType,
+		LastActivity:      rollup.LastActivity,
+		ReadyToComplete:   action == corecommission.LifecycleComplete || action == corecommission.LifecyclePromptComplete,
+	}, nil
+}
+
+// SyncCommissionLifecycle aligns commission status with its shipments and tomes.
+// Pinned commissions are never auto-completed; the result reports a prompt instead.
+func (s *CommissionServiceImpl) SyncCommissionLifecycle(ctx context.Context, commissionID string) (*primary.CommissionLifecycleResult, error) {
+	record, err := s.commissionRepo.GetByID(ctx, commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("commission not found: %w", err)
+	}
+
+	rollup, err := s.commissionRepo.GetRollup(ctx, commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commission rollup: %w", err)
+	}
+
+	action := corecommission.EvaluateLifecycle(lifecycleContext(record, rollup))
+	result := &primary.CommissionLifecycleResult{
+		CommissionID:   commissionID,
+		Action:         string(action),
+		PreviousStatus: record.Status,
+		NewStatus:      record.Status,
+	}
+
+	var transition corecommission.StatusTransitionResult
+	switch action {
+	case corecommission.LifecycleActivate:
+		transition = corecommission.ApplyStatusTransition(corecommission.StatusActive, time.Now())
+	case corecommission.LifecycleComplete:
+		transition = corecommission.ApplyStatusTransition(corecommission.StatusComplete, time.Now())
+	default:
+		return result, nil
+	}
+
+	record.Status = string(transition.NewStatus)
+	if transition.CompletedAt != nil {
+		record.CompletedAt = transition.CompletedAt.Format(time.RFC3339)
+	}
+	if err := s.commissionRepo.Update(ctx, record); err != nil {
+		return nil, err
+	}
+
+	result.NewStatus = record.Status
+	return result, nil
+}
+
 // Helper methods
 
+// syncCommissionLifecycle is called after a shipment or tome changes status.
+// Lifecycle sync is best-effort: a failure is reported in the result but never
+// fails the triggering operation. Returns nil when there is nothing to sync.
+func syncCommissionLifecycle(ctx context.Context, commissionService primary.CommissionService, commissionID string) *primary.CommissionLifecycleResult {
+	if commissionService == nil || commissionID == "" {
+		return nil
+	}
+
+	result, err := commissionService.SyncCommissionLifecycle(ctx, commissionID)
+	if err != nil {
+		return &primary.CommissionLifecycleResult{CommissionID: commissionID, Error: err.Error()}
+	}
+	return result
+}
+
+// lifecycleContext builds the core lifecycle context from a commission and its rollup.
+func lifecycleContext(record *secondary.CommissionRecord, rollup *secondary.CommissionRollupRecord) corecommission.LifecycleContext {
+	ctx := corecommission.LifecycleContext{
+		CommissionID:        record.ID,
+		Status:              corecommission.CommissionStatus(record.Status),
+		IsPinned:            record.Pinned,
+		InProgressShipments: rollup.ShipmentsByStatus["in-progress"],
+		ClosedShipments:     rollup.ShipmentsByStatus["closed"],
+		ClosedTomes:         rollup.TomesByStatus["closed"],
+	}
+	for status, count := range rollup.ShipmentsByStatus {
+		if status != "closed" {
+			ctx.OpenShipments += count
+		}
+	}
+	for status, count := range rollup.TomesByStatus {
+		if status != "closed" {
+			ctx.OpenTomes += count
+		}
+	}
+	return ctx
+}
+
 func (s *CommissionServiceImpl) recordToCommission(r *secondary.CommissionRecord) *primary.Commission {
 	return &primary.Commission{
 		ID:          r.ID,
@@ -18,6 +18,7 @@ import (
 type mockCommissionRepository struct {
 	commissions   map[string]*secondary.CommissionRecord
 	shipmentCount map[string]int
+	rollups       map[string]*secondary.CommissionRollupRecord
 	createErr     error
 	getErr        error
 	updateErr     error
@@ -29,6 +30,7 @@ func newMockCommissionRepository() *mockCommissionRepository {
 	return &mockCommissionRepository{
 		commissions:   make(map[string]*secondary.CommissionRecord),
 		shipmentCount: make(map[string]int),
+		rollups:       make(map[string]*secondary.CommissionRollupRecord),
 	}
 }
 
@@ -88,6 +90,13 @@ func (m *mockCommissionRepository) CountShipments(ctx context.Context, commissio
 	return m.shipmentCount[commissionID], nil
 }
 
+func (m *mockCommissionRepository) GetRollup(ctx context.Context, commissionID string) (*secondary.CommissionRollupRecord, error) {
+	if rollup, ok := m.rollups[commissionID]; ok {
+		return rollup, nil
+	}
+	return &secondary.CommissionRollupRecord{}, nil
+}
+
 func (m *mockCommissionRepository) Pin(ctx context.Context, id string) error {
 	if commission, ok := m.commissions[id]; ok {
 		commission.Pinned = true
@@ -567,3 +576,115 @@ func TestUpdateCommission_Description(t *testing.T) {
 		t.Errorf("expected description 'New description', got '%s'", commissionRepo.commissions["COMM-001"].Description)
 	}
 }
+
+// ============================================================================
+// Lifecycle Tests
+// ============================================================================
+
+func TestSyncCommissionLifecycle_AutoCompletes(t *testing.T) {
+	service, commissionRepo, _ := newTestService(secondary.AgentTypeORC)
+	ctx := context.Background()
+
+	commissionRepo.commissions["COMM-001"] = &secondary.CommissionRecord{ID: "COMM-001", Title: "Done", Status: "active"}
+	commissionRepo.rollups["COMM-001"] = &secondary.CommissionRollupRecord{
+		ShipmentsByStatus: map[string]int{"closed": 2},
+		TomesByStatus:     map[string]int{"closed": 1},
+	}
+
+	result, err := service.SyncCommissionLifecycle(ctx, "COMM-001")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Action != "complete" || result.NewStatus != "complete" {
+		t.Errorf("expected auto-complete, got %+v", result)
+	}
+	record := commissionRepo.commissions["COMM-001"]
+	if record.Status != "complete" || record.CompletedAt == "" {
+		t.Errorf("expected complete status with CompletedAt, got %+v", record)
+	}
+}
+
+func TestSyncCommissionLifecycle_PinnedPrompts(t *testing.T) {
+	service, commissionRepo, _ := newTestService(secondary.AgentTypeORC)
+	ctx := context.Background()
+
+	commissionRepo.commissions["COMM-001"] = &secondary.CommissionRecord{ID: "COMM-001", Title: "Pinned", Status: "active", Pinned: true}
+	commissionRepo.rollups["COMM-001"] = &secondary.CommissionRollupRecord{
+		ShipmentsByStatus: map[string]int{"closed": 1},
+	}
+
+	result, err := service.SyncCommissionLifecycle(ctx, "COMM-001")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Action != "prompt-complete" {
+		t.Errorf("expected prompt-complete, got %q", result.Action)
+	}
+	if commissionRepo.commissions["COMM-001"].Status != "active" {
+		t.Error("expected pinned commission to stay active")
+	}
+}
+
+func TestSyncCommissionLifecycle_ActivatesPaused(t *testing.T) {
+	service, commissionRepo, _ := newTestService(secondary.AgentTypeORC)
+	ctx := context.Background()
+
+	commissionRepo.commissions["COMM-001"] = &secondary.CommissionRecord{ID: "COMM-001", Title: "Paused", Status: "paused"}
+	commissionRepo.rollups["COMM-001"] = &secondary.CommissionRollupRecord{
+		ShipmentsByStatus: map[string]int{"in-progress": 1, "draft": 1},
+	}
+
+	result, err := service.SyncCommissionLifecycle(ctx, "COMM-001")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Action != "activate" || result.PreviousStatus != "paused" || result.NewStatus != "active" {
+		t.Errorf("expected activation, got %+v", result)
+	}
+}
+
+func TestSyncCommissionLifecycle_NoChange(t *testing.T) {
+	service, commissionRepo, _ := newTestService(secondary.AgentTypeORC)
+	ctx := context.Background()
+
+	commissionRepo.commissions["COMM-001"] = &secondary.CommissionRecord{ID: "COMM-001", Title: "Busy", Status: "active"}
+	commissionRepo.rollups["COMM-001"] = &secondary.CommissionRollupRecord{
+		ShipmentsByStatus: map[string]int{"in-progress": 1, "closed": 1},
+	}
+
+	result, err := service.SyncCommissionLifecycle(ctx, "COMM-001")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Action != "" || result.NewStatus != "active" {
+		t.Errorf("expected no change, got %+v", result)
+	}
+}
+
+func TestGetCommissionRollup(t *testing.T) {
+	service, commissionRepo, _ := newTestService(secondary.AgentTypeORC)
+	ctx := context.Background()
+
+	commissionRepo.commissions["COMM-001"] = &secondary.CommissionRecord{ID: "COMM-001", Title: "Done", Status: "active"}
+	commissionRepo.rollups["COMM-001"] = &secondary.CommissionRollupRecord{
+		ShipmentsByStatus: map[string]int{"closed": 2},
+		OpenNotesByType:   map[string]int{"idea": 3},
+		LastActivity:      "2026-01-19T10:00:00Z",
+	}
+
+	rollup, err := service.GetCommissionRollup(ctx, "COMM-001")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !rollup.ReadyToComplete {
+		t.Error("expected commission with all shipments closed to be ready to complete")
+	}
+	if rollup.OpenNotesByType["idea"] != 3 || rollup.LastActivity != "2026-01-19T10:00:00Z" {
+		t.Errorf("unexpected rollup: %+v", rollup)
+	}
+}
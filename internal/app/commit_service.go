@@ -0,0 +1,232 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/example/orc/internal/core/effects"
+	coregit "github.com/example/orc/internal/core/git"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// commitHookMarker identifies a prepare-commit-msg hook written by orc.
+// Hooks without it belong to the user and are never overwritten.
+const commitHookMarker = "# Installed by orc"
+
+// commitHookScript is the prepare-commit-msg hook installed into workbench repos.
+// It must never block a commit, so every failure is swallowed.
+const commitHookScript = `#!/bin/sh
+` + commitHookMarker + `: adds Orc-Task / Orc-Shipment trailers to commits made in orc workbenches.
+# Does nothing outside a workbench. Delete this file to opt out.
+command -v orc >/dev/null 2>&1 || exit 0
+orc commit prepare-msg "$1" "$2" >/dev/null 2>&1
+exit 0
+`
+
+// CommitServiceImpl implements the CommitService interface.
+type CommitServiceImpl struct {
+	commitRepo    secondary.CommitRepository
+	workbenchRepo secondary.WorkbenchRepository
+	repoRepo      secondary.RepoRepository
+	taskRepo      secondary.TaskRepository
+	shipmentRepo  secondary.ShipmentRepository
+	workspace     secondary.WorkspaceAdapter
+	gitService    *GitService
+}
+
+// NewCommitService creates a new CommitService with injected dependencies.
+func NewCommitService(
+	commitRepo secondary.CommitRepository,
+	workbenchRepo secondary.WorkbenchRepository,
+	repoRepo secondary.RepoRepository,
+	taskRepo secondary.TaskRepository,
+	shipmentRepo secondary.ShipmentRepository,
+	workspace secondary.WorkspaceAdapter,
+	gitService *GitService,
+) *CommitServiceImpl {
+	return &CommitServiceImpl{
+		commitRepo:    commitRepo,
+		workbenchRepo: workbenchRepo,
+		repoRepo:      repoRepo,
+		taskRepo:      taskRepo,
+		shipmentRepo:  shipmentRepo,
+		workspace:     workspace,
+		gitService:    gitService,
+	}
+}
+
+// ScanCommits stores trailer-carrying commits from the repos linked to workbenches.
+// Trailers naming tasks or shipments that are not in the ledger are dropped; a commit
+// with only a task trailer is linked to that task's shipment.
+func (s *CommitServiceImpl) ScanCommits(ctx context.Context, req primary.ScanCommitsRequest) (*primary.ScanCommitsResult, error) {
+	workbenches, err := s.workbenchRepo.List(ctx, req.WorkshopID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workbenches: %w", err)
+	}
+
+	result := &primary.ScanCommitsResult{}
+	scanned := make(map[string]bool)
+	taskShipments := make(map[string]string) // task ID -> shipment ID ("" if task unknown)
+	shipments := make(map[string]bool)       // shipment ID -> exists
+
+	for _, wb := range workbenches {
+		if wb.RepoID == "" || scanned[wb.RepoID] {
+			continue
+		}
+		scanned[wb.RepoID] = true
+
+		repo, err := s.repoRepo.GetByID(ctx, wb.RepoID)
+		if err != nil || repo.LocalPath == "" {
+			continue
+		}
+		if exists, err := s.workspace.DirectoryExists(ctx, repo.LocalPath); err != nil || !exists {
+			continue
+		}
+		commits, err := s.gitService.ListTrailerCommits(repo.LocalPath)
+		if err != nil {
+			continue // Not a git repo (or git unavailable)
+		}
+		result.Repos++
+
+		for _, c := range commits {
+			links := coregit.ParseTrailers(c.Message)
+
+			taskID := ""
+			if links.TaskID != "" {
+				shipmentID, known := taskShipments[links.TaskID]
+				if !known {
+					if task, err := s.taskRepo.GetByID(ctx, links.TaskID); err == nil {
+						shipmentID = task.ShipmentID
+						taskShipments[links.TaskID] = shipmentID
+						known = true
+					}
+				}
+				if known {
+					taskID = links.TaskID
+					if links.ShipmentID == "" {
+						links.ShipmentID = shipmentID
+					}
+				}
+			}
+
+			shipmentID := ""
+			if links.ShipmentID != "" {
+				exists, seen := shipments[links.ShipmentID]
+				if !seen {
+					_, err := s.shipmentRepo.GetByID(ctx, links.ShipmentID)
+					exists = err == nil
+					shipments[links.ShipmentID] = exists
+				}
+				if exists {
+					shipmentID = links.ShipmentID
+				}
+			}
+
+			if taskID == "" && shipmentID == "" {
+				result.Skipped++
+				continue
+			}
+
+			err := s.commitRepo.Upsert(ctx, &secondary.CommitRecord{
+				SHA:          c.Hash,
+				RepoID:       repo.ID,
+				TaskID:       taskID,
+				ShipmentID:   shipmentID,
+				Author:       c.Author,
+				Subject:      c.Subject,
+				Message:      c.Message,
+				FilesChanged: c.FilesChanged,
+				Insertions:   c.Insertions,
+				Deletions:    c.Deletions,
+				CommittedAt:  c.Date,
+			})
+			if err != nil {
+				return result, err
+			}
+			result.Linked++
+		}
+	}
+
+	return result, nil
+}
+
+// ListCommits lists stored commits with optional filters.
+func (s *CommitServiceImpl) ListCommits(ctx context.Context, filters primary.CommitFilters) ([]*primary.Commit, error) {
+	records, err := s.commitRepo.List(ctx, secondary.CommitFilters{
+		TaskID:     filters.TaskID,
+		ShipmentID: filters.ShipmentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]*primary.Commit, len(records))
+	for i, r := range records {
+		commits[i] = &primary.Commit{
+			SHA:          r.SHA,
+			RepoID:       r.RepoID,
+			TaskID:       r.TaskID,
+			ShipmentID:   r.ShipmentID,
+			Author:       r.Author,
+			Subject:      r.Subject,
+			Message:      r.Message,
+			FilesChanged: r.FilesChanged,
+			Insertions:   r.Insertions,
+			Deletions:    r.Deletions,
+			CommittedAt:  r.CommittedAt,
+		}
+	}
+	return commits, nil
+}
+
+// PrepareCommitMessage adds Orc-Task / Orc-Shipment trailers to a commit message file.
+// Merges, squashes, and amends keep their message untouched.
+func (s *CommitServiceImpl) PrepareCommitMessage(ctx context.Context, req primary.PrepareCommitMessageRequest) error {
+	switch req.Source {
+	case "merge", "squash", "commit":
+		return nil
+	}
+
+	wb, err := s.workbenchRepo.GetByID(ctx, req.WorkbenchID)
+	if err != nil {
+		return fmt.Errorf("workbench not found: %w", err)
+	}
+
+	tasks, err := s.taskRepo.List(ctx, secondary.TaskFilters{WorkbenchID: wb.ID, Status: "in-progress"})
+	if err != nil {
+		return fmt.Errorf("failed to list claimed tasks: %w", err)
+	}
+	claimed := make([]coregit.ClaimedTask, len(tasks))
+	for i, t := range tasks {
+		claimed[i] = coregit.ClaimedTask{ID: t.ID, ShipmentID: t.ShipmentID, ClaimedAt: t.ClaimedAt}
+	}
+
+	links := coregit.ResolveCommitLinks(wb.FocusedID, claimed)
+	return s.gitService.AddTrailers(req.WorkDir, req.MessageFile, coregit.FormatTrailers(links))
+}
+
+// commitHookEffects returns the effects that install the prepare-commit-msg hook for a repo.
+// Returns nothing when the hook is current, when the repo's hooks directory cannot be
+// resolved, or when a hook not written by orc is already in place.
+func commitHookEffects(gitService *GitService, repoPath string) []effects.Effect {
+	hookPath, err := gitService.HookPath(repoPath, "prepare-commit-msg")
+	if err != nil {
+		return nil
+	}
+	if existing, err := os.ReadFile(hookPath); err == nil {
+		if string(existing) == commitHookScript || !strings.Contains(string(existing), commitHookMarker) {
+			return nil
+		}
+	}
+	return []effects.Effect{
+		effects.FileEffect{Operation: "mkdir", Path: filepath.Dir(hookPath), Mode: 0755},
+		effects.FileEffect{Operation: "write", Path: hookPath, Content: []byte(commitHookScript), Mode: 0755},
+	}
+}
+
+// Ensure CommitServiceImpl implements the interface
+var _ primary.CommitService = (*CommitServiceImpl)(nil)
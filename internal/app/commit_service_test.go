@@ -0,0 +1,248 @@
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/core/effects"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ============================================================================
+// Mock Implementations
+// ============================================================================
+
+type mockCommitRepository struct {
+	commits map[string]*secondary.CommitRecord
+}
+
+func newMockCommitRepository() *mockCommitRepository {
+	return &mockCommitRepository{commits: make(map[string]*secondary.CommitRecord)}
+}
+
+func (m *mockCommitRepository) Upsert(ctx context.Context, commit *secondary.CommitRecord) error {
+	m.commits[commit.SHA] = commit
+	return nil
+}
+
+func (m *mockCommitRepository) List(ctx context.Context, filters secondary.CommitFilters) ([]*secondary.CommitRecord, error) {
+	var result []*secondary.CommitRecord
+	for _, c := range m.commits {
+		if filters.TaskID != "" && c.TaskID != filters.TaskID {
+			continue
+		}
+		if filters.ShipmentID != "" && c.ShipmentID != filters.ShipmentID {
+			continue
+		}
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CommittedAt < result[j].CommittedAt })
+	return result, nil
+}
+
+// ============================================================================
+// Test Helper
+// ============================================================================
+
+// newTestCommitService returns a commit service over one git repo (REPO-001) with one
+// workbench (BENCH-001), a shipment SHIP-001, and a task TASK-001 in it.
+func newTestCommitService(t *testing.T) (*CommitServiceImpl, *mockCommitRepository, *mockWorkbenchRepository, *mockTaskRepository, string) {
+	t.Helper()
+	home := setupGitHome(t)
+	repoPath := filepath.Join(home, "src", "app")
+	if err := os.MkdirAll(repoPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, repoPath, "init", "-q", "-b", "main")
+	commitFile(t, repoPath, "README.md", "hello\n")
+
+	workbenchRepo := newMockWorkbenchRepository()
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{ID: "BENCH-001", Name: "app-001", WorkshopID: "WORK-001", RepoID: "REPO-001"}
+	repoRepo := newMockRepoRepositoryForWorkbench()
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app", LocalPath: repoPath}
+	taskRepo := newMockTaskRepository()
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", ShipmentID: "SHIP-001", Status: "open"}
+	shipmentRepo := newMockShipmentRepository()
+	shipmentRepo.shipments["SHIP-001"] = &secondary.ShipmentRecord{ID: "SHIP-001"}
+	commitRepo := newMockCommitRepository()
+	workspace := newMockWorkspaceAdapter()
+	workspace.reposBasePath = home // Check directories on disk
+
+	service := NewCommitService(commitRepo, workbenchRepo, repoRepo, taskRepo, shipmentRepo, workspace, NewGitService())
+	return service, commitRepo, workbenchRepo, taskRepo, repoPath
+}
+
+func commitWithMessage(t *testing.T, dir, file, message string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, file), []byte(file+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, dir, "add", file)
+	runGit(t, dir, "commit", "-q", "-m", message)
+}
+
+// ============================================================================
+// ScanCommits Tests
+// ============================================================================
+
+func TestCommitService_ScanCommits(t *testing.T) {
+	service, commitRepo, _, _, repoPath := newTestCommitService(t)
+	ctx := context.Background()
+
+	runGit(t, repoPath, "checkout", "-q", "-b", "ml/SHIP-001-login")
+	commitWithMessage(t, repoPath, "login.go", "Add login\n\nOrc-Task: TASK-001")
+	commitWithMessage(t, repoPath, "docs.md", "Document login\n\nOrc-Shipment: SHIP-001")
+	commitWithMessage(t, repoPath, "old.go", "Old work\n\nOrc-Task: TASK-999")
+	commitWithMessage(t, repoPath, "misc.go", "No trailers")
+	runGit(t, repoPath, "checkout", "-q", "main")
+
+	result, err := service.ScanCommits(ctx, primary.ScanCommitsRequest{})
+	if err != nil {
+		t.Fatalf("ScanCommits failed: %v", err)
+	}
+	if result.Repos != 1 || result.Linked != 2 || result.Skipped != 1 {
+		t.Errorf("result = %+v, want 1 repo, 2 linked, 1 skipped", result)
+	}
+
+	byTask, _ := service.ListCommits(ctx, primary.CommitFilters{TaskID: "TASK-001"})
+	if len(byTask) != 1 {
+		t.Fatalf("expected 1 commit for TASK-001, got %d", len(byTask))
+	}
+	c := byTask[0]
+	if c.Subject != "Add login" || c.ShipmentID != "SHIP-001" || c.RepoID != "REPO-001" {
+		t.Errorf("commit = %+v, want task commit linked to SHIP-001 via its task", c)
+	}
+	if c.FilesChanged != 1 || c.Insertions != 1 || c.Deletions != 0 {
+		t.Errorf("diffstat = %d files +%d -%d, want 1 file +1 -0", c.FilesChanged, c.Insertions, c.Deletions)
+	}
+
+	byShipment, _ := service.ListCommits(ctx, primary.CommitFilters{ShipmentID: "SHIP-001"})
+	if len(byShipment) != 2 {
+		t.Errorf("expected 2 commits for SHIP-001, got %d", len(byShipment))
+	}
+
+	// Rescanning is idempotent
+	if _, err := service.ScanCommits(ctx, primary.ScanCommitsRequest{}); err != nil {
+		t.Fatalf("rescan failed: %v", err)
+	}
+	if len(commitRepo.commits) != 2 {
+		t.Errorf("expected 2 stored commits after rescan, got %d", len(commitRepo.commits))
+	}
+}
+
+func TestCommitService_ScanCommits_SkipsMissingRepo(t *testing.T) {
+	service, _, workbenchRepo, _, _ := newTestCommitService(t)
+	workbenchRepo.workbenches["BENCH-001"].RepoID = "REPO-404"
+
+	result, err := service.ScanCommits(context.Background(), primary.ScanCommitsRequest{})
+	if err != nil {
+		t.Fatalf("ScanCommits failed: %v", err)
+	}
+	if result.Repos != 0 {
+		t.Errorf("expected no repos scanned, got %d", result.Repos)
+	}
+}
+
+// ============================================================================
+// PrepareCommitMessage Tests
+// ============================================================================
+
+func TestCommitService_PrepareCommitMessage(t *testing.T) {
+	service, _, workbenchRepo, taskRepo, repoPath := newTestCommitService(t)
+	ctx := context.Background()
+	workbenchRepo.workbenches["BENCH-001"].FocusedID = "SHIP-001"
+	taskRepo.tasks["TASK-001"].Status = "in-progress"
+	taskRepo.tasks["TASK-001"].AssignedWorkbenchID = "BENCH-001"
+
+	msgFile := filepath.Join(t.TempDir(), "COMMIT_EDITMSG")
+	if err := os.WriteFile(msgFile, []byte("Add login\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := service.PrepareCommitMessage(ctx, primary.PrepareCommitMessageRequest{
+		WorkbenchID: "BENCH-001",
+		WorkDir:     repoPath,
+		MessageFile: msgFile,
+		Source:      "message",
+	})
+	if err != nil {
+		t.Fatalf("PrepareCommitMessage failed: %v", err)
+	}
+
+	got, _ := os.ReadFile(msgFile)
+	want := "Add login\n\nOrc-Task: TASK-001\nOrc-Shipment: SHIP-001\n"
+	if string(got) != want {
+		t.Errorf("message = %q, want %q", got, want)
+	}
+}
+
+func TestCommitService_PrepareCommitMessage_LeavesMergesAlone(t *testing.T) {
+	service, _, workbenchRepo, _, repoPath := newTestCommitService(t)
+	workbenchRepo.workbenches["BENCH-001"].FocusedID = "SHIP-001"
+
+	msgFile := filepath.Join(t.TempDir(), "MERGE_MSG")
+	if err := os.WriteFile(msgFile, []byte("Merge branch 'main'\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := service.PrepareCommitMessage(context.Background(), primary.PrepareCommitMessageRequest{
+		WorkbenchID: "BENCH-001",
+		WorkDir:     repoPath,
+		MessageFile: msgFile,
+		Source:      "merge",
+	})
+	if err != nil {
+		t.Fatalf("PrepareCommitMessage failed: %v", err)
+	}
+	if got, _ := os.ReadFile(msgFile); string(got) != "Merge branch 'main'\n" {
+		t.Errorf("merge message was modified: %q", got)
+	}
+}
+
+// ============================================================================
+// Hook Installation Tests
+// ============================================================================
+
+func TestCommitHookEffects(t *testing.T) {
+	_, _, _, _, repoPath := newTestCommitService(t)
+	hookPath := filepath.Join(repoPath, ".git", "hooks", "prepare-commit-msg")
+
+	effs := commitHookEffects(NewGitService(), repoPath)
+	if len(effs) != 2 {
+		t.Fatalf("expected mkdir + write effects, got %d", len(effs))
+	}
+	write, ok := effs[1].(effects.FileEffect)
+	if !ok || write.Path != hookPath || !strings.Contains(string(write.Content), "orc commit prepare-msg") {
+		t.Fatalf("write effect = %+v, want hook at %s", effs[1], hookPath)
+	}
+
+	// Current hook: nothing to do
+	if err := os.MkdirAll(filepath.Dir(hookPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(hookPath, write.Content, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if effs := commitHookEffects(NewGitService(), repoPath); len(effs) != 0 {
+		t.Errorf("expected no effects for a current hook, got %d", len(effs))
+	}
+
+	// A user's own hook is never overwritten
+	if err := os.WriteFile(hookPath, []byte("#!/bin/sh\nexit 0\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if effs := commitHookEffects(NewGitService(), repoPath); len(effs) != 0 {
+		t.Errorf("expected a foreign hook to be left alone, got %d effects", len(effs))
+	}
+}
+
+func TestCommitHookEffects_NotARepo(t *testing.T) {
+	if effs := commitHookEffects(NewGitService(), t.TempDir()); len(effs) != 0 {
+		t.Errorf("expected no effects outside a git repo, got %d", len(effs))
+	}
+}
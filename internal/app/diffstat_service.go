@@ -0,0 +1,256 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	coregit "github.com/example/orc/internal/core/git"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// DiffStatServiceImpl implements the DiffStatService interface.
+type DiffStatServiceImpl struct {
+	diffStatRepo  secondary.ShipmentDiffStatRepository
+	shipmentRepo  secondary.ShipmentRepository
+	repoRepo      secondary.RepoRepository
+	workbenchRepo secondary.WorkbenchRepository
+	workspace     secondary.WorkspaceAdapter
+	gitService    *GitService
+	locator       *WorkbenchLocator // optional: nil uses the default layout
+}
+
+// NewDiffStatService creates a new DiffStatService with injected dependencies.
+func NewDiffStatService(
+	diffStatRepo secondary.ShipmentDiffStatRepository,
+	shipmentRepo secondary.ShipmentRepository,
+	repoRepo secondary.RepoRepository,
+	workbenchRepo secondary.WorkbenchRepository,
+	workspace secondary.WorkspaceAdapter,
+	gitService *GitService,
+	locator *WorkbenchLocator,
+) *DiffStatServiceImpl {
+	return &DiffStatServiceImpl{
+		diffStatRepo:  diffStatRepo,
+		shipmentRepo:  shipmentRepo,
+		repoRepo:      repoRepo,
+		workbenchRepo: workbenchRepo,
+		workspace:     workspace,
+		gitService:    gitService,
+		locator:       locator,
+	}
+}
+
+// GetShipmentDiffStat returns the cached diff stats of a shipment, or nil if never computed.
+func (s *DiffStatServiceImpl) GetShipmentDiffStat(ctx context.Context, shipmentID string) (*primary.ShipmentDiffStat, error) {
+	records, err := s.diffStatRepo.List(ctx, shipmentID)
+	if err != nil || len(records) == 0 {
+		return nil, err
+	}
+
+	stat := &primary.ShipmentDiffStat{ShipmentID: shipmentID}
+	for _, r := range records {
+		stat.FilesChanged += r.FilesChanged
+		stat.Insertions += r.Insertions
+		stat.Deletions += r.Deletions
+		if stat.ComputedAt == "" || r.ComputedAt < stat.ComputedAt {
+			stat.ComputedAt = r.ComputedAt
+		}
+		stat.Repos = append(stat.Repos, s.statToPrimary(r))
+	}
+	return stat, nil
+}
+
+// RefreshShipmentDiffStat diffs the shipment branch in each of its repos against its
+// merge base with the default branch and replaces the cached stats. Repos are not fetched
+// first, so each base is whatever origin/<default> (or the local default branch) points to right now.
+func (s *DiffStatServiceImpl) RefreshShipmentDiffStat(ctx context.Context, shipmentID string) (*primary.ShipmentDiffStat, error) {
+	shipment, err := s.shipmentRepo.GetByID(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	if shipment.Branch == "" {
+		return nil, fmt.Errorf("shipment %s has no branch", shipmentID)
+	}
+	links, err := s.shipmentRepo.ListRepos(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipment repos: %w", err)
+	}
+
+	// The primary repo may be unset, in which case the assigned workbench's repo is used
+	targets := []*secondary.ShipmentRepoRecord{{
+		ShipmentID:          shipmentID,
+		RepoID:              shipment.RepoID,
+		Branch:              shipment.Branch,
+		AssignedWorkbenchID: shipment.AssignedWorkbenchID,
+	}}
+	targets = append(targets, links...)
+
+	var stats []*secondary.ShipmentDiffStatRecord
+	var files []*secondary.ShipmentDiffFileRecord
+	for _, target := range targets {
+		stat, repoFiles, err := s.diffRepo(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, stat)
+		files = append(files, repoFiles...)
+	}
+
+	if err := s.diffStatRepo.Save(ctx, shipmentID, stats, files); err != nil {
+		return nil, err
+	}
+	return s.GetShipmentDiffStat(ctx, shipmentID)
+}
+
+// diffRepo computes the diff stats of a shipment's branch in one of its repos.
+func (s *DiffStatServiceImpl) diffRepo(ctx context.Context, target *secondary.ShipmentRepoRecord) (*secondary.ShipmentDiffStatRecord, []*secondary.ShipmentDiffFileRecord, error) {
+	if target.Branch == "" {
+		return nil, nil, fmt.Errorf("shipment %s has no branch in %s", target.ShipmentID, target.RepoID)
+	}
+
+	repoID, repoPath, defaultBranch, err := s.resolveRepo(ctx, target)
+	if err != nil {
+		return nil, nil, err
+	}
+	if defaultBranch == "" {
+		defaultBranch, _ = s.gitService.GetDefaultBranch(repoPath)
+	}
+	baseRef, err := s.gitService.ResolveBaseRef(repoPath, defaultBranch)
+	if err != nil {
+		return nil, nil, err
+	}
+	head, err := s.gitService.ResolveCommit(repoPath, target.Branch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("shipment branch %w", err)
+	}
+	mergeBase, err := s.gitService.MergeBase(repoPath, baseRef, head)
+	if err != nil {
+		return nil, nil, err
+	}
+	diff, err := s.gitService.DiffNumstat(repoPath, mergeBase, head)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to diff %s: %w", target.Branch, err)
+	}
+
+	stat := &secondary.ShipmentDiffStatRecord{
+		ShipmentID:   target.ShipmentID,
+		RepoID:       repoID,
+		BaseRef:      baseRef,
+		MergeBase:    mergeBase,
+		HeadCommit:   head,
+		FilesChanged: len(diff),
+	}
+	files := make([]*secondary.ShipmentDiffFileRecord, len(diff))
+	for i, f := range diff {
+		stat.Insertions += f.Insertions
+		stat.Deletions += f.Deletions
+		files[i] = &secondary.ShipmentDiffFileRecord{
+			ShipmentID: target.ShipmentID,
+			RepoID:     repoID,
+			Path:       f.Path,
+			Insertions: f.Insertions,
+			Deletions:  f.Deletions,
+		}
+	}
+	return stat, files, nil
+}
+
+// GetShipmentFiles returns the cached touched-file map of a shipment grouped by repo and directory.
+func (s *DiffStatServiceImpl) GetShipmentFiles(ctx context.Context, req primary.GetShipmentFilesRequest) (*primary.ShipmentFiles, error) {
+	stat, err := s.GetShipmentDiffStat(ctx, req.ShipmentID)
+	if err != nil || stat == nil {
+		return nil, err
+	}
+	records, err := s.diffStatRepo.ListFiles(ctx, req.ShipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &primary.ShipmentFiles{Stat: stat}
+	byRepo := make(map[string][]coregit.FileStat)
+	for _, r := range records {
+		result.Files = append(result.Files, &primary.DiffFileStat{
+			RepoID:     r.RepoID,
+			Path:       r.Path,
+			Insertions: r.Insertions,
+			Deletions:  r.Deletions,
+		})
+		byRepo[r.RepoID] = append(byRepo[r.RepoID], coregit.FileStat{Path: r.Path, Insertions: r.Insertions, Deletions: r.Deletions})
+	}
+	for _, repo := range stat.Repos {
+		for _, d := range coregit.AggregateByDir(byRepo[repo.RepoID], req.Depth) {
+			result.Directories = append(result.Directories, &primary.DiffDirStat{
+				RepoID:     repo.RepoID,
+				Dir:        d.Dir,
+				Files:      d.Files,
+				Insertions: d.Insertions,
+				Deletions:  d.Deletions,
+			})
+		}
+	}
+	// Each repo's directories are already ordered; the stable sort interleaves them by churn
+	sort.SliceStable(result.Directories, func(i, j int) bool {
+		return result.Directories[i].Insertions+result.Directories[i].Deletions >
+			result.Directories[j].Insertions+result.Directories[j].Deletions
+	})
+	return result, nil
+}
+
+// resolveRepo finds the git checkout holding a shipment's branch in one repo: the repo
+// (or its workbench's repo) when cloned locally, otherwise the assigned workbench.
+// Also returns the resolved repo ID and the repo's configured default branch, if any.
+func (s *DiffStatServiceImpl) resolveRepo(ctx context.Context, target *secondary.ShipmentRepoRecord) (string, string, string, error) {
+	repoID := target.RepoID
+	workbenchPath := ""
+	if target.AssignedWorkbenchID != "" {
+		if wb, err := s.workbenchRepo.GetByID(ctx, target.AssignedWorkbenchID); err == nil {
+			workbenchPath = s.locator.Path(ctx, wb)
+			if repoID == "" {
+				repoID = wb.RepoID
+			}
+		}
+	}
+
+	defaultBranch := ""
+	if repoID != "" {
+		if repo, err := s.repoRepo.GetByID(ctx, repoID); err == nil && repo != nil {
+			defaultBranch = repo.DefaultBranch
+			if s.dirExists(ctx, repo.LocalPath) {
+				return repoID, repo.LocalPath, defaultBranch, nil
+			}
+		}
+	}
+	if s.dirExists(ctx, workbenchPath) {
+		return repoID, workbenchPath, defaultBranch, nil
+	}
+	if target.RepoID != "" {
+		return "", "", "", fmt.Errorf("no local checkout found for shipment %s in %s", target.ShipmentID, target.RepoID)
+	}
+	return "", "", "", fmt.Errorf("no local checkout found for shipment %s", target.ShipmentID)
+}
+
+func (s *DiffStatServiceImpl) statToPrimary(r *secondary.ShipmentDiffStatRecord) *primary.RepoDiffStat {
+	return &primary.RepoDiffStat{
+		RepoID:       r.RepoID,
+		BaseRef:      r.BaseRef,
+		MergeBase:    r.MergeBase,
+		HeadCommit:   r.HeadCommit,
+		FilesChanged: r.FilesChanged,
+		Insertions:   r.Insertions,
+		Deletions:    r.Deletions,
+		ComputedAt:   r.ComputedAt,
+	}
+}
+
+func (s *DiffStatServiceImpl) dirExists(ctx context.Context, path string) bool {
+	if path == "" {
+		return false
+	}
+	exists, err := s.workspace.DirectoryExists(ctx, path)
+	return err == nil && exists
+}
+
+// Ensure DiffStatServiceImpl implements the interface
+var _ primary.DiffStatService = (*DiffStatServiceImpl)(nil)
@@ -0,0 +1,204 @@
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ============================================================================
+// Mock Implementations
+// ============================================================================
+
+type mockShipmentDiffStatRepository struct {
+	stats map[string][]*secondary.ShipmentDiffStatRecord
+	files map[string][]*secondary.ShipmentDiffFileRecord
+}
+
+func newMockShipmentDiffStatRepository() *mockShipmentDiffStatRepository {
+	return &mockShipmentDiffStatRepository{
+		stats: make(map[string][]*secondary.ShipmentDiffStatRecord),
+		files: make(map[string][]*secondary.ShipmentDiffFileRecord),
+	}
+}
+
+func (m *mockShipmentDiffStatRepository) Save(ctx context.Context, shipmentID string, stats []*secondary.ShipmentDiffStatRecord, files []*secondary.ShipmentDiffFileRecord) error {
+	sortedStats := append([]*secondary.ShipmentDiffStatRecord(nil), stats...)
+	for _, stat := range sortedStats {
+		stat.ComputedAt = "2026-01-01T10:00:00Z"
+	}
+	sort.Slice(sortedStats, func(i, j int) bool { return sortedStats[i].RepoID < sortedStats[j].RepoID })
+	m.stats[shipmentID] = sortedStats
+	sorted := append([]*secondary.ShipmentDiffFileRecord(nil), files...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].RepoID != sorted[j].RepoID {
+			return sorted[i].RepoID < sorted[j].RepoID
+		}
+		return sorted[i].Path < sorted[j].Path
+	})
+	m.files[shipmentID] = sorted
+	return nil
+}
+
+func (m *mockShipmentDiffStatRepository) List(ctx context.Context, shipmentID string) ([]*secondary.ShipmentDiffStatRecord, error) {
+	return m.stats[shipmentID], nil
+}
+
+func (m *mockShipmentDiffStatRepository) ListFiles(ctx context.Context, shipmentID string) ([]*secondary.ShipmentDiffFileRecord, error) {
+	return m.files[shipmentID], nil
+}
+
+// ============================================================================
+// Test Helper
+// ============================================================================
+
+// newTestDiffStatService returns a diff stat service over one git repo (REPO-001) where
+// shipment SHIP-001 owns branch ml/SHIP-001-login, forked from main before main moved on.
+func newTestDiffStatService(t *testing.T) (*DiffStatServiceImpl, *mockShipmentRepository) {
+	t.Helper()
+	home := setupGitHome(t)
+	repoPath := filepath.Join(home, "src", "app")
+	if err := os.MkdirAll(repoPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, repoPath, "init", "-q", "-b", "main")
+	commitFile(t, repoPath, "README.md", "hello\n")
+
+	runGit(t, repoPath, "checkout", "-q", "-b", "ml/SHIP-001-login")
+	if err := os.MkdirAll(filepath.Join(repoPath, "internal", "app"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	commitFile(t, repoPath, "internal/app/login.go", "package app\n\nfunc Login() {}\n")
+	commitFile(t, repoPath, "README.md", "hello\nlogin\n")
+
+	// Work landing on main after the fork is not part of the shipment
+	runGit(t, repoPath, "checkout", "-q", "main")
+	commitFile(t, repoPath, "CHANGELOG.md", "v1\nv2\n")
+
+	shipmentRepo := newMockShipmentRepository()
+	shipmentRepo.shipments["SHIP-001"] = &secondary.ShipmentRecord{ID: "SHIP-001", RepoID: "REPO-001", Branch: "ml/SHIP-001-login"}
+	repoRepo := newMockRepoRepositoryForWorkbench()
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app", LocalPath: repoPath, DefaultBranch: "main"}
+	workspace := newMockWorkspaceAdapter()
+	workspace.reposBasePath = home // Check directories on disk
+
+	service := NewDiffStatService(newMockShipmentDiffStatRepository(), shipmentRepo, repoRepo, newMockWorkbenchRepository(), workspace, NewGitService(), nil)
+	return service, shipmentRepo
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestDiffStatService_RefreshShipmentDiffStat(t *testing.T) {
+	service, _ := newTestDiffStatService(t)
+	ctx := context.Background()
+
+	cached, err := service.GetShipmentDiffStat(ctx, "SHIP-001")
+	if err != nil || cached != nil {
+		t.Fatalf("expected no cached stats before refresh, got %+v, %v", cached, err)
+	}
+
+	stat, err := service.RefreshShipmentDiffStat(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("RefreshShipmentDiffStat failed: %v", err)
+	}
+	if stat.FilesChanged != 2 || stat.Insertions != 4 || stat.Deletions != 0 {
+		t.Errorf("stat = %d files +%d -%d, want 2 files +4 -0", stat.FilesChanged, stat.Insertions, stat.Deletions)
+	}
+	if len(stat.Repos) != 1 || stat.Repos[0].RepoID != "REPO-001" || stat.Repos[0].BaseRef != "main" || stat.Repos[0].MergeBase == "" || stat.Repos[0].HeadCommit == "" {
+		t.Errorf("stat repos = %+v, want REPO-001 based on main with merge base and head", stat.Repos)
+	}
+
+	cached, _ = service.GetShipmentDiffStat(ctx, "SHIP-001")
+	if cached == nil || cached.Repos[0].HeadCommit != stat.Repos[0].HeadCommit {
+		t.Errorf("expected refreshed stats to be cached, got %+v", cached)
+	}
+}
+
+func TestDiffStatService_RefreshShipmentDiffStat_MultiRepo(t *testing.T) {
+	service, shipmentRepo := newTestDiffStatService(t)
+	ctx := context.Background()
+
+	// REPO-002 carries the shipment on its own branch
+	apiPath := filepath.Join(os.Getenv("HOME"), "src", "api")
+	if err := os.MkdirAll(apiPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, apiPath, "init", "-q", "-b", "main")
+	commitFile(t, apiPath, "README.md", "api\n")
+	runGit(t, apiPath, "checkout", "-q", "-b", "ml/SHIP-001-api")
+	commitFile(t, apiPath, "handler.go", "package api\n")
+	runGit(t, apiPath, "checkout", "-q", "main")
+
+	service.repoRepo.(*mockRepoRepositoryForWorkbench).repos["REPO-002"] = &secondary.RepoRecord{ID: "REPO-002", Name: "api", LocalPath: apiPath, DefaultBranch: "main"}
+	shipmentRepo.repoLinks["SHIP-001"] = []*secondary.ShipmentRepoRecord{{ShipmentID: "SHIP-001", RepoID: "REPO-002", Branch: "ml/SHIP-001-api"}}
+
+	stat, err := service.RefreshShipmentDiffStat(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("RefreshShipmentDiffStat failed: %v", err)
+	}
+	if stat.FilesChanged != 3 || stat.Insertions != 5 {
+		t.Errorf("stat = %d files +%d, want 3 files +5 across both repos", stat.FilesChanged, stat.Insertions)
+	}
+	if len(stat.Repos) != 2 || stat.Repos[1].RepoID != "REPO-002" || stat.Repos[1].FilesChanged != 1 || stat.Repos[1].Insertions != 1 {
+		t.Errorf("repos = %+v, want REPO-002 with 1 file +1", stat.Repos)
+	}
+
+	files, err := service.GetShipmentFiles(ctx, primary.GetShipmentFilesRequest{ShipmentID: "SHIP-001"})
+	if err != nil {
+		t.Fatalf("GetShipmentFiles failed: %v", err)
+	}
+	if len(files.Files) != 3 || files.Files[2].RepoID != "REPO-002" || files.Files[2].Path != "handler.go" {
+		t.Errorf("files = %+v, want REPO-002 handler.go last", files.Files)
+	}
+	if len(files.Directories) != 3 || files.Directories[0].RepoID != "REPO-001" || files.Directories[0].Dir != "internal/app" {
+		t.Errorf("directories = %+v, want REPO-001 internal/app first", files.Directories)
+	}
+}
+
+func TestDiffStatService_GetShipmentFiles(t *testing.T) {
+	service, _ := newTestDiffStatService(t)
+	ctx := context.Background()
+
+	if files, err := service.GetShipmentFiles(ctx, primary.GetShipmentFilesRequest{ShipmentID: "SHIP-001"}); err != nil || files != nil {
+		t.Fatalf("expected no files before refresh, got %+v, %v", files, err)
+	}
+	if _, err := service.RefreshShipmentDiffStat(ctx, "SHIP-001"); err != nil {
+		t.Fatalf("RefreshShipmentDiffStat failed: %v", err)
+	}
+
+	files, err := service.GetShipmentFiles(ctx, primary.GetShipmentFilesRequest{ShipmentID: "SHIP-001", Depth: 1})
+	if err != nil {
+		t.Fatalf("GetShipmentFiles failed: %v", err)
+	}
+	if len(files.Files) != 2 || files.Files[0].Path != "README.md" {
+		t.Fatalf("files = %+v, want README.md and internal/app/login.go", files.Files)
+	}
+	if len(files.Directories) != 2 || files.Directories[0].Dir != "internal" || files.Directories[0].Insertions != 3 {
+		t.Errorf("directories = %+v, want internal (+3) before . (+1)", files.Directories)
+	}
+}
+
+func TestDiffStatService_RefreshShipmentDiffStat_NoBranch(t *testing.T) {
+	service, shipmentRepo := newTestDiffStatService(t)
+	shipmentRepo.shipments["SHIP-001"].Branch = ""
+
+	if _, err := service.RefreshShipmentDiffStat(context.Background(), "SHIP-001"); err == nil {
+		t.Error("expected error for a shipment without a branch")
+	}
+}
+
+func TestDiffStatService_RefreshShipmentDiffStat_NoCheckout(t *testing.T) {
+	service, shipmentRepo := newTestDiffStatService(t)
+	shipmentRepo.shipments["SHIP-001"].RepoID = ""
+
+	if _, err := service.RefreshShipmentDiffStat(context.Background(), "SHIP-001"); err == nil {
+		t.Error("expected error for a shipment without a local checkout")
+	}
+}
@@ -124,12 +124,15 @@ func (e *DefaultEffectExecutor) executeTMux(ctx context.Context, eff effects.TMu
 func (e *DefaultEffectExecutor) executeGit(ctx context.Context, eff effects.GitEffect) error {
 	switch eff.Operation {
 	case "worktree_add":
-		// Args[0] = branchName, Args[1] = targetPath
+		// Args[0] = branchName, Args[1] = targetPath, Args[2:] = sparse checkout paths (optional)
 		if len(eff.Args) < 2 {
 			return fmt.Errorf("worktree_add requires branchName and targetPath in Args")
 		}
 		branchName := eff.Args[0]
 		targetPath := eff.Args[1]
+		if sparsePaths := eff.Args[2:]; len(sparsePaths) > 0 {
+			return e.workspaceAdapter.CreateSparseWorktree(ctx, eff.RepoPath, branchName, targetPath, sparsePaths)
+		}
 		return e.workspaceAdapter.CreateWorktree(ctx, eff.RepoPath, branchName, targetPath)
 	default:
 		return fmt.Errorf("unsupported git operation: %s", eff.Operation)
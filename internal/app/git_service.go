@@ -3,10 +3,16 @@ package app
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
+
+	coregit "github.com/example/orc/internal/core/git"
+	corerepo "github.com/example/orc/internal/core/repo"
 )
 
 // UserInitials is the default user initials for branch naming.
@@ -47,14 +53,15 @@ func (s *GitService) StashDance(workbenchPath, targetBranch string) (*StashDance
 		return result, nil
 	}
 
-	// Check if dirty
-	dirty, err := s.IsDirty(workbenchPath)
+	// Check for tracked changes only: stash push leaves untracked files such as .orc/config.json
+	// in place, so "stashing" for them alone would pop an older, unrelated stash after checkout
+	changed, err := s.GetTrackedChangeCount(workbenchPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check dirty state: %w", err)
 	}
 
 	// Stash if dirty
-	if dirty {
+	if changed > 0 {
 		if err := s.runGitCommand(workbenchPath, "stash", "push", "-m", "orc-stash-dance"); err != nil {
 			return nil, fmt.Errorf("failed to stash changes: %w", err)
 		}
@@ -114,6 +121,19 @@ func (s *GitService) GetDirtyFileCount(repoPath string) (int, error) {
 	return len(lines), nil
 }
 
+// GetTrackedChangeCount returns the number of tracked files with uncommitted changes.
+// Untracked files (such as .orc/config.json) are ignored since they don't block a rebase.
+func (s *GitService) GetTrackedChangeCount(repoPath string) (int, error) {
+	output, err := s.runGitCommandOutput(repoPath, "status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return 0, err
+	}
+	if strings.TrimSpace(output) == "" {
+		return 0, nil
+	}
+	return len(strings.Split(strings.TrimSpace(output), "\n")), nil
+}
+
 // BranchExists checks if a branch exists.
 func (s *GitService) BranchExists(repoPath, branchName string) (bool, error) {
 	// rev-parse returns error if branch doesn't exist - that's expected, not an error condition
@@ -136,6 +156,19 @@ func (s *GitService) CreateBranch(repoPath, branchName, baseBranch string) error
 	return nil
 }
 
+// IsMergedInto reports whether branch is fully contained in baseRef.
+func (s *GitService) IsMergedInto(repoPath, branch, baseRef string) bool {
+	return s.runGitCommand(repoPath, "merge-base", "--is-ancestor", branch, baseRef) == nil
+}
+
+// DeleteBranch force-deletes a local branch. Callers must check IsMergedInto first.
+func (s *GitService) DeleteBranch(repoPath, branch string) error {
+	if err := s.runGitCommand(repoPath, "branch", "-D", branch); err != nil {
+		return fmt.Errorf("failed to delete branch %s: %w", branch, err)
+	}
+	return nil
+}
+
 // CreateAndCheckoutBranch creates a new branch and checks it out.
 func (s *GitService) CreateAndCheckoutBranch(repoPath, branchName, baseBranch string) error {
 	// Create branch (if it doesn't exist)
@@ -172,6 +205,366 @@ func (s *GitService) GetAheadBehind(repoPath string) (int, int, error) {
 	return ahead, behind, nil
 }
 
+// GetAheadBehindRef returns how many commits HEAD is ahead/behind an arbitrary ref
+// (e.g., origin/main), independent of the branch's upstream.
+func (s *GitService) GetAheadBehindRef(repoPath, baseRef string) (int, int, error) {
+	return s.GetAheadBehindRefs(repoPath, baseRef, "HEAD")
+}
+
+// GetAheadBehindRefs returns how many commits headRef is ahead/behind baseRef.
+func (s *GitService) GetAheadBehindRefs(repoPath, baseRef, headRef string) (int, int, error) {
+	output, err := s.runGitCommandOutput(repoPath, "rev-list", "--left-right", "--count", baseRef+"..."+headRef)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	parts := strings.Fields(strings.TrimSpace(output))
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+
+	behind, _ := strconv.Atoi(parts[0])
+	ahead, _ := strconv.Atoi(parts[1])
+	return ahead, behind, nil
+}
+
+// HasOrigin reports whether the repo has an origin remote.
+func (s *GitService) HasOrigin(repoPath string) bool {
+	return s.runGitCommand(repoPath, "remote", "get-url", "origin") == nil
+}
+
+// OriginURL returns the URL of the origin remote.
+func (s *GitService) OriginURL(repoPath string) (string, error) {
+	output, err := s.runGitCommandOutput(repoPath, "remote", "get-url", "origin")
+	if err != nil {
+		return "", fmt.Errorf("failed to get origin URL: %w", err)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// FetchOrigin fetches from the origin remote.
+// Returns false without error when the repo has no origin remote (local-only repos).
+func (s *GitService) FetchOrigin(repoPath string) (bool, error) {
+	if !s.HasOrigin(repoPath) {
+		return false, nil
+	}
+	if err := s.runGitCommand(repoPath, "fetch", "--quiet", "origin"); err != nil {
+		return false, fmt.Errorf("failed to fetch origin: %w", err)
+	}
+	return true, nil
+}
+
+// PushBranch pushes branch to origin and sets it as the branch's upstream.
+func (s *GitService) PushBranch(repoPath, branch string) error {
+	if err := s.runGitCommand(repoPath, "push", "--quiet", "--set-upstream", "origin", branch); err != nil {
+		return fmt.Errorf("failed to push %s: %w", branch, err)
+	}
+	return nil
+}
+
+// Clone clones url into targetPath. The parent directory must exist.
+func (s *GitService) Clone(url, targetPath string) error {
+	if err := s.runGitCommand(filepath.Dir(targetPath), "clone", "--quiet", url, targetPath); err != nil {
+		return fmt.Errorf("failed to clone %s: %w", url, err)
+	}
+	return nil
+}
+
+// LastFetchTime returns when the repo was last fetched, from the FETCH_HEAD timestamp.
+// Returns the zero time if it was never fetched.
+func (s *GitService) LastFetchTime(repoPath string) (time.Time, error) {
+	output, err := s.runGitCommandOutput(repoPath, "rev-parse", "--git-path", "FETCH_HEAD")
+	if err != nil {
+		return time.Time{}, err
+	}
+	path := strings.TrimSpace(output)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(repoPath, path)
+	}
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
+
+// ResolveBaseRef returns the ref to sync against for a default branch:
+// origin/<branch> when it exists, otherwise the local branch.
+func (s *GitService) ResolveBaseRef(repoPath, defaultBranch string) (string, error) {
+	if exists, _ := s.BranchExists(repoPath, "origin/"+defaultBranch); exists {
+		return "origin/" + defaultBranch, nil
+	}
+	if exists, _ := s.BranchExists(repoPath, defaultBranch); exists {
+		return defaultBranch, nil
+	}
+	return "", fmt.Errorf("default branch %s not found", defaultBranch)
+}
+
+// SyncWithBaseResult contains the result of syncing a branch with its base.
+type SyncWithBaseResult struct {
+	Conflicts   []string // Conflicting files; the rebase/merge was aborted when non-empty
+	WasStashed  bool
+	StashPopped bool
+}
+
+// SyncWithBase rebases (or merges) the current branch onto baseRef.
+// When autostash is set, uncommitted changes are stashed first and popped afterwards,
+// the same way StashDance protects a dirty tree. On conflicts the rebase/merge is
+// aborted so the workbench is left exactly as it was, and the conflicting files are reported.
+func (s *GitService) SyncWithBase(workbenchPath, baseRef string, merge, autostash bool) (*SyncWithBaseResult, error) {
+	result := &SyncWithBaseResult{}
+
+	if autostash {
+		changed, err := s.GetTrackedChangeCount(workbenchPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check dirty state: %w", err)
+		}
+		if changed > 0 {
+			if err := s.runGitCommand(workbenchPath, "stash", "push", "-m", "orc-sync"); err != nil {
+				return nil, fmt.Errorf("failed to stash changes: %w", err)
+			}
+			result.WasStashed = true
+		}
+	}
+
+	var syncErr error
+	if merge {
+		syncErr = s.runGitCommand(workbenchPath, "merge", "--no-edit", baseRef)
+	} else {
+		syncErr = s.runGitCommand(workbenchPath, "rebase", baseRef)
+	}
+
+	if syncErr != nil {
+		output, _ := s.runGitCommandOutput(workbenchPath, "diff", "--name-only", "--diff-filter=U")
+		for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+			if line != "" {
+				result.Conflicts = append(result.Conflicts, line)
+			}
+		}
+		if merge {
+			_ = s.runGitCommand(workbenchPath, "merge", "--abort")
+		} else {
+			_ = s.runGitCommand(workbenchPath, "rebase", "--abort")
+		}
+	}
+
+	// Restore stashed changes whether or not the sync went through
+	if result.WasStashed {
+		if err := s.runGitCommand(workbenchPath, "stash", "pop"); err != nil {
+			return result, fmt.Errorf("stash pop failed (changes kept in stash): %w", err)
+		}
+		result.StashPopped = true
+	}
+
+	if syncErr != nil && len(result.Conflicts) == 0 {
+		return result, fmt.Errorf("failed to sync with %s: %w", baseRef, syncErr)
+	}
+	return result, nil
+}
+
+// RebaseOnto replays the commits of the current branch after upstream onto onto
+// (git rebase --onto). On conflicts the rebase is aborted, leaving the workbench
+// as it was, and the conflicting files are returned.
+func (s *GitService) RebaseOnto(workbenchPath, onto, upstream string) ([]string, error) {
+	rebaseErr := s.runGitCommand(workbenchPath, "rebase", "--onto", onto, upstream)
+	if rebaseErr == nil {
+		return nil, nil
+	}
+
+	var conflicts []string
+	output, _ := s.runGitCommandOutput(workbenchPath, "diff", "--name-only", "--diff-filter=U")
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line != "" {
+			conflicts = append(conflicts, line)
+		}
+	}
+	_ = s.runGitCommand(workbenchPath, "rebase", "--abort")
+
+	if len(conflicts) == 0 {
+		return nil, fmt.Errorf("failed to rebase onto %s: %w", onto, rebaseErr)
+	}
+	return conflicts, nil
+}
+
+// ForcePushBranch pushes a rewritten branch to origin, refusing to overwrite
+// commits on the remote that were not fetched (--force-with-lease).
+func (s *GitService) ForcePushBranch(repoPath, branch string) error {
+	if err := s.runGitCommand(repoPath, "push", "--quiet", "--force-with-lease", "origin", branch); err != nil {
+		return fmt.Errorf("failed to push %s: %w", branch, err)
+	}
+	return nil
+}
+
+// FastForward moves the current branch forward to ref, refusing anything but a fast-forward.
+func (s *GitService) FastForward(repoPath, ref string) error {
+	if err := s.runGitCommand(repoPath, "merge", "--quiet", "--ff-only", ref); err != nil {
+		return fmt.Errorf("failed to fast-forward to %s: %w", ref, err)
+	}
+	return nil
+}
+
+// LandBranch lands branch on the current branch: as a merge commit, as one squashed
+// commit, or by replaying its commits on top (rebase). Rebasing cherry-picks the
+// commits so the branch itself, which may be checked out in a workbench, is left
+// alone; like git rebase, it drops merge commits and commits already on the target. On conflicts the operation is aborted and the checkout reset to where it
+// was, and the conflicting files are returned.
+func (s *GitService) LandBranch(repoPath, branch, strategy, message string) ([]string, error) {
+	head, err := s.GetHeadCommit(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var landErr error
+	switch strategy {
+	case corerepo.MergeStrategyMerge:
+		landErr = s.runGitCommand(repoPath, "merge", "--quiet", "--no-ff", "-m", message, branch)
+	case corerepo.MergeStrategySquash:
+		landErr = s.runGitCommand(repoPath, "merge", "--quiet", "--squash", branch)
+		if landErr == nil {
+			landErr = s.runGitCommand(repoPath, "commit", "--quiet", "-m", message)
+		}
+	case corerepo.MergeStrategyRebase:
+		var commits []string
+		commits, landErr = s.commitsToReplay(repoPath, branch)
+		if landErr == nil && len(commits) > 0 {
+			landErr = s.runGitCommand(repoPath, append([]string{"cherry-pick"}, commits...)...)
+		}
+	default:
+		return nil, corerepo.ValidateMergeStrategy(strategy)
+	}
+	if landErr == nil {
+		return nil, nil
+	}
+
+	var conflicts []string
+	output, _ := s.runGitCommandOutput(repoPath, "diff", "--name-only", "--diff-filter=U")
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line != "" {
+			conflicts = append(conflicts, line)
+		}
+	}
+	if strategy == corerepo.MergeStrategyRebase {
+		_ = s.runGitCommand(repoPath, "cherry-pick", "--abort")
+	} else {
+		_ = s.runGitCommand(repoPath, "merge", "--abort")
+	}
+	if err := s.ResetHard(repoPath, head); err != nil {
+		return conflicts, err
+	}
+
+	if len(conflicts) == 0 {
+		return nil, fmt.Errorf("failed to %s %s: %w", strategy, branch, landErr)
+	}
+	return conflicts, nil
+}
+
+// commitsToReplay lists, oldest first, the non-merge commits of branch whose changes
+// are not on HEAD yet.
+func (s *GitService) commitsToReplay(repoPath, branch string) ([]string, error) {
+	output, err := s.runGitCommandOutput(repoPath, "rev-list", "--reverse", "--no-merges", "--right-only", "--cherry-pick", "HEAD..."+branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits of %s: %w", branch, err)
+	}
+	return strings.Fields(output), nil
+}
+
+// GetHeadCommit returns the full hash of HEAD.
+func (s *GitService) GetHeadCommit(repoPath string) (string, error) {
+	output, err := s.runGitCommandOutput(repoPath, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// CommitAll stages all changes and commits them.
+// Returns false without error when there is nothing to commit.
+func (s *GitService) CommitAll(repoPath, message string) (bool, error) {
+	// Workbench config lives in .orc/ and never belongs in a commit
+	if err := s.runGitCommand(repoPath, "add", "-A", "--", ".", ":(exclude).orc"); err != nil {
+		return false, fmt.Errorf("failed to stage changes: %w", err)
+	}
+	if err := s.runGitCommand(repoPath, "diff", "--cached", "--quiet"); err == nil {
+		return false, nil
+	}
+	if err := s.runGitCommand(repoPath, "commit", "-q", "-m", message); err != nil {
+		return false, fmt.Errorf("failed to commit: %w", err)
+	}
+	return true, nil
+}
+
+// CreateTag creates (or moves) a lightweight tag at ref.
+func (s *GitService) CreateTag(repoPath, name, ref string) error {
+	if err := s.runGitCommand(repoPath, "tag", "-f", name, ref); err != nil {
+		return fmt.Errorf("failed to create tag %s: %w", name, err)
+	}
+	return nil
+}
+
+// Diff returns the diff between two refs. An empty toRef diffs against the working tree.
+// With stat set, returns a diffstat instead of the full patch.
+func (s *GitService) Diff(repoPath, fromRef, toRef string, stat bool) (string, error) {
+	args := []string{"diff"}
+	if stat {
+		args = append(args, "--stat")
+	}
+	args = append(args, fromRef)
+	if toRef != "" {
+		args = append(args, toRef)
+	}
+	return s.runGitCommandOutput(repoPath, args...)
+}
+
+// ResolveCommit returns the full hash of the commit a ref points to.
+func (s *GitService) ResolveCommit(repoPath, ref string) (string, error) {
+	output, err := s.runGitCommandOutput(repoPath, "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("%s not found", ref)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// MergeBase returns the best common ancestor of two refs.
+func (s *GitService) MergeBase(repoPath, refA, refB string) (string, error) {
+	output, err := s.runGitCommandOutput(repoPath, "merge-base", refA, refB)
+	if err != nil {
+		return "", fmt.Errorf("no merge base between %s and %s", refA, refB)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// DiffNumstat returns the per-file line churn between two commits.
+// Renames are reported as a deletion plus an addition; binary files count no lines.
+func (s *GitService) DiffNumstat(repoPath, fromRef, toRef string) ([]coregit.FileStat, error) {
+	output, err := s.runGitCommandOutput(repoPath, "diff", "--numstat", "-z", "--no-renames", fromRef, toRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []coregit.FileStat
+	for _, entry := range strings.Split(output, "\x00") {
+		parts := strings.SplitN(entry, "\t", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		insertions, _ := strconv.Atoi(parts[0]) // "-" for binary files
+		deletions, _ := strconv.Atoi(parts[1])
+		files = append(files, coregit.FileStat{Path: parts[2], Insertions: insertions, Deletions: deletions})
+	}
+	return files, nil
+}
+
+// ResetHard resets the current branch and working tree to ref, discarding all changes.
+func (s *GitService) ResetHard(repoPath, ref string) error {
+	if err := s.runGitCommand(repoPath, "reset", "-q", "--hard", ref); err != nil {
+		return fmt.Errorf("failed to reset to %s: %w", ref, err)
+	}
+	return nil
+}
+
 // GetDefaultBranch returns the default branch name for a repo (usually main or master).
 func (s *GitService) GetDefaultBranch(repoPath string) (string, error) {
 	// Try to get from remote HEAD
@@ -196,9 +589,133 @@ func (s *GitService) GetDefaultBranch(repoPath string) (string, error) {
 		return "master", nil
 	}
 
+	// Fallback: local branches, for repos without an origin
+	if exists, _ = s.BranchExists(repoPath, "refs/heads/main"); exists {
+		return "main", nil
+	}
+	if exists, _ = s.BranchExists(repoPath, "refs/heads/master"); exists {
+		return "master", nil
+	}
+
 	return "main", nil // Default to main
 }
 
+// CommitInfo describes a single commit.
+type CommitInfo struct {
+	Hash    string
+	Author  string
+	Date    string
+	Subject string
+}
+
+// ListCommits returns commits reachable from headRef but not from baseRef, oldest first.
+func (s *GitService) ListCommits(repoPath, baseRef, headRef string) ([]CommitInfo, error) {
+	output, err := s.runGitCommandOutput(repoPath, "log", "--reverse", "--format=%H%x1f%an%x1f%aI%x1f%s", baseRef+".."+headRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []CommitInfo
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		parts := strings.SplitN(line, "\x1f", 4)
+		if len(parts) != 4 {
+			continue
+		}
+		commits = append(commits, CommitInfo{
+			Hash:    parts[0],
+			Author:  parts[1],
+			Date:    parts[2],
+			Subject: parts[3],
+		})
+	}
+	return commits, nil
+}
+
+// CommitDetail describes a commit with its full message and diffstat.
+type CommitDetail struct {
+	CommitInfo
+	Message      string
+	FilesChanged int
+	Insertions   int
+	Deletions    int
+}
+
+var shortStatPattern = regexp.MustCompile(`(\d+) (files? changed|insertions?\(\+\)|deletions?\(-\))`)
+
+// ListTrailerCommits returns commits on any local branch whose message carries an
+// Orc-Task or Orc-Shipment trailer. Linked worktrees share branches, so one call per repo
+// covers every workbench of that repo.
+func (s *GitService) ListTrailerCommits(repoPath string) ([]CommitDetail, error) {
+	output, err := s.runGitCommandOutput(repoPath, "log", "--branches", "--reverse",
+		"--regexp-ignore-case", "--extended-regexp", "--grep=^Orc-(Task|Shipment):",
+		"--format=%x1e%H%x1f%an%x1f%aI%x1f%s%x1f%B%x1f", "--shortstat")
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []CommitDetail
+	for _, record := range strings.Split(output, "\x1e") {
+		parts := strings.SplitN(record, "\x1f", 6)
+		if len(parts) != 6 {
+			continue
+		}
+		commit := CommitDetail{
+			CommitInfo: CommitInfo{
+				Hash:    parts[0],
+				Author:  parts[1],
+				Date:    parts[2],
+				Subject: parts[3],
+			},
+			Message: strings.TrimSpace(parts[4]),
+		}
+		// e.g. " 2 files changed, 10 insertions(+), 1 deletion(-)"
+		for _, m := range shortStatPattern.FindAllStringSubmatch(parts[5], -1) {
+			n, _ := strconv.Atoi(m[1])
+			switch {
+			case strings.HasPrefix(m[2], "file"):
+				commit.FilesChanged = n
+			case strings.HasPrefix(m[2], "insertion"):
+				commit.Insertions = n
+			default:
+				commit.Deletions = n
+			}
+		}
+		commits = append(commits, commit)
+	}
+	return commits, nil
+}
+
+// AddTrailers appends trailers to a commit message file, placing them the way git does
+// (before the comment block, in the trailer paragraph). Keys already present are left alone.
+func (s *GitService) AddTrailers(workDir, messageFile string, trailers []string) error {
+	if len(trailers) == 0 {
+		return nil
+	}
+	args := []string{"interpret-trailers", "--in-place", "--if-exists", "doNothing"}
+	for _, t := range trailers {
+		args = append(args, "--trailer", t)
+	}
+	args = append(args, messageFile)
+	if err := s.runGitCommand(workDir, args...); err != nil {
+		return fmt.Errorf("failed to add trailers: %w", err)
+	}
+	return nil
+}
+
+// HookPath returns the path of a git hook for a repository.
+// Linked worktrees share their repository's hooks directory; core.hooksPath is honored.
+func (s *GitService) HookPath(repoPath, hook string) (string, error) {
+	output, err := s.runGitCommandOutput(repoPath, "rev-parse", "--git-path", "hooks/"+hook)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve hooks directory: %w", err)
+	}
+	path := strings.TrimSpace(output)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(repoPath, path)
+	}
+	return path, nil
+}
+
 // GenerateShipmentBranchName generates a branch name for a shipment.
 // Format: {initials}/SHIP-{id}-{slug}
 func GenerateShipmentBranchName(initials, shipmentID, title string) string {
@@ -1,6 +1,9 @@
 package app
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -37,3 +40,77 @@ func TestGitService_NewGitService(t *testing.T) {
 		t.Error("expected non-nil service")
 	}
 }
+
+func TestGitService_StashDance_UntrackedOnlyKeepsOlderStash(t *testing.T) {
+	home := setupGitHome(t)
+	repo := filepath.Join(home, "repo")
+	runGit(t, home, "init", "-q", "-b", "main", repo)
+	commitFile(t, repo, "README.md", "hello\n")
+	runGit(t, repo, "branch", "feature")
+
+	// An unrelated stash from earlier work
+	if err := os.WriteFile(filepath.Join(repo, "README.md"), []byte("wip\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, repo, "stash", "push", "-q", "-m", "earlier work")
+
+	// Only untracked files, which stash push leaves alone
+	if err := os.WriteFile(filepath.Join(repo, "notes.txt"), []byte("scratch\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewGitService()
+	result, err := s.StashDance(repo, "feature")
+	if err != nil {
+		t.Fatalf("StashDance: %v", err)
+	}
+	if result.WasStashed {
+		t.Error("expected nothing to be stashed for untracked-only changes")
+	}
+	out, err := s.runGitCommandOutput(repo, "stash", "list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "earlier work") {
+		t.Errorf("expected the earlier stash to be left alone, stash list: %q", out)
+	}
+}
+
+func TestGitService_LandBranch_RebaseSkipsMergeCommits(t *testing.T) {
+	home := setupGitHome(t)
+	repo := filepath.Join(home, "repo")
+	runGit(t, home, "init", "-q", "-b", "main", repo)
+	commitFile(t, repo, "README.md", "hello\n")
+
+	// The feature branch picks up main's later work through a merge commit
+	runGit(t, repo, "checkout", "-q", "-b", "feature")
+	commitFile(t, repo, "a.txt", "a\n")
+	runGit(t, repo, "checkout", "-q", "main")
+	commitFile(t, repo, "CHANGELOG.md", "v1\n")
+	runGit(t, repo, "checkout", "-q", "feature")
+	runGit(t, repo, "merge", "-q", "--no-ff", "-m", "Merge main into feature", "main")
+	commitFile(t, repo, "b.txt", "b\n")
+	runGit(t, repo, "checkout", "-q", "main")
+
+	s := NewGitService()
+	conflicts, err := s.LandBranch(repo, "feature", "rebase", "")
+	if err != nil || len(conflicts) != 0 {
+		t.Fatalf("LandBranch: conflicts %v, err %v", conflicts, err)
+	}
+
+	out, err := s.runGitCommandOutput(repo, "rev-list", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commits := strings.Fields(out); len(commits) != 4 {
+		t.Errorf("expected a and b replayed on main's two commits, got %d commits", len(commits))
+	}
+	if merges, _ := s.runGitCommandOutput(repo, "rev-list", "--merges", "main"); strings.TrimSpace(merges) != "" {
+		t.Errorf("expected no merge commit on main, got %q", merges)
+	}
+	for _, name := range []string{"a.txt", "b.txt", "CHANGELOG.md"} {
+		if _, err := os.Stat(filepath.Join(repo, name)); err != nil {
+			t.Errorf("expected %s on main after landing: %v", name, err)
+		}
+	}
+}
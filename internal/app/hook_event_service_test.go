@@ -20,6 +20,8 @@ type mockHookEventRepository struct {
 	getErr    error
 	listErr   error
 	nextID    int
+
+	compactedDays int
 }
 
 func newMockHookEventRepository() *mockHookEventRepository {
@@ -73,6 +75,18 @@ func (m *mockHookEventRepository) GetNextID(ctx context.Context) (string, error)
 	return "HEV-" + string('0'+byte(id/1000)) + string('0'+byte((id/100)%10)) + string('0'+byte((id/10)%10)) + string('0'+byte(id%10)), nil
 }
 
+func (m *mockHookEventRepository) CompactPayloadsOlderThan(ctx context.Context, days int) (int, error) {
+	m.compactedDays = days
+	count := 0
+	for _, e := range m.events {
+		if e.PayloadJSON != "" {
+			e.PayloadJSON = ""
+			count++
+		}
+	}
+	return count, nil
+}
+
 // ============================================================================
 // Test Helper
 // ============================================================================
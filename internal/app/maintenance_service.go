@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	coreshipment "github.com/example/orc/internal/core/shipment"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// MaintenanceServiceImpl implements the MaintenanceService interface.
+type MaintenanceServiceImpl struct {
+	archiveRepo          secondary.ArchiveRepository
+	hookEventRepo        secondary.HookEventRepository
+	operationalEventRepo secondary.OperationalEventRepository
+}
+
+// NewMaintenanceService creates a new MaintenanceService with injected dependencies.
+func NewMaintenanceService(
+	archiveRepo secondary.ArchiveRepository,
+	hookEventRepo secondary.HookEventRepository,
+	operationalEventRepo secondary.OperationalEventRepository,
+) *MaintenanceServiceImpl {
+	return &MaintenanceServiceImpl{
+		archiveRepo:          archiveRepo,
+		hookEventRepo:        hookEventRepo,
+		operationalEventRepo: operationalEventRepo,
+	}
+}
+
+// RunMaintenance applies a retention policy.
+// A dry run only evaluates shipment archival; events and hook payloads are left untouched.
+func (s *MaintenanceServiceImpl) RunMaintenance(ctx context.Context, req primary.MaintenanceRequest) (*primary.MaintenanceResult, error) {
+	result := &primary.MaintenanceResult{DryRun: req.DryRun}
+
+	if req.ArchiveShipmentsAfterDays > 0 {
+		if err := s.archiveShipments(ctx, req, result); err != nil {
+			return result, err
+		}
+	}
+
+	if req.DryRun {
+		return result, nil
+	}
+
+	// Only operational events are pruned; the audit trail is kept
+	if req.PruneEventsAfterDays > 0 {
+		count, err := s.operationalEventRepo.PruneOlderThan(ctx, req.PruneEventsAfterDays)
+		if err != nil {
+			return result, fmt.Errorf("failed to prune operational events: %w", err)
+		}
+		result.PrunedEvents = count
+	}
+
+	if req.CompactHookPayloadsAfterDays > 0 {
+		count, err := s.hookEventRepo.CompactPayloadsOlderThan(ctx, req.CompactHookPayloadsAfterDays)
+		if err != nil {
+			return result, fmt.Errorf("failed to compact hook payloads: %w", err)
+		}
+		result.CompactedHookPayloads = count
+	}
+
+	return result, nil
+}
+
+// archiveShipments moves eligible closed shipments to the archive ledger.
+func (s *MaintenanceServiceImpl) archiveShipments(ctx context.Context, req primary.MaintenanceRequest, result *primary.MaintenanceResult) error {
+	candidates, err := s.archiveRepo.ListArchiveCandidates(ctx, req.ArchiveShipmentsAfterDays)
+	if err != nil {
+		return fmt.Errorf("failed to list archive candidates: %w", err)
+	}
+
+	for _, c := range candidates {
+		guard := coreshipment.CanArchiveShipment(coreshipment.ArchiveShipmentContext{
+			ShipmentID:    c.ShipmentID,
+			Status:        c.Status,
+			IsPinned:      c.Pinned,
+			HasOpenPR:     c.HasOpenPR,
+			HoldsNewestID: c.HoldsNewestID,
+		})
+		if !guard.Allowed {
+			result.SkippedShipments = append(result.SkippedShipments, primary.SkippedShipment{
+				ShipmentID: c.ShipmentID,
+				Reason:     guard.Reason,
+			})
+			continue
+		}
+
+		if req.DryRun {
+			result.ArchivedShipments = append(result.ArchivedShipments, c.ShipmentID)
+			continue
+		}
+
+		counts, err := s.archiveRepo.ArchiveShipment(ctx, c.ShipmentID)
+		if err != nil {
+			return fmt.Errorf("failed to archive %s: %w", c.ShipmentID, err)
+		}
+		result.ArchivedShipments = append(result.ArchivedShipments, c.ShipmentID)
+		result.ArchivedTasks += counts.Tasks
+		result.ArchivedPlans += counts.Plans
+		result.ArchivedNotes += counts.Notes
+		result.ArchivedPRs += counts.PRs
+	}
+
+	return nil
+}
+
+// ListArchivedShipments retrieves shipments from the archive ledger.
+func (s *MaintenanceServiceImpl) ListArchivedShipments(ctx context.Context, filters primary.ShipmentFilters) ([]*primary.Shipment, error) {
+	records, err := s.archiveRepo.ListShipments(ctx, secondary.ShipmentFilters{
+		CommissionID: filters.CommissionID,
+		Status:       filters.Status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived shipments: %w", err)
+	}
+
+	shipments := make([]*primary.Shipment, len(records))
+	for i, r := range records {
+		shipments[i] = recordToShipment(r)
+	}
+	return shipments, nil
+}
+
+// GetArchivedShipment retrieves a shipment from the archive ledger.
+func (s *MaintenanceServiceImpl) GetArchivedShipment(ctx context.Context, shipmentID string) (*primary.Shipment, error) {
+	record, err := s.archiveRepo.GetShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	return recordToShipment(record), nil
+}
+
+// GetArchivedShipmentTasks retrieves the archived tasks of a shipment.
+func (s *MaintenanceServiceImpl) GetArchivedShipmentTasks(ctx context.Context, shipmentID string) ([]*primary.Task, error) {
+	records, err := s.archiveRepo.GetShipmentTasks(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archived tasks: %w", err)
+	}
+
+	tasks := make([]*primary.Task, len(records))
+	for i, r := range records {
+		tasks[i] = recordToTask(r)
+	}
+	return tasks, nil
+}
+
+// Ensure MaintenanceServiceImpl implements the interface
+var _ primary.MaintenanceService = (*MaintenanceServiceImpl)(nil)
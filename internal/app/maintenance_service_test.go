@@ -0,0 +1,197 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ============================================================================
+// Mock Implementations
+// ============================================================================
+
+// mockArchiveRepository implements secondary.ArchiveRepository for testing.
+type mockArchiveRepository struct {
+	candidates []*secondary.ArchiveCandidateRecord
+	archived   map[string]*secondary.ShipmentRecord
+	archiveErr error
+}
+
+func newMockArchiveRepository() *mockArchiveRepository {
+	return &mockArchiveRepository{archived: make(map[string]*secondary.ShipmentRecord)}
+}
+
+func (m *mockArchiveRepository) ListArchiveCandidates(ctx context.Context, olderThanDays int) ([]*secondary.ArchiveCandidateRecord, error) {
+	return m.candidates, nil
+}
+
+func (m *mockArchiveRepository) ArchiveShipment(ctx context.Context, shipmentID string) (*secondary.ArchivedCountsRecord, error) {
+	if m.archiveErr != nil {
+		return nil, m.archiveErr
+	}
+	m.archived[shipmentID] = &secondary.ShipmentRecord{ID: shipmentID, Status: "closed"}
+	return &secondary.ArchivedCountsRecord{Tasks: 2, Notes: 1}, nil
+}
+
+func (m *mockArchiveRepository) ListShipments(ctx context.Context, filters secondary.ShipmentFilters) ([]*secondary.ShipmentRecord, error) {
+	var records []*secondary.ShipmentRecord
+	for _, r := range m.archived {
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+func (m *mockArchiveRepository) GetShipment(ctx context.Context, id string) (*secondary.ShipmentRecord, error) {
+	if r, ok := m.archived[id]; ok {
+		return r, nil
+	}
+	return nil, errors.New("shipment not found")
+}
+
+func (m *mockArchiveRepository) GetShipmentTasks(ctx context.Context, shipmentID string) ([]*secondary.TaskRecord, error) {
+	return nil, nil
+}
+
+// ============================================================================
+// Test Helper
+// ============================================================================
+
+func newTestMaintenanceService() (*MaintenanceServiceImpl, *mockArchiveRepository, *mockHookEventRepository, *mockOperationalEventRepository) {
+	archiveRepo := newMockArchiveRepository()
+	hookRepo := newMockHookEventRepository()
+	opsRepo := newMockOperationalEventRepository()
+	opsRepo.events["OE-0001"] = &secondary.OperationalEventRecord{ID: "OE-0001", Timestamp: time.Now().AddDate(0, 0, -45).Format(time.RFC3339)}
+	opsRepo.events["OE-0002"] = &secondary.OperationalEventRecord{ID: "OE-0002", Timestamp: time.Now().Format(time.RFC3339)}
+	return NewMaintenanceService(archiveRepo, hookRepo, opsRepo), archiveRepo, hookRepo, opsRepo
+}
+
+// ============================================================================
+// RunMaintenance Tests
+// ============================================================================
+
+func TestRunMaintenance_ArchivesEligibleShipments(t *testing.T) {
+	service, archiveRepo, hookRepo, opsRepo := newTestMaintenanceService()
+	ctx := context.Background()
+
+	archiveRepo.candidates = []*secondary.ArchiveCandidateRecord{
+		{ShipmentID: "SHIP-001", Status: "closed"},
+		{ShipmentID: "SHIP-002", Status: "closed", Pinned: true},
+		{ShipmentID: "SHIP-003", Status: "closed", HoldsNewestID: true},
+	}
+	hookRepo.events["HEV-0001"] = &secondary.HookEventRecord{ID: "HEV-0001", PayloadJSON: `{}`}
+
+	result, err := service.RunMaintenance(ctx, primary.MaintenanceRequest{
+		ArchiveShipmentsAfterDays:    60,
+		PruneEventsAfterDays:         30,
+		CompactHookPayloadsAfterDays: 7,
+	})
+	if err != nil {
+		t.Fatalf("RunMaintenance failed: %v", err)
+	}
+
+	if len(result.ArchivedShipments) != 1 || result.ArchivedShipments[0] != "SHIP-001" {
+		t.Errorf("ArchivedShipments = %v, want [SHIP-001]", result.ArchivedShipments)
+	}
+	if len(result.SkippedShipments) != 2 {
+		t.Errorf("expected 2 skipped shipments, got %d", len(result.SkippedShipments))
+	}
+	if result.ArchivedTasks != 2 || result.ArchivedNotes != 1 {
+		t.Errorf("archived counts = %d tasks, %d notes, want 2 and 1", result.ArchivedTasks, result.ArchivedNotes)
+	}
+	if result.PrunedEvents != 1 || opsRepo.events["OE-0002"] == nil {
+		t.Errorf("PrunedEvents = %d, want only the 45-day-old operational event pruned", result.PrunedEvents)
+	}
+	if result.CompactedHookPayloads != 1 || hookRepo.compactedDays != 7 {
+		t.Errorf("CompactedHookPayloads = %d (days %d), want 1 (days 7)", result.CompactedHookPayloads, hookRepo.compactedDays)
+	}
+}
+
+func TestRunMaintenance_DryRunChangesNothing(t *testing.T) {
+	service, archiveRepo, hookRepo, opsRepo := newTestMaintenanceService()
+	ctx := context.Background()
+
+	archiveRepo.candidates = []*secondary.ArchiveCandidateRecord{{ShipmentID: "SHIP-001", Status: "closed"}}
+	hookRepo.events["HEV-0001"] = &secondary.HookEventRecord{ID: "HEV-0001", PayloadJSON: `{}`}
+
+	result, err := service.RunMaintenance(ctx, primary.MaintenanceRequest{
+		ArchiveShipmentsAfterDays:    60,
+		PruneEventsAfterDays:         30,
+		CompactHookPayloadsAfterDays: 7,
+		DryRun:                       true,
+	})
+	if err != nil {
+		t.Fatalf("RunMaintenance failed: %v", err)
+	}
+
+	if len(result.ArchivedShipments) != 1 {
+		t.Errorf("expected SHIP-001 reported as archivable, got %v", result.ArchivedShipments)
+	}
+	if len(archiveRepo.archived) != 0 {
+		t.Error("dry run should not archive shipments")
+	}
+	if len(opsRepo.events) != 2 {
+		t.Error("dry run should not prune events")
+	}
+	if hookRepo.events["HEV-0001"].PayloadJSON == "" {
+		t.Error("dry run should not compact hook payloads")
+	}
+}
+
+func TestRunMaintenance_ZeroDaysSkipsStep(t *testing.T) {
+	service, archiveRepo, _, opsRepo := newTestMaintenanceService()
+	ctx := context.Background()
+
+	archiveRepo.candidates = []*secondary.ArchiveCandidateRecord{{ShipmentID: "SHIP-001", Status: "closed"}}
+
+	result, err := service.RunMaintenance(ctx, primary.MaintenanceRequest{PruneEventsAfterDays: 30})
+	if err != nil {
+		t.Fatalf("RunMaintenance failed: %v", err)
+	}
+
+	if len(result.ArchivedShipments) != 0 {
+		t.Errorf("archival disabled, got %v", result.ArchivedShipments)
+	}
+	if result.PrunedEvents != 1 || len(opsRepo.events) != 1 {
+		t.Errorf("expected the old operational event pruned, got %d pruned", result.PrunedEvents)
+	}
+}
+
+func TestRunMaintenance_ArchiveError(t *testing.T) {
+	service, archiveRepo, _, _ := newTestMaintenanceService()
+	ctx := context.Background()
+
+	archiveRepo.candidates = []*secondary.ArchiveCandidateRecord{{ShipmentID: "SHIP-001", Status: "closed"}}
+	archiveRepo.archiveErr = errors.New("disk full")
+
+	_, err := service.RunMaintenance(ctx, primary.MaintenanceRequest{ArchiveShipmentsAfterDays: 60})
+	if err == nil {
+		t.Fatal("expected error when archival fails")
+	}
+}
+
+// ============================================================================
+// Archive Query Tests
+// ============================================================================
+
+func TestGetArchivedShipment(t *testing.T) {
+	service, archiveRepo, _, _ := newTestMaintenanceService()
+	ctx := context.Background()
+
+	archiveRepo.archived["SHIP-001"] = &secondary.ShipmentRecord{ID: "SHIP-001", Title: "Old work", Status: "closed"}
+
+	shipment, err := service.GetArchivedShipment(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("GetArchivedShipment failed: %v", err)
+	}
+	if shipment.Title != "Old work" {
+		t.Errorf("Title = %q, want %q", shipment.Title, "Old work")
+	}
+
+	if _, err := service.GetArchivedShipment(ctx, "SHIP-999"); err == nil {
+		t.Error("expected error for shipment not in archive")
+	}
+}
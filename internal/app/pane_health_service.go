@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/example/orc/internal/config"
+	"github.com/example/orc/internal/core/panehealth"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// paneHealthSource is the operational event source of pane state transitions.
+const paneHealthSource = "pane-monitor"
+
+// PaneHealthServiceImpl implements the PaneHealthService interface.
+type PaneHealthServiceImpl struct {
+	eventWriter secondary.EventWriter
+}
+
+// NewPaneHealthService creates a new PaneHealthService with injected dependencies.
+func NewPaneHealthService(eventWriter secondary.EventWriter) *PaneHealthServiceImpl {
+	return &PaneHealthServiceImpl{eventWriter: eventWriter}
+}
+
+// AssessPanes classifies each pane, marks agent panes that vanished since the
+// previous poll as crashed, and emits an operational event per transition. A
+// pane seen for the first time only emits one when it needs a human.
+func (s *PaneHealthServiceImpl) AssessPanes(ctx context.Context, req primary.AssessPanesRequest) (*primary.PaneHealthReport, error) {
+	report := &primary.PaneHealthReport{WorkshopID: req.WorkshopID}
+	seen := make(map[string]bool, len(req.Panes))
+	var windows []string
+	windowStates := make(map[string][]string)
+	var states []string
+
+	for _, obs := range req.Panes {
+		seen[obs.PaneID] = true
+		state := panehealth.Classify(panehealth.Observation{
+			CurrentCommand: obs.CurrentCommand,
+			Dead:           obs.Dead,
+			Agent:          isAgentPane(obs.Role, obs.StartCommand),
+			Content:        obs.Content,
+			PreviousState:  obs.PreviousState,
+			PreviousDigest: obs.PreviousDigest,
+		})
+		health := &primary.PaneHealth{
+			PaneID:         obs.PaneID,
+			Window:         obs.Window,
+			Index:          obs.Index,
+			Role:           obs.Role,
+			BenchID:        obs.BenchID,
+			State:          state,
+			PreviousState:  obs.PreviousState,
+			CurrentCommand: obs.CurrentCommand,
+			Digest:         panehealth.Digest(obs.Content),
+		}
+		if state == panehealth.StateWaiting {
+			health.Prompt = panehealth.PromptLine(obs.Content)
+		}
+		report.Panes = append(report.Panes, health)
+
+		if _, ok := windowStates[obs.Window]; !ok {
+			windows = append(windows, obs.Window)
+		}
+		windowStates[obs.Window] = append(windowStates[obs.Window], state)
+		states = append(states, state)
+
+		if state != obs.PreviousState && (obs.PreviousState != "" || panehealth.Alert(state)) {
+			report.Transitions = append(report.Transitions, health)
+		}
+	}
+
+	// Agent panes close when the agent exits (remain-on-exit is off)
+	for _, known := range req.Known {
+		if seen[known.PaneID] || !isAgentPane(known.Role, "") {
+			continue
+		}
+		if state := panehealth.Gone(known.State); state != "" {
+			report.Transitions = append(report.Transitions, &primary.PaneHealth{
+				PaneID:        known.PaneID,
+				Window:        known.Window,
+				Index:         known.Index,
+				Role:          known.Role,
+				BenchID:       known.BenchID,
+				State:         state,
+				PreviousState: known.State,
+				Gone:          true,
+			})
+		}
+	}
+
+	for _, w := range windows {
+		worst := panehealth.Worst(windowStates[w])
+		report.Windows = append(report.Windows, &primary.WindowHealth{Window: w, State: worst, Alert: panehealth.Alert(worst)})
+	}
+	report.Summary = panehealth.Summary(states)
+
+	for _, t := range report.Transitions {
+		t.Change = describeTransition(t)
+		s.recordTransition(ctx, req.WorkshopID, t)
+	}
+	return report, nil
+}
+
+// recordTransition emits a pane state change as an operational event. Event
+// failures don't stop monitoring.
+func (s *PaneHealthServiceImpl) recordTransition(ctx context.Context, workshopID string, p *primary.PaneHealth) {
+	if s.eventWriter == nil {
+		return
+	}
+	data := map[string]string{
+		"workshop_id": workshopID,
+		"pane_id":     p.PaneID,
+		"window":      p.Window,
+		"from":        p.PreviousState,
+		"to":          p.State,
+	}
+	if p.BenchID != "" {
+		data["bench_id"] = p.BenchID
+	}
+	if p.Role != "" {
+		data["role"] = p.Role
+	}
+	if p.CurrentCommand != "" {
+		data["command"] = p.CurrentCommand
+	}
+	_ = s.eventWriter.EmitOperational(ctx, paneHealthSource, panehealth.Level(p.State), p.Change, data)
+}
+
+// describeTransition renders a state change for events and the monitor output.
+func describeTransition(p *primary.PaneHealth) string {
+	from := p.PreviousState
+	if from == "" {
+		from = "new"
+	}
+	change := fmt.Sprintf("%s: %s → %s", paneLabel(p), from, p.State)
+	switch {
+	case p.Gone:
+		change += " (pane closed)"
+	case p.Prompt != "":
+		change += fmt.Sprintf(" (%s)", p.Prompt)
+	}
+	return change
+}
+
+// isAgentPane reports whether a pane runs a workbench agent: the goblin pane of
+// a layout, or any pane started with orc connect.
+func isAgentPane(role, startCommand string) bool {
+	return role == config.GoblinPaneRole || strings.Contains(startCommand, config.GoblinPaneCommand)
+}
+
+// paneLabel names a pane in events: "BENCH-014 goblin", falling back to the
+// window and pane index.
+func paneLabel(p *primary.PaneHealth) string {
+	label := p.Window
+	if p.BenchID != "" {
+		label = p.BenchID
+	}
+	if p.Role != "" {
+		return label + " " + p.Role
+	}
+	return fmt.Sprintf("%s.%d", label, p.Index)
+}
+
+// Ensure PaneHealthServiceImpl implements the interface
+var _ primary.PaneHealthService = (*PaneHealthServiceImpl)(nil)
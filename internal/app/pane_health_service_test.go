@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestPaneHealthService_AssessPanes(t *testing.T) {
+	events := &mockEventWriter{}
+	service := NewPaneHealthService(events)
+	ctx := context.Background()
+
+	panes := []primary.PaneObservation{
+		{PaneID: "%1", Window: "bench-1", Role: "goblin", BenchID: "BENCH-001", CurrentCommand: "claude", StartCommand: "orc connect",
+			Content: "Do you want to proceed?\n❯ 1. Yes\n", PreviousState: primary.PaneStateWorking},
+		{PaneID: "%2", Window: "bench-1", Index: 1, Role: "tests", BenchID: "BENCH-001", CurrentCommand: "zsh", PreviousState: primary.PaneStateShell},
+		{PaneID: "%3", Window: "bench-2", Role: "goblin", BenchID: "BENCH-002", CurrentCommand: "claude",
+			Content: "✻ Thinking… (esc to interrupt)"},
+	}
+	known := []*primary.PaneHealth{
+		{PaneID: "%4", Window: "bench-3", Role: "goblin", BenchID: "BENCH-003", State: primary.PaneStateIdle},
+		{PaneID: "%5", Window: "bench-3", Index: 1, Role: "logs", BenchID: "BENCH-003", State: primary.PaneStateWorking},
+	}
+
+	report, err := service.AssessPanes(ctx, primary.AssessPanesRequest{WorkshopID: "WORK-001", Panes: panes, Known: known})
+	if err != nil {
+		t.Fatalf("AssessPanes failed: %v", err)
+	}
+
+	got := map[string]string{}
+	for _, p := range report.Panes {
+		got[p.PaneID] = p.State
+	}
+	want := map[string]string{"%1": primary.PaneStateWaiting, "%2": primary.PaneStateShell, "%3": primary.PaneStateWorking}
+	for id, state := range want {
+		if got[id] != state {
+			t.Errorf("pane %s: expected %s, got %s", id, state, got[id])
+		}
+	}
+
+	// %1 changed; %3 is new but fine; %4 vanished while running; %5 isn't an agent pane
+	if len(report.Transitions) != 2 || report.Transitions[0].PaneID != "%1" || !report.Transitions[1].Gone || report.Transitions[1].PaneID != "%4" {
+		t.Fatalf("expected transitions for %%1 and %%4, got %+v", report.Transitions)
+	}
+	if len(report.Windows) != 2 || report.Windows[0].State != primary.PaneStateWaiting || !report.Windows[0].Alert || report.Windows[1].Alert {
+		t.Errorf("unexpected window health: %+v %+v", report.Windows[0], report.Windows[1])
+	}
+	if report.Summary != "1 waiting, 1 working, 1 shell" {
+		t.Errorf("unexpected summary %q", report.Summary)
+	}
+
+	if len(events.operational) != 2 {
+		t.Fatalf("expected 2 operational events, got %+v", events.operational)
+	}
+	waiting := events.operational[0]
+	if waiting.Source != "pane-monitor" || waiting.Level != "warn" || !strings.Contains(waiting.Message, "BENCH-001 goblin: working → waiting (Do you want to proceed?)") {
+		t.Errorf("unexpected waiting event: %+v", waiting)
+	}
+	if waiting.Data["workshop_id"] != "WORK-001" || waiting.Data["bench_id"] != "BENCH-001" {
+		t.Errorf("unexpected event data: %v", waiting.Data)
+	}
+	if crashed := events.operational[1]; crashed.Level != "error" || !strings.Contains(crashed.Message, "idle → crashed (pane closed)") {
+		t.Errorf("unexpected crash event: %+v", crashed)
+	}
+}
+
+func TestPaneHealthService_StableStatesAreQuiet(t *testing.T) {
+	events := &mockEventWriter{}
+	service := NewPaneHealthService(events)
+
+	report, err := service.AssessPanes(context.Background(), primary.AssessPanesRequest{
+		WorkshopID: "WORK-001",
+		Panes: []primary.PaneObservation{
+			{PaneID: "%1", Window: "bench-1", Role: "goblin", CurrentCommand: "claude", Content: "done", PreviousState: primary.PaneStateIdle},
+		},
+	})
+	if err != nil {
+		t.Fatalf("AssessPanes failed: %v", err)
+	}
+	// Without a previous digest the screen can't have moved, so the pane stays idle
+	if report.Panes[0].State != primary.PaneStateIdle || len(report.Transitions) != 0 || len(events.operational) != 0 {
+		t.Errorf("expected a quiet idle pane, got %+v / %+v", report.Panes[0], events.operational)
+	}
+	if report.Panes[0].Digest == "" {
+		t.Error("expected a digest for the next poll")
+	}
+}
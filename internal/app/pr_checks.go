@@ -0,0 +1,107 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/orc/internal/core/pr"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ListPRChecks retrieves the CI checks of a PR as of its last sync.
+func (s *PRServiceImpl) ListPRChecks(ctx context.Context, prID string) ([]*primary.PRCheck, error) {
+	if _, err := s.prRepo.GetByID(ctx, prID); err != nil {
+		return nil, err
+	}
+	records, err := s.prRepo.ListChecks(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]*primary.PRCheck, len(records))
+	for i, r := range records {
+		checks[i] = &primary.PRCheck{
+			Name:       r.Name,
+			Status:     r.Status,
+			Conclusion: r.Conclusion,
+			State:      pr.CheckState(toCoreCheck(r)),
+			URL:        r.URL,
+			Required:   r.Required,
+			UpdatedAt:  r.UpdatedAt,
+		}
+	}
+	return checks, nil
+}
+
+// GetShipmentChecksState rolls up the CI checks of a shipment's pending PRs.
+func (s *PRServiceImpl) GetShipmentChecksState(ctx context.Context, shipmentID string) (string, error) {
+	records, err := s.prRepo.List(ctx, secondary.PRFilters{ShipmentID: shipmentID})
+	if err != nil {
+		return "", fmt.Errorf("failed to list shipment PRs: %w", err)
+	}
+
+	var checks []pr.Check
+	for _, r := range records {
+		if r.Status == primary.PRStatusMerged || r.Status == primary.PRStatusClosed {
+			continue
+		}
+		stored, err := s.prRepo.ListChecks(ctx, r.ID)
+		if err != nil {
+			return "", err
+		}
+		checks = append(checks, toCoreChecks(stored)...)
+	}
+	return pr.ChecksState(checks), nil
+}
+
+// syncChecks replaces a PR's stored checks with the provider's, returning the
+// rolled-up state before and after.
+func (s *PRServiceImpl) syncChecks(ctx context.Context, record *secondary.PRRecord, provider secondary.PRProvider, headSHA string) (string, string, error) {
+	stored, err := s.prRepo.ListChecks(ctx, record.ID)
+	if err != nil {
+		return "", "", err
+	}
+	remote, err := provider.ListChecks(ctx, record.Number, headSHA)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to fetch checks: %w", err)
+	}
+
+	// Providers may report a name twice (e.g., a check run and a status); keep the last.
+	byName := make(map[string]int, len(remote))
+	var checks []*secondary.PRCheckRecord
+	for _, c := range remote {
+		check := &secondary.PRCheckRecord{
+			PRID:       record.ID,
+			Name:       c.Name,
+			Status:     c.Status,
+			Conclusion: c.Conclusion,
+			URL:        c.URL,
+			Required:   c.Required,
+			UpdatedAt:  c.UpdatedAt,
+		}
+		if i, ok := byName[c.Name]; ok {
+			checks[i] = check
+			continue
+		}
+		byName[c.Name] = len(checks)
+		checks = append(checks, check)
+	}
+
+	if err := s.prRepo.ReplaceChecks(ctx, record.ID, checks); err != nil {
+		return "", "", err
+	}
+	return pr.ChecksState(toCoreChecks(stored)), pr.ChecksState(toCoreChecks(checks)), nil
+}
+
+func toCoreCheck(r *secondary.PRCheckRecord) pr.Check {
+	return pr.Check{Name: r.Name, Status: r.Status, Conclusion: r.Conclusion, Required: r.Required}
+}
+
+func toCoreChecks(records []*secondary.PRCheckRecord) []pr.Check {
+	checks := make([]pr.Check, len(records))
+	for i, r := range records {
+		checks[i] = toCoreCheck(r)
+	}
+	return checks
+}
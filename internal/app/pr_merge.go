@@ -0,0 +1,227 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/example/orc/internal/core/pr"
+	corerepo "github.com/example/orc/internal/core/repo"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// verifyOutputLimit caps the output kept per verify command.
+const verifyOutputLimit = 4096
+
+// mergeSourceFlag reports a strategy passed to orc pr merge directly.
+const mergeSourceFlag = "--strategy"
+
+// landPR merges a PR into its target branch in the repo's main checkout when the
+// repo (or strategy) asks for a local merge: the target branch is brought up to date
+// with origin, the branch landed, the verify commands run on the result, and the
+// target branch pushed. Any failure resets the checkout to where it was. Repos
+// without a merge strategy are left alone and response.Strategy stays empty.
+// A merge config checked in to .orc/repo.json is refused until adopted onto the
+// repo record; with an explicit strategy its verify commands are only reported.
+func (s *PRServiceImpl) landPR(ctx context.Context, record *secondary.PRRecord, strategy string, response *primary.MergePRResponse) error {
+	if s.repoRepo == nil {
+		if strategy != "" {
+			return fmt.Errorf("cannot merge %s locally: no repositories configured", record.ID)
+		}
+		return nil
+	}
+	repo, err := s.repoRepo.GetByID(ctx, record.RepoID)
+	if err != nil {
+		return err
+	}
+	cfg, source, err := repoMergeConfig(repo)
+	if err != nil {
+		return err
+	}
+	// A checked-in config arrives with the code, so none of it applies until adopted
+	var pending *corerepo.MergeConfig
+	if source == corerepo.MergeSourceFile {
+		pending, cfg = cfg, nil
+	}
+	if strategy != "" {
+		if err := corerepo.ValidateMergeStrategy(strategy); err != nil {
+			return err
+		}
+		verify := []string(nil)
+		if cfg != nil {
+			verify = cfg.Verify
+		}
+		cfg, source = &corerepo.MergeConfig{Strategy: strategy, Verify: verify}, mergeSourceFlag
+	}
+	if cfg == nil {
+		if pending != nil {
+			return fmt.Errorf("%s of %s asks to land PRs with %s and %d verify command(s), but it is not adopted. Review it, then adopt it with: orc repo merge %s --from-file (or pass --strategy or --ledger-only)",
+				corerepo.ConfigFile, repo.ID, pending.Strategy, len(pending.Verify), repo.ID)
+		}
+		return nil
+	}
+	if pending != nil {
+		response.PendingVerify = pending.Verify
+	}
+
+	target := record.TargetBranch
+	if target == "" {
+		target = repo.DefaultBranch
+	}
+	response.TargetBranch = target
+
+	path := repo.LocalPath
+	guardCtx := pr.LocalMergeContext{
+		PRID:         record.ID,
+		RepoID:       repo.ID,
+		Branch:       record.Branch,
+		TargetBranch: target,
+	}
+	branchRef := record.Branch
+	if path != "" {
+		guardCtx.CheckoutPath = path
+		if guardCtx.CheckoutBranch, err = s.gitService.GetCurrentBranch(path); err != nil {
+			return fmt.Errorf("failed to inspect %s: %w", path, err)
+		}
+		if guardCtx.DirtyFiles, err = s.gitService.GetTrackedChangeCount(path); err != nil {
+			return fmt.Errorf("failed to inspect %s: %w", path, err)
+		}
+		if _, err := s.gitService.FetchOrigin(path); err != nil {
+			return err
+		}
+		if exists, _ := s.gitService.BranchExists(path, branchRef); !exists {
+			branchRef = "origin/" + record.Branch
+		}
+		guardCtx.BranchExists, _ = s.gitService.BranchExists(path, branchRef)
+		if guardCtx.BranchExists {
+			if guardCtx.Ahead, _, err = s.gitService.GetAheadBehindRefs(path, target, branchRef); err != nil {
+				return err
+			}
+		}
+	}
+	if err := pr.CanMergeLocally(guardCtx).Error(); err != nil {
+		return err
+	}
+
+	// Land on top of origin's target branch, so the push below is a fast-forward
+	if exists, _ := s.gitService.BranchExists(path, "origin/"+target); exists {
+		ahead, behind, err := s.gitService.GetAheadBehindRefs(path, "origin/"+target, target)
+		if err != nil {
+			return err
+		}
+		if ahead > 0 {
+			return fmt.Errorf("%s in %s has %d commit(s) not on origin/%s; push or drop them before merging", target, path, ahead, target)
+		}
+		if behind > 0 {
+			if err := s.gitService.FastForward(path, "origin/"+target); err != nil {
+				return err
+			}
+		}
+	}
+
+	head, err := s.gitService.GetHeadCommit(path)
+	if err != nil {
+		return err
+	}
+	message := pr.MergeCommitMessage(record.ID, record.Number, record.Title, record.Branch)
+	if cfg.Strategy == corerepo.MergeStrategySquash {
+		message = pr.SquashCommitMessage(record.ID, record.Number, record.Title, record.Branch)
+	}
+	conflicts, err := s.gitService.LandBranch(path, branchRef, cfg.Strategy, message)
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("merging %s into %s conflicts in %s; nothing was merged. Bring the branch up to date with %s and try again",
+			record.Branch, target, strings.Join(conflicts, ", "), target)
+	}
+
+	// From here on, undo the merge on failure
+	undo := func(cause error) error {
+		if err := s.gitService.ResetHard(path, head); err != nil {
+			return fmt.Errorf("%w; undoing the merge also failed (%v): reset %s with: git -C %s reset --hard %s", cause, err, target, path, head)
+		}
+		return fmt.Errorf("%w; the merge into %s was undone", cause, target)
+	}
+
+	for _, command := range cfg.Verify {
+		step := runVerifyCommand(ctx, path, command, record, target)
+		response.Verify = append(response.Verify, step)
+		if !step.Success {
+			return undo(fmt.Errorf("verify command failed: %s", command))
+		}
+	}
+
+	if s.gitService.HasOrigin(path) {
+		if err := s.gitService.PushBranch(path, target); err != nil {
+			return undo(err)
+		}
+		response.Pushed = true
+	}
+
+	response.Strategy = cfg.Strategy
+	response.Source = source
+	response.Commit, _ = s.gitService.GetHeadCommit(path)
+
+	if s.eventWriter != nil {
+		_ = s.eventWriter.EmitOperational(ctx, "pr-merge", "info",
+			fmt.Sprintf("%s landed on %s (%s)", record.ID, target, cfg.Strategy),
+			map[string]string{
+				"pr_id":    record.ID,
+				"repo_id":  repo.ID,
+				"branch":   record.Branch,
+				"target":   target,
+				"strategy": cfg.Strategy,
+				"commit":   response.Commit,
+				"pushed":   fmt.Sprintf("%t", response.Pushed),
+			})
+	}
+	return nil
+}
+
+// repoMergeConfig resolves a repo's merge config: the repo record first, then the
+// .orc/repo.json checked in to its main checkout. Callers must not run the verify
+// commands of a config from the file: only adopted ones are trusted.
+func repoMergeConfig(repo *secondary.RepoRecord) (*corerepo.MergeConfig, string, error) {
+	record, err := corerepo.ParseMergeConfig(repo.MergeJSON)
+	if err != nil {
+		return nil, "", err
+	}
+	var file *corerepo.MergeConfig
+	if repo.LocalPath != "" {
+		if data, err := os.ReadFile(filepath.Join(repo.LocalPath, corerepo.ConfigFile)); err == nil {
+			cfg, err := corerepo.ParseConfig(data)
+			if err != nil {
+				return nil, "", err
+			}
+			file = cfg.Merge
+		}
+	}
+	cfg, source := corerepo.ResolveMerge(record, file)
+	return cfg, source, nil
+}
+
+// runVerifyCommand runs one verify command via sh in the main checkout.
+func runVerifyCommand(ctx context.Context, path, command string, record *secondary.PRRecord, target string) *primary.PRVerifyStep {
+	start := time.Now()
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Dir = path
+	cmd.Env = append(os.Environ(),
+		"ORC_PR_ID="+record.ID,
+		"ORC_REPO_ID="+record.RepoID,
+		"ORC_BRANCH="+record.Branch,
+		"ORC_TARGET_BRANCH="+target,
+	)
+	output, err := cmd.CombinedOutput()
+	return &primary.PRVerifyStep{
+		Command:  command,
+		Success:  err == nil,
+		Output:   corerepo.TailOutput(string(output), verifyOutputLimit),
+		Duration: time.Since(start).Round(time.Millisecond).String(),
+	}
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// openOnProvider pushes the PR branch when requested and opens the PR on the repo's
+// provider. An open PR that already exists for the branch is adopted instead of
+// opening a duplicate.
+func (s *PRServiceImpl) openOnProvider(ctx context.Context, req *primary.CreatePRRequest, shipment *primary.Shipment, repos []*primary.ShipmentRepo, response *primary.CreatePRResponse) (*secondary.ProviderPR, error) {
+	if s.providers == nil || s.repoRepo == nil {
+		return nil, fmt.Errorf("opening PRs is not configured")
+	}
+
+	repo, err := s.repoRepo.GetByID(ctx, req.RepoID)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := s.providerFor(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Push {
+		wbPath, err := s.shipmentWorkbenchPath(ctx, req.RepoID, shipment, repos)
+		if err != nil {
+			return nil, fmt.Errorf("%w; push the branch yourself and use --no-push", err)
+		}
+		if err := s.gitService.PushBranch(wbPath, req.Branch); err != nil {
+			return nil, err
+		}
+		response.Pushed = true
+	}
+
+	existing, err := provider.FindPullRequest(ctx, req.Branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up existing PRs: %w", err)
+	}
+	if existing != nil && existing.State == secondary.ProviderPRStateOpen {
+		response.Adopted = true
+		if req.TargetBranch == "" {
+			req.TargetBranch = existing.BaseBranch
+		}
+		return existing, nil
+	}
+
+	if req.TargetBranch == "" {
+		req.TargetBranch = repo.DefaultBranch
+	}
+	if req.TargetBranch == "" {
+		return nil, fmt.Errorf("repo %s has no default branch; specify one with --target", repo.ID)
+	}
+
+	opened, err := provider.CreatePullRequest(ctx, secondary.ProviderPRCreate{
+		Title:      req.Title,
+		Body:       req.Description,
+		HeadBranch: req.Branch,
+		BaseBranch: req.TargetBranch,
+		Draft:      req.Draft,
+		Labels:     req.Labels,
+		Reviewers:  req.Reviewers,
+	})
+	if err != nil {
+		if opened == nil {
+			return nil, fmt.Errorf("failed to open PR on %s: %w", provider.Name(), err)
+		}
+		response.Warning = err.Error()
+	}
+	return opened, nil
+}
+
+// shipmentWorkbenchPath returns the directory of the workbench assigned to a
+// shipment's branch in repoID.
+func (s *PRServiceImpl) shipmentWorkbenchPath(ctx context.Context, repoID string, shipment *primary.Shipment, repos []*primary.ShipmentRepo) (string, error) {
+	workbenchID := ""
+	for _, r := range repos {
+		if r.RepoID == repoID {
+			workbenchID = r.AssignedWorkbenchID
+		}
+	}
+	if workbenchID == "" && (shipment.RepoID == "" || shipment.RepoID == repoID) {
+		workbenchID = shipment.AssignedWorkbenchID
+	}
+	if workbenchID == "" || s.workbenchService == nil {
+		return "", fmt.Errorf("shipment %s has no workbench for %s", shipment.ID, repoID)
+	}
+
+	wb, err := s.workbenchService.GetWorkbench(ctx, workbenchID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get workbench %s: %w", workbenchID, err)
+	}
+	return wb.Path, nil
+}
@@ -0,0 +1,276 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/example/orc/internal/core/pr"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ListPRReviews fetches a PR's review threads from its provider, with the notes
+// they were imported as.
+func (s *PRServiceImpl) ListPRReviews(ctx context.Context, prID string) ([]*primary.PRReviewThread, error) {
+	if s.providers == nil || s.repoRepo == nil {
+		return nil, fmt.Errorf("PR reviews are not configured")
+	}
+
+	record, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if record.Number == 0 {
+		return nil, fmt.Errorf("PR %s has no provider number yet. Run: orc pr sync %s", prID, prID)
+	}
+
+	provider, err := s.providerForRepo(ctx, record.RepoID)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := provider.ListReviewThreads(ctx, record.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch review threads: %w", err)
+	}
+	imported, err := s.importedThreads(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	threads := make([]*primary.PRReviewThread, 0, len(remote))
+	for _, t := range remote {
+		threads = append(threads, toPRReviewThread(t, imported[t.ID]))
+	}
+	return threads, nil
+}
+
+// ImportPRReviews turns a PR's unresolved review threads into notes on its
+// shipment, optionally with a follow-up task each.
+func (s *PRServiceImpl) ImportPRReviews(ctx context.Context, req primary.ImportPRReviewsRequest) (*primary.ImportPRReviewsResponse, error) {
+	if s.providers == nil || s.repoRepo == nil || s.noteService == nil {
+		return nil, fmt.Errorf("PR reviews are not configured")
+	}
+	if req.CreateTasks && s.taskService == nil {
+		return nil, fmt.Errorf("follow-up tasks are not configured")
+	}
+
+	record, err := s.prRepo.GetByID(ctx, req.PRID)
+	if err != nil {
+		return nil, err
+	}
+
+	noteType := req.NoteType
+	if noteType == "" {
+		noteType = primary.NoteTypeConcern
+	}
+	result := pr.CanImportReviews(pr.ImportReviewsContext{
+		PRID:     record.ID,
+		Number:   record.Number,
+		NoteType: noteType,
+	})
+	if err := result.Error(); err != nil {
+		return nil, err
+	}
+
+	provider, err := s.providerForRepo(ctx, record.RepoID)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := provider.ListReviewThreads(ctx, record.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch review threads: %w", err)
+	}
+	imported, err := s.importedThreads(ctx, record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &primary.ImportPRReviewsResponse{}
+	for _, t := range remote {
+		if t.Resolved || imported[t.ID] != nil {
+			response.Skipped++
+			continue
+		}
+
+		link, err := s.importThread(ctx, record, t, noteType, req.CreateTasks)
+		if err != nil {
+			return response, err
+		}
+		response.Imported = append(response.Imported, toPRReviewThread(t, link))
+	}
+	return response, nil
+}
+
+// importThread creates the note (and follow-up task) for one review thread and
+// links them in a single transaction, so a failure leaves nothing half-imported.
+func (s *PRServiceImpl) importThread(ctx context.Context, record *secondary.PRRecord, t *secondary.ProviderReviewThread, noteType string, createTask bool) (*secondary.PRReviewThreadRecord, error) {
+	var link *secondary.PRReviewThreadRecord
+	err := s.transactor.WithImmediateTx(ctx, func(txCtx context.Context) error {
+		var err error
+		link, err = s.importThreadTx(txCtx, record, t, noteType, createTask)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// importThreadTx does the writes for importThread inside its transaction.
+func (s *PRServiceImpl) importThreadTx(ctx context.Context, record *secondary.PRRecord, t *secondary.ProviderReviewThread, noteType string, createTask bool) (*secondary.PRReviewThreadRecord, error) {
+	thread := toCoreReviewThread(t)
+	title := pr.ReviewNoteTitle(thread)
+	content := pr.ReviewNoteContent(thread)
+
+	note, err := s.noteService.CreateNote(ctx, primary.CreateNoteRequest{
+		CommissionID:  record.CommissionID,
+		Title:         title,
+		Content:       content,
+		Type:          noteType,
+		ContainerID:   record.ShipmentID,
+		ContainerType: "shipment",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create note for review thread: %w", err)
+	}
+
+	link := &secondary.PRReviewThreadRecord{
+		PRID:     record.ID,
+		ThreadID: t.ID,
+		NoteID:   note.NoteID,
+		URL:      thread.URL,
+		Path:     t.Path,
+		Line:     t.Line,
+	}
+	if createTask {
+		task, err := s.taskService.CreateTask(ctx, primary.CreateTaskRequest{
+			ShipmentID:   record.ShipmentID,
+			CommissionID: record.CommissionID,
+			Title:        "Address review: " + title,
+			Description:  content + "\nSee " + note.NoteID + ".",
+			Type:         "fix",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create follow-up task for review thread: %w", err)
+		}
+		link.TaskID = task.TaskID
+	}
+
+	if err := s.prRepo.CreateReviewThread(ctx, link); err != nil {
+		return nil, fmt.Errorf("failed to link note to review thread: %w", err)
+	}
+	return link, nil
+}
+
+// syncReviewThreads brings each imported review thread and its note back in
+// agreement: whichever side was resolved or reopened since the last sync wins.
+func (s *PRServiceImpl) syncReviewThreads(ctx context.Context, record *secondary.PRRecord, provider secondary.PRProvider) ([]primary.PRThreadChange, error) {
+	if s.noteService == nil || record.Number == 0 {
+		return nil, nil
+	}
+	links, err := s.prRepo.ListReviewThreads(ctx, record.ID)
+	if err != nil || len(links) == 0 {
+		return nil, err
+	}
+
+	remote, err := provider.ListReviewThreads(ctx, record.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch review threads: %w", err)
+	}
+	byID := make(map[string]*secondary.ProviderReviewThread, len(remote))
+	for _, t := range remote {
+		byID[t.ID] = t
+	}
+
+	var changes []primary.PRThreadChange
+	for _, link := range links {
+		thread, ok := byID[link.ThreadID]
+		if !ok {
+			continue // Deleted on the provider
+		}
+		note, err := s.noteService.GetNote(ctx, link.NoteID)
+		if err != nil {
+			return changes, err
+		}
+		noteResolved := note.Status == "closed" || note.Status == "resolved"
+
+		action := pr.PlanThreadSync(link.Resolved, noteResolved, thread.Resolved)
+		agreed := noteResolved
+		switch action {
+		case pr.ThreadResolveRemote, pr.ThreadReopenRemote:
+			err := provider.SetReviewThreadResolved(ctx, record.Number, link.ThreadID, noteResolved)
+			if errors.Is(err, errors.ErrUnsupported) {
+				continue // Left for the reviewer to resolve on the provider
+			}
+			if err != nil {
+				return changes, fmt.Errorf("failed to update review thread of %s: %w", link.NoteID, err)
+			}
+		case pr.ThreadResolveNote:
+			if err := s.noteService.CloseNote(ctx, primary.CloseNoteRequest{NoteID: link.NoteID, Reason: "resolved"}); err != nil {
+				return changes, err
+			}
+			agreed = true
+		case pr.ThreadReopenNote:
+			if err := s.noteService.ReopenNote(ctx, link.NoteID); err != nil {
+				return changes, err
+			}
+			agreed = false
+		}
+
+		if agreed != link.Resolved {
+			if err := s.prRepo.UpdateReviewThreadResolved(ctx, record.ID, link.ThreadID, agreed); err != nil {
+				return changes, err
+			}
+		}
+		if action != pr.ThreadInSync {
+			changes = append(changes, primary.PRThreadChange{NoteID: link.NoteID, ThreadID: link.ThreadID, Action: string(action)})
+		}
+	}
+	return changes, nil
+}
+
+// importedThreads maps the thread IDs of a PR's imported review threads to their links.
+func (s *PRServiceImpl) importedThreads(ctx context.Context, prID string) (map[string]*secondary.PRReviewThreadRecord, error) {
+	links, err := s.prRepo.ListReviewThreads(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*secondary.PRReviewThreadRecord, len(links))
+	for _, l := range links {
+		byID[l.ThreadID] = l
+	}
+	return byID, nil
+}
+
+func toCoreReviewThread(t *secondary.ProviderReviewThread) pr.ReviewThread {
+	thread := pr.ReviewThread{Path: t.Path, Line: t.Line, Outdated: t.Outdated}
+	for _, c := range t.Comments {
+		thread.Comments = append(thread.Comments, pr.ReviewComment{Author: c.Author, Body: c.Body})
+	}
+	if len(t.Comments) > 0 {
+		thread.URL = t.Comments[0].URL
+	}
+	return thread
+}
+
+func toPRReviewThread(t *secondary.ProviderReviewThread, link *secondary.PRReviewThreadRecord) *primary.PRReviewThread {
+	thread := &primary.PRReviewThread{
+		ThreadID: t.ID,
+		Path:     t.Path,
+		Line:     t.Line,
+		Resolved: t.Resolved,
+		Outdated: t.Outdated,
+	}
+	if len(t.Comments) > 0 {
+		thread.URL = t.Comments[0].URL
+		thread.Author = t.Comments[0].Author
+		thread.Body = t.Comments[0].Body
+		thread.Replies = len(t.Comments) - 1
+	}
+	if link != nil {
+		thread.NoteID = link.NoteID
+		thread.TaskID = link.TaskID
+	}
+	return thread
+}
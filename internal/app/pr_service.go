@@ -11,21 +11,47 @@ import (
 
 // PRServiceImpl implements the PRService interface.
 type PRServiceImpl struct {
-	prRepo          secondary.PRRepository
-	shipmentService primary.ShipmentService
-	transactor      secondary.Transactor
+	prRepo           secondary.PRRepository
+	shipmentService  primary.ShipmentService
+	transactor       secondary.Transactor
+	repoRepo         secondary.RepoRepository // optional: needed by SyncPRs
+	providers        secondary.PRProviders    // optional: needed by SyncPRs
+	eventWriter      secondary.EventWriter    // optional: audits synced fields
+	workbenchService primary.WorkbenchService // optional: locates the workbench to push from
+	noteService      primary.NoteService      // optional: imports review threads as notes
+	taskService      primary.TaskService      // optional: creates review follow-up tasks
+	gitService       *GitService
 }
 
 // NewPRService creates a new PRService with injected dependencies.
-func NewPRService(prRepo secondary.PRRepository, shipmentService primary.ShipmentService, transactor secondary.Transactor) *PRServiceImpl {
+func NewPRService(
+	prRepo secondary.PRRepository,
+	shipmentService primary.ShipmentService,
+	transactor secondary.Transactor,
+	repoRepo secondary.RepoRepository,
+	providers secondary.PRProviders,
+	eventWriter secondary.EventWriter,
+	workbenchService primary.WorkbenchService,
+	noteService primary.NoteService,
+	taskService primary.TaskService,
+) *PRServiceImpl {
 	return &PRServiceImpl{
-		prRepo:          prRepo,
-		shipmentService: shipmentService,
-		transactor:      transactor,
+		prRepo:           prRepo,
+		shipmentService:  shipmentService,
+		transactor:       transactor,
+		repoRepo:         repoRepo,
+		providers:        providers,
+		eventWriter:      eventWriter,
+		workbenchService: workbenchService,
+		noteService:      noteService,
+		taskService:      taskService,
+		gitService:       NewGitService(),
 	}
 }
 
-// CreatePR creates a new pull request.
+// CreatePR creates a new pull request. With req.Open it first opens the PR on the
+// repo's provider (pushing the branch from the shipment's workbench when req.Push
+// is set) and records the returned number and URL.
 func (s *PRServiceImpl) CreatePR(ctx context.Context, req primary.CreatePRRequest) (*primary.CreatePRResponse, error) {
 	// Gather context for guards
 	shipmentExists, err := s.prRepo.ShipmentExists(ctx, req.ShipmentID)
@@ -34,14 +60,36 @@ func (s *PRServiceImpl) CreatePR(ctx context.Context, req primary.CreatePRReques
 	}
 
 	var shipmentStatus string
+	var shipmentRepos []*primary.ShipmentRepo
+	var shipmentRepoIDs []string
 	if shipmentExists {
 		shipmentStatus, err = s.prRepo.GetShipmentStatus(ctx, req.ShipmentID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get shipment status: %w", err)
 		}
+
+		shipmentRepos, err = s.shipmentService.ListShipmentRepos(ctx, req.ShipmentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list shipment repos: %w", err)
+		}
+		for _, r := range shipmentRepos {
+			shipmentRepoIDs = append(shipmentRepoIDs, r.RepoID)
+		}
 	}
 
-	shipmentHasPR, err := s.prRepo.ShipmentHasPR(ctx, req.ShipmentID)
+	// Get commission ID (and defaults) from shipment
+	var shipment *primary.Shipment
+	if shipmentExists {
+		shipment, err = s.shipmentService.GetShipment(ctx, req.ShipmentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get shipment: %w", err)
+		}
+		if err := applyShipmentDefaults(&req, shipment, shipmentRepos); err != nil {
+			return nil, err
+		}
+	}
+
+	shipmentHasPR, err := s.prRepo.ShipmentHasPR(ctx, req.ShipmentID, req.RepoID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check shipment PR: %w", err)
 	}
@@ -53,29 +101,56 @@ func (s *PRServiceImpl) CreatePR(ctx context.Context, req primary.CreatePRReques
 
 	// Evaluate guard
 	result := pr.CanCreatePR(pr.CreatePRContext{
-		ShipmentID:     req.ShipmentID,
-		RepoID:         req.RepoID,
-		ShipmentExists: shipmentExists,
-		ShipmentStatus: shipmentStatus,
-		ShipmentHasPR:  shipmentHasPR,
-		RepoExists:     repoExists,
+		ShipmentID:      req.ShipmentID,
+		RepoID:          req.RepoID,
+		ShipmentExists:  shipmentExists,
+		ShipmentStatus:  shipmentStatus,
+		ShipmentHasPR:   shipmentHasPR,
+		RepoExists:      repoExists,
+		ShipmentRepoIDs: shipmentRepoIDs,
 	})
 	if err := result.Error(); err != nil {
 		return nil, err
 	}
 
-	// Get commission ID from shipment
-	shipment, err := s.shipmentService.GetShipment(ctx, req.ShipmentID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get shipment: %w", err)
-	}
-
 	// Determine initial status
 	status := "open"
 	if req.Draft {
 		status = "draft"
 	}
 
+	// A stacked PR targets its parent's branch
+	if req.ParentPRID != "" {
+		parent, err := s.prRepo.GetByID(ctx, req.ParentPRID)
+		if err != nil {
+			return nil, err
+		}
+		result := pr.CanStackPR(pr.StackPRContext{
+			Status:             status,
+			RepoID:             req.RepoID,
+			CommissionID:       shipment.CommissionID,
+			ParentID:           parent.ID,
+			ParentStatus:       parent.Status,
+			ParentRepoID:       parent.RepoID,
+			ParentCommissionID: parent.CommissionID,
+		})
+		if err := result.Error(); err != nil {
+			return nil, err
+		}
+		req.TargetBranch = parent.Branch
+	}
+
+	response := &primary.CreatePRResponse{}
+	if req.Open {
+		opened, err := s.openOnProvider(ctx, &req, shipment, shipmentRepos, response)
+		if err != nil {
+			return nil, err
+		}
+		req.Number = opened.Number
+		req.URL = opened.URL
+		status = pr.StatusFromRemote(pr.RemotePR{State: opened.State, Draft: opened.Draft, ReviewDecision: opened.ReviewDecision})
+	}
+
 	var nextID string
 	err = s.transactor.WithImmediateTx(ctx, func(txCtx context.Context) error {
 		// Get next ID
@@ -98,6 +173,7 @@ func (s *PRServiceImpl) CreatePR(ctx context.Context, req primary.CreatePRReques
 			TargetBranch: req.TargetBranch,
 			URL:          req.URL,
 			Status:       status,
+			ParentPRID:   req.ParentPRID,
 		}
 
 		if err := s.prRepo.Create(txCtx, record); err != nil {
@@ -106,6 +182,9 @@ func (s *PRServiceImpl) CreatePR(ctx context.Context, req primary.CreatePRReques
 		return nil
 	})
 	if err != nil {
+		if req.Open {
+			return nil, fmt.Errorf("opened %s but %w; record it with: orc pr link %s %s --number %d", req.URL, err, req.ShipmentID, req.URL, req.Number)
+		}
 		return nil, err
 	}
 
@@ -115,10 +194,43 @@ func (s *PRServiceImpl) CreatePR(ctx context.Context, req primary.CreatePRReques
 		return nil, fmt.Errorf("failed to fetch created PR: %w", err)
 	}
 
-	return &primary.CreatePRResponse{
-		PRID: created.ID,
-		PR:   s.recordToPR(created),
-	}, nil
+	response.PRID = created.ID
+	response.PR = s.recordToPR(created)
+	return response, nil
+}
+
+// applyShipmentDefaults fills in the repo, branch and title of a PR request from
+// its shipment. A shipment spanning several repos needs an explicit repo.
+func applyShipmentDefaults(req *primary.CreatePRRequest, shipment *primary.Shipment, repos []*primary.ShipmentRepo) error {
+	if req.Title == "" {
+		req.Title = shipment.Title
+	}
+	if req.RepoID == "" {
+		switch {
+		case shipment.RepoID != "":
+			req.RepoID = shipment.RepoID
+		case len(repos) == 1:
+			req.RepoID = repos[0].RepoID
+		case len(repos) > 1:
+			return fmt.Errorf("shipment %s spans %d repositories; specify one with --repo", shipment.ID, len(repos))
+		default:
+			return fmt.Errorf("shipment %s has no repository; specify one with --repo", shipment.ID)
+		}
+	}
+	if req.Branch == "" {
+		for _, r := range repos {
+			if r.RepoID == req.RepoID {
+				req.Branch = r.Branch
+			}
+		}
+		if req.Branch == "" && req.RepoID == shipment.RepoID {
+			req.Branch = shipment.Branch
+		}
+		if req.Branch == "" {
+			return fmt.Errorf("shipment %s has no branch for %s; specify one with --branch", shipment.ID, req.RepoID)
+		}
+	}
+	return nil
 }
 
 // GetPR retrieves a pull request by ID.
@@ -219,36 +331,93 @@ func (s *PRServiceImpl) ApprovePR(ctx context.Context, prID string) error {
 	return s.prRepo.UpdateStatus(ctx, prID, "approved", false, false)
 }
 
-// MergePR merges a PR and cascades to complete the shipment.
-func (s *PRServiceImpl) MergePR(ctx context.Context, prID string) error {
+// MergePR merges a PR and, once none of the shipment's PRs is still pending,
+// cascades to complete the shipment. Required checks failing as of the last
+// sync block the merge unless forced. Repos with a merge strategy get the PR
+// landed locally first (see landPR); the ledger is only updated once that
+// succeeded, so a failed merge leaves it untouched.
+func (s *PRServiceImpl) MergePR(ctx context.Context, req primary.MergePRRequest) (*primary.MergePRResponse, error) {
 	// Get current PR
-	record, err := s.prRepo.GetByID(ctx, prID)
+	record, err := s.prRepo.GetByID(ctx, req.PRID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	checks, err := s.prRepo.ListChecks(ctx, req.PRID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Evaluate guard
 	result := pr.CanMergePR(pr.MergePRContext{
-		PRID:   prID,
-		Status: record.Status,
+		PRID:          req.PRID,
+		Status:        record.Status,
+		FailingChecks: pr.FailingRequiredChecks(toCoreChecks(checks)),
+		Force:         req.Force,
 	})
 	if err := result.Error(); err != nil {
+		return nil, err
+	}
+
+	response := &primary.MergePRResponse{TargetBranch: record.TargetBranch}
+	if !req.LedgerOnly {
+		if err := s.landPR(ctx, record, req.Strategy, response); err != nil {
+			return response, err
+		}
+	}
+
+	// Mark the PR merged and complete its shipment together, so a failed
+	// completion leaves the PR un-merged in the ledger and the merge can be retried
+	err = s.transactor.WithImmediateTx(ctx, func(txCtx context.Context) error {
+		if err := s.prRepo.UpdateStatus(txCtx, req.PRID, "merged", true, false); err != nil {
+			return fmt.Errorf("failed to update PR status: %w", err)
+		}
+		var err error
+		response.ShipmentCompleted, response.Commission, err = s.completeShipmentIfSettled(txCtx, record)
 		return err
+	})
+	if err != nil {
+		response.ShipmentCompleted, response.Commission = false, nil
+		if response.Strategy != "" {
+			return response, fmt.Errorf("landed %s on %s but failed to record it: %w; record it with: orc pr merge %s --ledger-only", req.PRID, response.TargetBranch, err, req.PRID)
+		}
+		return response, err
+	}
+	return response, nil
+}
+
+// completeShipmentIfSettled completes the shipment of a merged PR unless another of
+// its repos has no PR yet or one that is still pending: multi-repo shipments stay open
+// until every repo's PR is settled. Callers run it in the transaction that marks the PR merged.
+func (s *PRServiceImpl) completeShipmentIfSettled(ctx context.Context, record *secondary.PRRecord) (bool, *primary.CommissionLifecycleResult, error) {
+	siblings, err := s.prRepo.List(ctx, secondary.PRFilters{ShipmentID: record.ShipmentID})
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to list shipment PRs: %w", err)
+	}
+	hasPR := map[string]bool{record.RepoID: true}
+	for _, sibling := range siblings {
+		if sibling.ID != record.ID && (sibling.Status == "draft" || sibling.Status == "open" || sibling.Status == "approved") {
+			return false, nil, nil
+		}
+		hasPR[sibling.RepoID] = true
 	}
 
-	// Update PR status to merged
-	if err := s.prRepo.UpdateStatus(ctx, prID, "merged", true, false); err != nil {
-		return fmt.Errorf("failed to update PR status: %w", err)
+	repos, err := s.shipmentService.ListShipmentRepos(ctx, record.ShipmentID)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to list shipment repos: %w", err)
+	}
+	for _, repo := range repos {
+		if !hasPR[repo.RepoID] {
+			return false, nil, nil
+		}
 	}
 
 	// Cascade: complete the shipment (use force=true since PR merge implies tasks are done)
-	if err := s.shipmentService.CompleteShipment(ctx, record.ShipmentID, true); err != nil {
-		// Log but don't fail if shipment completion fails (e.g., already complete)
-		// The PR is still marked as merged
-		fmt.Printf("Warning: failed to complete shipment %s: %v\n", record.ShipmentID, err)
+	lifecycle, err := s.shipmentService.CompleteShipment(ctx, record.ShipmentID, true)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to complete shipment %s: %w", record.ShipmentID, err)
 	}
 
-	return nil
+	return true, lifecycle, nil
 }
 
 // ClosePR closes a PR without merging.
@@ -279,8 +448,8 @@ func (s *PRServiceImpl) LinkPR(ctx context.Context, shipmentID, url string, numb
 		return nil, fmt.Errorf("failed to get shipment: %w", err)
 	}
 
-	// Check if shipment already has a PR
-	hasPR, err := s.prRepo.ShipmentHasPR(ctx, shipmentID)
+	// Check if shipment already has a PR for its repo
+	hasPR, err := s.prRepo.ShipmentHasPR(ctx, shipmentID, shipment.RepoID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check shipment PR: %w", err)
 	}
@@ -288,12 +457,17 @@ func (s *PRServiceImpl) LinkPR(ctx context.Context, shipmentID, url string, numb
 		return nil, fmt.Errorf("shipment %s already has a PR", shipmentID)
 	}
 
+	branch := shipment.Branch
+	if branch == "" {
+		branch = fmt.Sprintf("shipment/%s", shipmentID)
+	}
+
 	// Create a linked PR (use shipment title as PR title)
 	resp, err := s.CreatePR(ctx, primary.CreatePRRequest{
 		ShipmentID: shipmentID,
-		RepoID:     "", // Will need to be provided or inferred
+		RepoID:     shipment.RepoID,
 		Title:      shipment.Title,
-		Branch:     fmt.Sprintf("shipment/%s", shipmentID),
+		Branch:     branch,
 		URL:        url,
 		Number:     number,
 	})
@@ -308,21 +482,25 @@ func (s *PRServiceImpl) LinkPR(ctx context.Context, shipmentID, url string, numb
 
 func (s *PRServiceImpl) recordToPR(r *secondary.PRRecord) *primary.PR {
 	return &primary.PR{
-		ID:           r.ID,
-		ShipmentID:   r.ShipmentID,
-		RepoID:       r.RepoID,
-		CommissionID: r.CommissionID,
-		Number:       r.Number,
-		Title:        r.Title,
-		Description:  r.Description,
-		Branch:       r.Branch,
-		TargetBranch: r.TargetBranch,
-		URL:          r.URL,
-		Status:       r.Status,
-		CreatedAt:    r.CreatedAt,
-		UpdatedAt:    r.UpdatedAt,
-		MergedAt:     r.MergedAt,
-		ClosedAt:     r.ClosedAt,
+		ID:             r.ID,
+		ShipmentID:     r.ShipmentID,
+		RepoID:         r.RepoID,
+		CommissionID:   r.CommissionID,
+		Number:         r.Number,
+		Title:          r.Title,
+		Description:    r.Description,
+		Branch:         r.Branch,
+		TargetBranch:   r.TargetBranch,
+		URL:            r.URL,
+		Status:         r.Status,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+		MergedAt:       r.MergedAt,
+		ClosedAt:       r.ClosedAt,
+		ReviewDecision: r.ReviewDecision,
+		Mergeable:      r.Mergeable,
+		SyncedAt:       r.SyncedAt,
+		ParentPRID:     r.ParentPRID,
 	}
 }
 
@@ -2,7 +2,11 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/example/orc/internal/ports/primary"
@@ -18,6 +22,8 @@ type mockPRRepository struct {
 	shipmentStatus map[string]string
 	repoExists     map[string]bool
 	shipmentHasPR  map[string]bool
+	threads        map[string][]*secondary.PRReviewThreadRecord // prID -> imported review threads
+	checks         map[string][]*secondary.PRCheckRecord        // prID -> synced CI checks
 }
 
 func newMockPRRepository() *mockPRRepository {
@@ -29,6 +35,8 @@ func newMockPRRepository() *mockPRRepository {
 		shipmentStatus: make(map[string]string),
 		repoExists:     make(map[string]bool),
 		shipmentHasPR:  make(map[string]bool),
+		threads:        make(map[string][]*secondary.PRReviewThreadRecord),
+		checks:         make(map[string][]*secondary.PRCheckRecord),
 	}
 }
 
@@ -38,7 +46,7 @@ func (m *mockPRRepository) Create(ctx context.Context, pr *secondary.PRRecord) e
 	}
 	m.prs[pr.ID] = pr
 	m.prsByShipment[pr.ShipmentID] = pr
-	m.shipmentHasPR[pr.ShipmentID] = true
+	m.shipmentHasPR[pr.ShipmentID+"/"+pr.RepoID] = true
 	return nil
 }
 
@@ -59,7 +67,9 @@ func (m *mockPRRepository) GetByShipment(ctx context.Context, shipmentID string)
 func (m *mockPRRepository) List(ctx context.Context, filters secondary.PRFilters) ([]*secondary.PRRecord, error) {
 	var result []*secondary.PRRecord
 	for _, r := range m.prs {
-		if filters.Status == "" || r.Status == filters.Status {
+		if (filters.Status == "" || r.Status == filters.Status) &&
+			(filters.ShipmentID == "" || r.ShipmentID == filters.ShipmentID) &&
+			(filters.CommissionID == "" || r.CommissionID == filters.CommissionID) {
 			result = append(result, r)
 		}
 	}
@@ -83,7 +93,7 @@ func (m *mockPRRepository) Update(ctx context.Context, pr *secondary.PRRecord) e
 func (m *mockPRRepository) Delete(ctx context.Context, id string) error {
 	if r, ok := m.prs[id]; ok {
 		delete(m.prsByShipment, r.ShipmentID)
-		delete(m.shipmentHasPR, r.ShipmentID)
+		delete(m.shipmentHasPR, r.ShipmentID+"/"+r.RepoID)
 		delete(m.prs, id)
 		return nil
 	}
@@ -110,6 +120,60 @@ func (m *mockPRRepository) UpdateStatus(ctx context.Context, id, status string,
 	return fmt.Errorf("PR %s not found", id)
 }
 
+func (m *mockPRRepository) CreateReviewThread(ctx context.Context, thread *secondary.PRReviewThreadRecord) error {
+	m.threads[thread.PRID] = append(m.threads[thread.PRID], thread)
+	return nil
+}
+
+func (m *mockPRRepository) ListReviewThreads(ctx context.Context, prID string) ([]*secondary.PRReviewThreadRecord, error) {
+	return m.threads[prID], nil
+}
+
+func (m *mockPRRepository) UpdateReviewThreadResolved(ctx context.Context, prID, threadID string, resolved bool) error {
+	for _, t := range m.threads[prID] {
+		if t.ThreadID == threadID {
+			t.Resolved = resolved
+			return nil
+		}
+	}
+	return fmt.Errorf("review thread %s of %s not found", threadID, prID)
+}
+
+func (m *mockPRRepository) UpdateStack(ctx context.Context, id, parentPRID, targetBranch string) error {
+	r, ok := m.prs[id]
+	if !ok {
+		return fmt.Errorf("PR %s not found", id)
+	}
+	r.ParentPRID = parentPRID
+	r.TargetBranch = targetBranch
+	return nil
+}
+
+func (m *mockPRRepository) ListChecks(ctx context.Context, prID string) ([]*secondary.PRCheckRecord, error) {
+	return m.checks[prID], nil
+}
+
+func (m *mockPRRepository) ReplaceChecks(ctx context.Context, prID string, checks []*secondary.PRCheckRecord) error {
+	m.checks[prID] = checks
+	return nil
+}
+
+func (m *mockPRRepository) UpdateSync(ctx context.Context, pr *secondary.PRRecord) error {
+	r, ok := m.prs[pr.ID]
+	if !ok {
+		return fmt.Errorf("PR %s not found", pr.ID)
+	}
+	r.Number = pr.Number
+	r.URL = pr.URL
+	r.Status = pr.Status
+	r.ReviewDecision = pr.ReviewDecision
+	r.Mergeable = pr.Mergeable
+	r.MergedAt = pr.MergedAt
+	r.ClosedAt = pr.ClosedAt
+	r.SyncedAt = "2026-01-22T00:00:00Z"
+	return nil
+}
+
 func (m *mockPRRepository) ShipmentExists(ctx context.Context, shipmentID string) (bool, error) {
 	return m.shipmentExists[shipmentID], nil
 }
@@ -118,8 +182,8 @@ func (m *mockPRRepository) RepoExists(ctx context.Context, repoID string) (bool,
 	return m.repoExists[repoID], nil
 }
 
-func (m *mockPRRepository) ShipmentHasPR(ctx context.Context, shipmentID string) (bool, error) {
-	return m.shipmentHasPR[shipmentID], nil
+func (m *mockPRRepository) ShipmentHasPR(ctx context.Context, shipmentID, repoID string) (bool, error) {
+	return m.shipmentHasPR[shipmentID+"/"+repoID], nil
 }
 
 func (m *mockPRRepository) GetShipmentStatus(ctx context.Context, shipmentID string) (string, error) {
@@ -132,12 +196,15 @@ func (m *mockPRRepository) GetShipmentStatus(ctx context.Context, shipmentID str
 // mockShipmentServiceForPR implements primary.ShipmentService for testing PR service.
 type mockShipmentServiceForPR struct {
 	shipments map[string]*primary.Shipment
+	repos     map[string][]*primary.ShipmentRepo
 	completed map[string]bool
+	failClose error // CompleteShipment error
 }
 
 func newMockShipmentServiceForPR() *mockShipmentServiceForPR {
 	return &mockShipmentServiceForPR{
 		shipments: make(map[string]*primary.Shipment),
+		repos:     make(map[string][]*primary.ShipmentRepo),
 		completed: make(map[string]bool),
 	}
 }
@@ -181,13 +248,16 @@ func (m *mockShipmentServiceForPR) ResumeShipment(ctx context.Context, shipmentI
 	return nil
 }
 
-func (m *mockShipmentServiceForPR) CompleteShipment(ctx context.Context, shipmentID string, force bool) error {
+func (m *mockShipmentServiceForPR) CompleteShipment(ctx context.Context, shipmentID string, force bool) (*primary.CommissionLifecycleResult, error) {
+	if m.failClose != nil {
+		return nil, m.failClose
+	}
 	m.completed[shipmentID] = true
-	return nil
+	return nil, nil
 }
 
-func (m *mockShipmentServiceForPR) AssignShipmentToWorkbench(ctx context.Context, shipmentID, workbenchID string) error {
-	return nil
+func (m *mockShipmentServiceForPR) AssignShipmentToWorkbench(ctx context.Context, shipmentID, workbenchID string) (*primary.AssignShipmentResponse, error) {
+	return &primary.AssignShipmentResponse{}, nil
 }
 
 func (m *mockShipmentServiceForPR) GetShipmentsByWorkbench(ctx context.Context, workbenchID string) ([]*primary.Shipment, error) {
@@ -214,14 +284,32 @@ func (m *mockShipmentServiceForPR) VerifyShipment(ctx context.Context, shipmentI
 	return nil
 }
 
-func (m *mockShipmentServiceForPR) SetStatus(ctx context.Context, shipmentID, status string, force bool) error {
-	return nil
+func (m *mockShipmentServiceForPR) SetStatus(ctx context.Context, shipmentID, status string, force bool) (*primary.CommissionLifecycleResult, error) {
+	return nil, nil
 }
 
 func (m *mockShipmentServiceForPR) MoveShipmentToCommission(ctx context.Context, shipmentID, targetCommissionID string) (*primary.MoveShipmentResult, error) {
 	return &primary.MoveShipmentResult{}, nil
 }
 
+func (m *mockShipmentServiceForPR) AddShipmentRepo(ctx context.Context, req primary.AddShipmentRepoRequest) (*primary.ShipmentRepo, error) {
+	repo := &primary.ShipmentRepo{ShipmentID: req.ShipmentID, RepoID: req.RepoID, Branch: req.Branch}
+	m.repos[req.ShipmentID] = append(m.repos[req.ShipmentID], repo)
+	return repo, nil
+}
+
+func (m *mockShipmentServiceForPR) RemoveShipmentRepo(ctx context.Context, shipmentID, repoID string) error {
+	return nil
+}
+
+func (m *mockShipmentServiceForPR) ListShipmentRepos(ctx context.Context, shipmentID string) ([]*primary.ShipmentRepo, error) {
+	return m.repos[shipmentID], nil
+}
+
+func (m *mockShipmentServiceForPR) ImportPlan(ctx context.Context, req primary.ImportPlanRequest) (*primary.ImportPlanResponse, error) {
+	return &primary.ImportPlanResponse{}, nil
+}
+
 func TestPRService_CreatePR(t *testing.T) {
 	ctx := context.Background()
 
@@ -238,7 +326,7 @@ func TestPRService_CreatePR(t *testing.T) {
 			Status:       "in-progress",
 		}
 
-		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{})
+		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
 		resp, err := svc.CreatePR(ctx, primary.CreatePRRequest{
 			ShipmentID: "SHIP-001",
@@ -271,7 +359,7 @@ func TestPRService_CreatePR(t *testing.T) {
 			Status:       "in-progress",
 		}
 
-		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{})
+		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
 		resp, err := svc.CreatePR(ctx, primary.CreatePRRequest{
 			ShipmentID: "SHIP-001",
@@ -293,7 +381,7 @@ func TestPRService_CreatePR(t *testing.T) {
 		prRepo := newMockPRRepository()
 		prRepo.shipmentExists["SHIP-001"] = false
 
-		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{})
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
 		_, err := svc.CreatePR(ctx, primary.CreatePRRequest{
 			ShipmentID: "SHIP-001",
@@ -313,7 +401,7 @@ func TestPRService_CreatePR(t *testing.T) {
 		prRepo.shipmentStatus["SHIP-001"] = "paused"
 		prRepo.repoExists["REPO-001"] = true
 
-		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{})
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
 		_, err := svc.CreatePR(ctx, primary.CreatePRRequest{
 			ShipmentID: "SHIP-001",
@@ -327,14 +415,36 @@ func TestPRService_CreatePR(t *testing.T) {
 		}
 	})
 
+	t.Run("fails for repo outside the shipment", func(t *testing.T) {
+		prRepo := newMockPRRepository()
+		prRepo.shipmentExists["SHIP-001"] = true
+		prRepo.shipmentStatus["SHIP-001"] = "in-progress"
+		prRepo.repoExists["REPO-003"] = true
+
+		shipmentSvc := newMockShipmentServiceForPR()
+		shipmentSvc.repos["SHIP-001"] = []*primary.ShipmentRepo{{ShipmentID: "SHIP-001", RepoID: "REPO-001", Primary: true}}
+		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.CreatePR(ctx, primary.CreatePRRequest{
+			ShipmentID: "SHIP-001",
+			RepoID:     "REPO-003",
+			Title:      "Test PR",
+			Branch:     "feature/test",
+		})
+
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
 	t.Run("fails when shipment already has PR", func(t *testing.T) {
 		prRepo := newMockPRRepository()
 		prRepo.shipmentExists["SHIP-001"] = true
 		prRepo.shipmentStatus["SHIP-001"] = "in-progress"
 		prRepo.repoExists["REPO-001"] = true
-		prRepo.shipmentHasPR["SHIP-001"] = true
+		prRepo.shipmentHasPR["SHIP-001/REPO-001"] = true
 
-		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{})
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
 		_, err := svc.CreatePR(ctx, primary.CreatePRRequest{
 			ShipmentID: "SHIP-001",
@@ -361,9 +471,9 @@ func TestPRService_MergePR(t *testing.T) {
 		}
 
 		shipmentSvc := newMockShipmentServiceForPR()
-		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{})
+		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
-		err := svc.MergePR(ctx, "PR-001")
+		_, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
 		if err != nil {
 			t.Fatalf("MergePR failed: %v", err)
 		}
@@ -380,6 +490,66 @@ func TestPRService_MergePR(t *testing.T) {
 		}
 	})
 
+	t.Run("returns the shipment completion error", func(t *testing.T) {
+		prRepo := newMockPRRepository()
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", Status: "open"}
+
+		shipmentSvc := newMockShipmentServiceForPR()
+		shipmentSvc.failClose = errors.New("cannot close pinned shipment SHIP-001")
+		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, nil, nil, nil, nil, nil, nil)
+
+		resp, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
+		if err == nil || !strings.Contains(err.Error(), "failed to complete shipment SHIP-001: cannot close pinned shipment") {
+			t.Fatalf("MergePR error = %v", err)
+		}
+		if resp.ShipmentCompleted {
+			t.Error("ShipmentCompleted should be false")
+		}
+	})
+
+	t.Run("keeps shipment open while another repo's PR is pending", func(t *testing.T) {
+		prRepo := newMockPRRepository()
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", Status: "open"}
+		prRepo.prs["PR-002"] = &secondary.PRRecord{ID: "PR-002", ShipmentID: "SHIP-001", RepoID: "REPO-002", Status: "approved"}
+
+		shipmentSvc := newMockShipmentServiceForPR()
+		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, nil, nil, nil, nil, nil, nil)
+
+		if _, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"}); err != nil {
+			t.Fatalf("MergePR failed: %v", err)
+		}
+		if shipmentSvc.completed["SHIP-001"] {
+			t.Error("Shipment should stay open until PR-002 is merged")
+		}
+
+		if _, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-002"}); err != nil {
+			t.Fatalf("MergePR failed: %v", err)
+		}
+		if !shipmentSvc.completed["SHIP-001"] {
+			t.Error("Shipment should be completed once every PR is merged")
+		}
+	})
+
+	t.Run("keeps shipment open while another repo has no PR", func(t *testing.T) {
+		prRepo := newMockPRRepository()
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", Status: "open"}
+
+		shipmentSvc := newMockShipmentServiceForPR()
+		shipmentSvc.repos["SHIP-001"] = []*primary.ShipmentRepo{
+			{ShipmentID: "SHIP-001", RepoID: "REPO-001", Primary: true},
+			{ShipmentID: "SHIP-001", RepoID: "REPO-002"},
+		}
+		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, nil, nil, nil, nil, nil, nil)
+
+		resp, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
+		if err != nil {
+			t.Fatalf("MergePR failed: %v", err)
+		}
+		if resp.ShipmentCompleted || shipmentSvc.completed["SHIP-001"] {
+			t.Error("Shipment should stay open until REPO-002 has a merged PR")
+		}
+	})
+
 	t.Run("merges approved PR", func(t *testing.T) {
 		prRepo := newMockPRRepository()
 		prRepo.prs["PR-001"] = &secondary.PRRecord{
@@ -388,9 +558,9 @@ func TestPRService_MergePR(t *testing.T) {
 			Status:     "approved",
 		}
 
-		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{})
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
-		err := svc.MergePR(ctx, "PR-001")
+		_, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
 		if err != nil {
 			t.Fatalf("MergePR failed: %v", err)
 		}
@@ -408,9 +578,9 @@ func TestPRService_MergePR(t *testing.T) {
 			Status: "draft",
 		}
 
-		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{})
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
-		err := svc.MergePR(ctx, "PR-001")
+		_, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
 		if err == nil {
 			t.Error("expected error, got nil")
 		}
@@ -427,7 +597,7 @@ func TestPRService_ClosePR(t *testing.T) {
 			Status: "open",
 		}
 
-		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{})
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
 		err := svc.ClosePR(ctx, "PR-001")
 		if err != nil {
@@ -447,7 +617,7 @@ func TestPRService_ClosePR(t *testing.T) {
 			Status: "merged",
 		}
 
-		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{})
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
 		err := svc.ClosePR(ctx, "PR-001")
 		if err == nil {
@@ -466,7 +636,7 @@ func TestPRService_OpenPR(t *testing.T) {
 			Status: "draft",
 		}
 
-		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{})
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
 		err := svc.OpenPR(ctx, "PR-001")
 		if err != nil {
@@ -486,7 +656,7 @@ func TestPRService_OpenPR(t *testing.T) {
 			Status: "open",
 		}
 
-		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{})
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
 
 		err := svc.OpenPR(ctx, "PR-001")
 		if err == nil {
@@ -494,3 +664,870 @@ func TestPRService_OpenPR(t *testing.T) {
 		}
 	})
 }
+
+// mockPRProvider implements secondary.PRProviders and secondary.PRProvider for testing.
+type mockPRProvider struct {
+	remotes   []string                          // Remote URLs resolved via ForRemote
+	byNumber  map[int]*secondary.ProviderPR     // GetPullRequest results
+	byBranch  map[string]*secondary.ProviderPR  // FindPullRequest results
+	created   []secondary.ProviderPRCreate      // CreatePullRequest requests
+	threads   []*secondary.ProviderReviewThread // ListReviewThreads results
+	threadErr error                             // ListReviewThreads error
+	resolved  map[string]bool                   // SetReviewThreadResolved calls by thread ID
+	noResolve bool                              // SetReviewThreadResolved is unsupported
+	checks    []*secondary.ProviderCheck        // ListChecks results
+	checksErr error                             // ListChecks error
+	bases     map[int]string                    // UpdatePullRequestBase calls by number
+}
+
+func newMockPRProvider() *mockPRProvider {
+	return &mockPRProvider{
+		byNumber: make(map[int]*secondary.ProviderPR),
+		byBranch: make(map[string]*secondary.ProviderPR),
+		resolved: make(map[string]bool),
+		bases:    make(map[int]string),
+	}
+}
+
+func (m *mockPRProvider) ForRemote(remoteURL string) (secondary.PRProvider, error) {
+	m.remotes = append(m.remotes, remoteURL)
+	return m, nil
+}
+
+func (m *mockPRProvider) Name() string { return "github" }
+
+func (m *mockPRProvider) GetPullRequest(ctx context.Context, number int) (*secondary.ProviderPR, error) {
+	if p, ok := m.byNumber[number]; ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("GET /pulls/%d: 404 Not Found", number)
+}
+
+func (m *mockPRProvider) FindPullRequest(ctx context.Context, branch string) (*secondary.ProviderPR, error) {
+	return m.byBranch[branch], nil
+}
+
+func (m *mockPRProvider) CreatePullRequest(ctx context.Context, req secondary.ProviderPRCreate) (*secondary.ProviderPR, error) {
+	m.created = append(m.created, req)
+	number := 40 + len(m.created)
+	return &secondary.ProviderPR{
+		Number:     number,
+		URL:        fmt.Sprintf("https://github.com/acme/app/pull/%d", number),
+		Title:      req.Title,
+		State:      secondary.ProviderPRStateOpen,
+		Draft:      req.Draft,
+		HeadBranch: req.HeadBranch,
+		BaseBranch: req.BaseBranch,
+	}, nil
+}
+
+func (m *mockPRProvider) ListReviewThreads(ctx context.Context, number int) ([]*secondary.ProviderReviewThread, error) {
+	if m.threadErr != nil {
+		return nil, m.threadErr
+	}
+	return m.threads, nil
+}
+
+func (m *mockPRProvider) SetReviewThreadResolved(ctx context.Context, number int, threadID string, resolved bool) error {
+	if m.noResolve {
+		return fmt.Errorf("resolving threads: %w", errors.ErrUnsupported)
+	}
+	m.resolved[threadID] = resolved
+	for _, t := range m.threads {
+		if t.ID == threadID {
+			t.Resolved = resolved
+		}
+	}
+	return nil
+}
+
+func (m *mockPRProvider) UpdatePullRequestBase(ctx context.Context, number int, base string) error {
+	m.bases[number] = base
+	return nil
+}
+
+func (m *mockPRProvider) ListChecks(ctx context.Context, number int, headSHA string) ([]*secondary.ProviderCheck, error) {
+	if m.checksErr != nil {
+		return nil, m.checksErr
+	}
+	return m.checks, nil
+}
+
+func TestPRService_CreatePR_Open(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*PRServiceImpl, *mockShipmentServiceForPR, *mockPRProvider) {
+		prRepo := newMockPRRepository()
+		prRepo.shipmentExists["SHIP-001"] = true
+		prRepo.shipmentStatus["SHIP-001"] = "in-progress"
+		prRepo.repoExists["REPO-001"] = true
+		prRepo.repoExists["REPO-002"] = true
+		repoRepo := newMockRepoRepository()
+		repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "app", URL: "git@github.com:acme/app.git", DefaultBranch: "main"})
+		shipmentSvc := newMockShipmentServiceForPR()
+		shipmentSvc.shipments["SHIP-001"] = &primary.Shipment{
+			ID:           "SHIP-001",
+			CommissionID: "COMM-001",
+			Title:        "Add login",
+			Status:       "in-progress",
+			RepoID:       "REPO-001",
+			Branch:       "ml/SHIP-001-add-login",
+		}
+		provider := newMockPRProvider()
+		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, repoRepo, provider, nil, nil, nil, nil)
+		return svc, shipmentSvc, provider
+	}
+
+	t.Run("opens PR from shipment defaults and records number and URL", func(t *testing.T) {
+		svc, _, provider := setup()
+
+		resp, err := svc.CreatePR(ctx, primary.CreatePRRequest{
+			ShipmentID:  "SHIP-001",
+			Description: "body",
+			Draft:       true,
+			Labels:      []string{"orc"},
+			Open:        true,
+		})
+		if err != nil {
+			t.Fatalf("CreatePR failed: %v", err)
+		}
+		if len(provider.created) != 1 {
+			t.Fatalf("created %d PRs, want 1", len(provider.created))
+		}
+		got := provider.created[0]
+		if got.Title != "Add login" || got.HeadBranch != "ml/SHIP-001-add-login" || got.BaseBranch != "main" || got.Body != "body" || !got.Draft || len(got.Labels) != 1 {
+			t.Errorf("provider request = %+v", got)
+		}
+		if resp.PR.Number != 41 || resp.PR.URL != "https://github.com/acme/app/pull/41" || resp.PR.Status != "draft" || resp.PR.TargetBranch != "main" {
+			t.Errorf("PR = %+v", resp.PR)
+		}
+		if resp.Adopted || resp.Pushed {
+			t.Errorf("response = %+v, want neither adopted nor pushed", resp)
+		}
+	})
+
+	t.Run("adopts an open PR for the branch", func(t *testing.T) {
+		svc, _, provider := setup()
+		provider.byBranch["ml/SHIP-001-add-login"] = &secondary.ProviderPR{Number: 9, URL: "https://github.com/acme/app/pull/9", State: secondary.ProviderPRStateOpen, BaseBranch: "develop"}
+
+		resp, err := svc.CreatePR(ctx, primary.CreatePRRequest{ShipmentID: "SHIP-001", Open: true})
+		if err != nil {
+			t.Fatalf("CreatePR failed: %v", err)
+		}
+		if len(provider.created) != 0 {
+			t.Errorf("created %d PRs, want 0", len(provider.created))
+		}
+		if !resp.Adopted || resp.PR.Number != 9 || resp.PR.TargetBranch != "develop" {
+			t.Errorf("response = %+v, PR = %+v", resp, resp.PR)
+		}
+	})
+
+	t.Run("push without a workbench fails before opening", func(t *testing.T) {
+		svc, _, provider := setup()
+
+		_, err := svc.CreatePR(ctx, primary.CreatePRRequest{ShipmentID: "SHIP-001", Open: true, Push: true})
+		if err == nil || !strings.Contains(err.Error(), "--no-push") {
+			t.Fatalf("error = %v, want hint about --no-push", err)
+		}
+		if len(provider.created) != 0 {
+			t.Errorf("created %d PRs, want 0", len(provider.created))
+		}
+	})
+
+	t.Run("multi-repo shipment needs a repo", func(t *testing.T) {
+		svc, shipmentSvc, _ := setup()
+		shipmentSvc.shipments["SHIP-001"].RepoID = ""
+		shipmentSvc.repos["SHIP-001"] = []*primary.ShipmentRepo{{RepoID: "REPO-001"}, {RepoID: "REPO-002"}}
+
+		_, err := svc.CreatePR(ctx, primary.CreatePRRequest{ShipmentID: "SHIP-001", Open: true})
+		if err == nil || !strings.Contains(err.Error(), "--repo") {
+			t.Fatalf("error = %v, want hint about --repo", err)
+		}
+	})
+}
+
+func TestPRService_SyncPRs(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*PRServiceImpl, *mockPRRepository, *mockShipmentServiceForPR, *mockPRProvider, *mockEventWriter) {
+		prRepo := newMockPRRepository()
+		repoRepo := newMockRepoRepository()
+		repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "app", URL: "git@github.com:acme/app.git"})
+		provider := newMockPRProvider()
+		events := &mockEventWriter{}
+		shipmentSvc := newMockShipmentServiceForPR()
+		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, repoRepo, provider, events, nil, nil, nil)
+		return svc, prRepo, shipmentSvc, provider, events
+	}
+
+	t.Run("records a merge done in the web UI and completes the shipment", func(t *testing.T) {
+		svc, prRepo, shipmentSvc, provider, events := setup()
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", Number: 7, URL: "u", Branch: "feature/x", Status: "open"}
+		provider.byNumber[7] = &secondary.ProviderPR{Number: 7, URL: "u", State: secondary.ProviderPRStateMerged, ReviewDecision: "approved", MergedAt: "2026-03-01T10:00:00Z"}
+
+		results, err := svc.SyncPRs(ctx, primary.SyncPRsRequest{PRID: "PR-001"})
+		if err != nil {
+			t.Fatalf("SyncPRs failed: %v", err)
+		}
+		if len(results) != 1 || results[0].Error != "" || len(results[0].Changes) != 3 {
+			t.Fatalf("results = %+v", results[0])
+		}
+
+		got := prRepo.prs["PR-001"]
+		if got.Status != "merged" || got.MergedAt != "2026-03-01T10:00:00Z" || got.ReviewDecision != "approved" || got.SyncedAt == "" {
+			t.Errorf("PR = %+v", got)
+		}
+		if !shipmentSvc.completed["SHIP-001"] {
+			t.Error("Shipment should have been completed")
+		}
+		if len(events.updates) != 3 || events.updates[0] != (mockAuditUpdate{"pr", "PR-001", "status", "open", "merged"}) {
+			t.Errorf("audit updates = %+v", events.updates)
+		}
+		if provider.remotes[0] != "git@github.com:acme/app.git" {
+			t.Errorf("resolved remote = %q", provider.remotes[0])
+		}
+	})
+
+	t.Run("completes the shipment even when thread sync fails", func(t *testing.T) {
+		_, prRepo, shipmentSvc, provider, _ := setup()
+		repoRepo := newMockRepoRepository()
+		repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "app", URL: "git@github.com:acme/app.git"})
+		svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, repoRepo, provider, nil, nil,
+			NewNoteService(newMockNoteRepository(), &mockTransactor{}), nil)
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", Number: 7, URL: "u", Branch: "feature/x", Status: "open"}
+		prRepo.threads["PR-001"] = []*secondary.PRReviewThreadRecord{{PRID: "PR-001", ThreadID: "T1", NoteID: "NOTE-001"}}
+		provider.byNumber[7] = &secondary.ProviderPR{Number: 7, URL: "u", State: secondary.ProviderPRStateMerged, MergedAt: "2026-03-01T10:00:00Z"}
+		provider.threadErr = errors.New("GET /graphql: 502 Bad Gateway")
+
+		results, err := svc.SyncPRs(ctx, primary.SyncPRsRequest{PRID: "PR-001"})
+		if err != nil {
+			t.Fatalf("SyncPRs failed: %v", err)
+		}
+		if results[0].Error == "" {
+			t.Error("expected the thread sync error to be reported")
+		}
+		if prRepo.prs["PR-001"].Status != "merged" {
+			t.Errorf("PR status = %q, want merged", prRepo.prs["PR-001"].Status)
+		}
+		if !shipmentSvc.completed["SHIP-001"] {
+			t.Error("Shipment should have been completed")
+		}
+	})
+
+	t.Run("finds a PR by branch when the number is unknown", func(t *testing.T) {
+		svc, prRepo, _, provider, _ := setup()
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", Branch: "feature/x", Status: "draft"}
+		provider.byBranch["feature/x"] = &secondary.ProviderPR{Number: 12, URL: "https://github.com/acme/app/pull/12", State: secondary.ProviderPRStateOpen, Mergeable: "mergeable"}
+
+		results, err := svc.SyncPRs(ctx, primary.SyncPRsRequest{PRID: "PR-001"})
+		if err != nil {
+			t.Fatalf("SyncPRs failed: %v", err)
+		}
+		if results[0].Number != 12 {
+			t.Errorf("Number = %d, want 12", results[0].Number)
+		}
+		if got := prRepo.prs["PR-001"]; got.Number != 12 || got.Status != "open" {
+			t.Errorf("PR = %+v", got)
+		}
+	})
+
+	t.Run("all skips settled PRs and reports per-PR errors", func(t *testing.T) {
+		svc, prRepo, _, provider, events := setup()
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", Number: 7, URL: "u", Status: "approved", ReviewDecision: "approved"}
+		prRepo.prs["PR-002"] = &secondary.PRRecord{ID: "PR-002", ShipmentID: "SHIP-002", RepoID: "REPO-001", Number: 8, Status: "open"}
+		prRepo.prs["PR-003"] = &secondary.PRRecord{ID: "PR-003", ShipmentID: "SHIP-003", RepoID: "REPO-001", Number: 9, Status: "merged"}
+		provider.byNumber[7] = &secondary.ProviderPR{Number: 7, URL: "u", State: secondary.ProviderPRStateOpen, ReviewDecision: "approved"}
+
+		results, err := svc.SyncPRs(ctx, primary.SyncPRsRequest{All: true})
+		if err != nil {
+			t.Fatalf("SyncPRs failed: %v", err)
+		}
+		if len(results) != 2 {
+			t.Fatalf("expected 2 results (merged PR skipped), got %d", len(results))
+		}
+		for _, r := range results {
+			switch r.PRID {
+			case "PR-001":
+				if r.Error != "" || len(r.Changes) != 0 {
+					t.Errorf("PR-001 should be unchanged, got %+v", r)
+				}
+			case "PR-002":
+				if r.Error == "" {
+					t.Error("PR-002 should report the provider error")
+				}
+			default:
+				t.Errorf("unexpected result for %s", r.PRID)
+			}
+		}
+		if len(events.updates) != 0 {
+			t.Errorf("no audit events expected, got %+v", events.updates)
+		}
+	})
+
+	t.Run("requires a provider", func(t *testing.T) {
+		svc := NewPRService(newMockPRRepository(), newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
+		if _, err := svc.SyncPRs(ctx, primary.SyncPRsRequest{All: true}); err == nil {
+			t.Error("expected error without a provider")
+		}
+	})
+}
+
+func TestPRService_Checks(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*PRServiceImpl, *mockPRRepository, *mockPRProvider, *mockEventWriter) {
+		prRepo := newMockPRRepository()
+		repoRepo := newMockRepoRepository()
+		repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "app", URL: "git@github.com:acme/app.git"})
+		provider := newMockPRProvider()
+		events := &mockEventWriter{}
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, repoRepo, provider, events, nil, nil, nil)
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", Number: 7, URL: "u", Status: "open"}
+		provider.byNumber[7] = &secondary.ProviderPR{Number: 7, URL: "u", State: secondary.ProviderPRStateOpen, HeadSHA: "abc"}
+		return svc, prRepo, provider, events
+	}
+
+	t.Run("sync stores checks and reports the rollup change", func(t *testing.T) {
+		svc, prRepo, provider, events := setup()
+		provider.checks = []*secondary.ProviderCheck{
+			{Name: "test", Status: "completed", Conclusion: "failure", Required: true},
+			{Name: "lint", Status: "completed", Conclusion: "success"},
+			{Name: "test", Status: "in_progress", Required: true}, // Reported twice; the last wins
+		}
+
+		results, err := svc.SyncPRs(ctx, primary.SyncPRsRequest{PRID: "PR-001"})
+		if err != nil {
+			t.Fatalf("SyncPRs failed: %v", err)
+		}
+		if len(results[0].Changes) != 1 || results[0].Changes[0] != (primary.PRFieldChange{Field: "checks", Old: "", New: "pending"}) {
+			t.Errorf("changes = %+v", results[0].Changes)
+		}
+		if len(prRepo.checks["PR-001"]) != 2 {
+			t.Errorf("stored checks = %+v", prRepo.checks["PR-001"])
+		}
+		if len(events.updates) != 1 || events.updates[0].Field != "checks" {
+			t.Errorf("audit updates = %+v", events.updates)
+		}
+
+		checks, err := svc.ListPRChecks(ctx, "PR-001")
+		if err != nil {
+			t.Fatalf("ListPRChecks failed: %v", err)
+		}
+		if len(checks) != 2 || checks[0].Name != "test" || checks[0].State != primary.PRCheckPending || checks[1].State != primary.PRCheckPassing {
+			t.Errorf("checks = %+v, %+v", checks[0], checks[1])
+		}
+	})
+
+	t.Run("field changes are audited even when the checks fetch fails", func(t *testing.T) {
+		svc, prRepo, provider, events := setup()
+		provider.byNumber[7].ReviewDecision = "approved"
+		provider.checksErr = errors.New("GET /check-runs: 502 Bad Gateway")
+
+		results, err := svc.SyncPRs(ctx, primary.SyncPRsRequest{PRID: "PR-001"})
+		if err != nil {
+			t.Fatalf("SyncPRs failed: %v", err)
+		}
+		if results[0].Error == "" {
+			t.Error("expected the checks error to be reported")
+		}
+		if prRepo.prs["PR-001"].ReviewDecision != "approved" {
+			t.Errorf("PR = %+v", prRepo.prs["PR-001"])
+		}
+		if len(results[0].Changes) == 0 || len(events.updates) != len(results[0].Changes) {
+			t.Errorf("changes = %+v, audit updates = %+v", results[0].Changes, events.updates)
+		}
+		for _, u := range events.updates {
+			if u.Field == "checks" {
+				t.Errorf("unexpected checks audit event: %+v", u)
+			}
+		}
+	})
+
+	t.Run("merge refuses failing required checks unless forced", func(t *testing.T) {
+		svc, prRepo, _, _ := setup()
+		prRepo.checks["PR-001"] = []*secondary.PRCheckRecord{
+			{Name: "test", Status: "completed", Conclusion: "failure", Required: true},
+			{Name: "flaky", Status: "completed", Conclusion: "failure"},
+		}
+
+		_, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
+		if err == nil || !strings.Contains(err.Error(), "required checks failing on PR-001: test.") {
+			t.Fatalf("MergePR error = %v", err)
+		}
+		if prRepo.prs["PR-001"].Status != "open" {
+			t.Error("PR should not have been merged")
+		}
+
+		if _, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001", Force: true}); err != nil {
+			t.Fatalf("forced MergePR failed: %v", err)
+		}
+		if prRepo.prs["PR-001"].Status != "merged" {
+			t.Error("forced merge should have merged the PR")
+		}
+	})
+
+	t.Run("shipment state rolls up pending PRs only", func(t *testing.T) {
+		svc, prRepo, _, _ := setup()
+		prRepo.prs["PR-002"] = &secondary.PRRecord{ID: "PR-002", ShipmentID: "SHIP-001", RepoID: "REPO-001", Status: "merged"}
+		prRepo.checks["PR-001"] = []*secondary.PRCheckRecord{{Name: "test", Status: "completed", Conclusion: "success"}}
+		prRepo.checks["PR-002"] = []*secondary.PRCheckRecord{{Name: "test", Status: "completed", Conclusion: "failure"}}
+
+		state, err := svc.GetShipmentChecksState(ctx, "SHIP-001")
+		if err != nil {
+			t.Fatalf("GetShipmentChecksState failed: %v", err)
+		}
+		if state != primary.PRCheckPassing {
+			t.Errorf("state = %q, want passing", state)
+		}
+		if state, _ := svc.GetShipmentChecksState(ctx, "SHIP-404"); state != "" {
+			t.Errorf("state without PRs = %q, want empty", state)
+		}
+	})
+}
+
+func TestPRService_Reviews(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*PRServiceImpl, *mockPRRepository, *mockPRProvider, *mockNoteRepository, *mockTaskRepository) {
+		prRepo := newMockPRRepository()
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", CommissionID: "COMM-001", Number: 7, URL: "u", Branch: "feature/x", Status: "open"}
+		repoRepo := newMockRepoRepository()
+		repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "app", URL: "git@github.com:acme/app.git"})
+		provider := newMockPRProvider()
+		provider.byNumber[7] = &secondary.ProviderPR{Number: 7, URL: "u", State: secondary.ProviderPRStateOpen}
+		provider.threads = []*secondary.ProviderReviewThread{
+			{ID: "T1", Path: "auth.go", Line: 42, Comments: []secondary.ProviderReviewComment{
+				{Author: "bob", Body: "Check the error", URL: "https://github.com/acme/app/pull/7#discussion_r1"},
+				{Author: "alice", Body: "Will do"},
+			}},
+			{ID: "T2", Resolved: true, Comments: []secondary.ProviderReviewComment{{Author: "bob", Body: "Typo"}}},
+		}
+		noteRepo := newMockNoteRepository()
+		taskRepo := newMockTaskRepository()
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, repoRepo, provider, nil, nil,
+			NewNoteService(noteRepo, &mockTransactor{}),
+			NewTaskService(taskRepo, newMockTagRepositoryForTask(), nil, nil, nil, &mockTransactor{}))
+		return svc, prRepo, provider, noteRepo, taskRepo
+	}
+
+	t.Run("imports unresolved threads as linked notes with follow-up tasks", func(t *testing.T) {
+		svc, prRepo, _, noteRepo, taskRepo := setup()
+
+		resp, err := svc.ImportPRReviews(ctx, primary.ImportPRReviewsRequest{PRID: "PR-001", NoteType: "bug", CreateTasks: true})
+		if err != nil {
+			t.Fatalf("ImportPRReviews failed: %v", err)
+		}
+		if len(resp.Imported) != 1 || resp.Skipped != 1 {
+			t.Fatalf("imported %d, skipped %d; want 1 and 1", len(resp.Imported), resp.Skipped)
+		}
+		got := resp.Imported[0]
+		if got.ThreadID != "T1" || got.NoteID == "" || got.TaskID == "" || got.Replies != 1 {
+			t.Errorf("imported = %+v", got)
+		}
+
+		note := noteRepo.notes[got.NoteID]
+		if note.Type != "bug" || note.ShipmentID != "SHIP-001" || note.Title != "auth.go:42: Check the error" {
+			t.Errorf("note = %+v", note)
+		}
+		if !strings.Contains(note.Content, "https://github.com/acme/app/pull/7#discussion_r1") || !strings.Contains(note.Content, "Location: auth.go:42") {
+			t.Errorf("note content = %q", note.Content)
+		}
+		if task := taskRepo.tasks[got.TaskID]; task == nil || task.ShipmentID != "SHIP-001" || !strings.Contains(task.Description, got.NoteID) {
+			t.Errorf("task = %+v", task)
+		}
+		if links := prRepo.threads["PR-001"]; len(links) != 1 || links[0].NoteID != got.NoteID || links[0].Line != 42 {
+			t.Errorf("links = %+v", links)
+		}
+
+		// Importing again skips the thread already imported
+		resp, err = svc.ImportPRReviews(ctx, primary.ImportPRReviewsRequest{PRID: "PR-001"})
+		if err != nil {
+			t.Fatalf("ImportPRReviews failed: %v", err)
+		}
+		if len(resp.Imported) != 0 || resp.Skipped != 2 {
+			t.Errorf("re-import imported %d, skipped %d; want 0 and 2", len(resp.Imported), resp.Skipped)
+		}
+	})
+
+	t.Run("lists threads with their notes", func(t *testing.T) {
+		svc, prRepo, _, _, _ := setup()
+		prRepo.threads["PR-001"] = []*secondary.PRReviewThreadRecord{{PRID: "PR-001", ThreadID: "T1", NoteID: "NOTE-009"}}
+
+		threads, err := svc.ListPRReviews(ctx, "PR-001")
+		if err != nil {
+			t.Fatalf("ListPRReviews failed: %v", err)
+		}
+		if len(threads) != 2 || threads[0].NoteID != "NOTE-009" || threads[0].Author != "bob" || threads[1].NoteID != "" || !threads[1].Resolved {
+			t.Errorf("threads = %+v, %+v", threads[0], threads[1])
+		}
+	})
+
+	t.Run("refuses PRs without a number", func(t *testing.T) {
+		svc, prRepo, _, _, _ := setup()
+		prRepo.prs["PR-001"].Number = 0
+
+		_, err := svc.ImportPRReviews(ctx, primary.ImportPRReviewsRequest{PRID: "PR-001"})
+		if err == nil || !strings.Contains(err.Error(), "orc pr sync PR-001") {
+			t.Errorf("error = %v, want hint to sync", err)
+		}
+	})
+
+	t.Run("sync resolves the thread of a resolved note", func(t *testing.T) {
+		svc, prRepo, provider, noteRepo, _ := setup()
+		resp, _ := svc.ImportPRReviews(ctx, primary.ImportPRReviewsRequest{PRID: "PR-001"})
+		noteID := resp.Imported[0].NoteID
+		if err := svc.noteService.CloseNote(ctx, primary.CloseNoteRequest{NoteID: noteID, Reason: "resolved"}); err != nil {
+			t.Fatalf("CloseNote failed: %v", err)
+		}
+
+		results, err := svc.SyncPRs(ctx, primary.SyncPRsRequest{PRID: "PR-001"})
+		if err != nil || results[0].Error != "" {
+			t.Fatalf("SyncPRs failed: %v %+v", err, results)
+		}
+		if resolved, ok := provider.resolved["T1"]; !ok || !resolved {
+			t.Errorf("thread T1 was not resolved on the provider: %v", provider.resolved)
+		}
+		if th := results[0].Threads; len(th) != 1 || th[0].NoteID != noteID || th[0].Action != primary.PRThreadResolved {
+			t.Errorf("thread changes = %+v", th)
+		}
+		if !prRepo.threads["PR-001"][0].Resolved {
+			t.Error("expected the agreed state to be resolved")
+		}
+		if noteRepo.notes[noteID].Status != "closed" {
+			t.Errorf("note status = %q, want closed", noteRepo.notes[noteID].Status)
+		}
+
+		// Nothing left to do on the next sync
+		results, _ = svc.SyncPRs(ctx, primary.SyncPRsRequest{PRID: "PR-001"})
+		if len(results[0].Threads) != 0 {
+			t.Errorf("second sync changed threads: %+v", results[0].Threads)
+		}
+	})
+
+	t.Run("sync closes the note of a thread resolved on the provider", func(t *testing.T) {
+		svc, _, provider, noteRepo, _ := setup()
+		resp, _ := svc.ImportPRReviews(ctx, primary.ImportPRReviewsRequest{PRID: "PR-001"})
+		noteID := resp.Imported[0].NoteID
+		provider.threads[0].Resolved = true
+
+		results, _ := svc.SyncPRs(ctx, primary.SyncPRsRequest{PRID: "PR-001"})
+		if th := results[0].Threads; len(th) != 1 || th[0].Action != primary.PRThreadNoteResolved {
+			t.Errorf("thread changes = %+v", th)
+		}
+		if note := noteRepo.notes[noteID]; note.Status != "closed" {
+			t.Errorf("note = %+v, want closed", note)
+		}
+	})
+
+	t.Run("sync leaves threads alone when the provider cannot resolve them", func(t *testing.T) {
+		svc, prRepo, provider, _, _ := setup()
+		provider.noResolve = true
+		resp, _ := svc.ImportPRReviews(ctx, primary.ImportPRReviewsRequest{PRID: "PR-001"})
+		svc.noteService.CloseNote(ctx, primary.CloseNoteRequest{NoteID: resp.Imported[0].NoteID, Reason: "resolved"})
+
+		results, _ := svc.SyncPRs(ctx, primary.SyncPRsRequest{PRID: "PR-001"})
+		if results[0].Error != "" || len(results[0].Threads) != 0 {
+			t.Errorf("result = %+v", results[0])
+		}
+		if prRepo.threads["PR-001"][0].Resolved {
+			t.Error("agreed state should stay unresolved until the thread is resolved")
+		}
+	})
+}
+
+func TestPRService_Stack(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*PRServiceImpl, *mockPRRepository, *mockPRProvider) {
+		prRepo := newMockPRRepository()
+		repoRepo := newMockRepoRepository()
+		repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "app", URL: "git@github.com:acme/app.git", DefaultBranch: "main"})
+		provider := newMockPRProvider()
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, repoRepo, provider, nil, nil, nil, nil)
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", CommissionID: "COMM-001", Number: 7, Branch: "a", TargetBranch: "main", Status: "open"}
+		prRepo.prs["PR-002"] = &secondary.PRRecord{ID: "PR-002", ShipmentID: "SHIP-002", RepoID: "REPO-001", CommissionID: "COMM-001", Number: 8, Branch: "b", TargetBranch: "main", Status: "open"}
+		prRepo.prs["PR-003"] = &secondary.PRRecord{ID: "PR-003", ShipmentID: "SHIP-003", RepoID: "REPO-001", CommissionID: "COMM-001", Branch: "c", TargetBranch: "main", Status: "draft"}
+		return svc, prRepo, provider
+	}
+
+	t.Run("stacking retargets on the provider and records the parent", func(t *testing.T) {
+		svc, prRepo, provider := setup()
+
+		if err := svc.StackPR(ctx, primary.StackPRRequest{PRID: "PR-002", ParentPRID: "PR-001"}); err != nil {
+			t.Fatalf("StackPR failed: %v", err)
+		}
+		if err := svc.StackPR(ctx, primary.StackPRRequest{PRID: "PR-003", ParentPRID: "PR-002"}); err != nil {
+			t.Fatalf("StackPR failed: %v", err)
+		}
+		if provider.bases[8] != "a" {
+			t.Errorf("provider base of #8 = %q, want a", provider.bases[8])
+		}
+		if got := prRepo.prs["PR-003"]; got.ParentPRID != "PR-002" || got.TargetBranch != "b" {
+			t.Errorf("PR-003 = %+v", got)
+		}
+
+		stacks, err := svc.ListPRStacks(ctx, "COMM-001")
+		if err != nil {
+			t.Fatalf("ListPRStacks failed: %v", err)
+		}
+		if len(stacks) != 1 || stacks[0].BaseBranch != "main" || len(stacks[0].Entries) != 3 {
+			t.Fatalf("stacks = %+v", stacks)
+		}
+		for i, want := range []string{"PR-001", "PR-002", "PR-003"} {
+			if e := stacks[0].Entries[i]; e.PR.ID != want || e.Depth != i {
+				t.Errorf("entry %d = %s at depth %d, want %s at depth %d", i, e.PR.ID, e.Depth, want, i)
+			}
+		}
+	})
+
+	t.Run("refuses to stack a PR below itself", func(t *testing.T) {
+		svc, prRepo, _ := setup()
+		prRepo.prs["PR-002"].ParentPRID = "PR-001"
+
+		err := svc.StackPR(ctx, primary.StackPRRequest{PRID: "PR-001", ParentPRID: "PR-002"})
+		if err == nil || !strings.Contains(err.Error(), "below itself") {
+			t.Fatalf("error = %v, want cycle refusal", err)
+		}
+	})
+
+	t.Run("detaching retargets onto the stack base", func(t *testing.T) {
+		svc, prRepo, provider := setup()
+		prRepo.prs["PR-002"].ParentPRID = "PR-001"
+		prRepo.prs["PR-002"].TargetBranch = "a"
+
+		if err := svc.StackPR(ctx, primary.StackPRRequest{PRID: "PR-002"}); err != nil {
+			t.Fatalf("StackPR failed: %v", err)
+		}
+		if got := prRepo.prs["PR-002"]; got.ParentPRID != "" || got.TargetBranch != "main" || provider.bases[8] != "main" {
+			t.Errorf("PR-002 = %+v, provider base = %q", got, provider.bases[8])
+		}
+	})
+
+	t.Run("restack needs a stack", func(t *testing.T) {
+		svc, _, _ := setup()
+
+		_, err := svc.RestackPRs(ctx, primary.RestackPRsRequest{PRID: "PR-001"})
+		if err == nil || !strings.Contains(err.Error(), "orc pr stack onto PR-001") {
+			t.Fatalf("error = %v, want stack hint", err)
+		}
+	})
+}
+
+func TestPRService_RestackPRs(t *testing.T) {
+	ctx := context.Background()
+	home := setupGitHome(t)
+
+	// main <- a (PR-001) <- b (PR-002), each branch in its own worktree
+	repoPath := filepath.Join(home, "app")
+	if err := os.MkdirAll(repoPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, repoPath, "init", "-q", "-b", "main")
+	commitFile(t, repoPath, "base.txt", "base")
+	wbA := filepath.Join(home, "wb-a")
+	wbB := filepath.Join(home, "wb-b")
+	runGit(t, repoPath, "worktree", "add", "-q", "-b", "a", wbA, "main")
+	commitFile(t, wbA, "a.txt", "a")
+	runGit(t, repoPath, "worktree", "add", "-q", "-b", "b", wbB, "a")
+	commitFile(t, wbB, "b.txt", "b")
+
+	// PR-001 lands as a squash merge, so main has a's change under a new commit
+	commitFile(t, repoPath, "a.txt", "a")
+
+	prRepo := newMockPRRepository()
+	prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", CommissionID: "COMM-001", Number: 7, Branch: "a", TargetBranch: "main", Status: "merged"}
+	prRepo.prs["PR-002"] = &secondary.PRRecord{ID: "PR-002", ShipmentID: "SHIP-002", RepoID: "REPO-001", CommissionID: "COMM-001", Number: 8, Branch: "b", TargetBranch: "a", ParentPRID: "PR-001", Status: "open"}
+	repoRepo := newMockRepoRepository()
+	repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "app", URL: "git@github.com:acme/app.git", DefaultBranch: "main"})
+	shipmentSvc := newMockShipmentServiceForPR()
+	shipmentSvc.shipments["SHIP-001"] = &primary.Shipment{ID: "SHIP-001", RepoID: "REPO-001", AssignedWorkbenchID: "BENCH-001"}
+	shipmentSvc.shipments["SHIP-002"] = &primary.Shipment{ID: "SHIP-002", RepoID: "REPO-001", AssignedWorkbenchID: "BENCH-002"}
+	workbenchSvc := newMockWorkbenchServiceForSummary()
+	workbenchSvc.workbenches["BENCH-001"] = &primary.Workbench{ID: "BENCH-001", Path: wbA}
+	workbenchSvc.workbenches["BENCH-002"] = &primary.Workbench{ID: "BENCH-002", Path: wbB}
+	provider := newMockPRProvider()
+	svc := NewPRService(prRepo, shipmentSvc, &mockTransactor{}, repoRepo, provider, nil, workbenchSvc, nil, nil)
+
+	resp, err := svc.RestackPRs(ctx, primary.RestackPRsRequest{PRID: "PR-002", Push: true})
+	if err != nil {
+		t.Fatalf("RestackPRs failed: %v", err)
+	}
+	if len(resp.Steps) != 1 {
+		t.Fatalf("steps = %+v, want one", resp.Steps)
+	}
+	step := resp.Steps[0]
+	if step.PRID != "PR-002" || step.Onto != "main" || !step.Rebased || step.Pushed || !step.Retargeted {
+		t.Errorf("step = %+v", step)
+	}
+
+	ahead, behind, err := NewGitService().GetAheadBehindRefs(wbB, "main", "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if behind != 0 || ahead != 1 {
+		t.Errorf("b is %d behind and %d ahead of main, want 0 and 1", behind, ahead)
+	}
+	if got := prRepo.prs["PR-002"]; got.ParentPRID != "" || got.TargetBranch != "main" || provider.bases[8] != "main" {
+		t.Errorf("PR-002 = %+v, provider base = %q", got, provider.bases[8])
+	}
+}
+
+func TestPRService_MergePR_Local(t *testing.T) {
+	ctx := context.Background()
+	git := NewGitService()
+
+	// setup clones a bare origin into a main checkout and commits two changes on a
+	// feature branch, then records an open PR for it.
+	setup := func(t *testing.T, mergeJSON string) (*PRServiceImpl, *mockPRRepository, string, string) {
+		home := setupGitHome(t)
+		origin := filepath.Join(home, "origin.git")
+		runGit(t, home, "init", "-q", "--bare", "-b", "main", origin)
+		checkout := filepath.Join(home, "app")
+		runGit(t, home, "clone", "-q", origin, checkout)
+		runGit(t, checkout, "checkout", "-q", "-b", "main")
+		commitFile(t, checkout, "README", "app")
+		runGit(t, checkout, "push", "-q", "-u", "origin", "main")
+		runGit(t, checkout, "checkout", "-q", "-b", "feature")
+		commitFile(t, checkout, "a.txt", "a")
+		commitFile(t, checkout, "b.txt", "b")
+		runGit(t, checkout, "checkout", "-q", "main")
+
+		prRepo := newMockPRRepository()
+		prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", Title: "Add a and b", Branch: "feature", TargetBranch: "main", Status: "open"}
+		repoRepo := newMockRepoRepository()
+		repoRepo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "app", LocalPath: checkout, DefaultBranch: "main", MergeJSON: mergeJSON})
+		svc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, repoRepo, nil, nil, nil, nil, nil)
+		return svc, prRepo, checkout, origin
+	}
+
+	for _, tt := range []struct {
+		strategy string
+		commits  int // Commits main gains
+	}{
+		{"merge", 3},
+		{"squash", 1},
+		{"rebase", 2},
+	} {
+		t.Run("lands with "+tt.strategy, func(t *testing.T) {
+			svc, prRepo, checkout, origin := setup(t, `{"strategy":"`+tt.strategy+`","verify":["test -f a.txt"]}`)
+			before, _ := git.GetHeadCommit(checkout)
+
+			resp, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
+			if err != nil {
+				t.Fatalf("MergePR failed: %v", err)
+			}
+			if resp.Strategy != tt.strategy || resp.Source != "repo record" || !resp.Pushed || len(resp.Verify) != 1 || !resp.Verify[0].Success {
+				t.Errorf("response = %+v", resp)
+			}
+			if ahead, _, _ := git.GetAheadBehindRefs(checkout, before, "main"); ahead != tt.commits {
+				t.Errorf("main gained %d commits, want %d", ahead, tt.commits)
+			}
+			if pushed, _ := git.ResolveCommit(origin, "main"); pushed != resp.Commit {
+				t.Errorf("origin main = %s, want %s", pushed, resp.Commit)
+			}
+			if prRepo.prs["PR-001"].Status != "merged" {
+				t.Errorf("status = %s, want merged", prRepo.prs["PR-001"].Status)
+			}
+		})
+	}
+
+	t.Run("failed verify undoes the merge and leaves the ledger alone", func(t *testing.T) {
+		svc, prRepo, checkout, origin := setup(t, `{"strategy":"squash","verify":["echo broken; exit 1"]}`)
+		before, _ := git.GetHeadCommit(checkout)
+
+		resp, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
+		if err == nil || !strings.Contains(err.Error(), "the merge into main was undone") {
+			t.Fatalf("error = %v, want undone merge", err)
+		}
+		if len(resp.Verify) != 1 || resp.Verify[0].Success || !strings.Contains(resp.Verify[0].Output, "broken") {
+			t.Errorf("verify = %+v", resp.Verify)
+		}
+		if head, _ := git.GetHeadCommit(checkout); head != before {
+			t.Errorf("main moved to %s, want %s", head, before)
+		}
+		if pushed, _ := git.ResolveCommit(origin, "main"); pushed != before {
+			t.Errorf("origin main moved to %s", pushed)
+		}
+		if prRepo.prs["PR-001"].Status != "open" {
+			t.Errorf("status = %s, want open", prRepo.prs["PR-001"].Status)
+		}
+	})
+
+	t.Run("conflicts abort before anything lands", func(t *testing.T) {
+		svc, prRepo, checkout, _ := setup(t, `{"strategy":"merge"}`)
+		commitFile(t, checkout, "a.txt", "main's a")
+		runGit(t, checkout, "push", "-q", "origin", "main")
+		before, _ := git.GetHeadCommit(checkout)
+
+		_, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
+		if err == nil || !strings.Contains(err.Error(), "conflicts in a.txt") {
+			t.Fatalf("error = %v, want conflict", err)
+		}
+		if head, _ := git.GetHeadCommit(checkout); head != before {
+			t.Errorf("main moved to %s, want %s", head, before)
+		}
+		if changed, _ := git.GetTrackedChangeCount(checkout); changed != 0 {
+			t.Errorf("checkout has %d changes, want clean", changed)
+		}
+		if prRepo.prs["PR-001"].Status != "open" {
+			t.Errorf("status = %s, want open", prRepo.prs["PR-001"].Status)
+		}
+	})
+
+	t.Run("repos without a strategy only record", func(t *testing.T) {
+		svc, prRepo, checkout, _ := setup(t, "")
+		before, _ := git.GetHeadCommit(checkout)
+
+		resp, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
+		if err != nil {
+			t.Fatalf("MergePR failed: %v", err)
+		}
+		if resp.Strategy != "" || prRepo.prs["PR-001"].Status != "merged" {
+			t.Errorf("response = %+v, status = %s", resp, prRepo.prs["PR-001"].Status)
+		}
+		if head, _ := git.GetHeadCommit(checkout); head != before {
+			t.Error("main should not have moved")
+		}
+	})
+
+	t.Run("checked-in config is not used until adopted", func(t *testing.T) {
+		svc, prRepo, checkout, _ := setup(t, "")
+		if err := os.MkdirAll(filepath.Join(checkout, ".orc"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(checkout, ".orc", "repo.json"), []byte(`{"merge":{"strategy":"merge","verify":["touch verified"]}}`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		before, _ := git.GetHeadCommit(checkout)
+
+		_, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001"})
+		if err == nil || !strings.Contains(err.Error(), "orc repo merge REPO-001 --from-file") {
+			t.Fatalf("error = %v, want a hint to adopt the file", err)
+		}
+		if head, _ := git.GetHeadCommit(checkout); head != before || prRepo.prs["PR-001"].Status != "open" {
+			t.Errorf("expected nothing landed or recorded, status = %s", prRepo.prs["PR-001"].Status)
+		}
+
+		// An explicit strategy lands the PR, but only reports the file's verify commands
+		resp, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001", Strategy: "squash"})
+		if err != nil {
+			t.Fatalf("MergePR failed: %v", err)
+		}
+		if resp.Strategy != "squash" || len(resp.Verify) != 0 || len(resp.PendingVerify) != 1 || resp.PendingVerify[0] != "touch verified" {
+			t.Errorf("response = %+v", resp)
+		}
+		if _, err := os.Stat(filepath.Join(checkout, "verified")); err == nil {
+			t.Error("the checked-in verify command should not have run")
+		}
+	})
+
+	t.Run("refuses a checkout on another branch", func(t *testing.T) {
+		svc, _, checkout, _ := setup(t, "")
+		runGit(t, checkout, "checkout", "-q", "feature")
+
+		_, err := svc.MergePR(ctx, primary.MergePRRequest{PRID: "PR-001", Strategy: "rebase"})
+		if err == nil || !strings.Contains(err.Error(), "is on feature, not main") {
+			t.Fatalf("error = %v, want wrong-branch refusal", err)
+		}
+	})
+}
@@ -0,0 +1,278 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/example/orc/internal/core/pr"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// StackPR stacks a PR on another PR, or unstacks it onto its stack's base branch.
+// Opened PRs are retargeted on the provider first, so the ledger never claims a
+// target the provider does not have.
+func (s *PRServiceImpl) StackPR(ctx context.Context, req primary.StackPRRequest) error {
+	record, err := s.prRepo.GetByID(ctx, req.PRID)
+	if err != nil {
+		return err
+	}
+	nodes, err := s.stackNodes(ctx, record.CommissionID)
+	if err != nil {
+		return err
+	}
+
+	var target string
+	if req.ParentPRID != "" {
+		parent, err := s.prRepo.GetByID(ctx, req.ParentPRID)
+		if err != nil {
+			return err
+		}
+		result := pr.CanStackPR(pr.StackPRContext{
+			PRID:               record.ID,
+			Status:             record.Status,
+			RepoID:             record.RepoID,
+			CommissionID:       record.CommissionID,
+			ParentID:           parent.ID,
+			ParentStatus:       parent.Status,
+			ParentRepoID:       parent.RepoID,
+			ParentCommissionID: parent.CommissionID,
+			ParentAncestors:    pr.Ancestors(nodes, parent.ID),
+		})
+		if err := result.Error(); err != nil {
+			return err
+		}
+		target = parent.Branch
+	} else {
+		if record.ParentPRID == "" {
+			return fmt.Errorf("PR %s is not stacked", record.ID)
+		}
+		target = pr.StackBase(nodes, record.ID)
+	}
+
+	if err := s.retarget(ctx, record, target); err != nil {
+		return err
+	}
+	return s.prRepo.UpdateStack(ctx, record.ID, req.ParentPRID, target)
+}
+
+// ListPRStacks lists a commission's stacks of PRs.
+func (s *PRServiceImpl) ListPRStacks(ctx context.Context, commissionID string) ([]*primary.PRStack, error) {
+	records, err := s.prRepo.List(ctx, secondary.PRFilters{CommissionID: commissionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PRs: %w", err)
+	}
+	byID := make(map[string]*secondary.PRRecord, len(records))
+	for _, r := range records {
+		byID[r.ID] = r
+	}
+
+	var stacks []*primary.PRStack
+	for _, stack := range pr.Stacks(toStackPRs(records)) {
+		bottom := byID[stack[0].PR.ID]
+		ps := &primary.PRStack{RepoID: bottom.RepoID, BaseBranch: bottom.TargetBranch}
+		for _, e := range stack {
+			ps.Entries = append(ps.Entries, primary.PRStackEntry{PR: s.recordToPR(byID[e.PR.ID]), Depth: e.Depth})
+		}
+		stacks = append(stacks, ps)
+	}
+	return stacks, nil
+}
+
+// RestackPRs rebases the open PRs of prID's stack onto their parents, bottom
+// first, each in the workbench of its shipment. Every workbench is checked before
+// anything moves; a rebase that hits conflicts is aborted and stops the restack.
+func (s *PRServiceImpl) RestackPRs(ctx context.Context, req primary.RestackPRsRequest) (*primary.RestackPRsResponse, error) {
+	record, err := s.prRepo.GetByID(ctx, req.PRID)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.prRepo.List(ctx, secondary.PRFilters{CommissionID: record.CommissionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PRs: %w", err)
+	}
+	byID := make(map[string]*secondary.PRRecord, len(records))
+	for _, r := range records {
+		byID[r.ID] = r
+	}
+
+	stack := pr.StackContaining(toStackPRs(records), record.ID)
+	if stack == nil {
+		return nil, fmt.Errorf("PR %s is not part of a stack. Stack it with: orc pr stack onto %s <parent-pr-id>", record.ID, record.ID)
+	}
+	steps := pr.PlanRestack(stack)
+	for i := range steps {
+		if steps[i].ParentID == "" && steps[i].Onto == "" {
+			base, err := s.defaultBranch(ctx, byID[steps[i].PRID].RepoID)
+			if err != nil {
+				return nil, err
+			}
+			steps[i].Onto = base
+		}
+	}
+
+	// Pre-flight: every branch must be checked out, clean, in its workbench
+	paths := make(map[string]string, len(steps))
+	for _, step := range steps {
+		path, err := s.prWorkbenchPath(ctx, byID[step.PRID])
+		if err != nil {
+			return nil, fmt.Errorf("cannot restack %s: %w", step.PRID, err)
+		}
+		branch, err := s.gitService.GetCurrentBranch(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot restack %s: %w", step.PRID, err)
+		}
+		if branch != step.Branch {
+			return nil, fmt.Errorf("cannot restack %s: workbench %s is on %s, not %s", step.PRID, path, branch, step.Branch)
+		}
+		changed, err := s.gitService.GetTrackedChangeCount(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot restack %s: %w", step.PRID, err)
+		}
+		if changed > 0 {
+			return nil, fmt.Errorf("cannot restack %s: workbench %s has %d uncommitted change(s); commit or stash them first", step.PRID, path, changed)
+		}
+		paths[step.PRID] = path
+	}
+	if len(steps) == 0 {
+		return &primary.RestackPRsResponse{}, nil
+	}
+
+	// Remember where each old parent was before any branch moves, so its
+	// commits are not replayed onto the new parent a second time
+	first := paths[steps[0].PRID]
+	if _, err := s.gitService.FetchOrigin(first); err != nil {
+		return nil, err
+	}
+	upstreams := make(map[string]string, len(steps))
+	for _, step := range steps {
+		if step.Upstream == "" {
+			continue
+		}
+		for _, ref := range []string{step.Upstream, "origin/" + step.Upstream} {
+			if sha, err := s.gitService.ResolveCommit(first, ref); err == nil {
+				upstreams[step.PRID] = sha
+				break
+			}
+		}
+	}
+
+	response := &primary.RestackPRsResponse{}
+	for _, step := range steps {
+		path := paths[step.PRID]
+		done := primary.PRRestackStep{PRID: step.PRID, Branch: step.Branch, Onto: step.Onto, ParentPRID: step.ParentID}
+
+		onto := step.Onto
+		if step.ParentID == "" {
+			if onto, err = s.gitService.ResolveBaseRef(path, step.Onto); err != nil {
+				return response, fmt.Errorf("cannot restack %s: %w", step.PRID, err)
+			}
+		}
+		upstream := upstreams[step.PRID]
+		if upstream == "" {
+			upstream = onto
+		}
+
+		conflicts, err := s.gitService.RebaseOnto(path, onto, upstream)
+		if err != nil {
+			return response, fmt.Errorf("failed to restack %s: %w", step.PRID, err)
+		}
+		if len(conflicts) > 0 {
+			done.Conflicts = conflicts
+			response.Steps = append(response.Steps, done)
+			return response, fmt.Errorf("rebasing %s onto %s conflicts in %s; the rebase was aborted. Resolve it with: git -C %s rebase --onto %s %s",
+				step.Branch, onto, strings.Join(conflicts, ", "), path, onto, upstream)
+		}
+		done.Rebased = true
+
+		if req.Push && s.gitService.HasOrigin(path) {
+			if err := s.gitService.ForcePushBranch(path, step.Branch); err != nil {
+				response.Steps = append(response.Steps, done)
+				return response, err
+			}
+			done.Pushed = true
+		}
+
+		if step.Retarget {
+			if err := s.retarget(ctx, byID[step.PRID], step.Onto); err != nil {
+				response.Steps = append(response.Steps, done)
+				return response, err
+			}
+			if err := s.prRepo.UpdateStack(ctx, step.PRID, step.ParentID, step.Onto); err != nil {
+				response.Steps = append(response.Steps, done)
+				return response, err
+			}
+			done.Retargeted = true
+		}
+		response.Steps = append(response.Steps, done)
+	}
+	return response, nil
+}
+
+// retarget points an opened PR at a new base branch on its provider. PRs not
+// opened on a provider (or without providers configured) only live in the ledger.
+func (s *PRServiceImpl) retarget(ctx context.Context, record *secondary.PRRecord, target string) error {
+	if record.Number == 0 || s.providers == nil || s.repoRepo == nil {
+		return nil
+	}
+	if target == "" {
+		var err error
+		if target, err = s.defaultBranch(ctx, record.RepoID); err != nil {
+			return err
+		}
+	}
+	provider, err := s.providerForRepo(ctx, record.RepoID)
+	if err != nil {
+		return err
+	}
+	if err := provider.UpdatePullRequestBase(ctx, record.Number, target); err != nil {
+		return fmt.Errorf("failed to retarget %s onto %s on %s: %w", record.ID, target, provider.Name(), err)
+	}
+	return nil
+}
+
+// defaultBranch returns the default branch of a repo, which an empty target branch stands for.
+func (s *PRServiceImpl) defaultBranch(ctx context.Context, repoID string) (string, error) {
+	if s.repoRepo == nil {
+		return "", fmt.Errorf("repo %s has no known default branch", repoID)
+	}
+	repo, err := s.repoRepo.GetByID(ctx, repoID)
+	if err != nil {
+		return "", err
+	}
+	if repo.DefaultBranch == "" {
+		return "", fmt.Errorf("repo %s has no default branch", repoID)
+	}
+	return repo.DefaultBranch, nil
+}
+
+// prWorkbenchPath returns the directory of the workbench holding a PR's branch.
+func (s *PRServiceImpl) prWorkbenchPath(ctx context.Context, record *secondary.PRRecord) (string, error) {
+	shipment, err := s.shipmentService.GetShipment(ctx, record.ShipmentID)
+	if err != nil {
+		return "", err
+	}
+	repos, err := s.shipmentService.ListShipmentRepos(ctx, record.ShipmentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list shipment repos: %w", err)
+	}
+	return s.shipmentWorkbenchPath(ctx, record.RepoID, shipment, repos)
+}
+
+// stackNodes loads a commission's PRs for stack planning.
+func (s *PRServiceImpl) stackNodes(ctx context.Context, commissionID string) ([]pr.StackPR, error) {
+	records, err := s.prRepo.List(ctx, secondary.PRFilters{CommissionID: commissionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PRs: %w", err)
+	}
+	return toStackPRs(records), nil
+}
+
+func toStackPRs(records []*secondary.PRRecord) []pr.StackPR {
+	nodes := make([]pr.StackPR, len(records))
+	for i, r := range records {
+		nodes[i] = pr.StackPR{ID: r.ID, ParentID: r.ParentPRID, Branch: r.Branch, TargetBranch: r.TargetBranch, Status: r.Status}
+	}
+	return nodes
+}
@@ -0,0 +1,171 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/orc/internal/core/pr"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// SyncPRs pulls each selected PR's state and CI checks from its repository's
+// provider into the ledger, emitting an audit event per changed field. A PR merged on the provider
+// completes its shipment the same way MergePR does.
+func (s *PRServiceImpl) SyncPRs(ctx context.Context, req primary.SyncPRsRequest) ([]*primary.PRSyncResult, error) {
+	if s.providers == nil || s.repoRepo == nil {
+		return nil, fmt.Errorf("PR sync is not configured")
+	}
+
+	var records []*secondary.PRRecord
+	switch {
+	case req.PRID != "":
+		record, err := s.prRepo.GetByID(ctx, req.PRID)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	case req.All:
+		all, err := s.prRepo.List(ctx, secondary.PRFilters{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list PRs: %w", err)
+		}
+		for _, r := range all {
+			if r.Status != primary.PRStatusMerged && r.Status != primary.PRStatusClosed {
+				records = append(records, r)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("specify a PR ID or --all")
+	}
+
+	results := make([]*primary.PRSyncResult, 0, len(records))
+	for _, record := range records {
+		result := &primary.PRSyncResult{PRID: record.ID, Number: record.Number, ShipmentID: record.ShipmentID}
+		if err := s.syncPR(ctx, record, result); err != nil {
+			result.Error = err.Error()
+		}
+		result.Number = record.Number
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// syncPR syncs one PR into result; on success record holds the synced values.
+func (s *PRServiceImpl) syncPR(ctx context.Context, record *secondary.PRRecord, result *primary.PRSyncResult) error {
+	provider, err := s.providerForRepo(ctx, record.RepoID)
+	if err != nil {
+		return err
+	}
+
+	var remote *secondary.ProviderPR
+	if record.Number > 0 {
+		remote, err = provider.GetPullRequest(ctx, record.Number)
+	} else {
+		remote, err = provider.FindPullRequest(ctx, record.Branch)
+		if err == nil && remote == nil {
+			err = fmt.Errorf("no %s pull request found for branch %s", provider.Name(), record.Branch)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	wasMerged := record.Status == primary.PRStatusMerged
+	next, planned := pr.PlanSync(pr.SyncState{
+		Number:         record.Number,
+		URL:            record.URL,
+		Status:         record.Status,
+		ReviewDecision: record.ReviewDecision,
+		Mergeable:      record.Mergeable,
+		MergedAt:       record.MergedAt,
+		ClosedAt:       record.ClosedAt,
+	}, pr.RemotePR{
+		Number:         remote.Number,
+		URL:            remote.URL,
+		State:          remote.State,
+		Draft:          remote.Draft,
+		ReviewDecision: remote.ReviewDecision,
+		Mergeable:      remote.Mergeable,
+		MergedAt:       remote.MergedAt,
+		ClosedAt:       remote.ClosedAt,
+	})
+
+	record.Number = next.Number
+	record.URL = next.URL
+	record.Status = next.Status
+	record.ReviewDecision = next.ReviewDecision
+	record.Mergeable = next.Mergeable
+	record.MergedAt = next.MergedAt
+	record.ClosedAt = next.ClosedAt
+	// A PR merged on the provider completes its shipment in the same
+	// transaction, so a failed completion is retried by the next sync
+	err = s.transactor.WithImmediateTx(ctx, func(txCtx context.Context) error {
+		if err := s.prRepo.UpdateSync(txCtx, record); err != nil {
+			return err
+		}
+		if wasMerged || record.Status != primary.PRStatusMerged {
+			return nil
+		}
+		var err error
+		result.ShipmentCompleted, result.Commission, err = s.completeShipmentIfSettled(txCtx, record)
+		return err
+	})
+	if err != nil {
+		result.ShipmentCompleted, result.Commission = false, nil
+		return err
+	}
+
+	for _, c := range planned {
+		s.recordSyncChange(ctx, record, result, c)
+	}
+
+	// Checks of merged or closed PRs are kept as they were last seen
+	if record.Status != primary.PRStatusMerged && record.Status != primary.PRStatusClosed {
+		oldChecks, newChecks, err := s.syncChecks(ctx, record, provider, remote.HeadSHA)
+		if err != nil {
+			return err
+		}
+		if oldChecks != newChecks {
+			s.recordSyncChange(ctx, record, result, pr.FieldChange{Field: "checks", Old: oldChecks, New: newChecks})
+		}
+	}
+
+	result.Threads, err = s.syncReviewThreads(ctx, record, provider)
+	return err
+}
+
+// recordSyncChange adds a synced field change to result and emits its audit event.
+func (s *PRServiceImpl) recordSyncChange(ctx context.Context, record *secondary.PRRecord, result *primary.PRSyncResult, c pr.FieldChange) {
+	result.Changes = append(result.Changes, primary.PRFieldChange{Field: c.Field, Old: c.Old, New: c.New})
+	if s.eventWriter != nil {
+		_ = s.eventWriter.EmitAuditUpdate(ctx, "pr", record.ID, c.Field, c.Old, c.New)
+	}
+}
+
+// providerForRepo resolves the PR provider of a repo.
+func (s *PRServiceImpl) providerForRepo(ctx context.Context, repoID string) (secondary.PRProvider, error) {
+	repo, err := s.repoRepo.GetByID(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	return s.providerFor(repo)
+}
+
+// providerFor resolves the PR provider from the repo's URL, falling back to the
+// origin remote of its local clone.
+func (s *PRServiceImpl) providerFor(repo *secondary.RepoRecord) (secondary.PRProvider, error) {
+	remoteURL := repo.URL
+	if remoteURL == "" && repo.LocalPath != "" && s.gitService.HasOrigin(repo.LocalPath) {
+		var err error
+		remoteURL, err = s.gitService.OriginURL(repo.LocalPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if remoteURL == "" {
+		return nil, fmt.Errorf("repo %s has no remote URL. Set one with: orc repo update %s --url <url>", repo.ID, repo.ID)
+	}
+
+	return s.providers.ForRemote(remoteURL)
+}
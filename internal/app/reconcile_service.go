@@ -0,0 +1,243 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/example/orc/internal/config"
+	"github.com/example/orc/internal/core/effects"
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ReconcileServiceImpl implements the ReconcileService interface.
+type ReconcileServiceImpl struct {
+	workbenchRepo    secondary.WorkbenchRepository
+	repoRepo         secondary.RepoRepository
+	tmuxAdapter      secondary.TMuxAdapter
+	workspaceAdapter secondary.WorkspaceAdapter
+	executor         EffectExecutor
+	gitService       *GitService
+	locator          *WorkbenchLocator // optional: nil uses the default layout
+}
+
+// NewReconcileService creates a new ReconcileService with injected dependencies.
+func NewReconcileService(
+	workbenchRepo secondary.WorkbenchRepository,
+	repoRepo secondary.RepoRepository,
+	tmuxAdapter secondary.TMuxAdapter,
+	workspaceAdapter secondary.WorkspaceAdapter,
+	executor EffectExecutor,
+	gitService *GitService,
+	locator *WorkbenchLocator,
+) *ReconcileServiceImpl {
+	return &ReconcileServiceImpl{
+		workbenchRepo:    workbenchRepo,
+		repoRepo:         repoRepo,
+		tmuxAdapter:      tmuxAdapter,
+		workspaceAdapter: workspaceAdapter,
+		executor:         executor,
+		gitService:       gitService,
+		locator:          locator,
+	}
+}
+
+// PlanReconcile gathers ledger, filesystem, git, and tmux state and computes fixes.
+func (s *ReconcileServiceImpl) PlanReconcile(ctx context.Context, req primary.ReconcileRequest) (*primary.ReconcilePlan, error) {
+	// 1. Ledger: workbenches in scope and the repos they link to
+	workbenches, err := s.workbenchRepo.List(ctx, req.WorkshopID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workbenches: %w", err)
+	}
+	repos, err := s.repoRepo.List(ctx, secondary.RepoFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repos: %w", err)
+	}
+	repoPaths := make(map[string]string)
+	for _, r := range repos {
+		if r.LocalPath != "" {
+			repoPaths[r.ID] = r.LocalPath
+		}
+	}
+
+	roots := s.locator.Layouts().Roots()
+	input := coreworkbench.ReconcileInput{ScopeAll: req.WorkshopID == ""}
+	for _, root := range roots {
+		input.BasePaths = append(input.BasePaths, root.Root)
+	}
+
+	// 2. Git: linked worktrees of every known repo
+	for _, repoPath := range repoPaths {
+		if !s.dirExists(ctx, repoPath) {
+			continue
+		}
+		worktrees, err := s.workspaceAdapter.ListWorktrees(ctx, repoPath)
+		if err != nil {
+			continue // Not a git repo (or git unavailable); nothing to compare
+		}
+		for _, wt := range worktrees {
+			dirty := false
+			if !wt.Prunable {
+				dirty, _ = s.gitService.IsDirty(wt.Path)
+			}
+			input.Worktrees = append(input.Worktrees, coreworkbench.ReconcileWorktree{
+				RepoPath: repoPath,
+				Path:     wt.Path,
+				Branch:   wt.Branch,
+				Prunable: wt.Prunable,
+				Dirty:    dirty,
+			})
+		}
+	}
+
+	// 3. Filesystem: workbench-level directories under each worktree root
+	if input.ScopeAll {
+		for _, root := range roots {
+			dirs, err := s.workspaceAdapter.ListWorkbenchDirs(ctx, root.Root, root.Depth())
+			if err != nil {
+				return nil, fmt.Errorf("failed to list workbench directories: %w", err)
+			}
+			input.Directories = append(input.Directories, dirs...)
+		}
+	}
+
+	// 4. Per-workbench observed state, plus tmux sessions of the workshops involved
+	sessionsSeen := make(map[string]bool)
+	for _, wb := range workbenches {
+		path := s.locator.Path(ctx, wb)
+		state := coreworkbench.ReconcileWorkbench{
+			ID:            wb.ID,
+			Name:          wb.Name,
+			WorkshopID:    wb.WorkshopID,
+			Status:        wb.Status,
+			Path:          path,
+			RepoPath:      repoPaths[wb.RepoID],
+			HomeBranch:    wb.HomeBranch,
+			CurrentBranch: wb.CurrentBranch,
+			DirExists:     s.dirExists(ctx, path),
+		}
+		if state.DirExists {
+			state.ActualBranch, _ = s.gitService.GetCurrentBranch(path)
+			if cfg, err := config.LoadConfig(path); err == nil {
+				state.ConfigPlaceID = cfg.PlaceID
+			}
+		}
+		input.Workbenches = append(input.Workbenches, state)
+
+		if wb.Status == "active" && !sessionsSeen[wb.WorkshopID] {
+			sessionsSeen[wb.WorkshopID] = true
+			if name := s.tmuxAdapter.FindSessionByWorkshopID(ctx, wb.WorkshopID); name != "" {
+				windows, _ := s.tmuxAdapter.ListWindows(ctx, name)
+				input.Sessions = append(input.Sessions, coreworkbench.ReconcileSession{
+					WorkshopID:  wb.WorkshopID,
+					SessionName: name,
+					Windows:     windows,
+				})
+			}
+		}
+	}
+
+	// 5. Generate plan using pure function
+	corePlan := coreworkbench.GenerateReconcilePlan(input)
+
+	plan := &primary.ReconcilePlan{
+		WorkshopID:  req.WorkshopID,
+		NothingToDo: corePlan.NothingToDo,
+	}
+	for _, a := range corePlan.Actions {
+		plan.Actions = append(plan.Actions, primary.ReconcileAction{
+			Type:        string(a.Type),
+			WorkbenchID: a.WorkbenchID,
+			Path:        a.Path,
+			RepoPath:    a.RepoPath,
+			Branch:      a.Branch,
+			Description: a.Description,
+		})
+	}
+	return plan, nil
+}
+
+// ApplyReconcile executes a previously generated plan in order.
+// Stops at the first failing action; actions already applied are reported in the result.
+func (s *ReconcileServiceImpl) ApplyReconcile(ctx context.Context, plan *primary.ReconcilePlan) (*primary.ReconcileResult, error) {
+	result := &primary.ReconcileResult{}
+
+	for _, action := range plan.Actions {
+		var err error
+		switch action.Type {
+		case primary.ReconcileManual:
+			result.Skipped = append(result.Skipped, action)
+			continue
+		case primary.ReconcilePruneWorktrees:
+			err = s.workspaceAdapter.PruneWorktrees(ctx, action.RepoPath)
+		case primary.ReconcileRemoveOrphan:
+			err = s.workspaceAdapter.RemoveWorktree(ctx, action.Path)
+		case primary.ReconcileRecreateWorktree:
+			err = s.recreateWorktree(ctx, action)
+		case primary.ReconcileUpdateBranch:
+			err = s.updateCurrentBranch(ctx, action.WorkbenchID, action.Branch)
+		case primary.ReconcileWriteConfig:
+			err = s.executor.Execute(ctx, s.configEffects(action.WorkbenchID, action.Path))
+		default:
+			err = fmt.Errorf("unknown reconcile action %q", action.Type)
+		}
+		if err != nil {
+			return result, fmt.Errorf("%s: %w", action.Description, err)
+		}
+		result.Applied = append(result.Applied, action)
+	}
+
+	return result, nil
+}
+
+// recreateWorktree recreates a missing workbench directory and its config in one batch.
+func (s *ReconcileServiceImpl) recreateWorktree(ctx context.Context, action primary.ReconcileAction) error {
+	var effs []effects.Effect
+	if action.RepoPath == "" {
+		effs = append(effs, effects.FileEffect{Operation: "mkdir", Path: action.Path, Mode: 0755})
+	} else {
+		var sparseJSON string
+		if wb, err := s.workbenchRepo.GetByID(ctx, action.WorkbenchID); err == nil {
+			sparseJSON = wb.SparseJSON
+		}
+		eff, err := worktreeAddEffect(action.RepoPath, action.Branch, action.Path, sparseJSON)
+		if err != nil {
+			return err
+		}
+		effs = append(effs, eff)
+	}
+	effs = append(effs, s.configEffects(action.WorkbenchID, action.Path)...)
+	return s.executor.Execute(ctx, effs)
+}
+
+// updateCurrentBranch records the branch actually checked out in a workbench.
+func (s *ReconcileServiceImpl) updateCurrentBranch(ctx context.Context, workbenchID, branch string) error {
+	wb, err := s.workbenchRepo.GetByID(ctx, workbenchID)
+	if err != nil {
+		return fmt.Errorf("workbench not found: %w", err)
+	}
+	wb.CurrentBranch = branch
+	return s.workbenchRepo.Update(ctx, wb)
+}
+
+// configEffects builds the effects that write a workbench's .orc/config.json.
+func (s *ReconcileServiceImpl) configEffects(workbenchID, path string) []effects.Effect {
+	orcDir := filepath.Join(path, ".orc")
+	cfg := &config.Config{Version: "1.0", PlaceID: workbenchID}
+	configJSON, _ := json.MarshalIndent(cfg, "", "  ")
+	return []effects.Effect{
+		effects.FileEffect{Operation: "mkdir", Path: orcDir, Mode: 0755},
+		effects.FileEffect{Operation: "write", Path: filepath.Join(orcDir, "config.json"), Content: configJSON, Mode: 0644},
+	}
+}
+
+func (s *ReconcileServiceImpl) dirExists(ctx context.Context, path string) bool {
+	exists, err := s.workspaceAdapter.DirectoryExists(ctx, path)
+	return err == nil && exists
+}
+
+// Ensure ReconcileServiceImpl implements the interface
+var _ primary.ReconcileService = (*ReconcileServiceImpl)(nil)
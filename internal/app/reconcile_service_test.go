@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/example/orc/internal/core/effects"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func newTestReconcileService(t *testing.T) (*ReconcileServiceImpl, *mockWorkbenchRepository, *mockRepoRepositoryForWorkbench, *mockEffectExecutor, string) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	workbenchRepo := newMockWorkbenchRepository()
+	repoRepo := newMockRepoRepositoryForWorkbench()
+	executor := newMockEffectExecutor()
+	workspaceAdapter := newMockWorkspaceAdapter()
+	workspaceAdapter.reposBasePath = home // Check directories on disk
+	service := NewReconcileService(workbenchRepo, repoRepo, newMockTMuxAdapter(), workspaceAdapter, executor, NewGitService(), nil)
+	return service, workbenchRepo, repoRepo, executor, home
+}
+
+func TestPlanReconcile_MissingWorktreeIsRecreated(t *testing.T) {
+	service, workbenchRepo, repoRepo, executor, home := newTestReconcileService(t)
+	ctx := context.Background()
+
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app", LocalPath: filepath.Join(home, "src", "app")}
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{
+		ID: "BENCH-001", Name: "auth-backend", WorkshopID: "WORK-001", RepoID: "REPO-001",
+		Status: "active", HomeBranch: "ml/auth-backend", CurrentBranch: "ml/auth-backend",
+	}
+
+	plan, err := service.PlanReconcile(ctx, primary.ReconcileRequest{})
+	if err != nil {
+		t.Fatalf("PlanReconcile failed: %v", err)
+	}
+	if plan.NothingToDo || len(plan.Actions) != 1 || plan.Actions[0].Type != primary.ReconcileRecreateWorktree {
+		t.Fatalf("plan = %+v, want one recreate-worktree action", plan)
+	}
+
+	result, err := service.ApplyReconcile(ctx, plan)
+	if err != nil {
+		t.Fatalf("ApplyReconcile failed: %v", err)
+	}
+	if len(result.Applied) != 1 {
+		t.Errorf("expected 1 applied action, got %d", len(result.Applied))
+	}
+
+	git, ok := executor.executedEffects[0].(effects.GitEffect)
+	if !ok || git.Operation != "worktree_add" {
+		t.Fatalf("first effect = %+v, want worktree_add", executor.executedEffects[0])
+	}
+	wantPath := filepath.Join(home, "wb", "auth-backend")
+	if git.Args[0] != "ml/auth-backend" || git.Args[1] != wantPath {
+		t.Errorf("worktree_add args = %v, want [ml/auth-backend %s]", git.Args, wantPath)
+	}
+}
+
+func TestPlanReconcile_WrongConfigIsRewritten(t *testing.T) {
+	service, workbenchRepo, _, executor, home := newTestReconcileService(t)
+	ctx := context.Background()
+
+	wbPath := filepath.Join(home, "wb", "scratch")
+	if err := os.MkdirAll(filepath.Join(wbPath, ".orc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(wbPath, ".orc", "config.json"), []byte(`{"place_id":"BENCH-009"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{ID: "BENCH-001", Name: "scratch", WorkshopID: "WORK-001", Status: "active"}
+
+	plan, err := service.PlanReconcile(ctx, primary.ReconcileRequest{WorkshopID: "WORK-001"})
+	if err != nil {
+		t.Fatalf("PlanReconcile failed: %v", err)
+	}
+	if len(plan.Actions) != 1 || plan.Actions[0].Type != primary.ReconcileWriteConfig {
+		t.Fatalf("plan = %+v, want one write-config action", plan)
+	}
+
+	if _, err := service.ApplyReconcile(ctx, plan); err != nil {
+		t.Fatalf("ApplyReconcile failed: %v", err)
+	}
+	write, ok := executor.executedEffects[1].(effects.FileEffect)
+	if !ok || write.Operation != "write" || string(write.Content) == "" {
+		t.Fatalf("second effect = %+v, want config write", executor.executedEffects[1])
+	}
+}
+
+func TestApplyReconcile_UpdatesBranchAndSkipsManual(t *testing.T) {
+	service, workbenchRepo, _, _, _ := newTestReconcileService(t)
+	ctx := context.Background()
+
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{ID: "BENCH-001", Name: "auth-backend", Status: "active", CurrentBranch: "ml/auth-backend"}
+
+	plan := &primary.ReconcilePlan{Actions: []primary.ReconcileAction{
+		{Type: primary.ReconcileUpdateBranch, WorkbenchID: "BENCH-001", Branch: "ml/SHIP-004-login"},
+		{Type: primary.ReconcileManual, Description: "stray directory"},
+	}}
+
+	result, err := service.ApplyReconcile(ctx, plan)
+	if err != nil {
+		t.Fatalf("ApplyReconcile failed: %v", err)
+	}
+	if len(result.Applied) != 1 || len(result.Skipped) != 1 {
+		t.Errorf("applied %d, skipped %d; want 1 and 1", len(result.Applied), len(result.Skipped))
+	}
+	if got := workbenchRepo.workbenches["BENCH-001"].CurrentBranch; got != "ml/SHIP-004-login" {
+		t.Errorf("CurrentBranch = %q, want %q", got, "ml/SHIP-004-login")
+	}
+}
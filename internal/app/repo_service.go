@@ -3,23 +3,31 @@ package app
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/example/orc/internal/core/repo"
+	coreworkbench "github.com/example/orc/internal/core/workbench"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
 
 // RepoServiceImpl implements the RepoService interface.
 type RepoServiceImpl struct {
-	repoRepo   secondary.RepoRepository
-	transactor secondary.Transactor
+	repoRepo         secondary.RepoRepository
+	transactor       secondary.Transactor
+	workspaceAdapter secondary.WorkspaceAdapter // optional: needed to clone and inspect clones
+	gitService       *GitService                // optional: needed to clone and inspect clones
 }
 
 // NewRepoService creates a new RepoService with injected dependencies.
-func NewRepoService(repoRepo secondary.RepoRepository, transactor secondary.Transactor) *RepoServiceImpl {
+func NewRepoService(repoRepo secondary.RepoRepository, transactor secondary.Transactor, workspaceAdapter secondary.WorkspaceAdapter, gitService *GitService) *RepoServiceImpl {
 	return &RepoServiceImpl{
-		repoRepo:   repoRepo,
-		transactor: transactor,
+		repoRepo:         repoRepo,
+		transactor:       transactor,
+		workspaceAdapter: workspaceAdapter,
+		gitService:       gitService,
 	}
 }
 
@@ -40,8 +48,20 @@ func (s *RepoServiceImpl) CreateRepo(ctx context.Context, req primary.CreateRepo
 		return nil, err
 	}
 
-	// Set default branch
+	// Clone first so a failed clone leaves no ledger record behind
+	localPath := req.LocalPath
 	defaultBranch := req.DefaultBranch
+	if req.Clone {
+		localPath, err = s.cloneRepo(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if defaultBranch == "" {
+			defaultBranch, _ = s.gitService.GetDefaultBranch(localPath)
+		}
+	}
+
+	// Set default branch
 	if defaultBranch == "" {
 		defaultBranch = "main"
 	}
@@ -60,7 +80,7 @@ func (s *RepoServiceImpl) CreateRepo(ctx context.Context, req primary.CreateRepo
 			ID:            nextID,
 			Name:          req.Name,
 			URL:           req.URL,
-			LocalPath:     req.LocalPath,
+			LocalPath:     localPath,
 			DefaultBranch: defaultBranch,
 		}
 
@@ -199,15 +219,223 @@ func (s *RepoServiceImpl) DeleteRepo(ctx context.Context, repoID string) error {
 	return s.repoRepo.Delete(ctx, repoID)
 }
 
+// GetRepoHealth inspects a repository's local clone.
+func (s *RepoServiceImpl) GetRepoHealth(ctx context.Context, repoID string) (*primary.RepoHealth, error) {
+	record, err := s.repoRepo.GetByID(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	if s.gitService == nil || s.workspaceAdapter == nil {
+		return nil, fmt.Errorf("repository inspection is not available")
+	}
+
+	health := &primary.RepoHealth{
+		RepoID:        record.ID,
+		Name:          record.Name,
+		LocalPath:     record.LocalPath,
+		DefaultBranch: record.DefaultBranch,
+	}
+	if record.LocalPath != "" {
+		health.Cloned, _ = s.workspaceAdapter.DirectoryExists(ctx, record.LocalPath)
+	}
+
+	healthCtx := repo.HealthContext{
+		Cloned:        health.Cloned,
+		Now:           time.Now(),
+		DefaultBranch: record.DefaultBranch,
+	}
+	if health.Cloned {
+		path := record.LocalPath
+		health.HasOrigin = s.gitService.HasOrigin(path)
+		healthCtx.HasOrigin = health.HasOrigin
+		if fetched, err := s.gitService.LastFetchTime(path); err == nil && !fetched.IsZero() {
+			healthCtx.LastFetch = fetched
+			health.LastFetchedAt = fetched.Format(time.RFC3339)
+			health.FetchAge = repo.FormatAge(healthCtx.Now.Sub(fetched))
+		}
+		if exists, _ := s.gitService.BranchExists(path, "origin/HEAD"); exists {
+			health.DetectedDefaultBranch, _ = s.gitService.GetDefaultBranch(path)
+			healthCtx.DetectedDefaultBranch = health.DetectedDefaultBranch
+		}
+		if exists, _ := s.gitService.BranchExists(path, "origin/"+record.DefaultBranch); exists {
+			health.Ahead, health.Behind, _ = s.gitService.GetAheadBehindRefs(path, "origin/"+record.DefaultBranch, record.DefaultBranch)
+			healthCtx.Ahead, healthCtx.Behind = health.Ahead, health.Behind
+		}
+		if worktrees, err := s.workspaceAdapter.ListWorktrees(ctx, path); err == nil {
+			for _, wt := range worktrees {
+				if !wt.Prunable {
+					health.Worktrees++
+				}
+			}
+		}
+		health.DirtyFiles, _ = s.gitService.GetDirtyFileCount(path)
+		healthCtx.DirtyFiles = health.DirtyFiles
+	}
+
+	health.Issues = repo.EvaluateHealth(healthCtx)
+	return health, nil
+}
+
+// FetchRepo fetches origin in a repository's local clone.
+func (s *RepoServiceImpl) FetchRepo(ctx context.Context, repoID string) (*primary.RepoHealth, error) {
+	health, err := s.GetRepoHealth(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	if !health.Cloned {
+		return nil, fmt.Errorf("repository %s has no local clone", repoID)
+	}
+	if _, err := s.gitService.FetchOrigin(health.LocalPath); err != nil {
+		return nil, fmt.Errorf("%s: %w", health.Name, err)
+	}
+	return s.GetRepoHealth(ctx, repoID)
+}
+
+// SetBootstrapSteps replaces the bootstrap commands stored on a repository.
+func (s *RepoServiceImpl) SetBootstrapSteps(ctx context.Context, repoID string, steps []string) error {
+	if _, err := s.repoRepo.GetByID(ctx, repoID); err != nil {
+		return err
+	}
+	data, err := repo.FormatBootstrapSteps(steps)
+	if err != nil {
+		return err
+	}
+	return s.repoRepo.UpdateBootstrap(ctx, repoID, data)
+}
+
+// AdoptBootstrapFile copies the bootstrap steps of the clone's .orc/repo.json onto the repository record.
+func (s *RepoServiceImpl) AdoptBootstrapFile(ctx context.Context, repoID string) ([]string, error) {
+	record, err := s.repoRepo.GetByID(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	if record.LocalPath == "" {
+		return nil, fmt.Errorf("repository %s has no local clone", repoID)
+	}
+	data, err := os.ReadFile(filepath.Join(record.LocalPath, repo.ConfigFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", repo.ConfigFile, err)
+	}
+	cfg, err := repo.ParseConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Bootstrap) == 0 {
+		return nil, fmt.Errorf("%s of %s defines no bootstrap steps", repo.ConfigFile, repoID)
+	}
+	if err := s.SetBootstrapSteps(ctx, repoID, cfg.Bootstrap); err != nil {
+		return nil, err
+	}
+	return cfg.Bootstrap, nil
+}
+
+// SetSparsePaths replaces the sparse checkout paths used for a repository's new worktrees.
+func (s *RepoServiceImpl) SetSparsePaths(ctx context.Context, repoID string, paths []string) error {
+	if _, err := s.repoRepo.GetByID(ctx, repoID); err != nil {
+		return err
+	}
+	data, err := coreworkbench.FormatSparsePaths(paths)
+	if err != nil {
+		return err
+	}
+	return s.repoRepo.UpdateSparse(ctx, repoID, data)
+}
+
+// SetMergeConfig sets how orc pr merge lands a repository's PRs locally; nil clears it.
+func (s *RepoServiceImpl) SetMergeConfig(ctx context.Context, repoID string, cfg *primary.RepoMergeConfig) error {
+	if _, err := s.repoRepo.GetByID(ctx, repoID); err != nil {
+		return err
+	}
+	var config *repo.MergeConfig
+	if cfg != nil {
+		config = &repo.MergeConfig{Strategy: cfg.Strategy, Verify: cfg.Verify}
+	}
+	data, err := repo.FormatMergeConfig(config)
+	if err != nil {
+		return err
+	}
+	return s.repoRepo.UpdateMerge(ctx, repoID, data)
+}
+
+// AdoptMergeFile copies the merge config of the clone's .orc/repo.json onto the repository record.
+func (s *RepoServiceImpl) AdoptMergeFile(ctx context.Context, repoID string) (*primary.RepoMergeConfig, error) {
+	record, err := s.repoRepo.GetByID(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	if record.LocalPath == "" {
+		return nil, fmt.Errorf("repository %s has no local clone", repoID)
+	}
+	data, err := os.ReadFile(filepath.Join(record.LocalPath, repo.ConfigFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", repo.ConfigFile, err)
+	}
+	cfg, err := repo.ParseConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Merge == nil {
+		return nil, fmt.Errorf("%s of %s defines no merge config", repo.ConfigFile, repoID)
+	}
+	adopted := &primary.RepoMergeConfig{Strategy: cfg.Merge.Strategy, Verify: cfg.Merge.Verify}
+	if err := s.SetMergeConfig(ctx, repoID, adopted); err != nil {
+		return nil, err
+	}
+	return adopted, nil
+}
+
 // Helper methods
 
+// cloneRepo clones req.URL into req.LocalPath, or the workspace repo path for req.Name.
+func (s *RepoServiceImpl) cloneRepo(ctx context.Context, req primary.CreateRepoRequest) (string, error) {
+	if s.gitService == nil || s.workspaceAdapter == nil {
+		return "", fmt.Errorf("cloning is not available")
+	}
+
+	targetPath := req.LocalPath
+	if targetPath == "" {
+		targetPath = s.workspaceAdapter.GetRepoPath(req.Name)
+	}
+	targetExists, err := s.workspaceAdapter.DirectoryExists(ctx, targetPath)
+	if err != nil {
+		return "", err
+	}
+
+	result := repo.CanCloneRepo(repo.CloneRepoContext{
+		Name:         req.Name,
+		URL:          req.URL,
+		TargetPath:   targetPath,
+		TargetExists: targetExists,
+	})
+	if err := result.Error(); err != nil {
+		return "", err
+	}
+
+	if err := s.workspaceAdapter.CreateDirectory(ctx, filepath.Dir(targetPath)); err != nil {
+		return "", err
+	}
+	if err := s.gitService.Clone(req.URL, targetPath); err != nil {
+		return "", err
+	}
+	return targetPath, nil
+}
+
 func (s *RepoServiceImpl) recordToRepo(r *secondary.RepoRecord) *primary.Repo {
+	bootstrap, _ := repo.ParseBootstrapSteps(r.BootstrapJSON)
+	sparsePaths, _ := coreworkbench.ParseSparsePaths(r.SparseJSON)
+	var merge *primary.RepoMergeConfig
+	if cfg, _ := repo.ParseMergeConfig(r.MergeJSON); cfg != nil {
+		merge = &primary.RepoMergeConfig{Strategy: cfg.Strategy, Verify: cfg.Verify}
+	}
 	return &primary.Repo{
 		ID:            r.ID,
 		Name:          r.Name,
 		URL:           r.URL,
 		LocalPath:     r.LocalPath,
 		DefaultBranch: r.DefaultBranch,
+		Bootstrap:     bootstrap,
+		SparsePaths:   sparsePaths,
+		Merge:         merge,
 		Status:        r.Status,
 		CreatedAt:     r.CreatedAt,
 		UpdatedAt:     r.UpdatedAt,
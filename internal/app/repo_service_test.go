@@ -3,6 +3,8 @@ package app
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/example/orc/internal/ports/primary"
@@ -100,12 +102,36 @@ func (m *mockRepoRepository) HasActivePRs(ctx context.Context, repoID string) (b
 	return m.hasActivePRs, nil
 }
 
+func (m *mockRepoRepository) UpdateBootstrap(ctx context.Context, id, bootstrapJSON string) error {
+	if r, ok := m.repos[id]; ok {
+		r.BootstrapJSON = bootstrapJSON
+		return nil
+	}
+	return fmt.Errorf("repository %s not found", id)
+}
+
+func (m *mockRepoRepository) UpdateSparse(ctx context.Context, id, sparseJSON string) error {
+	if r, ok := m.repos[id]; ok {
+		r.SparseJSON = sparseJSON
+		return nil
+	}
+	return fmt.Errorf("repository %s not found", id)
+}
+
+func (m *mockRepoRepository) UpdateMerge(ctx context.Context, id, mergeJSON string) error {
+	if r, ok := m.repos[id]; ok {
+		r.MergeJSON = mergeJSON
+		return nil
+	}
+	return fmt.Errorf("repository %s not found", id)
+}
+
 func TestRepoService_CreateRepo(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("creates repository with valid name", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		resp, err := svc.CreateRepo(ctx, primary.CreateRepoRequest{
 			Name:          "my-repo",
@@ -126,7 +152,7 @@ func TestRepoService_CreateRepo(t *testing.T) {
 
 	t.Run("fails with empty name", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		_, err := svc.CreateRepo(ctx, primary.CreateRepoRequest{
 			Name: "",
@@ -139,7 +165,7 @@ func TestRepoService_CreateRepo(t *testing.T) {
 
 	t.Run("fails with duplicate name", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		// Create first repo
 		_, err := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "duplicate"})
@@ -156,7 +182,7 @@ func TestRepoService_CreateRepo(t *testing.T) {
 
 	t.Run("uses default branch when not specified", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		resp, err := svc.CreateRepo(ctx, primary.CreateRepoRequest{
 			Name: "no-branch",
@@ -176,7 +202,7 @@ func TestRepoService_ArchiveRepo(t *testing.T) {
 
 	t.Run("archives active repository", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		// Create a repo
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "to-archive"})
@@ -195,7 +221,7 @@ func TestRepoService_ArchiveRepo(t *testing.T) {
 
 	t.Run("fails to archive already archived repository", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		// Create and archive a repo
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "already-archived"})
@@ -214,7 +240,7 @@ func TestRepoService_RestoreRepo(t *testing.T) {
 
 	t.Run("restores archived repository", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		// Create and archive a repo
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "to-restore"})
@@ -234,7 +260,7 @@ func TestRepoService_RestoreRepo(t *testing.T) {
 
 	t.Run("fails to restore active repository", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		// Create a repo (starts as active)
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "already-active"})
@@ -252,7 +278,7 @@ func TestRepoService_DeleteRepo(t *testing.T) {
 	t.Run("deletes repository with no active PRs", func(t *testing.T) {
 		repo := newMockRepoRepository()
 		repo.hasActivePRs = false
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		// Create a repo
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "to-delete"})
@@ -266,7 +292,7 @@ func TestRepoService_DeleteRepo(t *testing.T) {
 	t.Run("fails to delete repository with active PRs", func(t *testing.T) {
 		repo := newMockRepoRepository()
 		repo.hasActivePRs = true
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		// Create a repo
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "has-prs"})
@@ -283,7 +309,7 @@ func TestRepoService_GetRepoByName(t *testing.T) {
 
 	t.Run("finds repository by name", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		// Create a repo
 		_, _ = svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "find-me"})
@@ -299,7 +325,7 @@ func TestRepoService_GetRepoByName(t *testing.T) {
 
 	t.Run("returns error for non-existent name", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo, &mockTransactor{})
+		svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
 
 		_, err := svc.GetRepoByName(ctx, "non-existent")
 		if err == nil {
@@ -307,3 +333,212 @@ func TestRepoService_GetRepoByName(t *testing.T) {
 		}
 	})
 }
+
+func TestRepoService_SetBootstrapSteps(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepoRepository()
+	svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
+	resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "app"})
+
+	if err := svc.SetBootstrapSteps(ctx, resp.Repo.ID, []string{"npm ci", "cp .env.example .env"}); err != nil {
+		t.Fatalf("SetBootstrapSteps failed: %v", err)
+	}
+	got, _ := svc.GetRepo(ctx, resp.Repo.ID)
+	if len(got.Bootstrap) != 2 || got.Bootstrap[0] != "npm ci" {
+		t.Errorf("Bootstrap = %v, want the two steps in order", got.Bootstrap)
+	}
+
+	if err := svc.SetBootstrapSteps(ctx, resp.Repo.ID, []string{"  "}); err == nil {
+		t.Error("expected error for an empty step")
+	}
+
+	if err := svc.SetBootstrapSteps(ctx, resp.Repo.ID, nil); err != nil {
+		t.Fatalf("clearing steps failed: %v", err)
+	}
+	got, _ = svc.GetRepo(ctx, resp.Repo.ID)
+	if len(got.Bootstrap) != 0 {
+		t.Errorf("Bootstrap = %v, want cleared", got.Bootstrap)
+	}
+}
+
+func TestRepoService_AdoptBootstrapFile(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepoRepository()
+	svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
+	clone := t.TempDir()
+	resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "app", LocalPath: clone})
+
+	if _, err := svc.AdoptBootstrapFile(ctx, resp.Repo.ID); err == nil {
+		t.Error("expected error without .orc/repo.json")
+	}
+
+	if err := os.MkdirAll(filepath.Join(clone, ".orc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(clone, ".orc", "repo.json"), []byte(`{"bootstrap": ["npm ci"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	adopted, err := svc.AdoptBootstrapFile(ctx, resp.Repo.ID)
+	if err != nil {
+		t.Fatalf("AdoptBootstrapFile failed: %v", err)
+	}
+	got, _ := svc.GetRepo(ctx, resp.Repo.ID)
+	if len(adopted) != 1 || len(got.Bootstrap) != 1 || got.Bootstrap[0] != "npm ci" {
+		t.Errorf("adopted = %v, Bootstrap = %v, want [npm ci]", adopted, got.Bootstrap)
+	}
+}
+
+func TestRepoService_AdoptMergeFile(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepoRepository()
+	svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
+	clone := t.TempDir()
+	resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "app", LocalPath: clone})
+
+	if err := os.MkdirAll(filepath.Join(clone, ".orc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(clone, ".orc", "repo.json"), []byte(`{"bootstrap": ["npm ci"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.AdoptMergeFile(ctx, resp.Repo.ID); err == nil {
+		t.Error("expected error for a file without a merge config")
+	}
+
+	if err := os.WriteFile(filepath.Join(clone, ".orc", "repo.json"), []byte(`{"merge": {"strategy": "squash", "verify": ["make test"]}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	adopted, err := svc.AdoptMergeFile(ctx, resp.Repo.ID)
+	if err != nil {
+		t.Fatalf("AdoptMergeFile failed: %v", err)
+	}
+	got, _ := svc.GetRepo(ctx, resp.Repo.ID)
+	if adopted.Strategy != "squash" || got.Merge == nil || got.Merge.Strategy != "squash" || len(got.Merge.Verify) != 1 || got.Merge.Verify[0] != "make test" {
+		t.Errorf("adopted = %+v, Merge = %+v, want squash with make test", adopted, got.Merge)
+	}
+}
+
+func TestRepoService_SetSparsePaths(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepoRepository()
+	svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
+	resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "mono"})
+
+	if err := svc.SetSparsePaths(ctx, resp.Repo.ID, []string{"services/web", "services/api/"}); err != nil {
+		t.Fatalf("SetSparsePaths failed: %v", err)
+	}
+	got, _ := svc.GetRepo(ctx, resp.Repo.ID)
+	if len(got.SparsePaths) != 2 || got.SparsePaths[0] != "services/api" {
+		t.Errorf("SparsePaths = %v, want the two paths, cleaned and sorted", got.SparsePaths)
+	}
+
+	if err := svc.SetSparsePaths(ctx, resp.Repo.ID, []string{"/abs"}); err == nil {
+		t.Error("expected error for an absolute path")
+	}
+
+	if err := svc.SetSparsePaths(ctx, resp.Repo.ID, nil); err != nil {
+		t.Fatalf("clearing paths failed: %v", err)
+	}
+	got, _ = svc.GetRepo(ctx, resp.Repo.ID)
+	if len(got.SparsePaths) != 0 {
+		t.Errorf("SparsePaths = %v, want cleared", got.SparsePaths)
+	}
+}
+
+func TestRepoService_SetMergeConfig(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepoRepository()
+	svc := NewRepoService(repo, &mockTransactor{}, nil, nil)
+	resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "solo"})
+
+	cfg := &primary.RepoMergeConfig{Strategy: primary.MergeStrategySquash, Verify: []string{"make test"}}
+	if err := svc.SetMergeConfig(ctx, resp.Repo.ID, cfg); err != nil {
+		t.Fatalf("SetMergeConfig failed: %v", err)
+	}
+	got, _ := svc.GetRepo(ctx, resp.Repo.ID)
+	if got.Merge == nil || got.Merge.Strategy != "squash" || len(got.Merge.Verify) != 1 {
+		t.Errorf("Merge = %+v, want the squash config", got.Merge)
+	}
+
+	if err := svc.SetMergeConfig(ctx, resp.Repo.ID, &primary.RepoMergeConfig{Strategy: "octopus"}); err == nil {
+		t.Error("expected error for an unknown strategy")
+	}
+
+	if err := svc.SetMergeConfig(ctx, resp.Repo.ID, nil); err != nil {
+		t.Fatalf("clearing config failed: %v", err)
+	}
+	got, _ = svc.GetRepo(ctx, resp.Repo.ID)
+	if got.Merge != nil {
+		t.Errorf("Merge = %+v, want cleared", got.Merge)
+	}
+}
+
+func TestRepoService_CloneAndHealth(t *testing.T) {
+	home := setupGitHome(t)
+	ctx := context.Background()
+
+	// Upstream repo whose default branch is not main
+	upstream := filepath.Join(home, "upstream", "api")
+	if err := os.MkdirAll(upstream, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, upstream, "init", "-q", "-b", "trunk")
+	commitFile(t, upstream, "README.md", "api\n")
+
+	repos := newMockRepoRepository()
+	workspace := newMockWorkspaceAdapter()
+	workspace.reposBasePath = filepath.Join(home, "src")
+	svc := NewRepoService(repos, &mockTransactor{}, workspace, NewGitService())
+
+	resp, err := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "api", URL: upstream, Clone: true})
+	if err != nil {
+		t.Fatalf("CreateRepo failed: %v", err)
+	}
+	clonePath := filepath.Join(home, "src", "api")
+	if resp.Repo.LocalPath != clonePath || resp.Repo.DefaultBranch != "trunk" {
+		t.Fatalf("expected clone at %s on trunk, got %+v", clonePath, resp.Repo)
+	}
+
+	t.Run("refuses to clone over an existing directory", func(t *testing.T) {
+		_, err := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "api-copy", URL: upstream, LocalPath: clonePath, Clone: true})
+		if err == nil {
+			t.Fatal("expected error cloning into an existing directory")
+		}
+		if r, _ := repos.GetByName(ctx, "api-copy"); r != nil {
+			t.Error("expected no ledger record after a refused clone")
+		}
+	})
+
+	t.Run("reports worktrees, dirty files and fetch drift", func(t *testing.T) {
+		workspace.listWorktrees = map[string][]secondary.WorktreeInfo{clonePath: {
+			{Path: filepath.Join(home, "wb", "api-001"), Branch: "ml/home"},
+			{Path: filepath.Join(home, "wb", "api-gone"), Prunable: true},
+		}}
+		if err := os.WriteFile(filepath.Join(clonePath, "scratch.txt"), []byte("wip\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		commitFile(t, upstream, "CHANGELOG.md", "v2\n")
+
+		health, err := svc.GetRepoHealth(ctx, resp.RepoID)
+		if err != nil {
+			t.Fatalf("GetRepoHealth failed: %v", err)
+		}
+		if !health.Cloned || health.DetectedDefaultBranch != "trunk" || health.Worktrees != 1 || health.DirtyFiles != 1 {
+			t.Errorf("unexpected health: %+v", health)
+		}
+		if health.LastFetchedAt != "" || health.Behind != 0 {
+			t.Errorf("expected a never-fetched clone that is not behind yet, got %+v", health)
+		}
+
+		health, err = svc.FetchRepo(ctx, resp.RepoID)
+		if err != nil {
+			t.Fatalf("FetchRepo failed: %v", err)
+		}
+		if health.LastFetchedAt == "" || health.Behind != 1 {
+			t.Errorf("expected a fresh fetch one commit ahead of local trunk, got %+v", health)
+		}
+		if len(health.Issues) != 2 {
+			t.Errorf("expected behind and dirty issues, got %q", health.Issues)
+		}
+	})
+}
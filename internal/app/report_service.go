@@ -0,0 +1,292 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/example/orc/internal/models"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ReportServiceImpl implements the ReportService interface.
+type ReportServiceImpl struct {
+	shipmentService  primary.ShipmentService
+	noteService      primary.NoteService
+	planService      primary.PlanService
+	prService        primary.PRService
+	workbenchService primary.WorkbenchService
+	repoService      primary.RepoService
+	gitService       *GitService
+	templates        secondary.TemplateLoader
+}
+
+// NewReportService creates a new ReportService with injected dependencies.
+func NewReportService(
+	shipmentService primary.ShipmentService,
+	noteService primary.NoteService,
+	planService primary.PlanService,
+	prService primary.PRService,
+	workbenchService primary.WorkbenchService,
+	repoService primary.RepoService,
+	gitService *GitService,
+	templates secondary.TemplateLoader,
+) *ReportServiceImpl {
+	return &ReportServiceImpl{
+		shipmentService:  shipmentService,
+		noteService:      noteService,
+		planService:      planService,
+		prService:        prService,
+		workbenchService: workbenchService,
+		repoService:      repoService,
+		gitService:       gitService,
+		templates:        templates,
+	}
+}
+
+// GetShipmentReport gathers the data for a shipment report.
+func (s *ReportServiceImpl) GetShipmentReport(ctx context.Context, shipmentID string) (*primary.ShipmentReport, error) {
+	shipment, err := s.shipmentService.GetShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipment: %w", err)
+	}
+	if shipment == nil {
+		return nil, fmt.Errorf("shipment %s not found", shipmentID)
+	}
+
+	report := &primary.ShipmentReport{
+		Shipment:    shipment,
+		GeneratedAt: time.Now().Format(time.RFC3339),
+	}
+
+	// Notes: the first open spec plus all decisions
+	notes, err := s.noteService.GetNotesByContainer(ctx, "shipment", shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipment notes: %w", err)
+	}
+	for _, note := range notes {
+		switch note.Type {
+		case models.NoteTypeSpec:
+			if report.Spec == nil && note.Status != "closed" {
+				report.Spec = note
+			}
+		case models.NoteTypeDecision:
+			report.Decisions = append(report.Decisions, note)
+		}
+	}
+
+	// Tasks with completion state, and the plans attached to them
+	tasks, err := s.shipmentService.GetShipmentTasks(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipment tasks: %w", err)
+	}
+	for _, task := range tasks {
+		completed := task.Status == models.TaskStatusClosed
+		report.Tasks = append(report.Tasks, &primary.ReportTask{
+			ID:        task.ID,
+			Title:     task.Title,
+			Type:      task.Type,
+			Status:    task.Status,
+			Completed: completed,
+		})
+		if completed {
+			report.TasksCompleted++
+		}
+
+		plans, err := s.planService.ListPlans(ctx, primary.PlanFilters{TaskID: task.ID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get plans for %s: %w", task.ID, err)
+		}
+		report.Plans = append(report.Plans, plans...)
+	}
+	report.TasksTotal = len(report.Tasks)
+
+	// PRs are optional; a shipment without PRs is still reported
+	if prs, err := s.prService.ListPRs(ctx, primary.PRFilters{ShipmentID: shipmentID}); err == nil {
+		sort.SliceStable(prs, func(i, j int) bool { return prs[i].CreatedAt < prs[j].CreatedAt })
+		report.PRs = prs
+	}
+
+	s.collectCommits(ctx, report)
+
+	return report, nil
+}
+
+// collectCommits fills in commits on the shipment branch of each repository.
+// Git state is best-effort: a report is still useful when a branch is not available locally.
+func (s *ReportServiceImpl) collectCommits(ctx context.Context, report *primary.ShipmentReport) {
+	shipment := report.Shipment
+	if s.gitService == nil {
+		return
+	}
+
+	repos, err := s.shipmentService.ListShipmentRepos(ctx, shipment.ID)
+	if err != nil || len(repos) == 0 {
+		repos = []*primary.ShipmentRepo{{
+			ShipmentID:          shipment.ID,
+			RepoID:              shipment.RepoID,
+			Branch:              shipment.Branch,
+			AssignedWorkbenchID: shipment.AssignedWorkbenchID,
+			Primary:             true,
+		}}
+	}
+	for _, repo := range repos {
+		if r := s.collectRepoCommits(ctx, report, repo); r != nil {
+			report.Repos = append(report.Repos, r)
+		}
+	}
+}
+
+// collectRepoCommits lists the commits on one repository's shipment branch, preferring
+// its workbench over the repo clone. Returns nil when no checkout has the branch.
+func (s *ReportServiceImpl) collectRepoCommits(ctx context.Context, report *primary.ShipmentReport, shipmentRepo *primary.ShipmentRepo) *primary.ReportRepo {
+	if shipmentRepo.Branch == "" {
+		return nil
+	}
+
+	var repoPath, baseBranch string
+	if shipmentRepo.RepoID != "" {
+		if repo, err := s.repoService.GetRepo(ctx, shipmentRepo.RepoID); err == nil && repo != nil {
+			repoPath = repo.LocalPath
+			baseBranch = repo.DefaultBranch
+		}
+	}
+	if shipmentRepo.AssignedWorkbenchID != "" {
+		if wb, err := s.workbenchService.GetWorkbench(ctx, shipmentRepo.AssignedWorkbenchID); err == nil && wb != nil && wb.Path != "" {
+			repoPath = wb.Path
+		}
+	}
+	if repoPath == "" {
+		return nil
+	}
+	for _, pr := range report.PRs {
+		if pr.RepoID == shipmentRepo.RepoID && pr.TargetBranch != "" {
+			baseBranch = pr.TargetBranch
+		}
+	}
+	if baseBranch == "" {
+		baseBranch, _ = s.gitService.GetDefaultBranch(repoPath)
+	}
+
+	commits, err := s.gitService.ListCommits(repoPath, baseBranch, shipmentRepo.Branch)
+	if err != nil {
+		return nil
+	}
+	result := &primary.ReportRepo{
+		RepoID:     shipmentRepo.RepoID,
+		Branch:     shipmentRepo.Branch,
+		BaseBranch: baseBranch,
+	}
+	for _, c := range commits {
+		result.Commits = append(result.Commits, primary.ReportCommit{
+			Hash:    c.Hash,
+			Author:  c.Author,
+			Date:    c.Date,
+			Subject: c.Subject,
+		})
+	}
+	return result
+}
+
+// RenderShipmentReport renders a shipment report in the requested format.
+func (s *ReportServiceImpl) RenderShipmentReport(ctx context.Context, req primary.RenderReportRequest) (string, error) {
+	format := req.Format
+	if format == "" {
+		format = primary.ReportFormatMarkdown
+	}
+
+	var ext string
+	switch format {
+	case primary.ReportFormatMarkdown:
+		ext = "md"
+	case primary.ReportFormatHTML:
+		ext = "html"
+	case primary.ReportFormatJSON:
+		if req.TemplatePath != "" {
+			return "", fmt.Errorf("templates are not supported for json output")
+		}
+	default:
+		return "", fmt.Errorf("unsupported report format %q (must be markdown, json, or html)", format)
+	}
+
+	report, err := s.GetShipmentReport(ctx, req.ShipmentID)
+	if err != nil {
+		return "", err
+	}
+
+	if format == primary.ReportFormatJSON {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to encode report: %w", err)
+		}
+		return string(data) + "\n", nil
+	}
+
+	text, err := s.loadTemplate(ctx, ext, req.TemplatePath)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if format == primary.ReportFormatHTML {
+		tmpl, err := htmltemplate.New("report").Parse(text)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse report template: %w", err)
+		}
+		if err := tmpl.Execute(&buf, report); err != nil {
+			return "", fmt.Errorf("failed to render report: %w", err)
+		}
+		return buf.String(), nil
+	}
+
+	tmpl, err := template.New("report").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse report template: %w", err)
+	}
+	if err := tmpl.Execute(&buf, report); err != nil {
+		return "", fmt.Errorf("failed to render report: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// RenderPRBody renders the pull request description for a shipment, using the
+// user's pr-body.md.tmpl override when present.
+func (s *ReportServiceImpl) RenderPRBody(ctx context.Context, shipmentID string) (string, error) {
+	report, err := s.GetShipmentReport(ctx, shipmentID)
+	if err != nil {
+		return "", err
+	}
+
+	text, err := s.templates.LoadTemplate(ctx, "pr-body.md.tmpl")
+	if err != nil {
+		return "", err
+	}
+
+	tmpl, err := template.New("pr-body").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse PR body template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, report); err != nil {
+		return "", fmt.Errorf("failed to render PR body: %w", err)
+	}
+	return strings.TrimSpace(buf.String()) + "\n", nil
+}
+
+// loadTemplate resolves the report template: explicit path, then user override, then built-in default.
+func (s *ReportServiceImpl) loadTemplate(ctx context.Context, ext, templatePath string) (string, error) {
+	if templatePath != "" {
+		return s.templates.ReadTemplateFile(ctx, templatePath)
+	}
+	return s.templates.LoadTemplate(ctx, "shipment-report."+ext+".tmpl")
+}
+
+// Ensure ReportServiceImpl implements the interface
+var _ primary.ReportService = (*ReportServiceImpl)(nil)
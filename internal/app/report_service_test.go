@@ -0,0 +1,358 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func newTestReportService(templates map[string]string) (*ReportServiceImpl, *mockShipmentServiceForSummary, *mockPRRepository, *mockRepoRepository) {
+	shipments := newMockShipmentServiceForSummary()
+	notes := newMockNoteServiceForShipment()
+	plans := newMockPlanRepository()
+	prs := newMockPRRepository()
+	repos := newMockRepoRepository()
+
+	shipments.shipments["SHIP-001"] = &primary.Shipment{
+		ID:           "SHIP-001",
+		CommissionID: "COMM-001",
+		Title:        "OAuth2 integration",
+		Description:  "Add OAuth2 login",
+		Status:       "implementing",
+	}
+	shipments.shipmentTasks["SHIP-001"] = []*primary.Task{
+		{ID: "TASK-001", Title: "Design schema", Status: "closed"},
+		{ID: "TASK-002", Title: "Implement store", Status: "open"},
+	}
+	notes.containerNotes["shipment:SHIP-001"] = []*primary.Note{
+		{ID: "NOTE-001", Title: "Spec", Content: "Users can sign in with OAuth2.", Type: "spec", Status: "open"},
+		{ID: "NOTE-002", Title: "Use PKCE", Content: "Public clients cannot keep secrets.", Type: "decision", Status: "open"},
+		{ID: "NOTE-003", Title: "Old idea", Type: "idea", Status: "open"},
+	}
+	plans.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", TaskID: "TASK-001", Title: "Schema plan", Status: "approved"}
+
+	prService := NewPRService(prs, shipments, &mockTransactor{}, nil, nil, nil, nil, nil, nil)
+	service := NewReportService(
+		shipments,
+		notes,
+		NewPlanService(plans, &mockTransactor{}),
+		prService,
+		newMockWorkbenchServiceForSummary(),
+		NewRepoService(repos, &mockTransactor{}, nil, nil),
+		NewGitService(),
+		&mockTemplateLoader{overrides: templates},
+	)
+	return service, shipments, prs, repos
+}
+
+// mockTemplateLoader implements secondary.TemplateLoader with the built-in
+// templates from internal/templates and in-memory overrides.
+type mockTemplateLoader struct {
+	overrides map[string]string // Template name or explicit path -> content
+}
+
+func (m *mockTemplateLoader) LoadTemplate(ctx context.Context, name string) (string, error) {
+	if content, ok := m.overrides[name]; ok {
+		return content, nil
+	}
+	content, err := os.ReadFile(filepath.Join("..", "templates", "report", strings.Replace(name, "shipment-report", "shipment", 1)))
+	return string(content), err
+}
+
+func (m *mockTemplateLoader) ReadTemplateFile(ctx context.Context, path string) (string, error) {
+	if content, ok := m.overrides[path]; ok {
+		return content, nil
+	}
+	return "", fmt.Errorf("failed to read template: open %s: no such file or directory", path)
+}
+
+func TestReportService_GetShipmentReport(t *testing.T) {
+	service, _, prs, _ := newTestReportService(nil)
+	prs.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", Branch: "ml/SHIP-001-oauth", Status: "open"}
+
+	report, err := service.GetShipmentReport(context.Background(), "SHIP-001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Spec == nil || report.Spec.ID != "NOTE-001" {
+		t.Errorf("expected spec NOTE-001, got %+v", report.Spec)
+	}
+	if len(report.Decisions) != 1 || report.Decisions[0].ID != "NOTE-002" {
+		t.Errorf("expected decision NOTE-002, got %+v", report.Decisions)
+	}
+	if report.TasksTotal != 2 || report.TasksCompleted != 1 {
+		t.Errorf("expected 1/2 tasks completed, got %d/%d", report.TasksCompleted, report.TasksTotal)
+	}
+	if !report.Tasks[0].Completed || report.Tasks[1].Completed {
+		t.Errorf("unexpected completion state: %+v, %+v", report.Tasks[0], report.Tasks[1])
+	}
+	if len(report.Plans) != 1 || report.Plans[0].ID != "PLAN-001" {
+		t.Errorf("expected plan PLAN-001, got %+v", report.Plans)
+	}
+	if len(report.PRs) != 1 || report.PRs[0].ID != "PR-001" {
+		t.Errorf("expected PR-001, got %+v", report.PRs)
+	}
+	if len(report.Repos) != 0 {
+		t.Errorf("expected no commits without a branch, got %+v", report.Repos)
+	}
+}
+
+func TestReportService_GetShipmentReport_NotFound(t *testing.T) {
+	service, _, _, _ := newTestReportService(nil)
+
+	_, err := service.GetShipmentReport(context.Background(), "SHIP-999")
+	if err == nil {
+		t.Fatal("expected error for missing shipment")
+	}
+}
+
+func TestReportService_GetShipmentReport_Commits(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	git("init", "-q", "-b", "main")
+	git("commit", "-q", "--allow-empty", "-m", "initial")
+	git("checkout", "-q", "-b", "ml/SHIP-001-oauth")
+	git("commit", "-q", "--allow-empty", "-m", "Add token store")
+	git("commit", "-q", "--allow-empty", "-m", "Wire up login")
+
+	service, shipments, _, repos := newTestReportService(nil)
+	repos.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "orc", LocalPath: dir, DefaultBranch: "main", Status: "active"}
+	ship := shipments.shipments["SHIP-001"]
+	ship.RepoID = "REPO-001"
+	ship.Branch = "ml/SHIP-001-oauth"
+
+	report, err := service.GetShipmentReport(context.Background(), "SHIP-001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Repos) != 1 {
+		t.Fatalf("expected 1 repo, got %d", len(report.Repos))
+	}
+	repo := report.Repos[0]
+	if repo.BaseBranch != "main" {
+		t.Errorf("expected base branch main, got %q", repo.BaseBranch)
+	}
+	if len(repo.Commits) != 2 {
+		t.Fatalf("expected 2 commits, got %d", len(repo.Commits))
+	}
+	if repo.Commits[0].Subject != "Add token store" || repo.Commits[1].Subject != "Wire up login" {
+		t.Errorf("unexpected commits: %+v", repo.Commits)
+	}
+}
+
+func TestReportService_GetShipmentReport_MultiRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	newRepo := func(subject string) string {
+		dir := t.TempDir()
+		git := func(args ...string) {
+			t.Helper()
+			cmd := exec.Command("git", append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+			cmd.Dir = dir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("git %v: %v\n%s", args, err, out)
+			}
+		}
+		git("init", "-q", "-b", "main")
+		git("commit", "-q", "--allow-empty", "-m", "initial")
+		git("checkout", "-q", "-b", "ml/SHIP-001-oauth")
+		git("commit", "-q", "--allow-empty", "-m", subject)
+		return dir
+	}
+
+	service, shipments, prs, repos := newTestReportService(nil)
+	repos.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "api", LocalPath: newRepo("Add token endpoint"), DefaultBranch: "main", Status: "active"}
+	repos.repos["REPO-002"] = &secondary.RepoRecord{ID: "REPO-002", Name: "web", LocalPath: newRepo("Add login button"), DefaultBranch: "main", Status: "active"}
+	ship := shipments.shipments["SHIP-001"]
+	ship.RepoID = "REPO-001"
+	ship.Branch = "ml/SHIP-001-oauth"
+	shipments.shipmentRepos["SHIP-001"] = []*primary.ShipmentRepo{
+		{ShipmentID: "SHIP-001", RepoID: "REPO-001", Branch: "ml/SHIP-001-oauth", Primary: true},
+		{ShipmentID: "SHIP-001", RepoID: "REPO-002", Branch: "ml/SHIP-001-oauth"},
+	}
+	prs.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", RepoID: "REPO-001", Branch: "ml/SHIP-001-oauth", Status: "open", CreatedAt: "2026-01-01 10:00:00"}
+	prs.prs["PR-002"] = &secondary.PRRecord{ID: "PR-002", ShipmentID: "SHIP-001", RepoID: "REPO-002", Branch: "ml/SHIP-001-oauth", Status: "open", CreatedAt: "2026-01-01 11:00:00"}
+
+	report, err := service.GetShipmentReport(context.Background(), "SHIP-001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.PRs) != 2 || report.PRs[0].ID != "PR-001" || report.PRs[1].ID != "PR-002" {
+		t.Errorf("expected PR-001 and PR-002, got %+v", report.PRs)
+	}
+	if len(report.Repos) != 2 || report.Repos[1].RepoID != "REPO-002" || len(report.Repos[1].Commits) != 1 {
+		t.Fatalf("expected commits from both repos, got %+v", report.Repos)
+	}
+
+	out, err := service.RenderShipmentReport(context.Background(), primary.RenderReportRequest{ShipmentID: "SHIP-001"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"### Commits in REPO-001 since `main`",
+		"Add token endpoint",
+		"### Commits in REPO-002 since `main`",
+		"Add login button",
+		"### Pull Requests",
+		"- PR-001 (REPO-001)",
+		"- PR-002 (REPO-002)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected markdown to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestReportService_RenderShipmentReport_Markdown(t *testing.T) {
+	service, _, _, _ := newTestReportService(nil)
+
+	out, err := service.RenderShipmentReport(context.Background(), primary.RenderReportRequest{ShipmentID: "SHIP-001"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"## OAuth2 integration",
+		"Users can sign in with OAuth2.",
+		"**Use PKCE** (NOTE-002)",
+		"### Tasks (1/2)",
+		"- [x] TASK-001: Design schema",
+		"- [ ] TASK-002: Implement store",
+		"PLAN-001 (TASK-001): Schema plan",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected markdown to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Old idea") {
+		t.Error("expected non-spec, non-decision notes to be omitted")
+	}
+}
+
+func TestReportService_RenderShipmentReport_JSON(t *testing.T) {
+	service, _, _, _ := newTestReportService(nil)
+
+	out, err := service.RenderShipmentReport(context.Background(), primary.RenderReportRequest{
+		ShipmentID: "SHIP-001",
+		Format:     primary.ReportFormatJSON,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var report primary.ShipmentReport
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("expected valid JSON: %v", err)
+	}
+	if report.Shipment.ID != "SHIP-001" || report.TasksTotal != 2 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+}
+
+func TestReportService_RenderShipmentReport_HTMLEscapes(t *testing.T) {
+	service, shipments, _, _ := newTestReportService(nil)
+	shipments.shipments["SHIP-001"].Title = "<script>alert(1)</script>"
+
+	out, err := service.RenderShipmentReport(context.Background(), primary.RenderReportRequest{
+		ShipmentID: "SHIP-001",
+		Format:     primary.ReportFormatHTML,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(out, "<script>") {
+		t.Error("expected HTML output to escape shipment title")
+	}
+}
+
+func TestReportService_RenderShipmentReport_Templates(t *testing.T) {
+	service, _, _, _ := newTestReportService(map[string]string{
+		"shipment-report.md.tmpl": "override: {{ .Shipment.ID }}",
+		"custom.tmpl":             "custom: {{ .TasksCompleted }}/{{ .TasksTotal }}",
+	})
+	ctx := context.Background()
+
+	out, err := service.RenderShipmentReport(ctx, primary.RenderReportRequest{ShipmentID: "SHIP-001"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != "override: SHIP-001" {
+		t.Errorf("expected user override template, got %q", out)
+	}
+
+	out, err = service.RenderShipmentReport(ctx, primary.RenderReportRequest{ShipmentID: "SHIP-001", TemplatePath: "custom.tmpl"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != "custom: 1/2" {
+		t.Errorf("expected explicit template to win, got %q", out)
+	}
+}
+
+func TestReportService_RenderShipmentReport_InvalidFormat(t *testing.T) {
+	service, _, _, _ := newTestReportService(nil)
+	ctx := context.Background()
+
+	_, err := service.RenderShipmentReport(ctx, primary.RenderReportRequest{ShipmentID: "SHIP-001", Format: "pdf"})
+	if err == nil || !strings.Contains(err.Error(), "unsupported report format") {
+		t.Errorf("expected unsupported format error, got %v", err)
+	}
+
+	_, err = service.RenderShipmentReport(ctx, primary.RenderReportRequest{ShipmentID: "SHIP-001", Format: "json", TemplatePath: "x.tmpl"})
+	if err == nil {
+		t.Error("expected error when combining json with a template")
+	}
+}
+
+func TestReportService_RenderPRBody(t *testing.T) {
+	service, _, _, _ := newTestReportService(nil)
+	ctx := context.Background()
+
+	out, err := service.RenderPRBody(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"Add OAuth2 login",
+		"## Spec\n\nUsers can sign in with OAuth2.",
+		"## Tasks (1/2)",
+		"- [x] TASK-001: Design schema",
+		"_Shipment SHIP-001 · COMM-001_",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected PR body to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Use PKCE") || strings.Contains(out, "OAuth2 integration") {
+		t.Errorf("expected PR body to omit decisions and the title, got:\n%s", out)
+	}
+
+	service, _, _, _ = newTestReportService(map[string]string{"pr-body.md.tmpl": "Closes {{ .Shipment.ID }}"})
+	out, err = service.RenderPRBody(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != "Closes SHIP-001\n" {
+		t.Errorf("expected user override template, got %q", out)
+	}
+}
@@ -13,24 +13,33 @@ import (
 
 // ShipmentServiceImpl implements the ShipmentService interface.
 type ShipmentServiceImpl struct {
-	shipmentRepo secondary.ShipmentRepository
-	taskRepo     secondary.TaskRepository
-	noteService  primary.NoteService
-	transactor   secondary.Transactor
+	shipmentRepo      secondary.ShipmentRepository
+	taskRepo          secondary.TaskRepository
+	noteRepo          secondary.NoteRepository
+	noteService       primary.NoteService
+	commissionService primary.CommissionService // Optional: keeps commission status in sync with shipments
+	workbenchService  primary.WorkbenchService  // Optional: creates shipment branches on assignment
+	transactor        secondary.Transactor
 }
 
 // NewShipmentService creates a new ShipmentService with injected dependencies.
 func NewShipmentService(
 	shipmentRepo secondary.ShipmentRepository,
 	taskRepo secondary.TaskRepository,
+	noteRepo secondary.NoteRepository,
 	noteService primary.NoteService,
+	commissionService primary.CommissionService,
+	workbenchService primary.WorkbenchService,
 	transactor secondary.Transactor,
 ) *ShipmentServiceImpl {
 	return &ShipmentServiceImpl{
-		shipmentRepo: shipmentRepo,
-		taskRepo:     taskRepo,
-		noteService:  noteService,
-		transactor:   transactor,
+		shipmentRepo:      shipmentRepo,
+		taskRepo:          taskRepo,
+		noteRepo:          noteRepo,
+		noteService:       noteService,
+		commissionService: commissionService,
+		workbenchService:  workbenchService,
+		transactor:        transactor,
 	}
 }
 
@@ -92,7 +101,7 @@ func (s *ShipmentServiceImpl) CreateShipment(ctx context.Context, req primary.Cr
 
 	return &primary.CreateShipmentResponse{
 		ShipmentID: created.ID,
-		Shipment:   s.recordToShipment(created),
+		Shipment:   recordToShipment(created),
 	}, nil
 }
 
@@ -102,7 +111,7 @@ func (s *ShipmentServiceImpl) GetShipment(ctx context.Context, shipmentID string
 	if err != nil {
 		return nil, err
 	}
-	return s.recordToShipment(record), nil
+	return recordToShipment(record), nil
 }
 
 // ListShipments lists shipments with optional filters.
@@ -117,7 +126,7 @@ func (s *ShipmentServiceImpl) ListShipments(ctx context.Context, filters primary
 
 	shipments := make([]*primary.Shipment, len(records))
 	for i, r := range records {
-		shipments[i] = s.recordToShipment(r)
+		shipments[i] = recordToShipment(r)
 	}
 	return shipments, nil
 }
@@ -125,16 +134,16 @@ func (s *ShipmentServiceImpl) ListShipments(ctx context.Context, filters primary
 // CloseShipment marks a shipment as closed.
 // If force is true, closes even if tasks are not closed.
 // Closes any type=spec notes attached to this shipment with reason "resolved".
-func (s *ShipmentServiceImpl) CloseShipment(ctx context.Context, shipmentID string, force bool) error {
+func (s *ShipmentServiceImpl) CloseShipment(ctx context.Context, shipmentID string, force bool) (*primary.CommissionLifecycleResult, error) {
 	record, err := s.shipmentRepo.GetByID(ctx, shipmentID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Get tasks for this shipment
 	taskRecords, err := s.taskRepo.List(ctx, secondary.TaskFilters{ShipmentID: shipmentID})
 	if err != nil {
-		return fmt.Errorf("failed to get tasks for shipment: %w", err)
+		return nil, fmt.Errorf("failed to get tasks for shipment: %w", err)
 	}
 
 	// Build task summaries for guard
@@ -146,20 +155,46 @@ func (s *ShipmentServiceImpl) CloseShipment(ctx context.Context, shipmentID stri
 		}
 	}
 
+	// Get PRs for this shipment, plus an empty summary for each repo it spans without one
+	prRecords, err := s.shipmentRepo.ListPRSummaries(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PRs for shipment: %w", err)
+	}
+	repos, err := s.ListShipmentRepos(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	prs := make([]coreshipment.PRSummary, 0, len(repos))
+	hasPR := make(map[string]bool, len(prRecords))
+	for _, p := range prRecords {
+		prs = append(prs, coreshipment.PRSummary{
+			ID:     p.ID,
+			RepoID: p.RepoID,
+			Status: p.Status,
+		})
+		hasPR[p.RepoID] = true
+	}
+	for _, repo := range repos {
+		if !hasPR[repo.RepoID] {
+			prs = append(prs, coreshipment.PRSummary{RepoID: repo.RepoID})
+		}
+	}
+
 	// Guard: check all close preconditions
 	guardCtx := coreshipment.CloseShipmentContext{
 		ShipmentID:      shipmentID,
 		IsPinned:        record.Pinned,
 		Tasks:           tasks,
+		PRs:             prs,
 		ForceCompletion: force,
 	}
 	if result := coreshipment.CanCloseShipment(guardCtx); !result.Allowed {
-		return result.Error()
+		return nil, result.Error()
 	}
 
 	// Update shipment status to closed
 	if err := s.shipmentRepo.UpdateStatus(ctx, shipmentID, "closed", true); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Close any spec notes attached to this shipment
@@ -167,7 +202,6 @@ func (s *ShipmentServiceImpl) CloseShipment(ctx context.Context, shipmentID stri
 		notes, err := s.noteService.GetNotesByContainer(ctx, "shipment", shipmentID)
 		if err != nil {
 			fmt.Printf("Warning: failed to query notes for shipment %s: %v\n", shipmentID, err)
-			return nil
 		}
 		for _, note := range notes {
 			if note.Type == "spec" && note.Status != "closed" {
@@ -182,11 +216,11 @@ func (s *ShipmentServiceImpl) CloseShipment(ctx context.Context, shipmentID stri
 		}
 	}
 
-	return nil
+	return syncCommissionLifecycle(ctx, s.commissionService, record.CommissionID), nil
 }
 
 // CompleteShipment is an alias for CloseShipment for backwards compatibility.
-func (s *ShipmentServiceImpl) CompleteShipment(ctx context.Context, shipmentID string, force bool) error {
+func (s *ShipmentServiceImpl) CompleteShipment(ctx context.Context, shipmentID string, force bool) (*primary.CommissionLifecycleResult, error) {
 	return s.CloseShipment(ctx, shipmentID, force)
 }
 
@@ -203,15 +237,21 @@ func (s *ShipmentServiceImpl) UpdateShipment(ctx context.Context, req primary.Up
 
 // UpdateStatus sets a shipment's status directly.
 func (s *ShipmentServiceImpl) UpdateStatus(ctx context.Context, shipmentID, status string) error {
-	return s.shipmentRepo.UpdateStatus(ctx, shipmentID, status, false)
+	if err := s.shipmentRepo.UpdateStatus(ctx, shipmentID, status, false); err != nil {
+		return err
+	}
+	if record, err := s.shipmentRepo.GetByID(ctx, shipmentID); err == nil {
+		_ = syncCommissionLifecycle(ctx, s.commissionService, record.CommissionID)
+	}
+	return nil
 }
 
 // SetStatus sets a shipment's status with escape hatch protection.
 // If force is true, allows backwards transitions.
-func (s *ShipmentServiceImpl) SetStatus(ctx context.Context, shipmentID, status string, force bool) error {
+func (s *ShipmentServiceImpl) SetStatus(ctx context.Context, shipmentID, status string, force bool) (*primary.CommissionLifecycleResult, error) {
 	record, err := s.shipmentRepo.GetByID(ctx, shipmentID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Guard: check for backwards transitions
@@ -222,13 +262,17 @@ func (s *ShipmentServiceImpl) SetStatus(ctx context.Context, shipmentID, status
 		Force:         force,
 	}
 	if result := coreshipment.CanOverrideStatus(guardCtx); !result.Allowed {
-		return result.Error()
+		return nil, result.Error()
 	}
 
 	// Set completed flag if transitioning to closed
 	setCompleted := status == "closed"
 
-	return s.shipmentRepo.UpdateStatus(ctx, shipmentID, status, setCompleted)
+	if err := s.shipmentRepo.UpdateStatus(ctx, shipmentID, status, setCompleted); err != nil {
+		return nil, err
+	}
+
+	return syncCommissionLifecycle(ctx, s.commissionService, record.CommissionID), nil
 }
 
 // PinShipment pins a shipment.
@@ -242,29 +286,207 @@ func (s *ShipmentServiceImpl) UnpinShipment(ctx context.Context, shipmentID stri
 }
 
 // AssignShipmentToWorkbench assigns a shipment to a workbench.
-func (s *ShipmentServiceImpl) AssignShipmentToWorkbench(ctx context.Context, shipmentID, workbenchID string) error {
+// When the workbench is linked to a repo, the shipment branch is created from the
+// default branch and checked out there. If that fails (for example because the worktree
+// does not exist yet), the assignment is recorded anyway and a warning returned.
+// A workbench on one of the shipment's additional repos is assigned to that repo only;
+// tasks follow the workbench of the primary repo.
+func (s *ShipmentServiceImpl) AssignShipmentToWorkbench(ctx context.Context, shipmentID, workbenchID string) (*primary.AssignShipmentResponse, error) {
 	// Verify shipment exists
-	_, err := s.shipmentRepo.GetByID(ctx, shipmentID)
+	record, err := s.shipmentRepo.GetByID(ctx, shipmentID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check if workbench is already assigned to another shipment
 	otherShipmentID, err := s.shipmentRepo.WorkbenchAssignedToOther(ctx, workbenchID, shipmentID)
 	if err != nil {
-		return fmt.Errorf("failed to check workbench assignment: %w", err)
+		return nil, fmt.Errorf("failed to check workbench assignment: %w", err)
 	}
 	if otherShipmentID != "" {
-		return fmt.Errorf("workbench already assigned to shipment %s", otherShipmentID)
+		return nil, fmt.Errorf("workbench already assigned to shipment %s", otherShipmentID)
+	}
+
+	var workbench *primary.Workbench
+	if s.workbenchService != nil {
+		workbench, err = s.workbenchService.GetWorkbench(ctx, workbenchID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Multi-repo shipments: route the workbench to the repo it has checked out
+	links, err := s.shipmentRepo.ListRepos(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipment repos: %w", err)
+	}
+	if len(links) > 0 && workbench != nil && workbench.RepoID != "" && workbench.RepoID != record.RepoID {
+		for _, link := range links {
+			if link.RepoID == workbench.RepoID {
+				return s.assignRepoWorkbench(ctx, link, workbenchID)
+			}
+		}
+		return nil, fmt.Errorf("workbench %s is on repository %s, which is not part of shipment %s. Add it with: orc shipment repo add %s %s",
+			workbenchID, workbench.RepoID, shipmentID, shipmentID, workbench.RepoID)
+	}
+
+	resp := &primary.AssignShipmentResponse{}
+	if workbench != nil && workbench.RepoID != "" {
+		resp.RepoID = workbench.RepoID
+		resp.Branch = record.Branch
+		if resp.Branch == "" {
+			resp.Branch = GenerateShipmentBranchName(UserInitials, record.ID, record.Title)
+		}
+		resp.Warning = s.startShipmentBranch(ctx, shipmentID, workbenchID, resp.Branch)
 	}
 
 	// Assign workbench to shipment
 	if err := s.shipmentRepo.AssignWorkbench(ctx, shipmentID, workbenchID); err != nil {
-		return err
+		return nil, err
+	}
+	if resp.Branch != "" && resp.Branch != record.Branch {
+		if err := s.shipmentRepo.Update(ctx, &secondary.ShipmentRecord{ID: shipmentID, Branch: resp.Branch}); err != nil {
+			return nil, fmt.Errorf("failed to record shipment branch: %w", err)
+		}
 	}
 
 	// Cascade to tasks
-	return s.taskRepo.AssignWorkbenchByShipment(ctx, shipmentID, workbenchID)
+	if err := s.taskRepo.AssignWorkbenchByShipment(ctx, shipmentID, workbenchID); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// startShipmentBranch checks out the shipment branch in the workbench.
+// Returns a warning instead of failing, so the assignment can still be recorded.
+func (s *ShipmentServiceImpl) startShipmentBranch(ctx context.Context, shipmentID, workbenchID, branch string) string {
+	if _, err := s.workbenchService.StartBranch(ctx, primary.StartBranchRequest{
+		WorkbenchID: workbenchID,
+		Branch:      branch,
+	}); err != nil {
+		return fmt.Sprintf("branch %s not checked out: %v. Once the workbench is ready, re-run: orc shipment assign %s %s",
+			branch, err, shipmentID, workbenchID)
+	}
+	return ""
+}
+
+// assignRepoWorkbench checks out an additional repo's branch in the workbench and records it.
+func (s *ShipmentServiceImpl) assignRepoWorkbench(ctx context.Context, link *secondary.ShipmentRepoRecord, workbenchID string) (*primary.AssignShipmentResponse, error) {
+	resp := &primary.AssignShipmentResponse{RepoID: link.RepoID, Branch: link.Branch}
+	resp.Warning = s.startShipmentBranch(ctx, link.ShipmentID, workbenchID, link.Branch)
+	if err := s.shipmentRepo.AssignRepoWorkbench(ctx, link.ShipmentID, link.RepoID, workbenchID); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AddShipmentRepo adds a repository to a shipment. Without an explicit branch, every
+// repo of the shipment uses the same branch name so cross-repo work is easy to match up.
+func (s *ShipmentServiceImpl) AddShipmentRepo(ctx context.Context, req primary.AddShipmentRepoRequest) (*primary.ShipmentRepo, error) {
+	record, err := s.shipmentRepo.GetByID(ctx, req.ShipmentID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.shipmentRepo.ListRepos(ctx, req.ShipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipment repos: %w", err)
+	}
+	repoExists, err := s.shipmentRepo.RepoExists(ctx, req.RepoID)
+	if err != nil {
+		return nil, err
+	}
+
+	linkedIDs := make([]string, len(links))
+	for i, link := range links {
+		linkedIDs[i] = link.RepoID
+	}
+	result := coreshipment.CanAddRepo(coreshipment.AddRepoContext{
+		ShipmentID:     req.ShipmentID,
+		ShipmentStatus: record.Status,
+		RepoID:         req.RepoID,
+		RepoExists:     repoExists,
+		PrimaryRepoID:  record.RepoID,
+		LinkedRepoIDs:  linkedIDs,
+	})
+	if err := result.Error(); err != nil {
+		return nil, err
+	}
+
+	branch := req.Branch
+	if branch == "" {
+		branch = record.Branch
+	}
+	if branch == "" {
+		branch = GenerateShipmentBranchName(UserInitials, record.ID, record.Title)
+	}
+
+	// The first repo becomes the shipment's own (primary) repo
+	if record.RepoID == "" {
+		if err := s.shipmentRepo.Update(ctx, &secondary.ShipmentRecord{ID: record.ID, RepoID: req.RepoID, Branch: branch}); err != nil {
+			return nil, err
+		}
+		return &primary.ShipmentRepo{
+			ShipmentID:          record.ID,
+			RepoID:              req.RepoID,
+			Branch:              branch,
+			AssignedWorkbenchID: record.AssignedWorkbenchID,
+			Primary:             true,
+		}, nil
+	}
+
+	if err := s.shipmentRepo.AddRepo(ctx, &secondary.ShipmentRepoRecord{
+		ShipmentID: record.ID,
+		RepoID:     req.RepoID,
+		Branch:     branch,
+	}); err != nil {
+		return nil, err
+	}
+	return &primary.ShipmentRepo{ShipmentID: record.ID, RepoID: req.RepoID, Branch: branch}, nil
+}
+
+// RemoveShipmentRepo removes an additional repository from a shipment.
+// The primary repo stays: it is the shipment's own repo_id.
+func (s *ShipmentServiceImpl) RemoveShipmentRepo(ctx context.Context, shipmentID, repoID string) error {
+	record, err := s.shipmentRepo.GetByID(ctx, shipmentID)
+	if err != nil {
+		return err
+	}
+	if repoID == record.RepoID {
+		return fmt.Errorf("repository %s is the primary repo of shipment %s and cannot be removed", repoID, shipmentID)
+	}
+	return s.shipmentRepo.RemoveRepo(ctx, shipmentID, repoID)
+}
+
+// ListShipmentRepos lists the repositories a shipment spans, primary first.
+func (s *ShipmentServiceImpl) ListShipmentRepos(ctx context.Context, shipmentID string) ([]*primary.ShipmentRepo, error) {
+	record, err := s.shipmentRepo.GetByID(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.shipmentRepo.ListRepos(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipment repos: %w", err)
+	}
+
+	var repos []*primary.ShipmentRepo
+	if record.RepoID != "" {
+		repos = append(repos, &primary.ShipmentRepo{
+			ShipmentID:          record.ID,
+			RepoID:              record.RepoID,
+			Branch:              record.Branch,
+			AssignedWorkbenchID: record.AssignedWorkbenchID,
+			Primary:             true,
+		})
+	}
+	for _, link := range links {
+		repos = append(repos, &primary.ShipmentRepo{
+			ShipmentID:          link.ShipmentID,
+			RepoID:              link.RepoID,
+			Branch:              link.Branch,
+			AssignedWorkbenchID: link.AssignedWorkbenchID,
+		})
+	}
+	return repos, nil
 }
 
 // GetShipmentsByWorkbench retrieves shipments assigned to a workbench.
@@ -276,7 +498,7 @@ func (s *ShipmentServiceImpl) GetShipmentsByWorkbench(ctx context.Context, workb
 
 	shipments := make([]*primary.Shipment, len(records))
 	for i, r := range records {
-		shipments[i] = s.recordToShipment(r)
+		shipments[i] = recordToShipment(r)
 	}
 	return shipments, nil
 }
@@ -302,7 +524,8 @@ func (s *ShipmentServiceImpl) DeleteShipment(ctx context.Context, shipmentID str
 
 // Helper methods
 
-func (s *ShipmentServiceImpl) recordToShipment(r *secondary.ShipmentRecord) *primary.Shipment {
+// recordToShipment converts a ShipmentRecord to a Shipment (shared helper).
+func recordToShipment(r *secondary.ShipmentRecord) *primary.Shipment {
 	return &primary.Shipment{
 		ID:                  r.ID,
 		CommissionID:        r.CommissionID,
@@ -343,6 +566,8 @@ func recordToTask(r *secondary.TaskRecord) *primary.Task {
 		UpdatedAt:           r.UpdatedAt,
 		ClaimedAt:           r.ClaimedAt,
 		CompletedAt:         r.CompletedAt,
+		StartCommit:         r.StartCommit,
+		EndCommit:           r.EndCommit,
 	}
 }
 
@@ -376,6 +601,147 @@ func (s *ShipmentServiceImpl) MoveShipmentToCommission(ctx context.Context, ship
 	}, nil
 }
 
+// ImportPlan creates tasks and notes from a structured markdown plan.
+// All records are created in a single transaction; "#N" dependency references
+// are resolved to the task IDs allocated during the import.
+func (s *ShipmentServiceImpl) ImportPlan(ctx context.Context, req primary.ImportPlanRequest) (*primary.ImportPlanResponse, error) {
+	record, err := s.shipmentRepo.GetByID(ctx, req.ShipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := coreshipment.ParsePlanDocument(req.Content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plan document: %w", err)
+	}
+
+	guardCtx := coreshipment.ImportPlanContext{
+		ShipmentID:     req.ShipmentID,
+		ShipmentStatus: record.Status,
+		TaskCount:      len(doc.Tasks),
+		NoteCount:      len(doc.Notes),
+	}
+	if result := coreshipment.CanImportPlan(guardCtx); !result.Allowed {
+		return nil, result.Error()
+	}
+
+	// Validate references to existing tasks before opening the transaction
+	for _, t := range doc.Tasks {
+		for _, dep := range t.DependsOn {
+			if _, isRef := coreshipment.DocumentRef(dep); isRef {
+				continue
+			}
+			if _, err := s.taskRepo.GetByID(ctx, dep); err != nil {
+				return nil, fmt.Errorf("task %q depends on %s, which was not found", t.Title, dep)
+			}
+		}
+	}
+
+	resp := &primary.ImportPlanResponse{
+		ShipmentID: req.ShipmentID,
+		DryRun:     req.DryRun,
+		Tasks:      make([]primary.ImportedTask, len(doc.Tasks)),
+		Notes:      make([]primary.ImportedNote, len(doc.Notes)),
+	}
+	for i, t := range doc.Tasks {
+		resp.Tasks[i] = primary.ImportedTask{
+			Ref:          t.Ref,
+			Title:        t.Title,
+			Type:         t.Type,
+			Priority:     t.Priority,
+			DependsOn:    t.DependsOn,
+			SubtaskCount: len(t.Subtasks),
+			Done:         t.Done,
+		}
+	}
+	for i, n := range doc.Notes {
+		resp.Notes[i] = primary.ImportedNote{Title: n.Title, Type: n.Type}
+	}
+
+	if req.DryRun {
+		return resp, nil
+	}
+
+	err = s.transactor.WithImmediateTx(ctx, func(txCtx context.Context) error {
+		// Allocate IDs up front so forward "#N" references resolve
+		taskIDs := make([]string, len(doc.Tasks))
+		for i, t := range doc.Tasks {
+			id, err := s.taskRepo.GetNextID(txCtx)
+			if err != nil {
+				return fmt.Errorf("failed to generate task ID: %w", err)
+			}
+			taskIDs[i] = id
+
+			// Create without dependencies first; they are filled in once all IDs exist
+			status := "open"
+			if t.Done {
+				status = "closed"
+			}
+			taskRecord := &secondary.TaskRecord{
+				ID:           id,
+				ShipmentID:   req.ShipmentID,
+				CommissionID: record.CommissionID,
+				Title:        t.Title,
+				Description:  t.RenderDescription(),
+				Type:         t.Type,
+				Priority:     t.Priority,
+				Status:       status,
+			}
+			if err := s.taskRepo.Create(txCtx, taskRecord); err != nil {
+				return fmt.Errorf("failed to create task %q: %w", t.Title, err)
+			}
+			resp.Tasks[i].ID = id
+		}
+
+		for i, t := range doc.Tasks {
+			if len(t.DependsOn) == 0 {
+				continue
+			}
+			resolved := make([]string, len(t.DependsOn))
+			for j, dep := range t.DependsOn {
+				if ref, isRef := coreshipment.DocumentRef(dep); isRef {
+					resolved[j] = taskIDs[ref-1]
+				} else {
+					resolved[j] = dep
+				}
+			}
+			data, err := json.Marshal(resolved)
+			if err != nil {
+				return fmt.Errorf("failed to serialize depends_on: %w", err)
+			}
+			if err := s.taskRepo.UpdateDependsOn(txCtx, taskIDs[i], string(data)); err != nil {
+				return fmt.Errorf("failed to set dependencies for %s: %w", taskIDs[i], err)
+			}
+			resp.Tasks[i].DependsOn = resolved
+		}
+
+		for i, n := range doc.Notes {
+			id, err := s.noteRepo.GetNextID(txCtx)
+			if err != nil {
+				return fmt.Errorf("failed to generate note ID: %w", err)
+			}
+			noteRecord := &secondary.NoteRecord{
+				ID:           id,
+				CommissionID: record.CommissionID,
+				ShipmentID:   req.ShipmentID,
+				Title:        n.Title,
+				Content:      n.Content,
+				Type:         n.Type,
+			}
+			if err := s.noteRepo.Create(txCtx, noteRecord); err != nil {
+				return fmt.Errorf("failed to create note %q: %w", n.Title, err)
+			}
+			resp.Notes[i].ID = id
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 // Ensure ShipmentServiceImpl implements the interface
 var _ primary.ShipmentService = (*ShipmentServiceImpl)(nil)
 
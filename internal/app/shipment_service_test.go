@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/example/orc/internal/ports/primary"
@@ -27,6 +28,9 @@ type mockShipmentRepository struct {
 	assignWorkbenchErr     error
 	commissionExistsResult bool
 	commissionExistsErr    error
+	repoExistsResult       bool
+	repoLinks              map[string][]*secondary.ShipmentRepoRecord // shipmentID -> additional repos
+	prSummaries            map[string][]*secondary.ShipmentPRSummaryRecord
 }
 
 func newMockShipmentRepository() *mockShipmentRepository {
@@ -34,6 +38,9 @@ func newMockShipmentRepository() *mockShipmentRepository {
 		shipments:              make(map[string]*secondary.ShipmentRecord),
 		workbenchAssignments:   make(map[string]string),
 		commissionExistsResult: true,
+		repoExistsResult:       true,
+		repoLinks:              make(map[string][]*secondary.ShipmentRepoRecord),
+		prSummaries:            make(map[string][]*secondary.ShipmentPRSummaryRecord),
 	}
 }
 
@@ -85,6 +92,12 @@ func (m *mockShipmentRepository) Update(ctx context.Context, shipment *secondary
 		if shipment.Description != "" {
 			existing.Description = shipment.Description
 		}
+		if shipment.Branch != "" {
+			existing.Branch = shipment.Branch
+		}
+		if shipment.RepoID != "" {
+			existing.RepoID = shipment.RepoID
+		}
 	}
 	return nil
 }
@@ -174,6 +187,45 @@ func (m *mockShipmentRepository) MoveToCommission(ctx context.Context, shipmentI
 	return 0, 0, 0, fmt.Errorf("shipment %s not found", shipmentID)
 }
 
+func (m *mockShipmentRepository) RepoExists(ctx context.Context, repoID string) (bool, error) {
+	return m.repoExistsResult, nil
+}
+
+func (m *mockShipmentRepository) AddRepo(ctx context.Context, link *secondary.ShipmentRepoRecord) error {
+	m.repoLinks[link.ShipmentID] = append(m.repoLinks[link.ShipmentID], link)
+	return nil
+}
+
+func (m *mockShipmentRepository) RemoveRepo(ctx context.Context, shipmentID, repoID string) error {
+	links := m.repoLinks[shipmentID]
+	for i, link := range links {
+		if link.RepoID == repoID {
+			m.repoLinks[shipmentID] = append(links[:i], links[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("repository %s is not part of shipment %s", repoID, shipmentID)
+}
+
+func (m *mockShipmentRepository) ListRepos(ctx context.Context, shipmentID string) ([]*secondary.ShipmentRepoRecord, error) {
+	return m.repoLinks[shipmentID], nil
+}
+
+func (m *mockShipmentRepository) AssignRepoWorkbench(ctx context.Context, shipmentID, repoID, workbenchID string) error {
+	for _, link := range m.repoLinks[shipmentID] {
+		if link.RepoID == repoID {
+			link.AssignedWorkbenchID = workbenchID
+			m.workbenchAssignments[workbenchID] = shipmentID
+			return nil
+		}
+	}
+	return fmt.Errorf("repository %s is not part of shipment %s", repoID, shipmentID)
+}
+
+func (m *mockShipmentRepository) ListPRSummaries(ctx context.Context, shipmentID string) ([]*secondary.ShipmentPRSummaryRecord, error) {
+	return m.prSummaries[shipmentID], nil
+}
+
 // mockTaskRepositoryForShipment implements minimal TaskRepository for shipment tests.
 type mockTaskRepositoryForShipment struct {
 	tasks     map[string]*secondary.TaskRecord
@@ -187,11 +239,15 @@ func newMockTaskRepositoryForShipment() *mockTaskRepositoryForShipment {
 }
 
 func (m *mockTaskRepositoryForShipment) Create(ctx context.Context, task *secondary.TaskRecord) error {
+	m.tasks[task.ID] = task
 	return nil
 }
 
 func (m *mockTaskRepositoryForShipment) GetByID(ctx context.Context, id string) (*secondary.TaskRecord, error) {
-	return nil, errors.New("not implemented")
+	if task, ok := m.tasks[id]; ok {
+		return task, nil
+	}
+	return nil, errors.New("task not found")
 }
 
 func (m *mockTaskRepositoryForShipment) List(ctx context.Context, filters secondary.TaskFilters) ([]*secondary.TaskRecord, error) {
@@ -222,7 +278,7 @@ func (m *mockTaskRepositoryForShipment) Unpin(ctx context.Context, id string) er
 }
 
 func (m *mockTaskRepositoryForShipment) GetNextID(ctx context.Context) (string, error) {
-	return "TASK-001", nil
+	return fmt.Sprintf("TASK-%03d", len(m.tasks)+1), nil
 }
 
 func (m *mockTaskRepositoryForShipment) GetByWorkbench(ctx context.Context, workbenchID string) ([]*secondary.TaskRecord, error) {
@@ -241,6 +297,17 @@ func (m *mockTaskRepositoryForShipment) Claim(ctx context.Context, id, workbench
 	return nil
 }
 
+func (m *mockTaskRepositoryForShipment) UpdateDependsOn(ctx context.Context, id, dependsOn string) error {
+	if task, ok := m.tasks[id]; ok {
+		task.DependsOn = dependsOn
+	}
+	return nil
+}
+
+func (m *mockTaskRepositoryForShipment) UpdateCommits(ctx context.Context, id, startCommit, endCommit string) error {
+	return nil
+}
+
 func (m *mockTaskRepositoryForShipment) AssignWorkbenchByShipment(ctx context.Context, shipmentID, workbenchID string) error {
 	return m.assignErr
 }
@@ -356,7 +423,7 @@ func newTestShipmentService() (*ShipmentServiceImpl, *mockShipmentRepository, *m
 	shipmentRepo := newMockShipmentRepository()
 	taskRepo := newMockTaskRepositoryForShipment()
 	noteService := newMockNoteServiceForShipment()
-	service := NewShipmentService(shipmentRepo, taskRepo, noteService, &mockTransactor{})
+	service := NewShipmentService(shipmentRepo, taskRepo, newMockNoteRepository(), noteService, nil, nil, &mockTransactor{})
 	return service, shipmentRepo, taskRepo
 }
 
@@ -536,7 +603,7 @@ func TestCompleteShipment_UnpinnedAllowed(t *testing.T) {
 	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", ShipmentID: "SHIPMENT-001", Status: "closed"}
 	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", ShipmentID: "SHIPMENT-001", Status: "closed"}
 
-	err := service.CompleteShipment(ctx, "SHIPMENT-001", false)
+	_, err := service.CompleteShipment(ctx, "SHIPMENT-001", false)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -546,6 +613,35 @@ func TestCompleteShipment_UnpinnedAllowed(t *testing.T) {
 	}
 }
 
+func TestCompleteShipment_SyncsCommissionLifecycle(t *testing.T) {
+	commissionService, commissionRepo, _ := newTestService(secondary.AgentTypeORC)
+	shipmentRepo := newMockShipmentRepository()
+	service := NewShipmentService(shipmentRepo, newMockTaskRepositoryForShipment(), newMockNoteRepository(), nil, commissionService, nil, &mockTransactor{})
+	ctx := context.Background()
+
+	commissionRepo.commissions["COMM-001"] = &secondary.CommissionRecord{ID: "COMM-001", Title: "Test", Status: "active"}
+	commissionRepo.rollups["COMM-001"] = &secondary.CommissionRollupRecord{
+		ShipmentsByStatus: map[string]int{"closed": 1},
+	}
+	shipmentRepo.shipments["SHIPMENT-001"] = &secondary.ShipmentRecord{
+		ID:           "SHIPMENT-001",
+		CommissionID: "COMM-001",
+		Title:        "Last Shipment",
+		Status:       "in-progress",
+	}
+
+	lifecycle, err := service.CompleteShipment(ctx, "SHIPMENT-001", false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if lifecycle == nil || lifecycle.Action != "complete" || lifecycle.CommissionID != "COMM-001" {
+		t.Errorf("expected a complete lifecycle result for COMM-001, got %+v", lifecycle)
+	}
+	if commissionRepo.commissions["COMM-001"].Status != "complete" {
+		t.Errorf("expected commission to auto-complete, got '%s'", commissionRepo.commissions["COMM-001"].Status)
+	}
+}
+
 func TestCompleteShipment_PinnedBlocked(t *testing.T) {
 	service, shipmentRepo, _ := newTestShipmentService()
 	ctx := context.Background()
@@ -558,7 +654,7 @@ func TestCompleteShipment_PinnedBlocked(t *testing.T) {
 		Pinned:       true,
 	}
 
-	err := service.CompleteShipment(ctx, "SHIPMENT-001", false)
+	_, err := service.CompleteShipment(ctx, "SHIPMENT-001", false)
 
 	if err == nil {
 		t.Fatal("expected error for completing pinned shipment, got nil")
@@ -580,7 +676,7 @@ func TestCompleteShipment_IncompleteTasksBlocked(t *testing.T) {
 	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", ShipmentID: "SHIPMENT-001", Status: "closed"}
 	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", ShipmentID: "SHIPMENT-001", Status: "open"}
 
-	err := service.CompleteShipment(ctx, "SHIPMENT-001", false)
+	_, err := service.CompleteShipment(ctx, "SHIPMENT-001", false)
 
 	if err == nil {
 		t.Fatal("expected error for non-closed tasks, got nil")
@@ -606,7 +702,7 @@ func TestCompleteShipment_IncompleteTasksForced(t *testing.T) {
 	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", ShipmentID: "SHIPMENT-001", Status: "open"}
 
 	// Force close
-	err := service.CompleteShipment(ctx, "SHIPMENT-001", true)
+	_, err := service.CompleteShipment(ctx, "SHIPMENT-001", true)
 
 	if err != nil {
 		t.Fatalf("expected no error with force=true, got %v", err)
@@ -616,11 +712,52 @@ func TestCompleteShipment_IncompleteTasksForced(t *testing.T) {
 	}
 }
 
+func TestCompleteShipment_OpenPRBlocked(t *testing.T) {
+	service, shipmentRepo, _ := newTestShipmentService()
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIPMENT-001"] = &secondary.ShipmentRecord{ID: "SHIPMENT-001", CommissionID: "COMM-001", Status: "in-progress"}
+	shipmentRepo.prSummaries["SHIPMENT-001"] = []*secondary.ShipmentPRSummaryRecord{
+		{ID: "PR-001", RepoID: "REPO-001", Status: "merged"},
+		{ID: "PR-002", RepoID: "REPO-002", Status: "open"},
+	}
+
+	if _, err := service.CompleteShipment(ctx, "SHIPMENT-001", true); err == nil {
+		t.Fatal("expected error while a PR is still open, even with force")
+	}
+	if shipmentRepo.shipments["SHIPMENT-001"].Status == "closed" {
+		t.Error("expected shipment to stay open")
+	}
+}
+
+func TestCompleteShipment_RepoWithoutPRBlocked(t *testing.T) {
+	service, shipmentRepo, _ := newTestShipmentService()
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIPMENT-001"] = &secondary.ShipmentRecord{ID: "SHIPMENT-001", CommissionID: "COMM-001", RepoID: "REPO-001", Status: "in-progress"}
+	shipmentRepo.repoLinks["SHIPMENT-001"] = []*secondary.ShipmentRepoRecord{{ShipmentID: "SHIPMENT-001", RepoID: "REPO-002"}}
+	shipmentRepo.prSummaries["SHIPMENT-001"] = []*secondary.ShipmentPRSummaryRecord{
+		{ID: "PR-001", RepoID: "REPO-001", Status: "merged"},
+	}
+
+	_, err := service.CompleteShipment(ctx, "SHIPMENT-001", false)
+	if err == nil || !strings.Contains(err.Error(), "1 repo(s) have no PR (REPO-002)") {
+		t.Fatalf("expected REPO-002 without a PR to block closing, got %v", err)
+	}
+	if shipmentRepo.shipments["SHIPMENT-001"].Status == "closed" {
+		t.Error("expected shipment to stay open")
+	}
+
+	if _, err := service.CompleteShipment(ctx, "SHIPMENT-001", true); err != nil {
+		t.Fatalf("expected force to close anyway, got %v", err)
+	}
+}
+
 func TestCompleteShipment_NotFound(t *testing.T) {
 	service, _, _ := newTestShipmentService()
 	ctx := context.Background()
 
-	err := service.CompleteShipment(ctx, "SHIPMENT-NONEXISTENT", false)
+	_, err := service.CompleteShipment(ctx, "SHIPMENT-NONEXISTENT", false)
 
 	if err == nil {
 		t.Fatal("expected error for non-existent shipment, got nil")
@@ -690,7 +827,7 @@ func TestAssignShipmentToWorkbench_Success(t *testing.T) {
 		Status:       "draft",
 	}
 
-	err := service.AssignShipmentToWorkbench(ctx, "SHIPMENT-001", "BENCH-001")
+	_, err := service.AssignShipmentToWorkbench(ctx, "SHIPMENT-001", "BENCH-001")
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -704,7 +841,7 @@ func TestAssignShipmentToWorkbench_ShipmentNotFound(t *testing.T) {
 	service, _, _ := newTestShipmentService()
 	ctx := context.Background()
 
-	err := service.AssignShipmentToWorkbench(ctx, "SHIPMENT-NONEXISTENT", "BENCH-001")
+	_, err := service.AssignShipmentToWorkbench(ctx, "SHIPMENT-NONEXISTENT", "BENCH-001")
 
 	if err == nil {
 		t.Fatal("expected error for non-existent shipment, got nil")
@@ -730,13 +867,191 @@ func TestAssignShipmentToWorkbench_WorkbenchAlreadyAssigned(t *testing.T) {
 	}
 	shipmentRepo.workbenchAssignments["BENCH-001"] = "SHIPMENT-002"
 
-	err := service.AssignShipmentToWorkbench(ctx, "SHIPMENT-001", "BENCH-001")
+	_, err := service.AssignShipmentToWorkbench(ctx, "SHIPMENT-001", "BENCH-001")
 
 	if err == nil {
 		t.Fatal("expected error for workbench already assigned, got nil")
 	}
 }
 
+func TestAssignShipmentToWorkbench_StartsShipmentBranch(t *testing.T) {
+	shipmentRepo := newMockShipmentRepository()
+	workbenchService := newMockWorkbenchServiceForSummary()
+	service := NewShipmentService(shipmentRepo, newMockTaskRepositoryForShipment(), newMockNoteRepository(), nil, nil, workbenchService, &mockTransactor{})
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIP-004"] = &secondary.ShipmentRecord{ID: "SHIP-004", CommissionID: "COMM-001", Title: "Login Flow", Status: "draft"}
+	workbenchService.workbenches["BENCH-001"] = &primary.Workbench{ID: "BENCH-001", RepoID: "REPO-001"}
+
+	if _, err := service.AssignShipmentToWorkbench(ctx, "SHIP-004", "BENCH-001"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := "ml/SHIP-004-login-flow"
+	if got := workbenchService.startedBranches["BENCH-001"]; got != want {
+		t.Errorf("StartBranch branch = %q, want %q", got, want)
+	}
+	if got := shipmentRepo.shipments["SHIP-004"].Branch; got != want {
+		t.Errorf("shipment branch = %q, want %q", got, want)
+	}
+}
+
+func TestAssignShipmentToWorkbench_BranchFailureFallsBackToLedger(t *testing.T) {
+	shipmentRepo := newMockShipmentRepository()
+	workbenchService := newMockWorkbenchServiceForSummary()
+	workbenchService.startBranchErr = errors.New("workbench path does not exist")
+	service := NewShipmentService(shipmentRepo, newMockTaskRepositoryForShipment(), newMockNoteRepository(), nil, nil, workbenchService, &mockTransactor{})
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIP-004"] = &secondary.ShipmentRecord{ID: "SHIP-004", CommissionID: "COMM-001", Title: "Login Flow", Status: "draft"}
+	workbenchService.workbenches["BENCH-001"] = &primary.Workbench{ID: "BENCH-001", RepoID: "REPO-001"}
+
+	resp, err := service.AssignShipmentToWorkbench(ctx, "SHIP-004", "BENCH-001")
+	if err != nil {
+		t.Fatalf("expected ledger-only assignment, got %v", err)
+	}
+	if !strings.Contains(resp.Warning, "workbench path does not exist") {
+		t.Errorf("expected a warning carrying the git error, got %q", resp.Warning)
+	}
+	if got := shipmentRepo.shipments["SHIP-004"].AssignedWorkbenchID; got != "BENCH-001" {
+		t.Errorf("expected shipment assigned to BENCH-001, got %q", got)
+	}
+	if got := shipmentRepo.shipments["SHIP-004"].Branch; got != "ml/SHIP-004-login-flow" {
+		t.Errorf("expected the shipment branch to be recorded, got %q", got)
+	}
+}
+
+func TestAssignShipmentToWorkbench_NoRepoSkipsBranch(t *testing.T) {
+	shipmentRepo := newMockShipmentRepository()
+	workbenchService := newMockWorkbenchServiceForSummary()
+	service := NewShipmentService(shipmentRepo, newMockTaskRepositoryForShipment(), newMockNoteRepository(), nil, nil, workbenchService, &mockTransactor{})
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIP-004"] = &secondary.ShipmentRecord{ID: "SHIP-004", CommissionID: "COMM-001", Title: "Login Flow", Status: "draft"}
+	workbenchService.workbenches["BENCH-001"] = &primary.Workbench{ID: "BENCH-001"}
+
+	if _, err := service.AssignShipmentToWorkbench(ctx, "SHIP-004", "BENCH-001"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(workbenchService.startedBranches) != 0 {
+		t.Errorf("expected no branch to be started, got %v", workbenchService.startedBranches)
+	}
+	if got := shipmentRepo.shipments["SHIP-004"].Branch; got != "" {
+		t.Errorf("expected no shipment branch, got %q", got)
+	}
+}
+
+func TestAssignShipmentToWorkbench_AdditionalRepo(t *testing.T) {
+	shipmentRepo := newMockShipmentRepository()
+	workbenchService := newMockWorkbenchServiceForSummary()
+	service := NewShipmentService(shipmentRepo, newMockTaskRepositoryForShipment(), newMockNoteRepository(), nil, nil, workbenchService, &mockTransactor{})
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIP-004"] = &secondary.ShipmentRecord{
+		ID: "SHIP-004", CommissionID: "COMM-001", Title: "Login Flow", Status: "in-progress",
+		RepoID: "REPO-001", Branch: "ml/SHIP-004-login-flow", AssignedWorkbenchID: "BENCH-001",
+	}
+	shipmentRepo.repoLinks["SHIP-004"] = []*secondary.ShipmentRepoRecord{
+		{ShipmentID: "SHIP-004", RepoID: "REPO-002", Branch: "ml/SHIP-004-login-flow"},
+	}
+	workbenchService.workbenches["BENCH-002"] = &primary.Workbench{ID: "BENCH-002", RepoID: "REPO-002"}
+
+	if _, err := service.AssignShipmentToWorkbench(ctx, "SHIP-004", "BENCH-002"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := workbenchService.startedBranches["BENCH-002"]; got != "ml/SHIP-004-login-flow" {
+		t.Errorf("StartBranch branch = %q, want the repo's shipment branch", got)
+	}
+	if got := shipmentRepo.repoLinks["SHIP-004"][0].AssignedWorkbenchID; got != "BENCH-002" {
+		t.Errorf("repo workbench = %q, want BENCH-002", got)
+	}
+	if got := shipmentRepo.shipments["SHIP-004"].AssignedWorkbenchID; got != "BENCH-001" {
+		t.Errorf("primary workbench changed to %q", got)
+	}
+}
+
+func TestAssignShipmentToWorkbench_RepoNotInShipment(t *testing.T) {
+	shipmentRepo := newMockShipmentRepository()
+	workbenchService := newMockWorkbenchServiceForSummary()
+	service := NewShipmentService(shipmentRepo, newMockTaskRepositoryForShipment(), newMockNoteRepository(), nil, nil, workbenchService, &mockTransactor{})
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIP-004"] = &secondary.ShipmentRecord{ID: "SHIP-004", CommissionID: "COMM-001", Status: "in-progress", RepoID: "REPO-001"}
+	shipmentRepo.repoLinks["SHIP-004"] = []*secondary.ShipmentRepoRecord{{ShipmentID: "SHIP-004", RepoID: "REPO-002"}}
+	workbenchService.workbenches["BENCH-003"] = &primary.Workbench{ID: "BENCH-003", RepoID: "REPO-003"}
+
+	if _, err := service.AssignShipmentToWorkbench(ctx, "SHIP-004", "BENCH-003"); err == nil {
+		t.Fatal("expected error for a workbench on a repo the shipment does not span")
+	}
+	if len(workbenchService.startedBranches) != 0 {
+		t.Errorf("expected no branch to be started, got %v", workbenchService.startedBranches)
+	}
+}
+
+// ============================================================================
+// Shipment Repo Tests
+// ============================================================================
+
+func TestAddShipmentRepo_FirstRepoBecomesPrimary(t *testing.T) {
+	service, shipmentRepo, _ := newTestShipmentService()
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIP-004"] = &secondary.ShipmentRecord{ID: "SHIP-004", CommissionID: "COMM-001", Title: "Login Flow", Status: "draft"}
+
+	repo, err := service.AddShipmentRepo(ctx, primary.AddShipmentRepoRequest{ShipmentID: "SHIP-004", RepoID: "REPO-001"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !repo.Primary || repo.Branch != "ml/SHIP-004-login-flow" {
+		t.Errorf("expected primary repo on the generated branch, got %+v", repo)
+	}
+	if got := shipmentRepo.shipments["SHIP-004"].RepoID; got != "REPO-001" {
+		t.Errorf("shipment repo = %q, want REPO-001", got)
+	}
+}
+
+func TestAddShipmentRepo_AdditionalRepoSharesBranch(t *testing.T) {
+	service, shipmentRepo, _ := newTestShipmentService()
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIP-004"] = &secondary.ShipmentRecord{
+		ID: "SHIP-004", CommissionID: "COMM-001", Status: "in-progress", RepoID: "REPO-001", Branch: "ml/SHIP-004-login-flow",
+	}
+
+	if _, err := service.AddShipmentRepo(ctx, primary.AddShipmentRepoRequest{ShipmentID: "SHIP-004", RepoID: "REPO-002"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.AddShipmentRepo(ctx, primary.AddShipmentRepoRequest{ShipmentID: "SHIP-004", RepoID: "REPO-002"}); err == nil {
+		t.Error("expected error adding the same repo twice")
+	}
+
+	repos, err := service.ListShipmentRepos(ctx, "SHIP-004")
+	if err != nil {
+		t.Fatalf("ListShipmentRepos failed: %v", err)
+	}
+	if len(repos) != 2 || !repos[0].Primary || repos[1].RepoID != "REPO-002" || repos[1].Branch != "ml/SHIP-004-login-flow" {
+		t.Errorf("unexpected repos: %+v, %+v", repos[0], repos[len(repos)-1])
+	}
+}
+
+func TestRemoveShipmentRepo_PrimaryRejected(t *testing.T) {
+	service, shipmentRepo, _ := newTestShipmentService()
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIP-004"] = &secondary.ShipmentRecord{ID: "SHIP-004", CommissionID: "COMM-001", Status: "in-progress", RepoID: "REPO-001"}
+	shipmentRepo.repoLinks["SHIP-004"] = []*secondary.ShipmentRepoRecord{{ShipmentID: "SHIP-004", RepoID: "REPO-002"}}
+
+	if err := service.RemoveShipmentRepo(ctx, "SHIP-004", "REPO-001"); err == nil {
+		t.Error("expected error removing the primary repo")
+	}
+	if err := service.RemoveShipmentRepo(ctx, "SHIP-004", "REPO-002"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(shipmentRepo.repoLinks["SHIP-004"]) != 0 {
+		t.Errorf("expected additional repo to be removed, got %+v", shipmentRepo.repoLinks["SHIP-004"])
+	}
+}
+
 // ============================================================================
 // GetShipmentsByWorkbench Tests
 // ============================================================================
@@ -857,7 +1172,7 @@ func TestCompleteShipment_ClosesSpecNote(t *testing.T) {
 	shipmentRepo := newMockShipmentRepository()
 	taskRepo := newMockTaskRepositoryForShipment()
 	noteService := newMockNoteServiceForShipment()
-	service := NewShipmentService(shipmentRepo, taskRepo, noteService, &mockTransactor{})
+	service := NewShipmentService(shipmentRepo, taskRepo, newMockNoteRepository(), noteService, nil, nil, &mockTransactor{})
 	ctx := context.Background()
 
 	// Create a shipment
@@ -878,7 +1193,7 @@ func TestCompleteShipment_ClosesSpecNote(t *testing.T) {
 		},
 	}
 
-	err := service.CompleteShipment(ctx, "SHIP-001", true)
+	_, err := service.CompleteShipment(ctx, "SHIP-001", true)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -898,7 +1213,7 @@ func TestCompleteShipment_WithoutSpecNote(t *testing.T) {
 	shipmentRepo := newMockShipmentRepository()
 	taskRepo := newMockTaskRepositoryForShipment()
 	noteService := newMockNoteServiceForShipment()
-	service := NewShipmentService(shipmentRepo, taskRepo, noteService, &mockTransactor{})
+	service := NewShipmentService(shipmentRepo, taskRepo, newMockNoteRepository(), noteService, nil, nil, &mockTransactor{})
 	ctx := context.Background()
 
 	// Create a shipment with no notes attached
@@ -909,7 +1224,7 @@ func TestCompleteShipment_WithoutSpecNote(t *testing.T) {
 		Status:       "draft",
 	}
 
-	err := service.CompleteShipment(ctx, "SHIP-001", true)
+	_, err := service.CompleteShipment(ctx, "SHIP-001", true)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -997,3 +1312,151 @@ func TestMoveShipmentToCommission_RepoError(t *testing.T) {
 		t.Fatal("expected error for repo failure, got nil")
 	}
 }
+
+// ============================================================================
+// ImportPlan Tests
+// ============================================================================
+
+const importPlanDoc = "# Plan\n" +
+	"\n" +
+	"> decision: Keep tokens opaque\n" +
+	"\n" +
+	"## Write middleware\n" +
+	"```orc\n" +
+	"type: implementation\n" +
+	"priority: high\n" +
+	"depends-on: #2\n" +
+	"```\n" +
+	"- [ ] Parse header\n" +
+	"\n" +
+	"## Design token schema\n"
+
+func newTestShipmentServiceForImport() (*ShipmentServiceImpl, *mockShipmentRepository, *mockTaskRepositoryForShipment, *mockNoteRepository) {
+	shipmentRepo := newMockShipmentRepository()
+	taskRepo := newMockTaskRepositoryForShipment()
+	noteRepo := newMockNoteRepository()
+	service := NewShipmentService(shipmentRepo, taskRepo, noteRepo, newMockNoteServiceForShipment(), nil, nil, &mockTransactor{})
+	shipmentRepo.shipments["SHIP-001"] = &secondary.ShipmentRecord{
+		ID:           "SHIP-001",
+		CommissionID: "COMM-001",
+		Title:        "Test Shipment",
+		Status:       "ready",
+	}
+	return service, shipmentRepo, taskRepo, noteRepo
+}
+
+func TestImportPlan_CreatesTasksAndNotes(t *testing.T) {
+	service, _, taskRepo, noteRepo := newTestShipmentServiceForImport()
+	ctx := context.Background()
+
+	resp, err := service.ImportPlan(ctx, primary.ImportPlanRequest{
+		ShipmentID: "SHIP-001",
+		Content:    importPlanDoc,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(resp.Tasks) != 2 || len(taskRepo.tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d in response and %d persisted", len(resp.Tasks), len(taskRepo.tasks))
+	}
+
+	middleware := taskRepo.tasks[resp.Tasks[0].ID]
+	if middleware.Priority != "high" || middleware.Type != "implementation" {
+		t.Errorf("unexpected task fields: %+v", middleware)
+	}
+	if middleware.CommissionID != "COMM-001" || middleware.ShipmentID != "SHIP-001" {
+		t.Errorf("expected task under SHIP-001/COMM-001, got %s/%s", middleware.ShipmentID, middleware.CommissionID)
+	}
+	if middleware.Description != "- [ ] Parse header" {
+		t.Errorf("expected subtasks in description, got %q", middleware.Description)
+	}
+
+	// Forward "#2" reference resolves to the second task's allocated ID
+	wantDeps := fmt.Sprintf(`["%s"]`, resp.Tasks[1].ID)
+	if middleware.DependsOn != wantDeps {
+		t.Errorf("DependsOn = %q, want %q", middleware.DependsOn, wantDeps)
+	}
+
+	if len(noteRepo.notes) != 1 {
+		t.Fatalf("expected 1 note, got %d", len(noteRepo.notes))
+	}
+	note := noteRepo.notes[resp.Notes[0].ID]
+	if note.Type != "decision" || note.ShipmentID != "SHIP-001" {
+		t.Errorf("unexpected note: %+v", note)
+	}
+}
+
+func TestImportPlan_CheckedItemsImportClosed(t *testing.T) {
+	service, _, taskRepo, _ := newTestShipmentServiceForImport()
+	ctx := context.Background()
+
+	resp, err := service.ImportPlan(ctx, primary.ImportPlanRequest{
+		ShipmentID: "SHIP-001",
+		Content:    "- [x] Spike auth\n- [ ] Write middleware\n",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !resp.Tasks[0].Done || taskRepo.tasks[resp.Tasks[0].ID].Status != "closed" {
+		t.Errorf("expected checked item imported closed, got %+v", taskRepo.tasks[resp.Tasks[0].ID])
+	}
+	if resp.Tasks[1].Done || taskRepo.tasks[resp.Tasks[1].ID].Status != "open" {
+		t.Errorf("expected unchecked item imported open, got %+v", taskRepo.tasks[resp.Tasks[1].ID])
+	}
+}
+
+func TestImportPlan_DryRunWritesNothing(t *testing.T) {
+	service, _, taskRepo, noteRepo := newTestShipmentServiceForImport()
+	ctx := context.Background()
+
+	resp, err := service.ImportPlan(ctx, primary.ImportPlanRequest{
+		ShipmentID: "SHIP-001",
+		Content:    importPlanDoc,
+		DryRun:     true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !resp.DryRun || len(resp.Tasks) != 2 || len(resp.Notes) != 1 {
+		t.Errorf("unexpected preview: %+v", resp)
+	}
+	if resp.Tasks[0].ID != "" {
+		t.Errorf("expected no ID in dry run, got %s", resp.Tasks[0].ID)
+	}
+	if len(taskRepo.tasks) != 0 || len(noteRepo.notes) != 0 {
+		t.Errorf("dry run persisted %d tasks and %d notes", len(taskRepo.tasks), len(noteRepo.notes))
+	}
+}
+
+func TestImportPlan_ClosedShipment(t *testing.T) {
+	service, shipmentRepo, _, _ := newTestShipmentServiceForImport()
+	ctx := context.Background()
+	shipmentRepo.shipments["SHIP-001"].Status = "closed"
+
+	_, err := service.ImportPlan(ctx, primary.ImportPlanRequest{
+		ShipmentID: "SHIP-001",
+		Content:    importPlanDoc,
+	})
+	if err == nil {
+		t.Fatal("expected error for closed shipment, got nil")
+	}
+}
+
+func TestImportPlan_UnknownExistingDependency(t *testing.T) {
+	service, _, taskRepo, _ := newTestShipmentServiceForImport()
+	ctx := context.Background()
+
+	_, err := service.ImportPlan(ctx, primary.ImportPlanRequest{
+		ShipmentID: "SHIP-001",
+		Content:    "## Task\n```orc\ndepends-on: TASK-999\n```\n",
+	})
+	if err == nil {
+		t.Fatal("expected error for missing dependency, got nil")
+	}
+	if len(taskRepo.tasks) != 0 {
+		t.Errorf("expected no tasks to be created, got %d", len(taskRepo.tasks))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	coregit "github.com/example/orc/internal/core/git"
 	"github.com/example/orc/internal/ports/primary"
 )
 
@@ -16,6 +17,9 @@ type SummaryServiceImpl struct {
 	noteService       primary.NoteService
 	workbenchService  primary.WorkbenchService
 	planService       primary.PlanService
+	commitService     primary.CommitService   // Optional: linked commit counts
+	diffStatService   primary.DiffStatService // Optional: cached shipment diff badges
+	prService         primary.PRService       // Optional: CI check badges from the last PR sync
 }
 
 // NewSummaryService creates a new SummaryService with injected dependencies.
@@ -27,6 +31,9 @@ func NewSummaryService(
 	noteService primary.NoteService,
 	workbenchService primary.WorkbenchService,
 	planService primary.PlanService,
+	commitService primary.CommitService,
+	diffStatService primary.DiffStatService,
+	prService primary.PRService,
 ) *SummaryServiceImpl {
 	return &SummaryServiceImpl{
 		commissionService: commissionService,
@@ -36,6 +43,9 @@ func NewSummaryService(
 		noteService:       noteService,
 		workbenchService:  workbenchService,
 		planService:       planService,
+		commitService:     commitService,
+		diffStatService:   diffStatService,
+		prService:         prService,
 	}
 }
 
@@ -203,6 +213,37 @@ func (s *SummaryServiceImpl) buildShipmentSummary(ctx context.Context, ship *pri
 
 	isFocused := ship.ID == focusID
 
+	// Linked commits (counted per task for the focused shipment's task list)
+	commitCount := 0
+	taskCommits := make(map[string]int)
+	if s.commitService != nil {
+		commits, err := s.commitService.ListCommits(ctx, primary.CommitFilters{ShipmentID: ship.ID})
+		if err == nil {
+			commitCount = len(commits)
+			for _, c := range commits {
+				if c.TaskID != "" {
+					taskCommits[c.TaskID]++
+				}
+			}
+		}
+	}
+
+	// Cached diff size only: summaries never shell out to git
+	diffBadge := ""
+	if s.diffStatService != nil {
+		if stat, err := s.diffStatService.GetShipmentDiffStat(ctx, ship.ID); err == nil && stat != nil {
+			diffBadge = coregit.FormatBadge(stat.Insertions, stat.Deletions)
+		}
+	}
+
+	// Stored check results only: summaries never call the PR provider
+	checksState := ""
+	if s.prService != nil {
+		if state, err := s.prService.GetShipmentChecksState(ctx, ship.ID); err == nil {
+			checksState = state
+		}
+	}
+
 	if err == nil {
 		for _, t := range tasks {
 			tasksTotal++
@@ -212,9 +253,10 @@ func (s *SummaryServiceImpl) buildShipmentSummary(ctx context.Context, ship *pri
 			// Include non-closed tasks for focused shipment
 			if isFocused && t.Status != "closed" {
 				taskSummary := primary.TaskSummary{
-					ID:     t.ID,
-					Title:  t.Title,
-					Status: t.Status,
+					ID:          t.ID,
+					Title:       t.Title,
+					Status:      t.Status,
+					CommitCount: taskCommits[t.ID],
 				}
 				// Fetch children for focused shipment tasks
 				s.fetchTaskChildren(ctx, &taskSummary)
@@ -254,18 +296,21 @@ func (s *SummaryServiceImpl) buildShipmentSummary(ctx context.Context, ship *pri
 	}
 
 	return &primary.ShipmentSummary{
-		ID:         ship.ID,
-		Title:      ship.Title,
-		Status:     ship.Status,
-		IsFocused:  isFocused,
-		Pinned:     ship.Pinned,
-		BenchID:    ship.AssignedWorkbenchID,
-		BenchName:  benchName,
-		TasksDone:  tasksDone,
-		TasksTotal: tasksTotal,
-		NoteCount:  noteCount,
-		Tasks:      taskSummaries,
-		Notes:      noteSummaries,
+		ID:          ship.ID,
+		Title:       ship.Title,
+		Status:      ship.Status,
+		IsFocused:   isFocused,
+		Pinned:      ship.Pinned,
+		BenchID:     ship.AssignedWorkbenchID,
+		BenchName:   benchName,
+		TasksDone:   tasksDone,
+		TasksTotal:  tasksTotal,
+		NoteCount:   noteCount,
+		CommitCount: commitCount,
+		DiffBadge:   diffBadge,
+		ChecksState: checksState,
+		Tasks:       taskSummaries,
+		Notes:       noteSummaries,
 	}, nil
 }
 
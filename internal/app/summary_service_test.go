@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
 )
 
 // ============================================================================
@@ -77,6 +78,14 @@ func (m *mockCommissionServiceForSummary) UnpinCommission(_ context.Context, _ s
 	return nil
 }
 
+func (m *mockCommissionServiceForSummary) GetCommissionRollup(_ context.Context, _ string) (*primary.CommissionRollup, error) {
+	return nil, nil
+}
+
+func (m *mockCommissionServiceForSummary) SyncCommissionLifecycle(_ context.Context, _ string) (*primary.CommissionLifecycleResult, error) {
+	return nil, nil
+}
+
 // mockTomeServiceForSummary implements primary.TomeService for testing.
 type mockTomeServiceForSummary struct {
 	tomes     map[string]*primary.Tome
@@ -112,8 +121,8 @@ func (m *mockTomeServiceForSummary) ListTomes(_ context.Context, filters primary
 	return result, nil
 }
 
-func (m *mockTomeServiceForSummary) CloseTome(_ context.Context, _ string) error {
-	return nil
+func (m *mockTomeServiceForSummary) CloseTome(_ context.Context, _ string) (*primary.CommissionLifecycleResult, error) {
+	return nil, nil
 }
 
 func (m *mockTomeServiceForSummary) UpdateTome(_ context.Context, _ primary.UpdateTomeRequest) error {
@@ -163,12 +172,14 @@ func (m *mockTomeServiceForSummary) MoveTomeToCommission(_ context.Context, _, _
 type mockShipmentServiceForSummary struct {
 	shipments     map[string]*primary.Shipment
 	shipmentTasks map[string][]*primary.Task
+	shipmentRepos map[string][]*primary.ShipmentRepo
 }
 
 func newMockShipmentServiceForSummary() *mockShipmentServiceForSummary {
 	return &mockShipmentServiceForSummary{
 		shipments:     make(map[string]*primary.Shipment),
 		shipmentTasks: make(map[string][]*primary.Task),
+		shipmentRepos: make(map[string][]*primary.ShipmentRepo),
 	}
 }
 
@@ -194,8 +205,8 @@ func (m *mockShipmentServiceForSummary) ListShipments(_ context.Context, filters
 	return result, nil
 }
 
-func (m *mockShipmentServiceForSummary) CompleteShipment(_ context.Context, _ string, _ bool) error {
-	return nil
+func (m *mockShipmentServiceForSummary) CompleteShipment(_ context.Context, _ string, _ bool) (*primary.CommissionLifecycleResult, error) {
+	return nil, nil
 }
 
 func (m *mockShipmentServiceForSummary) PauseShipment(_ context.Context, _ string) error {
@@ -218,8 +229,8 @@ func (m *mockShipmentServiceForSummary) UnpinShipment(_ context.Context, _ strin
 	return nil
 }
 
-func (m *mockShipmentServiceForSummary) AssignShipmentToWorkbench(_ context.Context, _, _ string) error {
-	return nil
+func (m *mockShipmentServiceForSummary) AssignShipmentToWorkbench(_ context.Context, _, _ string) (*primary.AssignShipmentResponse, error) {
+	return &primary.AssignShipmentResponse{}, nil
 }
 
 func (m *mockShipmentServiceForSummary) GetShipmentsByWorkbench(_ context.Context, _ string) ([]*primary.Shipment, error) {
@@ -253,14 +264,30 @@ func (m *mockShipmentServiceForSummary) VerifyShipment(_ context.Context, _ stri
 	return nil
 }
 
-func (m *mockShipmentServiceForSummary) SetStatus(_ context.Context, _, _ string, _ bool) error {
-	return nil
+func (m *mockShipmentServiceForSummary) SetStatus(_ context.Context, _, _ string, _ bool) (*primary.CommissionLifecycleResult, error) {
+	return nil, nil
 }
 
 func (m *mockShipmentServiceForSummary) MoveShipmentToCommission(_ context.Context, _, _ string) (*primary.MoveShipmentResult, error) {
 	return &primary.MoveShipmentResult{}, nil
 }
 
+func (m *mockShipmentServiceForSummary) ImportPlan(_ context.Context, _ primary.ImportPlanRequest) (*primary.ImportPlanResponse, error) {
+	return &primary.ImportPlanResponse{}, nil
+}
+
+func (m *mockShipmentServiceForSummary) AddShipmentRepo(_ context.Context, _ primary.AddShipmentRepoRequest) (*primary.ShipmentRepo, error) {
+	return nil, nil
+}
+
+func (m *mockShipmentServiceForSummary) RemoveShipmentRepo(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *mockShipmentServiceForSummary) ListShipmentRepos(_ context.Context, shipmentID string) ([]*primary.ShipmentRepo, error) {
+	return m.shipmentRepos[shipmentID], nil
+}
+
 // mockTaskServiceForSummary implements primary.TaskService for testing.
 type mockTaskServiceForSummary struct{}
 
@@ -284,8 +311,8 @@ func (m *mockTaskServiceForSummary) ClaimTask(_ context.Context, _ primary.Claim
 	return nil
 }
 
-func (m *mockTaskServiceForSummary) CompleteTask(_ context.Context, _ string) error {
-	return nil
+func (m *mockTaskServiceForSummary) CompleteTask(_ context.Context, _ primary.CompleteTaskRequest) (*primary.TaskCheckpoint, error) {
+	return nil, nil
 }
 
 func (m *mockTaskServiceForSummary) PauseTask(_ context.Context, _ string) error {
@@ -332,6 +359,26 @@ func (m *mockTaskServiceForSummary) DiscoverTasks(_ context.Context, _ string) (
 	return nil, nil
 }
 
+func (m *mockTaskServiceForSummary) CheckpointTask(_ context.Context, _ primary.CheckpointTaskRequest) (*primary.TaskCheckpoint, error) {
+	return nil, nil
+}
+
+func (m *mockTaskServiceForSummary) GetTaskCommits(_ context.Context, _ string) ([]*primary.TaskCommit, error) {
+	return nil, nil
+}
+
+func (m *mockTaskServiceForSummary) GetTaskDiff(_ context.Context, _ string, _ bool) (string, error) {
+	return "", nil
+}
+
+func (m *mockTaskServiceForSummary) PreviewTaskRollback(_ context.Context, _ string) (*primary.TaskRollbackPreview, error) {
+	return nil, nil
+}
+
+func (m *mockTaskServiceForSummary) RollbackTask(_ context.Context, _ string) error {
+	return nil
+}
+
 func (m *mockTaskServiceForSummary) MoveTask(_ context.Context, _ primary.MoveTaskRequest) error {
 	return nil
 }
@@ -397,12 +444,15 @@ func (m *mockNoteServiceForSummary) SetNoteInFlight(_ context.Context, _ string)
 
 // mockWorkbenchServiceForSummary implements primary.WorkbenchService for testing.
 type mockWorkbenchServiceForSummary struct {
-	workbenches map[string]*primary.Workbench
+	workbenches     map[string]*primary.Workbench
+	startedBranches map[string]string // workbenchID -> branch passed to StartBranch
+	startBranchErr  error
 }
 
 func newMockWorkbenchServiceForSummary() *mockWorkbenchServiceForSummary {
 	return &mockWorkbenchServiceForSummary{
-		workbenches: make(map[string]*primary.Workbench),
+		workbenches:     make(map[string]*primary.Workbench),
+		startedBranches: make(map[string]string),
 	}
 }
 
@@ -441,10 +491,38 @@ func (m *mockWorkbenchServiceForSummary) CheckoutBranch(_ context.Context, _ pri
 	return nil, nil
 }
 
+func (m *mockWorkbenchServiceForSummary) StartBranch(_ context.Context, req primary.StartBranchRequest) (*primary.CheckoutBranchResponse, error) {
+	if m.startBranchErr != nil {
+		return nil, m.startBranchErr
+	}
+	m.startedBranches[req.WorkbenchID] = req.Branch
+	return &primary.CheckoutBranchResponse{CurrentBranch: req.Branch}, nil
+}
+
+func (m *mockWorkbenchServiceForSummary) ReturnToHomeBranch(_ context.Context, _ primary.ReturnToHomeBranchRequest) (*primary.ReturnToHomeBranchResponse, error) {
+	return nil, nil
+}
+
 func (m *mockWorkbenchServiceForSummary) GetWorkbenchStatus(_ context.Context, _ string) (*primary.WorkbenchGitStatus, error) {
 	return nil, nil
 }
 
+func (m *mockWorkbenchServiceForSummary) SyncWorkbenches(_ context.Context, _ primary.SyncWorkbenchesRequest) ([]*primary.WorkbenchSyncResult, error) {
+	return nil, nil
+}
+
+func (m *mockWorkbenchServiceForSummary) BootstrapWorkbench(_ context.Context, _ string) (*primary.BootstrapResult, error) {
+	return nil, nil
+}
+
+func (m *mockWorkbenchServiceForSummary) RelocateWorkbenches(_ context.Context, _ primary.RelocateWorkbenchesRequest) ([]*primary.WorkbenchRelocation, error) {
+	return nil, nil
+}
+
+func (m *mockWorkbenchServiceForSummary) UpdateSparsePaths(_ context.Context, _ primary.UpdateSparsePathsRequest) ([]string, error) {
+	return nil, nil
+}
+
 func (m *mockWorkbenchServiceForSummary) UpdateFocusedID(_ context.Context, _, _ string) error {
 	return nil
 }
@@ -527,7 +605,7 @@ func TestSummaryService_GetCommissionSummary_FlatStructure(t *testing.T) {
 	}
 
 	// Create service
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil, nil, nil, nil)
 
 	// Request summary
 	req := primary.SummaryRequest{
@@ -616,7 +694,7 @@ func TestSummaryService_GetCommissionSummary_ShowsAllShipments(t *testing.T) {
 	}
 
 	// Create service
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil, nil, nil, nil)
 
 	// Request summary - all shipments should be visible regardless of workbench assignment
 	req := primary.SummaryRequest{
@@ -670,7 +748,7 @@ func TestSummaryService_GetCommissionSummary_TaskCounting(t *testing.T) {
 		{ID: "TASK-008", Status: "open"},
 	}
 
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil, nil, nil, nil)
 
 	req := primary.SummaryRequest{
 		CommissionID: "COMM-001",
@@ -690,6 +768,115 @@ func TestSummaryService_GetCommissionSummary_TaskCounting(t *testing.T) {
 	}
 }
 
+func TestSummaryService_GetCommissionSummary_CommitCounts(t *testing.T) {
+	commissionSvc := newMockCommissionServiceForSummary()
+	shipmentSvc := newMockShipmentServiceForSummary()
+
+	commissionSvc.commissions["COMM-001"] = &primary.Commission{ID: "COMM-001", Title: "Test Commission", Status: "active"}
+	shipmentSvc.shipments["SHIP-001"] = &primary.Shipment{ID: "SHIP-001", CommissionID: "COMM-001", Title: "Feature Work", Status: "active"}
+	shipmentSvc.shipmentTasks["SHIP-001"] = []*primary.Task{
+		{ID: "TASK-001", Status: "in-progress"},
+		{ID: "TASK-002", Status: "open"},
+	}
+
+	commitRepo := newMockCommitRepository()
+	for _, c := range []*secondary.CommitRecord{
+		{SHA: "a1", TaskID: "TASK-001", ShipmentID: "SHIP-001"},
+		{SHA: "a2", TaskID: "TASK-001", ShipmentID: "SHIP-001"},
+		{SHA: "a3", ShipmentID: "SHIP-001"},
+	} {
+		_ = commitRepo.Upsert(context.Background(), c)
+	}
+	commitSvc := NewCommitService(commitRepo, nil, nil, nil, nil, nil, nil)
+
+	svc := NewSummaryService(commissionSvc, newMockTomeServiceForSummary(), shipmentSvc, newMockTaskServiceForSummary(),
+		newMockNoteServiceForSummary(), newMockWorkbenchServiceForSummary(), nil, commitSvc, nil, nil)
+
+	summary, err := svc.GetCommissionSummary(context.Background(), primary.SummaryRequest{CommissionID: "COMM-001", FocusID: "SHIP-001"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ship := summary.Shipments[0]
+	if ship.CommitCount != 3 {
+		t.Errorf("expected 3 shipment commits, got %d", ship.CommitCount)
+	}
+	if len(ship.Tasks) != 2 || ship.Tasks[0].CommitCount != 2 || ship.Tasks[1].CommitCount != 0 {
+		t.Errorf("task commit counts = %+v, want 2 and 0", ship.Tasks)
+	}
+}
+
+func TestSummaryService_GetCommissionSummary_DiffBadge(t *testing.T) {
+	commissionSvc := newMockCommissionServiceForSummary()
+	shipmentSvc := newMockShipmentServiceForSummary()
+
+	commissionSvc.commissions["COMM-001"] = &primary.Commission{ID: "COMM-001", Title: "Test Commission", Status: "active"}
+	shipmentSvc.shipments["SHIP-001"] = &primary.Shipment{ID: "SHIP-001", CommissionID: "COMM-001", Title: "Measured", Status: "active"}
+	shipmentSvc.shipments["SHIP-002"] = &primary.Shipment{ID: "SHIP-002", CommissionID: "COMM-001", Title: "Unmeasured", Status: "active"}
+
+	diffStatRepo := newMockShipmentDiffStatRepository()
+	_ = diffStatRepo.Save(context.Background(), "SHIP-001", []*secondary.ShipmentDiffStatRecord{
+		{ShipmentID: "SHIP-001", RepoID: "REPO-001", Insertions: 100, Deletions: 30},
+		{ShipmentID: "SHIP-001", RepoID: "REPO-002", Insertions: 20, Deletions: 10},
+	}, nil)
+	diffStatSvc := NewDiffStatService(diffStatRepo, nil, nil, nil, nil, nil, nil)
+
+	svc := NewSummaryService(commissionSvc, newMockTomeServiceForSummary(), shipmentSvc, newMockTaskServiceForSummary(),
+		newMockNoteServiceForSummary(), newMockWorkbenchServiceForSummary(), nil, nil, diffStatSvc, nil)
+
+	summary, err := svc.GetCommissionSummary(context.Background(), primary.SummaryRequest{CommissionID: "COMM-001"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	badges := make(map[string]string)
+	for _, ship := range summary.Shipments {
+		badges[ship.ID] = ship.DiffBadge
+	}
+	if badges["SHIP-001"] != "+120/-40" {
+		t.Errorf("SHIP-001 badge = %q, want %q", badges["SHIP-001"], "+120/-40")
+	}
+	if badges["SHIP-002"] != "" {
+		t.Errorf("SHIP-002 badge = %q, want none before stats are computed", badges["SHIP-002"])
+	}
+}
+
+func TestSummaryService_GetCommissionSummary_ChecksState(t *testing.T) {
+	commissionSvc := newMockCommissionServiceForSummary()
+	shipmentSvc := newMockShipmentServiceForSummary()
+
+	commissionSvc.commissions["COMM-001"] = &primary.Commission{ID: "COMM-001", Title: "Test Commission", Status: "active"}
+	shipmentSvc.shipments["SHIP-001"] = &primary.Shipment{ID: "SHIP-001", CommissionID: "COMM-001", Title: "Red", Status: "active"}
+	shipmentSvc.shipments["SHIP-002"] = &primary.Shipment{ID: "SHIP-002", CommissionID: "COMM-001", Title: "No PR", Status: "active"}
+
+	prRepo := newMockPRRepository()
+	prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", Status: "open"}
+	prRepo.checks["PR-001"] = []*secondary.PRCheckRecord{
+		{Name: "lint", Status: "completed", Conclusion: "success"},
+		{Name: "test", Status: "completed", Conclusion: "failure"},
+	}
+	prSvc := NewPRService(prRepo, newMockShipmentServiceForPR(), &mockTransactor{}, nil, nil, nil, nil, nil, nil)
+
+	svc := NewSummaryService(commissionSvc, newMockTomeServiceForSummary(), shipmentSvc, newMockTaskServiceForSummary(),
+		newMockNoteServiceForSummary(), newMockWorkbenchServiceForSummary(), nil, nil, nil, prSvc)
+
+	summary, err := svc.GetCommissionSummary(context.Background(), primary.SummaryRequest{CommissionID: "COMM-001"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	states := make(map[string]string)
+	for _, ship := range summary.Shipments {
+		states[ship.ID] = ship.ChecksState
+	}
+	if states["SHIP-001"] != primary.PRCheckFailing {
+		t.Errorf("SHIP-001 checks = %q, want failing", states["SHIP-001"])
+	}
+	if states["SHIP-002"] != "" {
+		t.Errorf("SHIP-002 checks = %q, want none without a PR", states["SHIP-002"])
+	}
+}
+
 func TestSummaryService_GetCommissionSummary_HidesClosedAndComplete(t *testing.T) {
 	// Setup mocks
 	commissionSvc := newMockCommissionServiceForSummary()
@@ -741,7 +928,7 @@ func TestSummaryService_GetCommissionSummary_HidesClosedAndComplete(t *testing.T
 		Status:       "closed",
 	}
 
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil, nil, nil, nil)
 
 	req := primary.SummaryRequest{
 		CommissionID: "COMM-001",
@@ -785,7 +972,7 @@ func TestSummaryService_GetCommissionSummary_FocusedCommission(t *testing.T) {
 		Status:       "active",
 	}
 
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil, nil, nil, nil)
 
 	// Test with focus on shipment in this commission
 	req := primary.SummaryRequest{
@@ -848,7 +1035,7 @@ func TestSummaryService_GetCommissionSummary_TomeNoteCount(t *testing.T) {
 		{ID: "NOTE-003", Title: "Closed Note", Status: "closed"},
 	}
 
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil, nil, nil, nil)
 
 	req := primary.SummaryRequest{
 		CommissionID: "COMM-001",
@@ -937,7 +1124,7 @@ func TestSummaryService_GetCommissionSummary_TomeExpansion(t *testing.T) {
 				Status:       "active",
 			}
 
-			svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+			svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil, nil, nil, nil)
 
 			req := primary.SummaryRequest{
 				CommissionID: "COMM-001",
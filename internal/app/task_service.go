@@ -12,10 +12,13 @@ import (
 
 // TaskServiceImpl implements the TaskService interface.
 type TaskServiceImpl struct {
-	taskRepo     secondary.TaskRepository
-	tagRepo      secondary.TagRepository
-	shipmentRepo secondary.ShipmentRepository
-	transactor   secondary.Transactor
+	taskRepo         secondary.TaskRepository
+	tagRepo          secondary.TagRepository
+	shipmentRepo     secondary.ShipmentRepository
+	workbenchService primary.WorkbenchService // Optional: locates workbenches for git checkpoints
+	workspace        secondary.WorkspaceAdapter
+	gitService       *GitService
+	transactor       secondary.Transactor
 }
 
 // NewTaskService creates a new TaskService with injected dependencies.
@@ -23,13 +26,18 @@ func NewTaskService(
 	taskRepo secondary.TaskRepository,
 	tagRepo secondary.TagRepository,
 	shipmentRepo secondary.ShipmentRepository,
+	workbenchService primary.WorkbenchService,
+	workspace secondary.WorkspaceAdapter,
 	transactor secondary.Transactor,
 ) *TaskServiceImpl {
 	return &TaskServiceImpl{
-		taskRepo:     taskRepo,
-		tagRepo:      tagRepo,
-		shipmentRepo: shipmentRepo,
-		transactor:   transactor,
+		taskRepo:         taskRepo,
+		tagRepo:          tagRepo,
+		shipmentRepo:     shipmentRepo,
+		workbenchService: workbenchService,
+		workspace:        workspace,
+		gitService:       NewGitService(),
+		transactor:       transactor,
 	}
 }
 
@@ -158,14 +166,27 @@ func (s *TaskServiceImpl) ListTasks(ctx context.Context, filters primary.TaskFil
 }
 
 // ClaimTask claims a task for a workbench.
+// Records the workbench HEAD as the task's checkpoint, unless one exists from an earlier claim.
 func (s *TaskServiceImpl) ClaimTask(ctx context.Context, req primary.ClaimTaskRequest) error {
 	// Verify task exists
-	_, err := s.taskRepo.GetByID(ctx, req.TaskID)
+	record, err := s.taskRepo.GetByID(ctx, req.TaskID)
 	if err != nil {
 		return err
 	}
 
-	return s.taskRepo.Claim(ctx, req.TaskID, req.WorkbenchID)
+	if err := s.taskRepo.Claim(ctx, req.TaskID, req.WorkbenchID); err != nil {
+		return err
+	}
+
+	// Best effort: a workbench without git simply has no checkpoint
+	if record.StartCommit == "" {
+		if path := s.workbenchPath(ctx, req.WorkbenchID); path != "" {
+			if head, err := s.gitService.GetHeadCommit(path); err == nil {
+				_ = s.taskRepo.UpdateCommits(ctx, req.TaskID, head, "")
+			}
+		}
+	}
+	return nil
 }
 
 // CloseTask marks a task as closed.
@@ -180,12 +201,40 @@ func (s *TaskServiceImpl) CloseTask(ctx context.Context, taskID string) error {
 		return fmt.Errorf("cannot close pinned task %s. Unpin first with: orc task unpin %s", taskID, taskID)
 	}
 
-	return s.taskRepo.UpdateStatus(ctx, taskID, "closed", false, true)
+	if err := s.taskRepo.UpdateStatus(ctx, taskID, "closed", false, true); err != nil {
+		return err
+	}
+
+	// Best effort: close the commit range at the workbench HEAD
+	if record.StartCommit != "" {
+		if path := s.workbenchPath(ctx, record.AssignedWorkbenchID); path != "" {
+			if head, err := s.gitService.GetHeadCommit(path); err == nil {
+				_ = s.taskRepo.UpdateCommits(ctx, taskID, record.StartCommit, head)
+			}
+		}
+	}
+	return nil
 }
 
-// CompleteTask marks a task as closed (backwards compatibility alias).
-func (s *TaskServiceImpl) CompleteTask(ctx context.Context, taskID string) error {
-	return s.CloseTask(ctx, taskID)
+// CompleteTask marks a task as closed. A requested checkpoint commit or tag is only
+// made once the close guard passes, so a refused completion leaves git untouched.
+func (s *TaskServiceImpl) CompleteTask(ctx context.Context, req primary.CompleteTaskRequest) (*primary.TaskCheckpoint, error) {
+	record, err := s.taskRepo.GetByID(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := task.CanCloseTask(task.CloseTaskContext{TaskID: req.TaskID, IsPinned: record.Pinned}).Error(); err != nil {
+		return nil, err
+	}
+
+	var checkpoint *primary.TaskCheckpoint
+	if req.Checkpoint != "" {
+		checkpoint, err = s.CheckpointTask(ctx, primary.CheckpointTaskRequest{TaskID: req.TaskID, Mode: req.Checkpoint})
+		if err != nil {
+			return nil, fmt.Errorf("failed to checkpoint task: %w", err)
+		}
+	}
+	return checkpoint, s.CloseTask(ctx, req.TaskID)
 }
 
 // PauseTask pauses an in-progress task (sets to open).
@@ -410,3 +459,154 @@ func (s *TaskServiceImpl) MoveTask(ctx context.Context, req primary.MoveTaskRequ
 
 // Ensure TaskServiceImpl implements the interface
 var _ primary.TaskService = (*TaskServiceImpl)(nil)
+
+// CheckpointTask records the end of a task's commit range, optionally committing or tagging first.
+func (s *TaskServiceImpl) CheckpointTask(ctx context.Context, req primary.CheckpointTaskRequest) (*primary.TaskCheckpoint, error) {
+	record, path, err := s.taskWorkbench(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &primary.TaskCheckpoint{TaskID: req.TaskID, StartCommit: record.StartCommit}
+	switch req.Mode {
+	case "":
+	case primary.CheckpointModeCommit:
+		committed, err := s.gitService.CommitAll(path, fmt.Sprintf("checkpoint: %s %s", record.ID, record.Title))
+		if err != nil {
+			return nil, err
+		}
+		checkpoint.Committed = committed
+	case primary.CheckpointModeTag:
+		checkpoint.Tag = "orc/" + record.ID
+		if err := s.gitService.CreateTag(path, checkpoint.Tag, "HEAD"); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown checkpoint mode %q (use %s or %s)", req.Mode, primary.CheckpointModeCommit, primary.CheckpointModeTag)
+	}
+
+	head, err := s.gitService.GetHeadCommit(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workbench HEAD: %w", err)
+	}
+	checkpoint.EndCommit = head
+	if err := s.taskRepo.UpdateCommits(ctx, req.TaskID, record.StartCommit, head); err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+// GetTaskCommits lists commits between the task's checkpoint and its end commit
+// (or the workbench HEAD while the task is still open).
+func (s *TaskServiceImpl) GetTaskCommits(ctx context.Context, taskID string) ([]*primary.TaskCommit, error) {
+	record, path, err := s.taskWorkbench(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	end := record.EndCommit
+	if end == "" {
+		end = "HEAD"
+	}
+	return s.listCommits(path, record.StartCommit, end)
+}
+
+// GetTaskDiff returns the diff of a task's commit range.
+// While the task is open, the diff runs against the working tree so uncommitted work shows up.
+func (s *TaskServiceImpl) GetTaskDiff(ctx context.Context, taskID string, stat bool) (string, error) {
+	record, path, err := s.taskWorkbench(ctx, taskID)
+	if err != nil {
+		return "", err
+	}
+	return s.gitService.Diff(path, record.StartCommit, record.EndCommit, stat)
+}
+
+// PreviewTaskRollback describes what resetting to the task's checkpoint would discard.
+func (s *TaskServiceImpl) PreviewTaskRollback(ctx context.Context, taskID string) (*primary.TaskRollbackPreview, error) {
+	record, path, err := s.taskWorkbench(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	guardCtx := task.RollbackTaskContext{
+		TaskID:          taskID,
+		WorkbenchID:     record.AssignedWorkbenchID,
+		StartCommit:     record.StartCommit,
+		StartIsAncestor: s.gitService.IsMergedInto(path, record.StartCommit, "HEAD"),
+	}
+	if result := task.CanRollbackTask(guardCtx); !result.Allowed {
+		return nil, result.Error()
+	}
+
+	discarded, err := s.listCommits(path, record.StartCommit, "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	// reset --hard leaves untracked files (such as .orc/config.json) alone
+	dirty, _ := s.gitService.GetTrackedChangeCount(path)
+
+	return &primary.TaskRollbackPreview{
+		TaskID:        taskID,
+		WorkbenchID:   record.AssignedWorkbenchID,
+		WorkbenchPath: path,
+		StartCommit:   record.StartCommit,
+		Discarded:     discarded,
+		DirtyFiles:    dirty,
+	}, nil
+}
+
+// RollbackTask hard-resets the workbench to the task's checkpoint and clears the task's end commit.
+func (s *TaskServiceImpl) RollbackTask(ctx context.Context, taskID string) error {
+	preview, err := s.PreviewTaskRollback(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.gitService.ResetHard(preview.WorkbenchPath, preview.StartCommit); err != nil {
+		return err
+	}
+	return s.taskRepo.UpdateCommits(ctx, taskID, preview.StartCommit, "")
+}
+
+// taskWorkbench loads a task with a checkpoint and the path of the workbench it was claimed in.
+func (s *TaskServiceImpl) taskWorkbench(ctx context.Context, taskID string) (*secondary.TaskRecord, string, error) {
+	record, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, "", err
+	}
+	if record.StartCommit == "" {
+		return nil, "", fmt.Errorf("task %s has no checkpoint (it was not claimed in a git workbench)", taskID)
+	}
+	path := s.workbenchPath(ctx, record.AssignedWorkbenchID)
+	if path == "" {
+		return nil, "", fmt.Errorf("workbench %s for task %s is not available", record.AssignedWorkbenchID, taskID)
+	}
+	return record, path, nil
+}
+
+// workbenchPath returns the path of a workbench if it exists on disk, or "".
+func (s *TaskServiceImpl) workbenchPath(ctx context.Context, workbenchID string) string {
+	if s.workbenchService == nil || workbenchID == "" {
+		return ""
+	}
+	wb, err := s.workbenchService.GetWorkbench(ctx, workbenchID)
+	if err != nil || wb == nil {
+		return ""
+	}
+	if exists, err := s.workspace.DirectoryExists(ctx, wb.Path); err != nil || !exists {
+		return ""
+	}
+	return wb.Path
+}
+
+func (s *TaskServiceImpl) listCommits(path, from, to string) ([]*primary.TaskCommit, error) {
+	commits, err := s.gitService.ListCommits(path, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits: %w", err)
+	}
+	result := make([]*primary.TaskCommit, len(commits))
+	for i, c := range commits {
+		result[i] = &primary.TaskCommit{Hash: c.Hash, Author: c.Author, Date: c.Date, Subject: c.Subject}
+	}
+	return result, nil
+}
@@ -3,6 +3,9 @@ package app
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/example/orc/internal/ports/primary"
@@ -72,6 +75,9 @@ func (m *mockTaskRepository) List(ctx context.Context, filters secondary.TaskFil
 		if filters.Status != "" && t.Status != filters.Status {
 			continue
 		}
+		if filters.WorkbenchID != "" && t.AssignedWorkbenchID != filters.WorkbenchID {
+			continue
+		}
 		result = append(result, t)
 	}
 	return result, nil
@@ -166,6 +172,21 @@ func (m *mockTaskRepository) Claim(ctx context.Context, id, workbenchID string)
 	return nil
 }
 
+func (m *mockTaskRepository) UpdateDependsOn(ctx context.Context, id, dependsOn string) error {
+	if task, ok := m.tasks[id]; ok {
+		task.DependsOn = dependsOn
+	}
+	return nil
+}
+
+func (m *mockTaskRepository) UpdateCommits(ctx context.Context, id, startCommit, endCommit string) error {
+	if task, ok := m.tasks[id]; ok {
+		task.StartCommit = startCommit
+		task.EndCommit = endCommit
+	}
+	return nil
+}
+
 func (m *mockTaskRepository) AssignWorkbenchByShipment(ctx context.Context, shipmentID, workbenchID string) error {
 	return nil
 }
@@ -282,7 +303,7 @@ func (m *mockTagRepositoryForTask) GetEntityTag(ctx context.Context, entityID, e
 func newTestTaskService() (*TaskServiceImpl, *mockTaskRepository, *mockTagRepositoryForTask) {
 	taskRepo := newMockTaskRepository()
 	tagRepo := newMockTagRepositoryForTask()
-	service := NewTaskService(taskRepo, tagRepo, nil, &mockTransactor{}) // nil shipmentRepo for basic tests
+	service := NewTaskService(taskRepo, tagRepo, nil, nil, nil, &mockTransactor{}) // nil shipmentRepo for basic tests
 	return service, taskRepo, tagRepo
 }
 
@@ -600,7 +621,7 @@ func TestCompleteTask_UnpinnedAllowed(t *testing.T) {
 		Pinned:       false,
 	}
 
-	err := service.CompleteTask(ctx, "TASK-001")
+	_, err := service.CompleteTask(ctx, primary.CompleteTaskRequest{TaskID: "TASK-001"})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -622,7 +643,7 @@ func TestCompleteTask_PinnedBlocked(t *testing.T) {
 		Pinned:       true,
 	}
 
-	err := service.CompleteTask(ctx, "TASK-001")
+	_, err := service.CompleteTask(ctx, primary.CompleteTaskRequest{TaskID: "TASK-001"})
 
 	if err == nil {
 		t.Fatal("expected error for completing pinned task, got nil")
@@ -633,7 +654,7 @@ func TestCompleteTask_NotFound(t *testing.T) {
 	service, _, _ := newTestTaskService()
 	ctx := context.Background()
 
-	err := service.CompleteTask(ctx, "TASK-NONEXISTENT")
+	_, err := service.CompleteTask(ctx, primary.CompleteTaskRequest{TaskID: "TASK-NONEXISTENT"})
 
 	if err == nil {
 		t.Fatal("expected error for non-existent task, got nil")
@@ -1054,3 +1075,213 @@ func TestDeleteTask_NotFound(t *testing.T) {
 		t.Fatal("expected error for non-existent task")
 	}
 }
+
+// ============================================================================
+// Git Checkpoint Tests
+// ============================================================================
+
+// newTestTaskServiceWithWorkbench returns a task service whose BENCH-001 is a git repo
+// with one commit, and TASK-001 open in it.
+func newTestTaskServiceWithWorkbench(t *testing.T) (*TaskServiceImpl, *mockTaskRepository, string) {
+	t.Helper()
+	home := setupGitHome(t)
+	path := filepath.Join(home, "wb", "bench")
+	if err := os.MkdirAll(path, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, path, "init", "-q", "-b", "main")
+	commitFile(t, path, "README.md", "hello\n")
+
+	workbenchService := newMockWorkbenchServiceForSummary()
+	workbenchService.workbenches["BENCH-001"] = &primary.Workbench{ID: "BENCH-001", Path: path}
+
+	taskRepo := newMockTaskRepository()
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Title: "Add login", Status: "open"}
+	workspace := newMockWorkspaceAdapter()
+	workspace.reposBasePath = home // Check directories on disk
+	service := NewTaskService(taskRepo, newMockTagRepositoryForTask(), nil, workbenchService, workspace, &mockTransactor{})
+	return service, taskRepo, path
+}
+
+func TestClaimAndCompleteTask_RecordsCommitRange(t *testing.T) {
+	service, taskRepo, path := newTestTaskServiceWithWorkbench(t)
+	ctx := context.Background()
+
+	if err := service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001"}); err != nil {
+		t.Fatalf("ClaimTask failed: %v", err)
+	}
+	start := taskRepo.tasks["TASK-001"].StartCommit
+	if start == "" {
+		t.Fatal("expected start commit to be recorded on claim")
+	}
+
+	commitFile(t, path, "login.go", "package login\n")
+	commitFile(t, path, "login_test.go", "package login\n")
+
+	if _, err := service.CompleteTask(ctx, primary.CompleteTaskRequest{TaskID: "TASK-001"}); err != nil {
+		t.Fatalf("CompleteTask failed: %v", err)
+	}
+	if end := taskRepo.tasks["TASK-001"].EndCommit; end == "" || end == start {
+		t.Errorf("end commit = %q, want HEAD after the task's commits", end)
+	}
+
+	commits, err := service.GetTaskCommits(ctx, "TASK-001")
+	if err != nil {
+		t.Fatalf("GetTaskCommits failed: %v", err)
+	}
+	if len(commits) != 2 || commits[0].Subject != "update login.go" {
+		t.Errorf("commits = %+v, want the 2 task commits oldest first", commits)
+	}
+
+	// Commits after completion belong to later work
+	commitFile(t, path, "other.go", "package other\n")
+	stat, err := service.GetTaskDiff(ctx, "TASK-001", true)
+	if err != nil {
+		t.Fatalf("GetTaskDiff failed: %v", err)
+	}
+	if !strings.Contains(stat, "login.go") || strings.Contains(stat, "other.go") {
+		t.Errorf("diffstat = %q, want only the task's files", stat)
+	}
+}
+
+func TestClaimTask_KeepsExistingCheckpoint(t *testing.T) {
+	service, taskRepo, path := newTestTaskServiceWithWorkbench(t)
+	ctx := context.Background()
+
+	_ = service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001"})
+	start := taskRepo.tasks["TASK-001"].StartCommit
+
+	commitFile(t, path, "login.go", "package login\n")
+	_ = service.PauseTask(ctx, "TASK-001")
+	_ = service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001"})
+
+	if got := taskRepo.tasks["TASK-001"].StartCommit; got != start {
+		t.Errorf("start commit = %q after re-claim, want original %q", got, start)
+	}
+}
+
+func TestClaimTask_WithoutWorkbenchHasNoCheckpoint(t *testing.T) {
+	service, taskRepo, _ := newTestTaskService()
+	ctx := context.Background()
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "open"}
+
+	if err := service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001"}); err != nil {
+		t.Fatalf("ClaimTask failed: %v", err)
+	}
+	if taskRepo.tasks["TASK-001"].StartCommit != "" {
+		t.Error("expected no start commit without a workbench")
+	}
+	if _, err := service.GetTaskCommits(ctx, "TASK-001"); err == nil {
+		t.Error("expected error listing commits of a task without a checkpoint")
+	}
+}
+
+func TestCheckpointTask_Commit(t *testing.T) {
+	service, taskRepo, path := newTestTaskServiceWithWorkbench(t)
+	ctx := context.Background()
+	_ = service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001"})
+
+	if err := os.WriteFile(filepath.Join(path, "login.go"), []byte("package login\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(path, ".orc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(path, ".orc", "config.json"), []byte(`{"place_id":"BENCH-001"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoint, err := service.CheckpointTask(ctx, primary.CheckpointTaskRequest{TaskID: "TASK-001", Mode: primary.CheckpointModeCommit})
+	if err != nil {
+		t.Fatalf("CheckpointTask failed: %v", err)
+	}
+	if !checkpoint.Committed {
+		t.Error("expected a checkpoint commit")
+	}
+	if taskRepo.tasks["TASK-001"].EndCommit != checkpoint.EndCommit {
+		t.Errorf("stored end commit %q, want %q", taskRepo.tasks["TASK-001"].EndCommit, checkpoint.EndCommit)
+	}
+
+	commits, _ := service.GetTaskCommits(ctx, "TASK-001")
+	if len(commits) != 1 || commits[0].Subject != "checkpoint: TASK-001 Add login" {
+		t.Errorf("commits = %+v, want the checkpoint commit", commits)
+	}
+	if stat, _ := service.GetTaskDiff(ctx, "TASK-001", true); strings.Contains(stat, ".orc") {
+		t.Errorf("checkpoint commit includes workbench config: %q", stat)
+	}
+}
+
+func TestCheckpointTask_UnknownMode(t *testing.T) {
+	service, _, _ := newTestTaskServiceWithWorkbench(t)
+	ctx := context.Background()
+	_ = service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001"})
+
+	if _, err := service.CheckpointTask(ctx, primary.CheckpointTaskRequest{TaskID: "TASK-001", Mode: "squash"}); err == nil {
+		t.Error("expected error for unknown checkpoint mode")
+	}
+}
+
+func TestCompleteTask_PinnedSkipsCheckpoint(t *testing.T) {
+	service, taskRepo, path := newTestTaskServiceWithWorkbench(t)
+	ctx := context.Background()
+	_ = service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001"})
+	taskRepo.tasks["TASK-001"].Pinned = true
+
+	if err := os.WriteFile(filepath.Join(path, "login.go"), []byte("package login\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.CompleteTask(ctx, primary.CompleteTaskRequest{TaskID: "TASK-001", Checkpoint: primary.CheckpointModeCommit}); err == nil {
+		t.Fatal("expected error for completing pinned task")
+	}
+	if commits, _ := service.GetTaskCommits(ctx, "TASK-001"); len(commits) != 0 {
+		t.Errorf("expected no checkpoint commit for a refused completion, got %+v", commits)
+	}
+	if taskRepo.tasks["TASK-001"].EndCommit != "" {
+		t.Errorf("expected no end commit, got %q", taskRepo.tasks["TASK-001"].EndCommit)
+	}
+}
+
+func TestRollbackTask(t *testing.T) {
+	service, taskRepo, path := newTestTaskServiceWithWorkbench(t)
+	ctx := context.Background()
+	_ = service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001"})
+	start := taskRepo.tasks["TASK-001"].StartCommit
+
+	commitFile(t, path, "login.go", "package login\n")
+	if err := os.WriteFile(filepath.Join(path, "README.md"), []byte("edited\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	preview, err := service.PreviewTaskRollback(ctx, "TASK-001")
+	if err != nil {
+		t.Fatalf("PreviewTaskRollback failed: %v", err)
+	}
+	if len(preview.Discarded) != 1 || preview.DirtyFiles != 1 {
+		t.Errorf("preview discards %d commits and %d dirty files, want 1 and 1", len(preview.Discarded), preview.DirtyFiles)
+	}
+
+	if err := service.RollbackTask(ctx, "TASK-001"); err != nil {
+		t.Fatalf("RollbackTask failed: %v", err)
+	}
+	if head, _ := NewGitService().GetHeadCommit(path); head != start {
+		t.Errorf("HEAD = %q after rollback, want %q", head, start)
+	}
+	if _, err := os.Stat(filepath.Join(path, "login.go")); !os.IsNotExist(err) {
+		t.Error("expected login.go to be gone after rollback")
+	}
+}
+
+func TestRollbackTask_RejectsUnrelatedHistory(t *testing.T) {
+	service, _, path := newTestTaskServiceWithWorkbench(t)
+	ctx := context.Background()
+	_ = service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001"})
+
+	// Switch to a branch that does not contain the checkpoint
+	runGit(t, path, "checkout", "-q", "--orphan", "other")
+	commitFile(t, path, "other.go", "package other\n")
+
+	if err := service.RollbackTask(ctx, "TASK-001"); err == nil {
+		t.Error("expected rollback to be refused when the checkpoint is not in HEAD's history")
+	}
+}
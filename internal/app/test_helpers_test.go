@@ -2,6 +2,8 @@ package app
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -25,6 +27,13 @@ type mockWorkspaceAdapter struct {
 	worktreeExistsResult bool
 	createWorktreeErr    error
 	removeWorktreeErr    error
+	listWorktrees        map[string][]secondary.WorktreeInfo
+	workbenchDirs        []string
+	prunedRepos          []string
+	movedWorktrees       []string            // Source paths passed to MoveWorktree
+	removedEmptyDirs     []string            // Paths passed to RemoveEmptyDirectory
+	sparsePaths          map[string][]string // Sparse checkout paths by worktree path
+	reposBasePath        string              // When set, repo paths and directory checks use the real filesystem
 }
 
 func newMockWorkspaceAdapter() *mockWorkspaceAdapter {
@@ -42,6 +51,21 @@ func (m *mockWorkspaceAdapter) CreateWorktree(ctx context.Context, repoPath, bra
 	return nil
 }
 
+func (m *mockWorkspaceAdapter) CreateSparseWorktree(ctx context.Context, repoPath, branchName, targetPath string, paths []string) error {
+	if err := m.CreateWorktree(ctx, repoPath, branchName, targetPath); err != nil {
+		return err
+	}
+	return m.SetSparseCheckout(ctx, targetPath, paths)
+}
+
+func (m *mockWorkspaceAdapter) SetSparseCheckout(ctx context.Context, worktreePath string, paths []string) error {
+	if m.sparsePaths == nil {
+		m.sparsePaths = make(map[string][]string)
+	}
+	m.sparsePaths[worktreePath] = paths
+	return nil
+}
+
 func (m *mockWorkspaceAdapter) RemoveWorktree(ctx context.Context, path string) error {
 	if m.removeWorktreeErr != nil {
 		return m.removeWorktreeErr
@@ -57,7 +81,35 @@ func (m *mockWorkspaceAdapter) WorktreeExists(ctx context.Context, path string)
 	return m.worktrees[path], nil
 }
 
+func (m *mockWorkspaceAdapter) ListWorktrees(ctx context.Context, repoPath string) ([]secondary.WorktreeInfo, error) {
+	return m.listWorktrees[repoPath], nil
+}
+
+func (m *mockWorkspaceAdapter) PruneWorktrees(ctx context.Context, repoPath string) error {
+	m.prunedRepos = append(m.prunedRepos, repoPath)
+	return nil
+}
+
+func (m *mockWorkspaceAdapter) ListWorkbenchDirs(ctx context.Context, root string, depth int) ([]string, error) {
+	return m.workbenchDirs, nil
+}
+
+func (m *mockWorkspaceAdapter) MoveWorktree(ctx context.Context, repoPath, from, to string) error {
+	m.movedWorktrees = append(m.movedWorktrees, from)
+	return m.MoveDirectory(ctx, from, to)
+}
+
+func (m *mockWorkspaceAdapter) MoveDirectory(ctx context.Context, from, to string) error {
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		return err
+	}
+	return os.Rename(from, to)
+}
+
 func (m *mockWorkspaceAdapter) CreateDirectory(ctx context.Context, path string) error {
+	if m.reposBasePath != "" {
+		return os.MkdirAll(path, 0755)
+	}
 	return nil
 }
 
@@ -65,8 +117,17 @@ func (m *mockWorkspaceAdapter) RemoveDirectory(ctx context.Context, path string)
 	return nil
 }
 
+func (m *mockWorkspaceAdapter) RemoveEmptyDirectory(ctx context.Context, path string) error {
+	m.removedEmptyDirs = append(m.removedEmptyDirs, path)
+	return nil
+}
+
 func (m *mockWorkspaceAdapter) DirectoryExists(ctx context.Context, path string) (bool, error) {
-	return false, nil
+	if m.reposBasePath == "" {
+		return false, nil
+	}
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir(), nil
 }
 
 func (m *mockWorkspaceAdapter) GetWorktreesBasePath() string {
@@ -74,9 +135,46 @@ func (m *mockWorkspaceAdapter) GetWorktreesBasePath() string {
 }
 
 func (m *mockWorkspaceAdapter) GetRepoPath(repoName string) string {
+	if m.reposBasePath != "" {
+		return filepath.Join(m.reposBasePath, repoName)
+	}
 	return "/tmp/repos/" + repoName
 }
 
 func (m *mockWorkspaceAdapter) ResolveWorkbenchPath(workbenchName string) string {
 	return "/tmp/worktrees/" + workbenchName
 }
+
+// mockEventWriter implements secondary.EventWriter for testing, recording audit updates
+// and operational events.
+type mockEventWriter struct {
+	updates     []mockAuditUpdate
+	operational []mockOperationalEvent
+}
+
+type mockAuditUpdate struct {
+	EntityType, EntityID, Field, Old, New string
+}
+
+type mockOperationalEvent struct {
+	Source, Level, Message string
+	Data                   map[string]string
+}
+
+func (m *mockEventWriter) EmitAuditCreate(ctx context.Context, entityType, entityID string) error {
+	return nil
+}
+
+func (m *mockEventWriter) EmitAuditUpdate(ctx context.Context, entityType, entityID, fieldName, oldValue, newValue string) error {
+	m.updates = append(m.updates, mockAuditUpdate{EntityType: entityType, EntityID: entityID, Field: fieldName, Old: oldValue, New: newValue})
+	return nil
+}
+
+func (m *mockEventWriter) EmitAuditDelete(ctx context.Context, entityType, entityID string) error {
+	return nil
+}
+
+func (m *mockEventWriter) EmitOperational(ctx context.Context, source, level, message string, data map[string]string) error {
+	m.operational = append(m.operational, mockOperationalEvent{Source: source, Level: level, Message: message, Data: data})
+	return nil
+}
@@ -0,0 +1,107 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// TmuxSnapshotServiceImpl implements the TmuxSnapshotService interface.
+type TmuxSnapshotServiceImpl struct {
+	snapshotRepo secondary.TmuxSnapshotRepository
+	workshopRepo secondary.WorkshopRepository
+	transactor   secondary.Transactor
+}
+
+// NewTmuxSnapshotService creates a new TmuxSnapshotService with injected dependencies.
+func NewTmuxSnapshotService(
+	snapshotRepo secondary.TmuxSnapshotRepository,
+	workshopRepo secondary.WorkshopRepository,
+	transactor secondary.Transactor,
+) *TmuxSnapshotServiceImpl {
+	return &TmuxSnapshotServiceImpl{
+		snapshotRepo: snapshotRepo,
+		workshopRepo: workshopRepo,
+		transactor:   transactor,
+	}
+}
+
+// SaveSnapshot stores a captured session under the next SNAP ID.
+func (s *TmuxSnapshotServiceImpl) SaveSnapshot(ctx context.Context, req primary.SaveTmuxSnapshotRequest) (*primary.TmuxSnapshot, error) {
+	if _, err := s.workshopRepo.GetByID(ctx, req.WorkshopID); err != nil {
+		return nil, err
+	}
+	if req.SessionName == "" || req.Content == "" {
+		return nil, fmt.Errorf("snapshot of %s has no session content", req.WorkshopID)
+	}
+
+	var nextID string
+	err := s.transactor.WithImmediateTx(ctx, func(txCtx context.Context) error {
+		var err error
+		nextID, err = s.snapshotRepo.GetNextID(txCtx)
+		if err != nil {
+			return fmt.Errorf("failed to generate snapshot ID: %w", err)
+		}
+		return s.snapshotRepo.Create(txCtx, &secondary.TmuxSnapshotRecord{
+			ID:          nextID,
+			WorkshopID:  req.WorkshopID,
+			SessionName: req.SessionName,
+			WindowCount: req.WindowCount,
+			PaneCount:   req.PaneCount,
+			ContentJSON: req.Content,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetSnapshot(ctx, nextID)
+}
+
+// GetSnapshot retrieves a snapshot by ID.
+func (s *TmuxSnapshotServiceImpl) GetSnapshot(ctx context.Context, snapshotID string) (*primary.TmuxSnapshot, error) {
+	record, err := s.snapshotRepo.GetByID(ctx, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	return s.recordToSnapshot(record), nil
+}
+
+// GetLatestSnapshot retrieves the newest snapshot of a workshop, or nil if it has none.
+func (s *TmuxSnapshotServiceImpl) GetLatestSnapshot(ctx context.Context, workshopID string) (*primary.TmuxSnapshot, error) {
+	record, err := s.snapshotRepo.GetLatest(ctx, workshopID)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	return s.recordToSnapshot(record), nil
+}
+
+// ListSnapshots lists the snapshots of a workshop, newest first.
+func (s *TmuxSnapshotServiceImpl) ListSnapshots(ctx context.Context, workshopID string) ([]*primary.TmuxSnapshot, error) {
+	records, err := s.snapshotRepo.List(ctx, workshopID)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]*primary.TmuxSnapshot, len(records))
+	for i, record := range records {
+		snapshots[i] = s.recordToSnapshot(record)
+	}
+	return snapshots, nil
+}
+
+func (s *TmuxSnapshotServiceImpl) recordToSnapshot(r *secondary.TmuxSnapshotRecord) *primary.TmuxSnapshot {
+	return &primary.TmuxSnapshot{
+		ID:          r.ID,
+		WorkshopID:  r.WorkshopID,
+		SessionName: r.SessionName,
+		WindowCount: r.WindowCount,
+		PaneCount:   r.PaneCount,
+		Content:     r.ContentJSON,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+// Ensure TmuxSnapshotServiceImpl implements the interface
+var _ primary.TmuxSnapshotService = (*TmuxSnapshotServiceImpl)(nil)
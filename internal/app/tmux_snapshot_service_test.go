@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ============================================================================
+// Mock Implementations
+// ============================================================================
+
+type mockTmuxSnapshotRepository struct {
+	snapshots []*secondary.TmuxSnapshotRecord
+}
+
+func (m *mockTmuxSnapshotRepository) Create(ctx context.Context, snapshot *secondary.TmuxSnapshotRecord) error {
+	snapshot.CreatedAt = "2026-01-01T10:00:00Z"
+	m.snapshots = append(m.snapshots, snapshot)
+	return nil
+}
+
+func (m *mockTmuxSnapshotRepository) GetByID(ctx context.Context, id string) (*secondary.TmuxSnapshotRecord, error) {
+	for _, s := range m.snapshots {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, errors.New("tmux snapshot not found")
+}
+
+func (m *mockTmuxSnapshotRepository) GetLatest(ctx context.Context, workshopID string) (*secondary.TmuxSnapshotRecord, error) {
+	list, _ := m.List(ctx, workshopID)
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (m *mockTmuxSnapshotRepository) List(ctx context.Context, workshopID string) ([]*secondary.TmuxSnapshotRecord, error) {
+	var list []*secondary.TmuxSnapshotRecord
+	for i := len(m.snapshots) - 1; i >= 0; i-- {
+		if m.snapshots[i].WorkshopID == workshopID {
+			list = append(list, m.snapshots[i])
+		}
+	}
+	return list, nil
+}
+
+func (m *mockTmuxSnapshotRepository) GetNextID(ctx context.Context) (string, error) {
+	return fmt.Sprintf("SNAP-%03d", len(m.snapshots)+1), nil
+}
+
+func newTestTmuxSnapshotService() *TmuxSnapshotServiceImpl {
+	workshopRepo := newMockWorkshopRepository()
+	workshopRepo.workshops["WORK-001"] = &secondary.WorkshopRecord{ID: "WORK-001", Name: "test-workshop"}
+	return NewTmuxSnapshotService(&mockTmuxSnapshotRepository{}, workshopRepo, &mockTransactor{})
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestTmuxSnapshotService_SaveAndLatest(t *testing.T) {
+	service := newTestTmuxSnapshotService()
+	ctx := context.Background()
+
+	latest, err := service.GetLatestSnapshot(ctx, "WORK-001")
+	if err != nil || latest != nil {
+		t.Fatalf("expected no snapshot yet, got %+v, %v", latest, err)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := service.SaveSnapshot(ctx, primary.SaveTmuxSnapshotRequest{
+			WorkshopID: "WORK-001", SessionName: "orc-test", WindowCount: 1, PaneCount: 2 + i, Content: `{"session":"orc-test"}`,
+		})
+		if err != nil {
+			t.Fatalf("SaveSnapshot failed: %v", err)
+		}
+	}
+
+	latest, err = service.GetLatestSnapshot(ctx, "WORK-001")
+	if err != nil {
+		t.Fatalf("GetLatestSnapshot failed: %v", err)
+	}
+	if latest.ID != "SNAP-002" || latest.PaneCount != 3 || latest.Content == "" {
+		t.Errorf("expected SNAP-002 with 3 panes, got %+v", latest)
+	}
+
+	list, err := service.ListSnapshots(ctx, "WORK-001")
+	if err != nil {
+		t.Fatalf("ListSnapshots failed: %v", err)
+	}
+	if len(list) != 2 || list[0].ID != "SNAP-002" {
+		t.Errorf("expected 2 snapshots newest first, got %+v", list)
+	}
+}
+
+func TestTmuxSnapshotService_SaveValidates(t *testing.T) {
+	service := newTestTmuxSnapshotService()
+	ctx := context.Background()
+
+	if _, err := service.SaveSnapshot(ctx, primary.SaveTmuxSnapshotRequest{WorkshopID: "WORK-999", SessionName: "x", Content: "{}"}); err == nil {
+		t.Error("expected an error for an unknown workshop")
+	}
+	if _, err := service.SaveSnapshot(ctx, primary.SaveTmuxSnapshotRequest{WorkshopID: "WORK-001"}); err == nil {
+		t.Error("expected an error for an empty snapshot")
+	}
+}
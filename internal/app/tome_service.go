@@ -10,21 +10,24 @@ import (
 
 // TomeServiceImpl implements the TomeService interface.
 type TomeServiceImpl struct {
-	tomeRepo    secondary.TomeRepository
-	noteService primary.NoteService
-	transactor  secondary.Transactor
+	tomeRepo          secondary.TomeRepository
+	noteService       primary.NoteService
+	commissionService primary.CommissionService // Optional: keeps commission status in sync with tomes
+	transactor        secondary.Transactor
 }
 
 // NewTomeService creates a new TomeService with injected dependencies.
 func NewTomeService(
 	tomeRepo secondary.TomeRepository,
 	noteService primary.NoteService,
+	commissionService primary.CommissionService,
 	transactor secondary.Transactor,
 ) *TomeServiceImpl {
 	return &TomeServiceImpl{
-		tomeRepo:    tomeRepo,
-		noteService: noteService,
-		transactor:  transactor,
+		tomeRepo:          tomeRepo,
+		noteService:       noteService,
+		commissionService: commissionService,
+		transactor:        transactor,
 	}
 }
 
@@ -105,18 +108,22 @@ func (s *TomeServiceImpl) ListTomes(ctx context.Context, filters primary.TomeFil
 }
 
 // CloseTome marks a tome as closed.
-func (s *TomeServiceImpl) CloseTome(ctx context.Context, tomeID string) error {
+func (s *TomeServiceImpl) CloseTome(ctx context.Context, tomeID string) (*primary.CommissionLifecycleResult, error) {
 	record, err := s.tomeRepo.GetByID(ctx, tomeID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Guard: cannot close pinned tome
 	if record.Pinned {
-		return fmt.Errorf("cannot close pinned tome %s. Unpin first with: orc tome unpin %s", tomeID, tomeID)
+		return nil, fmt.Errorf("cannot close pinned tome %s. Unpin first with: orc tome unpin %s", tomeID, tomeID)
+	}
+
+	if err := s.tomeRepo.UpdateStatus(ctx, tomeID, "closed", true); err != nil {
+		return nil, err
 	}
 
-	return s.tomeRepo.UpdateStatus(ctx, tomeID, "closed", true)
+	return syncCommissionLifecycle(ctx, s.commissionService, record.CommissionID), nil
 }
 
 // UpdateTome updates a tome's title and/or description.
@@ -259,7 +259,7 @@ func (m *mockNoteServiceForTome) SetNoteInFlight(ctx context.Context, noteID str
 func newTestTomeService() (*TomeServiceImpl, *mockTomeRepository, *mockNoteServiceForTome) {
 	tomeRepo := newMockTomeRepository()
 	noteService := newMockNoteServiceForTome()
-	service := NewTomeService(tomeRepo, noteService, &mockTransactor{})
+	service := NewTomeService(tomeRepo, noteService, nil, &mockTransactor{})
 	return service, tomeRepo, noteService
 }
 
@@ -421,7 +421,7 @@ func TestCloseTome_UnpinnedAllowed(t *testing.T) {
 		Pinned:       false,
 	}
 
-	err := service.CloseTome(ctx, "TOME-001")
+	_, err := service.CloseTome(ctx, "TOME-001")
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -443,7 +443,7 @@ func TestCloseTome_PinnedBlocked(t *testing.T) {
 		Pinned:       true,
 	}
 
-	err := service.CloseTome(ctx, "TOME-001")
+	_, err := service.CloseTome(ctx, "TOME-001")
 
 	if err == nil {
 		t.Fatal("expected error for completing pinned tome, got nil")
@@ -454,7 +454,7 @@ func TestCloseTome_NotFound(t *testing.T) {
 	service, _, _ := newTestTomeService()
 	ctx := context.Background()
 
-	err := service.CloseTome(ctx, "TOME-NONEXISTENT")
+	_, err := service.CloseTome(ctx, "TOME-NONEXISTENT")
 
 	if err == nil {
 		t.Fatal("expected error for non-existent tome, got nil")
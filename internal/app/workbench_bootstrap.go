@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	corerepo "github.com/example/orc/internal/core/repo"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// bootstrapOutputLimit caps the output kept per bootstrap step, in results and events.
+const bootstrapOutputLimit = 4096
+
+// runBootstrap runs a repo's bootstrap steps in a workbench worktree, in order, stopping
+// at the first failure. Steps come from the repo record. Steps checked in to the worktree's
+// .orc/repo.json arrive with the code, so they are only reported as pending until adopted
+// onto the record (orc repo bootstrap --from-file). Each step is recorded as an operational
+// event when an event writer is available. Returns nil when the repo defines no steps.
+func runBootstrap(ctx context.Context, eventWriter secondary.EventWriter, wb *secondary.WorkbenchRecord, repo *secondary.RepoRecord, wbPath string) (*primary.BootstrapResult, error) {
+	recordSteps, err := corerepo.ParseBootstrapSteps(repo.BootstrapJSON)
+	if err != nil {
+		return nil, err
+	}
+	var fileSteps []string
+	if data, err := os.ReadFile(filepath.Join(wbPath, corerepo.ConfigFile)); err == nil {
+		cfg, err := corerepo.ParseConfig(data)
+		if err != nil {
+			return nil, err
+		}
+		fileSteps = cfg.Bootstrap
+	}
+
+	steps, source := corerepo.ResolveBootstrap(recordSteps, fileSteps)
+	if len(steps) == 0 {
+		return nil, nil
+	}
+
+	result := &primary.BootstrapResult{WorkbenchID: wb.ID, RepoID: repo.ID, Source: source}
+	if source == corerepo.BootstrapSourceFile {
+		result.Pending = steps
+		return result, nil
+	}
+	for i, command := range steps {
+		start := time.Now()
+		cmd := exec.CommandContext(ctx, "sh", "-c", command)
+		cmd.Dir = wbPath
+		cmd.Env = append(os.Environ(),
+			"ORC_WORKBENCH_ID="+wb.ID,
+			"ORC_REPO_ID="+repo.ID,
+			"ORC_REPO_PATH="+repo.LocalPath, // main clone, for copying untracked files or sharing caches
+		)
+		output, runErr := cmd.CombinedOutput()
+
+		step := &primary.BootstrapStepResult{
+			Command:  command,
+			Success:  runErr == nil,
+			Output:   corerepo.TailOutput(string(output), bootstrapOutputLimit),
+			Duration: time.Since(start).Round(time.Millisecond).String(),
+		}
+		result.Steps = append(result.Steps, step)
+
+		if eventWriter != nil {
+			level, message := "info", fmt.Sprintf("%s bootstrap step %d/%d ok: %s", wb.ID, i+1, len(steps), command)
+			if runErr != nil {
+				level, message = "error", fmt.Sprintf("%s bootstrap step %d/%d failed (%v): %s", wb.ID, i+1, len(steps), runErr, command)
+			}
+			_ = eventWriter.EmitOperational(ctx, "workbench-bootstrap", level, message, map[string]string{
+				"workbench_id": wb.ID,
+				"repo_id":      repo.ID,
+				"source":       source,
+				"command":      command,
+				"duration":     step.Duration,
+				"output":       step.Output,
+			})
+		}
+
+		if runErr != nil {
+			result.Failed = true
+			break
+		}
+	}
+	return result, nil
+}
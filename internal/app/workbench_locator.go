@@ -0,0 +1,100 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/orc/internal/config"
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// WorkbenchLocator resolves workbench names and directories under the configured
+// workspace layout (workspace.json). A nil locator uses the default layout, ~/wb/{name}.
+type WorkbenchLocator struct {
+	layouts      coreworkbench.Layouts
+	workshopRepo secondary.WorkshopRepository
+	repoRepo     secondary.RepoRepository
+}
+
+// NewWorkbenchLocator creates a WorkbenchLocator from a workspace config.
+func NewWorkbenchLocator(cfg *config.WorkspaceConfig, workshopRepo secondary.WorkshopRepository, repoRepo secondary.RepoRepository) (*WorkbenchLocator, error) {
+	factories := make(map[string]coreworkbench.Layout, len(cfg.Factories))
+	for factoryID := range cfg.Factories {
+		factories[factoryID] = layoutFromConfig(cfg.LayoutFor(factoryID))
+	}
+	layouts, err := validateLayouts(layoutFromConfig(cfg.LayoutFor("")), factories)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", config.WorkspaceFileName, err)
+	}
+	return &WorkbenchLocator{layouts: layouts, workshopRepo: workshopRepo, repoRepo: repoRepo}, nil
+}
+
+// Layouts returns the configured layouts.
+func (l *WorkbenchLocator) Layouts() coreworkbench.Layouts {
+	if l == nil {
+		return coreworkbench.Layouts{Default: coreworkbench.DefaultLayout()}
+	}
+	return l.layouts
+}
+
+// Path returns the directory of a workbench.
+func (l *WorkbenchLocator) Path(ctx context.Context, wb *secondary.WorkbenchRecord) string {
+	if l == nil {
+		return coreworkbench.ComputePath(wb.Name)
+	}
+	layout := l.layoutFor(ctx, wb.WorkshopID)
+	repoName := ""
+	if layout.Style == coreworkbench.LayoutByRepo && wb.RepoID != "" {
+		if repo, err := l.repoRepo.GetByID(ctx, wb.RepoID); err == nil && repo != nil {
+			repoName = repo.Name
+		}
+	}
+	return layout.Path(wb.Name, repoName)
+}
+
+// Name generates the name of a new workbench in a workshop.
+func (l *WorkbenchLocator) Name(ctx context.Context, workshopID, repoName string, number int) string {
+	if l == nil {
+		return coreworkbench.DefaultLayout().Name(repoName, number)
+	}
+	return l.layoutFor(ctx, workshopID).Name(repoName, number)
+}
+
+// layoutFor returns the layout of the factory a workshop belongs to.
+func (l *WorkbenchLocator) layoutFor(ctx context.Context, workshopID string) coreworkbench.Layout {
+	if len(l.layouts.Factories) == 0 {
+		return l.layouts.Default
+	}
+	workshop, err := l.workshopRepo.GetByID(ctx, workshopID)
+	if err != nil || workshop == nil {
+		return l.layouts.Default
+	}
+	return l.layouts.For(workshop.FactoryID)
+}
+
+// validateLayouts checks a global layout and its per-factory overrides.
+func validateLayouts(def coreworkbench.Layout, factories map[string]coreworkbench.Layout) (coreworkbench.Layouts, error) {
+	layouts := coreworkbench.Layouts{Default: def}
+	if err := coreworkbench.ValidateLayout(def); err != nil {
+		return layouts, err
+	}
+	for factoryID, layout := range factories {
+		if err := coreworkbench.ValidateLayout(layout); err != nil {
+			return layouts, fmt.Errorf("factory %s: %w", factoryID, err)
+		}
+	}
+	if len(factories) > 0 {
+		layouts.Factories = factories
+	}
+	return layouts, nil
+}
+
+func layoutFromConfig(l config.WorkspaceLayout) coreworkbench.Layout {
+	return coreworkbench.Layout{Root: l.WorktreeRoot, Style: l.Layout, NamePattern: l.NamePattern}
+}
+
+func layoutFromPrimary(l primary.WorkbenchLayout) coreworkbench.Layout {
+	return coreworkbench.Layout{Root: l.WorktreeRoot, Style: l.Layout, NamePattern: l.NamePattern}
+}
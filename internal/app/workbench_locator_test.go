@@ -0,0 +1,61 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/config"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestWorkbenchLocator_FactoryOverride(t *testing.T) {
+	ctx := context.Background()
+	workshopRepo := newMockWorkshopRepositoryForWorkbench()
+	workshopRepo.workshops["WORK-001"] = &secondary.WorkshopRecord{ID: "WORK-001", FactoryID: "FACT-001"}
+	workshopRepo.workshops["WORK-002"] = &secondary.WorkshopRecord{ID: "WORK-002", FactoryID: "FACT-002"}
+	repoRepo := newMockRepoRepositoryForWorkbench()
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app"}
+
+	cfg := config.DefaultWorkspaceConfig()
+	cfg.WorktreeRoot = "/vol/code/wb"
+	cfg.Factories = map[string]config.WorkspaceLayout{"FACT-002": {Layout: "by-repo", NamePattern: "wb{number}-{repo}"}}
+	locator, err := NewWorkbenchLocator(cfg, workshopRepo, repoRepo)
+	if err != nil {
+		t.Fatalf("NewWorkbenchLocator failed: %v", err)
+	}
+
+	flat := &secondary.WorkbenchRecord{Name: "app-001", WorkshopID: "WORK-001", RepoID: "REPO-001"}
+	if got := locator.Path(ctx, flat); got != "/vol/code/wb/app-001" {
+		t.Errorf("Path(flat) = %q, want /vol/code/wb/app-001", got)
+	}
+	byRepo := &secondary.WorkbenchRecord{Name: "wb002-app", WorkshopID: "WORK-002", RepoID: "REPO-001"}
+	if got := locator.Path(ctx, byRepo); got != "/vol/code/wb/app/wb002-app" {
+		t.Errorf("Path(by-repo) = %q, want /vol/code/wb/app/wb002-app", got)
+	}
+
+	if got := locator.Name(ctx, "WORK-001", "app", 7); got != "app-007" {
+		t.Errorf("Name(WORK-001) = %q, want app-007", got)
+	}
+	if got := locator.Name(ctx, "WORK-002", "app", 7); got != "wb007-app" {
+		t.Errorf("Name(WORK-002) = %q, want wb007-app", got)
+	}
+}
+
+func TestWorkbenchLocator_InvalidConfig(t *testing.T) {
+	cfg := config.DefaultWorkspaceConfig()
+	cfg.Factories = map[string]config.WorkspaceLayout{"FACT-002": {Layout: "nested"}}
+
+	if _, err := NewWorkbenchLocator(cfg, nil, nil); err == nil {
+		t.Error("expected error for an unknown layout")
+	}
+}
+
+func TestWorkbenchLocator_NilUsesDefaultLayout(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	var locator *WorkbenchLocator
+	if got, want := locator.Path(context.Background(), &secondary.WorkbenchRecord{Name: "app-001"}), home+"/wb/app-001"; got != want {
+		t.Errorf("Path() = %q, want %q", got, want)
+	}
+}
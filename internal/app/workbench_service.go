@@ -24,6 +24,8 @@ type WorkbenchServiceImpl struct {
 	gitService       *GitService
 	workspaceAdapter secondary.WorkspaceAdapter
 	transactor       secondary.Transactor
+	eventWriter      secondary.EventWriter // optional: records bootstrap output
+	locator          *WorkbenchLocator     // optional: nil uses the default layout
 }
 
 // NewWorkbenchService creates a new WorkbenchService with injected dependencies.
@@ -35,6 +37,8 @@ func NewWorkbenchService(
 	executor EffectExecutor,
 	workspaceAdapter secondary.WorkspaceAdapter,
 	transactor secondary.Transactor,
+	eventWriter secondary.EventWriter,
+	locator *WorkbenchLocator,
 ) *WorkbenchServiceImpl {
 	return &WorkbenchServiceImpl{
 		workbenchRepo:    workbenchRepo,
@@ -45,6 +49,8 @@ func NewWorkbenchService(
 		gitService:       NewGitService(),
 		workspaceAdapter: workspaceAdapter,
 		transactor:       transactor,
+		eventWriter:      eventWriter,
+		locator:          locator,
 	}
 }
 
@@ -65,6 +71,12 @@ func (s *WorkbenchServiceImpl) CreateWorkbench(ctx context.Context, req primary.
 		return nil, result.Error()
 	}
 
+	// Resolve sparse checkout paths (explicit paths win over the repo's defaults)
+	sparseJSON, err := s.resolveSparsePaths(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	var record *secondary.WorkbenchRecord
 	var workbenchPath string
 
@@ -86,11 +98,11 @@ func (s *WorkbenchServiceImpl) CreateWorkbench(ctx context.Context, req primary.
 			if err != nil {
 				return fmt.Errorf("failed to get repo for name generation: %w", err)
 			}
-			name = fmt.Sprintf("%s-%03d", repo.Name, benchNumber)
+			name = s.locator.Name(txCtx, req.WorkshopID, repo.Name, benchNumber)
 		}
 
-		// 6. Compute workbench path (deterministic: ~/wb/<name>)
-		workbenchPath = coreworkbench.ComputePath(name)
+		// 6. Compute workbench path (deterministic under the workspace layout)
+		workbenchPath = s.locator.Path(txCtx, &secondary.WorkbenchRecord{Name: name, WorkshopID: req.WorkshopID, RepoID: req.RepoID})
 
 		// 7. Generate home branch name
 		homeBranch := GenerateHomeBranchName(UserInitials, name)
@@ -104,6 +116,7 @@ func (s *WorkbenchServiceImpl) CreateWorkbench(ctx context.Context, req primary.
 			Status:        "active",
 			HomeBranch:    homeBranch,
 			CurrentBranch: homeBranch,
+			SparseJSON:    sparseJSON,
 		}
 		if err := s.workbenchRepo.Create(txCtx, record); err != nil {
 			return fmt.Errorf("failed to create workbench: %w", err)
@@ -115,7 +128,8 @@ func (s *WorkbenchServiceImpl) CreateWorkbench(ctx context.Context, req primary.
 	}
 
 	// 9. Create worktree/directory and config immediately (not deferred to infra apply)
-	if err := s.ensureWorktreeExists(ctx, record); err != nil {
+	bootstrap, err := s.ensureWorktreeExists(ctx, record)
+	if err != nil {
 		return nil, fmt.Errorf("failed to create worktree: %w", err)
 	}
 	if err := s.ensureConfigExists(ctx, record); err != nil {
@@ -124,8 +138,9 @@ func (s *WorkbenchServiceImpl) CreateWorkbench(ctx context.Context, req primary.
 
 	return &primary.CreateWorkbenchResponse{
 		WorkbenchID: record.ID,
-		Workbench:   s.recordToWorkbench(record),
+		Workbench:   s.recordToWorkbench(ctx, record),
 		Path:        workbenchPath,
+		Bootstrap:   bootstrap,
 	}, nil
 }
 
@@ -135,7 +150,7 @@ func (s *WorkbenchServiceImpl) GetWorkbench(ctx context.Context, workbenchID str
 	if err != nil {
 		return nil, fmt.Errorf("workbench not found: %w", err)
 	}
-	return s.recordToWorkbench(record), nil
+	return s.recordToWorkbench(ctx, record), nil
 }
 
 // GetWorkbenchByPath retrieves a workbench by its filesystem path.
@@ -144,7 +159,7 @@ func (s *WorkbenchServiceImpl) GetWorkbenchByPath(ctx context.Context, path stri
 	if err != nil {
 		return nil, fmt.Errorf("workbench not found at path: %w", err)
 	}
-	return s.recordToWorkbench(record), nil
+	return s.recordToWorkbench(ctx, record), nil
 }
 
 // UpdateWorkbenchPath updates the filesystem path of a workbench.
@@ -167,7 +182,7 @@ func (s *WorkbenchServiceImpl) ListWorkbenches(ctx context.Context, filters prim
 
 	workbenches := make([]*primary.Workbench, len(records))
 	for i, r := range records {
-		workbenches[i] = s.recordToWorkbench(r)
+		workbenches[i] = s.recordToWorkbench(ctx, r)
 	}
 	return workbenches, nil
 }
@@ -214,70 +229,137 @@ func (s *WorkbenchServiceImpl) DeleteWorkbench(ctx context.Context, req primary.
 
 // Helper methods
 
-func (s *WorkbenchServiceImpl) recordToWorkbench(r *secondary.WorkbenchRecord) *primary.Workbench {
+func (s *WorkbenchServiceImpl) recordToWorkbench(ctx context.Context, r *secondary.WorkbenchRecord) *primary.Workbench {
+	sparsePaths, _ := coreworkbench.ParseSparsePaths(r.SparseJSON)
 	return &primary.Workbench{
 		ID:            r.ID,
 		Name:          r.Name,
 		WorkshopID:    r.WorkshopID,
 		RepoID:        r.RepoID,
-		Path:          coreworkbench.ComputePath(r.Name),
+		Path:          s.locator.Path(ctx, r),
 		Status:        r.Status,
 		HomeBranch:    r.HomeBranch,
 		CurrentBranch: r.CurrentBranch,
+		SparsePaths:   sparsePaths,
 		CreatedAt:     r.CreatedAt,
 		UpdatedAt:     r.UpdatedAt,
 	}
 }
 
+// resolveSparsePaths returns the encoded sparse checkout paths for a new workbench:
+// the requested paths, else the linked repo's defaults. Empty means a full checkout.
+func (s *WorkbenchServiceImpl) resolveSparsePaths(ctx context.Context, req primary.CreateWorkbenchRequest) (string, error) {
+	if len(req.SparsePaths) > 0 {
+		if req.RepoID == "" {
+			return "", fmt.Errorf("sparse paths require a linked repository")
+		}
+		return coreworkbench.FormatSparsePaths(req.SparsePaths)
+	}
+	if req.RepoID == "" {
+		return "", nil
+	}
+	repo, err := s.repoRepo.GetByID(ctx, req.RepoID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get repo: %w", err)
+	}
+	return repo.SparseJSON, nil
+}
+
 func (s *WorkbenchServiceImpl) pathExists(path string) bool {
 	_, err := os.Stat(path)
 	return err == nil
 }
 
-// ensureWorktreeExists creates a worktree (or directory if no repo) if it doesn't already exist.
-func (s *WorkbenchServiceImpl) ensureWorktreeExists(ctx context.Context, wb *secondary.WorkbenchRecord) error {
-	wbPath := coreworkbench.ComputePath(wb.Name)
+// ensureWorktreeExists creates a worktree (or directory if no repo) if it doesn't already exist,
+// and installs the commit trailer hook for a linked repo. A newly created worktree is
+// bootstrapped with the repo's setup steps; their result is returned (nil when none ran).
+func (s *WorkbenchServiceImpl) ensureWorktreeExists(ctx context.Context, wb *secondary.WorkbenchRecord) (*primary.BootstrapResult, error) {
+	wbPath := s.locator.Path(ctx, wb)
 
 	// Check if worktree already exists (idempotent)
 	exists, err := s.workspaceAdapter.WorktreeExists(ctx, wbPath)
 	if err != nil {
-		return err
-	}
-	if exists {
-		return nil // Already exists, nothing to do
+		return nil, err
 	}
 
 	var effs []effects.Effect
+	var created *secondary.RepoRecord
 
 	// If no repo linked, just create a directory
 	if wb.RepoID == "" {
+		if exists {
+			return nil, nil // Already exists, nothing to do
+		}
 		effs = append(effs, effects.FileEffect{
 			Operation: "mkdir",
 			Path:      wbPath,
 			Mode:      0755,
 		})
+	} else if repo, err := s.repoRepo.GetByID(ctx, wb.RepoID); err != nil {
+		if !exists {
+			return nil, fmt.Errorf("repo %s not found: %w", wb.RepoID, err)
+		}
 	} else {
 		// Linked to repo - create git worktree
-		repo, err := s.repoRepo.GetByID(ctx, wb.RepoID)
-		if err != nil {
-			return fmt.Errorf("repo %s not found: %w", wb.RepoID, err)
+		if !exists {
+			eff, err := worktreeAddEffect(repo.LocalPath, wb.HomeBranch, wbPath, wb.SparseJSON)
+			if err != nil {
+				return nil, err
+			}
+			effs = append(effs, eff)
+			created = repo
 		}
-		effs = append(effs, effects.GitEffect{
-			Operation: "worktree_add",
-			RepoPath:  repo.LocalPath,
-			Args:      []string{wb.HomeBranch, wbPath},
-		})
+		effs = append(effs, commitHookEffects(s.gitService, repo.LocalPath)...)
 	}
 
 	if len(effs) > 0 {
-		return s.executor.Execute(ctx, effs)
+		if err := s.executor.Execute(ctx, effs); err != nil {
+			return nil, err
+		}
+	}
+	if created == nil {
+		return nil, nil
+	}
+
+	result, err := runBootstrap(ctx, s.eventWriter, wb, created, wbPath)
+	if err != nil {
+		return nil, fmt.Errorf("worktree created but bootstrap could not start: %w", err)
+	}
+	return result, nil
+}
+
+// BootstrapWorkbench re-runs the repo's bootstrap steps in a workbench.
+func (s *WorkbenchServiceImpl) BootstrapWorkbench(ctx context.Context, workbenchID string) (*primary.BootstrapResult, error) {
+	wb, err := s.workbenchRepo.GetByID(ctx, workbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+	if wb.RepoID == "" {
+		return nil, fmt.Errorf("workbench %s is not linked to a repository", workbenchID)
+	}
+	repo, err := s.repoRepo.GetByID(ctx, wb.RepoID)
+	if err != nil {
+		return nil, err
+	}
+
+	wbPath := s.locator.Path(ctx, wb)
+	if !s.pathExists(wbPath) {
+		return nil, fmt.Errorf("workbench path does not exist: %s", wbPath)
+	}
+
+	result, err := runBootstrap(ctx, s.eventWriter, wb, repo, wbPath)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	if result == nil {
+		return nil, fmt.Errorf("repository %s defines no bootstrap steps. Set them with: orc repo bootstrap %s --step <command>", repo.ID, repo.ID)
+	}
+	return result, nil
 }
 
 // ensureConfigExists creates the .orc/config.json file if it doesn't already exist.
 func (s *WorkbenchServiceImpl) ensureConfigExists(ctx context.Context, wb *secondary.WorkbenchRecord) error {
-	wbPath := coreworkbench.ComputePath(wb.Name)
+	wbPath := s.locator.Path(ctx, wb)
 	orcDir := filepath.Join(wbPath, ".orc")
 	configPath := filepath.Join(orcDir, "config.json")
 
@@ -312,7 +394,7 @@ func (s *WorkbenchServiceImpl) CheckoutBranch(ctx context.Context, req primary.C
 	}
 
 	// 2. Compute path and check it exists
-	wbPath := coreworkbench.ComputePath(workbench.Name)
+	wbPath := s.locator.Path(ctx, workbench)
 	if !s.pathExists(wbPath) {
 		return nil, fmt.Errorf("workbench path does not exist: %s", wbPath)
 	}
@@ -337,6 +419,113 @@ func (s *WorkbenchServiceImpl) CheckoutBranch(ctx context.Context, req primary.C
 	}, nil
 }
 
+// StartBranch creates a branch from the repo's default branch and checks it out in the workbench.
+// An existing branch is checked out as-is so reassigning a shipment never resets its work.
+func (s *WorkbenchServiceImpl) StartBranch(ctx context.Context, req primary.StartBranchRequest) (*primary.CheckoutBranchResponse, error) {
+	workbench, err := s.workbenchRepo.GetByID(ctx, req.WorkbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+	if workbench.RepoID == "" {
+		return nil, fmt.Errorf("workbench %s is not linked to a repo", req.WorkbenchID)
+	}
+
+	wbPath := s.locator.Path(ctx, workbench)
+	if !s.pathExists(wbPath) {
+		return nil, fmt.Errorf("workbench path does not exist: %s", wbPath)
+	}
+
+	exists, _ := s.gitService.BranchExists(wbPath, "refs/heads/"+req.Branch)
+	if !exists {
+		defaultBranch, err := s.defaultBranchFor(ctx, workbench.RepoID, wbPath)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.gitService.CreateBranch(wbPath, req.Branch, defaultBranch); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.CheckoutBranch(ctx, primary.CheckoutBranchRequest{
+		WorkbenchID:  req.WorkbenchID,
+		TargetBranch: req.Branch,
+	})
+}
+
+// ReturnToHomeBranch checks out the workbench's home branch and deletes DeleteBranch
+// if it has been merged into the repo's default branch. Unmerged branches are kept.
+func (s *WorkbenchServiceImpl) ReturnToHomeBranch(ctx context.Context, req primary.ReturnToHomeBranchRequest) (*primary.ReturnToHomeBranchResponse, error) {
+	workbench, err := s.workbenchRepo.GetByID(ctx, req.WorkbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+	if workbench.HomeBranch == "" {
+		return nil, fmt.Errorf("workbench %s has no home branch", req.WorkbenchID)
+	}
+
+	checkout, err := s.CheckoutBranch(ctx, primary.CheckoutBranchRequest{
+		WorkbenchID:  req.WorkbenchID,
+		TargetBranch: workbench.HomeBranch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &primary.ReturnToHomeBranchResponse{
+		PreviousBranch: checkout.PreviousBranch,
+		CurrentBranch:  checkout.CurrentBranch,
+		StashApplied:   checkout.StashApplied,
+	}
+	if req.DeleteBranch == "" || req.DeleteBranch == workbench.HomeBranch {
+		return resp, nil
+	}
+
+	// Only delete branches whose commits are all on the default branch
+	wbPath := s.locator.Path(ctx, workbench)
+	defaultBranch, err := s.defaultBranchFor(ctx, workbench.RepoID, wbPath)
+	if err != nil {
+		resp.KeptReason = err.Error()
+		return resp, nil
+	}
+	if _, err := s.gitService.FetchOrigin(wbPath); err != nil {
+		resp.KeptReason = err.Error()
+		return resp, nil
+	}
+	baseRef, err := s.gitService.ResolveBaseRef(wbPath, defaultBranch)
+	if err != nil {
+		resp.KeptReason = err.Error()
+		return resp, nil
+	}
+	if !s.gitService.IsMergedInto(wbPath, req.DeleteBranch, baseRef) {
+		resp.KeptReason = fmt.Sprintf("not merged into %s", baseRef)
+		return resp, nil
+	}
+	if err := s.gitService.DeleteBranch(wbPath, req.DeleteBranch); err != nil {
+		resp.KeptReason = err.Error()
+		return resp, nil
+	}
+	resp.BranchDeleted = true
+	return resp, nil
+}
+
+// defaultBranchFor returns the default branch configured for a repo. The
+// configured branch defaults to main in the ledger, so when it doesn't exist in
+// the checkout at wbPath the branch detected by git is used instead.
+func (s *WorkbenchServiceImpl) defaultBranchFor(ctx context.Context, repoID, wbPath string) (string, error) {
+	repo, err := s.repoRepo.GetByID(ctx, repoID)
+	if err != nil {
+		return "", fmt.Errorf("repo %s not found", repoID)
+	}
+	branch := repo.DefaultBranch
+	if branch == "" {
+		branch = "main"
+	}
+	if _, err := s.gitService.ResolveBaseRef(wbPath, branch); err == nil {
+		return branch, nil
+	}
+	return s.gitService.GetDefaultBranch(wbPath)
+}
+
 // GetWorkbenchStatus returns the current git status of a workbench.
 func (s *WorkbenchServiceImpl) GetWorkbenchStatus(ctx context.Context, workbenchID string) (*primary.WorkbenchGitStatus, error) {
 	// 1. Get workbench
@@ -346,7 +535,7 @@ func (s *WorkbenchServiceImpl) GetWorkbenchStatus(ctx context.Context, workbench
 	}
 
 	// Compute path
-	wbPath := coreworkbench.ComputePath(workbench.Name)
+	wbPath := s.locator.Path(ctx, workbench)
 
 	status := &primary.WorkbenchGitStatus{
 		WorkbenchID: workbenchID,
@@ -394,6 +583,128 @@ func (s *WorkbenchServiceImpl) GetWorkbenchStatus(ctx context.Context, workbench
 	return status, nil
 }
 
+// SyncWorkbenches fetches and rebases (or merges) active workbenches onto their repo's default branch.
+// Each workbench is handled independently; a failure in one never stops the others.
+func (s *WorkbenchServiceImpl) SyncWorkbenches(ctx context.Context, req primary.SyncWorkbenchesRequest) ([]*primary.WorkbenchSyncResult, error) {
+	strategy := req.Strategy
+	if strategy == "" {
+		strategy = primary.SyncStrategyRebase
+	}
+	if strategy != primary.SyncStrategyRebase && strategy != primary.SyncStrategyMerge {
+		return nil, fmt.Errorf("unknown sync strategy %q (use %s or %s)", strategy, primary.SyncStrategyRebase, primary.SyncStrategyMerge)
+	}
+
+	records, err := s.workbenchRepo.List(ctx, req.WorkshopID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workbenches: %w", err)
+	}
+
+	fetched := make(map[string]bool) // Worktrees share refs, so fetch once per repo
+	var results []*primary.WorkbenchSyncResult
+	for _, wb := range records {
+		if wb.Status != "active" {
+			continue
+		}
+		results = append(results, s.syncWorkbench(ctx, wb, strategy == primary.SyncStrategyMerge, req.Autostash, fetched))
+	}
+	return results, nil
+}
+
+// syncWorkbench syncs a single workbench and reports the outcome.
+func (s *WorkbenchServiceImpl) syncWorkbench(ctx context.Context, wb *secondary.WorkbenchRecord, merge, autostash bool, fetched map[string]bool) *primary.WorkbenchSyncResult {
+	result := &primary.WorkbenchSyncResult{
+		WorkbenchID:   wb.ID,
+		WorkbenchName: wb.Name,
+		Branch:        wb.CurrentBranch,
+	}
+	skip := func(status, reason string) *primary.WorkbenchSyncResult {
+		result.Status = status
+		result.Reason = reason
+		return result
+	}
+
+	// 1. Gather state for the guard
+	wbPath := s.locator.Path(ctx, wb)
+	guardCtx := coreworkbench.SyncWorkbenchContext{
+		WorkbenchID: wb.ID,
+		HasRepo:     wb.RepoID != "",
+		PathExists:  s.pathExists(wbPath),
+	}
+	defaultBranch := "main"
+	if guardCtx.HasRepo {
+		var err error
+		if defaultBranch, err = s.defaultBranchFor(ctx, wb.RepoID, wbPath); err != nil {
+			return skip(primary.SyncStatusFailed, err.Error())
+		}
+	}
+	guardCtx.BaseBranch = defaultBranch
+	if guardCtx.PathExists {
+		if branch, err := s.gitService.GetCurrentBranch(wbPath); err == nil {
+			guardCtx.CurrentBranch = branch
+			result.Branch = branch
+		}
+	}
+
+	// 2. Guard check
+	if guard := coreworkbench.CanSyncWorkbench(guardCtx); !guard.Allowed {
+		return skip(primary.SyncStatusSkipped, guard.Reason)
+	}
+
+	// 3. Fetch and resolve the base ref
+	if !fetched[wb.RepoID] {
+		if _, err := s.gitService.FetchOrigin(wbPath); err != nil {
+			return skip(primary.SyncStatusFailed, err.Error())
+		}
+		fetched[wb.RepoID] = true
+	}
+	baseRef, err := s.gitService.ResolveBaseRef(wbPath, defaultBranch)
+	if err != nil {
+		return skip(primary.SyncStatusFailed, err.Error())
+	}
+	result.BaseRef = baseRef
+
+	// 4. Commits pushed to the branch's upstream would be left out of the rebase
+	if _, upstreamBehind, err := s.gitService.GetAheadBehind(wbPath); err == nil && upstreamBehind > 0 {
+		return skip(primary.SyncStatusSkipped, fmt.Sprintf("%d commit(s) behind its upstream; pull first", upstreamBehind))
+	}
+
+	// 5. Nothing to do if the branch already contains the base
+	_, behind, err := s.gitService.GetAheadBehindRef(wbPath, baseRef)
+	if err != nil {
+		return skip(primary.SyncStatusFailed, err.Error())
+	}
+	result.Behind = behind
+	if behind == 0 {
+		result.Status = primary.SyncStatusUpToDate
+		return result
+	}
+
+	// 6. Dirty trees are left alone unless autostash was requested
+	if !autostash {
+		if count, err := s.gitService.GetTrackedChangeCount(wbPath); err == nil && count > 0 {
+			return skip(primary.SyncStatusSkippedDirty, fmt.Sprintf("%d uncommitted file(s); commit or use --autostash", count))
+		}
+	}
+
+	// 7. Rebase or merge
+	syncResult, err := s.gitService.SyncWithBase(wbPath, baseRef, merge, autostash)
+	if err != nil {
+		return skip(primary.SyncStatusFailed, err.Error())
+	}
+	if len(syncResult.Conflicts) > 0 {
+		result.Status = primary.SyncStatusConflicts
+		result.Conflicts = syncResult.Conflicts
+		result.Reason = "aborted; resolve manually"
+		return result
+	}
+
+	result.Status = primary.SyncStatusRebased
+	if merge {
+		result.Status = primary.SyncStatusMerged
+	}
+	return result
+}
+
 // UpdateFocusedID sets or clears the focused container ID for a workbench.
 func (s *WorkbenchServiceImpl) UpdateFocusedID(ctx context.Context, workbenchID, focusedID string) error {
 	return s.workbenchRepo.UpdateFocusedID(ctx, workbenchID, focusedID)
@@ -418,11 +729,47 @@ func (s *WorkbenchServiceImpl) GetWorkbenchesByFocusedID(ctx context.Context, fo
 
 	workbenches := make([]*primary.Workbench, len(records))
 	for i, r := range records {
-		workbenches[i] = s.recordToWorkbench(r)
+		workbenches[i] = s.recordToWorkbench(ctx, r)
 	}
 	return workbenches, nil
 }
 
+// UpdateSparsePaths adjusts a workbench's sparse checkout and applies it to the worktree.
+// A workbench whose worktree is missing only has its record updated; the paths apply
+// when the worktree is recreated.
+func (s *WorkbenchServiceImpl) UpdateSparsePaths(ctx context.Context, req primary.UpdateSparsePathsRequest) ([]string, error) {
+	wb, err := s.workbenchRepo.GetByID(ctx, req.WorkbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+	if wb.RepoID == "" {
+		return nil, fmt.Errorf("workbench %s is not linked to a repository", wb.ID)
+	}
+
+	current, err := coreworkbench.ParseSparsePaths(wb.SparseJSON)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := coreworkbench.EditSparsePaths(current, req.Add, req.Remove)
+	if err != nil {
+		return nil, err
+	}
+	data, err := coreworkbench.FormatSparsePaths(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	if wbPath := s.locator.Path(ctx, wb); s.pathExists(wbPath) {
+		if err := s.workspaceAdapter.SetSparseCheckout(ctx, wbPath, paths); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.workbenchRepo.UpdateSparse(ctx, wb.ID, data); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
 // ArchiveWorkbench soft-deletes a workbench by setting status to 'archived'.
 func (s *WorkbenchServiceImpl) ArchiveWorkbench(ctx context.Context, workbenchID string) error {
 	record, err := s.workbenchRepo.GetByID(ctx, workbenchID)
@@ -436,5 +783,84 @@ func (s *WorkbenchServiceImpl) ArchiveWorkbench(ctx context.Context, workbenchID
 	return s.workbenchRepo.Update(ctx, record)
 }
 
+// RelocateWorkbenches moves workbench directories from their paths under the current
+// layout to their paths under the requested one.
+func (s *WorkbenchServiceImpl) RelocateWorkbenches(ctx context.Context, req primary.RelocateWorkbenchesRequest) ([]*primary.WorkbenchRelocation, error) {
+	factories := make(map[string]coreworkbench.Layout, len(req.Factories))
+	for factoryID, layout := range req.Factories {
+		factories[factoryID] = layoutFromPrimary(layout)
+	}
+	layouts, err := validateLayouts(layoutFromPrimary(req.Default), factories)
+	if err != nil {
+		return nil, err
+	}
+	target := &WorkbenchLocator{layouts: layouts, workshopRepo: s.workshopRepo, repoRepo: s.repoRepo}
+
+	records, err := s.workbenchRepo.List(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workbenches: %w", err)
+	}
+
+	var results []*primary.WorkbenchRelocation
+	for _, wb := range records {
+		from, to := s.locator.Path(ctx, wb), target.Path(ctx, wb)
+		result := &primary.WorkbenchRelocation{WorkbenchID: wb.ID, WorkbenchName: wb.Name, From: from, To: to}
+		results = append(results, result)
+
+		switch coreworkbench.PlanRelocation(coreworkbench.RelocateInput{
+			From:       from,
+			To:         to,
+			FromExists: s.pathExists(from),
+			ToExists:   s.pathExists(to),
+		}) {
+		case coreworkbench.RelocateUnchanged:
+			result.Status = primary.RelocateUnchanged
+		case coreworkbench.RelocateDone:
+			result.Status = primary.RelocateDone
+		case coreworkbench.RelocateMissing:
+			result.Status = primary.RelocateMissing
+			result.Reason = "no directory at either path"
+		case coreworkbench.RelocateConflict:
+			result.Status = primary.RelocateConflict
+			result.Reason = "both paths exist; remove one and re-run"
+		case coreworkbench.RelocateMove:
+			if req.DryRun {
+				result.Status = primary.RelocateWouldMove
+			} else if err := s.moveWorkbench(ctx, wb, from, to); err != nil {
+				result.Status = primary.RelocateFailed
+				result.Reason = err.Error()
+			} else {
+				result.Status = primary.RelocateMoved
+				s.removeEmptyRepoDir(ctx, filepath.Dir(from))
+			}
+		}
+	}
+	return results, nil
+}
+
+// removeEmptyRepoDir removes a by-repo layout's repo directory once its last workbench
+// has moved out. Worktree roots themselves are kept.
+func (s *WorkbenchServiceImpl) removeEmptyRepoDir(ctx context.Context, dir string) {
+	for _, root := range s.locator.Layouts().Roots() {
+		if filepath.Clean(root.Root) == filepath.Clean(dir) {
+			return
+		}
+	}
+	_ = s.workspaceAdapter.RemoveEmptyDirectory(ctx, dir)
+}
+
+// moveWorkbench moves a workbench directory: git worktrees through their repo, so git
+// keeps tracking them, and plain directories as-is.
+func (s *WorkbenchServiceImpl) moveWorkbench(ctx context.Context, wb *secondary.WorkbenchRecord, from, to string) error {
+	if wb.RepoID != "" && s.pathExists(filepath.Join(from, ".git")) {
+		repo, err := s.repoRepo.GetByID(ctx, wb.RepoID)
+		if err != nil {
+			return err
+		}
+		return s.workspaceAdapter.MoveWorktree(ctx, repo.LocalPath, from, to)
+	}
+	return s.workspaceAdapter.MoveDirectory(ctx, from, to)
+}
+
 // Ensure WorkbenchServiceImpl implements the interface
 var _ primary.WorkbenchService = (*WorkbenchServiceImpl)(nil)
@@ -3,9 +3,16 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/example/orc/internal/core/effects"
+	coreworkbench "github.com/example/orc/internal/core/workbench"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -145,6 +152,14 @@ func (m *mockWorkbenchRepository) WorkshopExists(ctx context.Context, workshopID
 	return m.workshopExists[workshopID], nil
 }
 
+func (m *mockWorkbenchRepository) UpdateSparse(ctx context.Context, id, sparseJSON string) error {
+	if wb, ok := m.workbenches[id]; ok {
+		wb.SparseJSON = sparseJSON
+		return nil
+	}
+	return errors.New("workbench not found")
+}
+
 func (m *mockWorkbenchRepository) UpdateFocusedID(ctx context.Context, id, focusedID string) error {
 	if wb, ok := m.workbenches[id]; ok {
 		wb.FocusedID = focusedID
@@ -301,6 +316,26 @@ func (m *mockRepoRepositoryForWorkbench) HasActivePRs(ctx context.Context, repoI
 	return false, nil
 }
 
+func (m *mockRepoRepositoryForWorkbench) UpdateSparse(ctx context.Context, id, sparseJSON string) error {
+	if repo, ok := m.repos[id]; ok {
+		repo.SparseJSON = sparseJSON
+		return nil
+	}
+	return errors.New("repo not found")
+}
+
+func (m *mockRepoRepositoryForWorkbench) UpdateMerge(ctx context.Context, id, mergeJSON string) error {
+	return nil
+}
+
+func (m *mockRepoRepositoryForWorkbench) UpdateBootstrap(ctx context.Context, id, bootstrapJSON string) error {
+	if repo, ok := m.repos[id]; ok {
+		repo.BootstrapJSON = bootstrapJSON
+		return nil
+	}
+	return errors.New("repo not found")
+}
+
 // ============================================================================
 // Test Helper
 // ============================================================================
@@ -313,7 +348,7 @@ func newTestWorkbenchService() (*WorkbenchServiceImpl, *mockWorkbenchRepository,
 	executor := newMockEffectExecutor()
 	workspaceAdapter := newMockWorkspaceAdapter()
 
-	service := NewWorkbenchService(workbenchRepo, workshopRepo, repoRepo, agentProvider, executor, workspaceAdapter, &mockTransactor{})
+	service := NewWorkbenchService(workbenchRepo, workshopRepo, repoRepo, agentProvider, executor, workspaceAdapter, &mockTransactor{}, nil, nil)
 	return service, workbenchRepo, workshopRepo, repoRepo, executor, workspaceAdapter
 }
 
@@ -705,3 +740,533 @@ func TestWorkbenchService_CreateWorkbench_ExecutesEffects(t *testing.T) {
 		t.Error("expected write effect for config.json")
 	}
 }
+
+func TestWorkbenchService_CreateWorkbench_SparsePaths(t *testing.T) {
+	service, workbenchRepo, _, repoRepo, executor, _ := newTestWorkbenchService()
+	ctx := context.Background()
+
+	workbenchRepo.workshopExists["WORK-001"] = true
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{
+		ID:         "REPO-001",
+		Name:       "mono",
+		LocalPath:  "/tmp/src/mono",
+		SparseJSON: `["services/api"]`,
+	}
+
+	worktreeArgs := func() []string {
+		for _, eff := range executor.executedEffects {
+			if ge, ok := eff.(effects.GitEffect); ok && ge.Operation == "worktree_add" {
+				return ge.Args
+			}
+		}
+		return nil
+	}
+
+	// The repo's sparse paths apply by default
+	resp, err := service.CreateWorkbench(ctx, primary.CreateWorkbenchRequest{WorkshopID: "WORK-001", RepoID: "REPO-001"})
+	if err != nil {
+		t.Fatalf("CreateWorkbench failed: %v", err)
+	}
+	if !reflect.DeepEqual(resp.Workbench.SparsePaths, []string{"services/api"}) {
+		t.Errorf("SparsePaths = %v, want the repo default", resp.Workbench.SparsePaths)
+	}
+	if args := worktreeArgs(); len(args) != 3 || args[2] != "services/api" {
+		t.Errorf("worktree_add args = %v, want the sparse path after branch and path", args)
+	}
+
+	// Explicit paths win over the repo's defaults
+	executor.executedEffects = nil
+	resp, err = service.CreateWorkbench(ctx, primary.CreateWorkbenchRequest{
+		Name:        "mono-web",
+		WorkshopID:  "WORK-001",
+		RepoID:      "REPO-001",
+		SparsePaths: []string{"services/web/", "libs/ui"},
+	})
+	if err != nil {
+		t.Fatalf("CreateWorkbench failed: %v", err)
+	}
+	if want := []string{"libs/ui", "services/web"}; !reflect.DeepEqual(resp.Workbench.SparsePaths, want) {
+		t.Errorf("SparsePaths = %v, want %v", resp.Workbench.SparsePaths, want)
+	}
+	if args := worktreeArgs(); len(args) != 4 {
+		t.Errorf("worktree_add args = %v, want two sparse paths", args)
+	}
+
+	// Sparse paths need a repository
+	_, err = service.CreateWorkbench(ctx, primary.CreateWorkbenchRequest{Name: "scratch", WorkshopID: "WORK-001", SparsePaths: []string{"docs"}})
+	if err == nil || !strings.Contains(err.Error(), "require a linked repository") {
+		t.Errorf("err = %v, want repository required", err)
+	}
+}
+
+func TestWorkbenchService_UpdateSparsePaths(t *testing.T) {
+	setupGitHome(t)
+	service, workbenchRepo, _, _, _, workspace := newTestWorkbenchService()
+	ctx := context.Background()
+
+	wbPath := coreworkbench.ComputePath("mono-001")
+	if err := os.MkdirAll(wbPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{
+		ID:         "BENCH-001",
+		Name:       "mono-001",
+		RepoID:     "REPO-001",
+		SparseJSON: `["services/api"]`,
+	}
+
+	paths, err := service.UpdateSparsePaths(ctx, primary.UpdateSparsePathsRequest{WorkbenchID: "BENCH-001", Add: []string{"libs/core"}})
+	if err != nil {
+		t.Fatalf("UpdateSparsePaths failed: %v", err)
+	}
+	if want := []string{"libs/core", "services/api"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+	if got := workspace.sparsePaths[wbPath]; !reflect.DeepEqual(got, paths) {
+		t.Errorf("applied sparse paths = %v, want %v", got, paths)
+	}
+	if got := workbenchRepo.workbenches["BENCH-001"].SparseJSON; got != `["libs/core","services/api"]` {
+		t.Errorf("stored SparseJSON = %s", got)
+	}
+
+	// Removing an unknown path fails without touching the worktree
+	if _, err := service.UpdateSparsePaths(ctx, primary.UpdateSparsePathsRequest{WorkbenchID: "BENCH-001", Remove: []string{"services/web"}}); err == nil {
+		t.Error("expected error removing a path that is not checked out")
+	}
+
+	// Removing every path restores a full checkout
+	paths, err = service.UpdateSparsePaths(ctx, primary.UpdateSparsePathsRequest{WorkbenchID: "BENCH-001", Remove: []string{"libs/core", "services/api"}})
+	if err != nil {
+		t.Fatalf("UpdateSparsePaths failed: %v", err)
+	}
+	if len(paths) != 0 || len(workspace.sparsePaths[wbPath]) != 0 || workbenchRepo.workbenches["BENCH-001"].SparseJSON != "" {
+		t.Errorf("paths = %v, stored = %q, want a full checkout", paths, workbenchRepo.workbenches["BENCH-001"].SparseJSON)
+	}
+
+	workbenchRepo.workbenches["BENCH-002"] = &secondary.WorkbenchRecord{ID: "BENCH-002", Name: "scratch"}
+	if _, err := service.UpdateSparsePaths(ctx, primary.UpdateSparsePathsRequest{WorkbenchID: "BENCH-002", Add: []string{"docs"}}); err == nil {
+		t.Error("expected error for a workbench without a repository")
+	}
+}
+
+// ============================================================================
+// SyncWorkbenches Tests
+// ============================================================================
+
+// runGit runs git in dir, skipping the test if git is unavailable.
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %v: %s", args, err, out)
+	}
+}
+
+func commitFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, dir, "add", name)
+	runGit(t, dir, "commit", "-q", "-m", "update "+name)
+}
+
+// setupGitHome points HOME at a temp dir (so workbenches live under it) and sets a git identity.
+func setupGitHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	for _, k := range []string{"GIT_AUTHOR_NAME", "GIT_COMMITTER_NAME"} {
+		t.Setenv(k, "test")
+	}
+	for _, k := range []string{"GIT_AUTHOR_EMAIL", "GIT_COMMITTER_EMAIL"} {
+		t.Setenv(k, "test@example.com")
+	}
+	return home
+}
+
+func TestWorkbenchService_SyncWorkbenches(t *testing.T) {
+	home := setupGitHome(t)
+
+	service, workbenchRepo, _, repoRepo, _, _ := newTestWorkbenchService()
+	ctx := context.Background()
+
+	// Repo with a shared file, then one workbench per scenario branched from the first commit
+	repoPath := filepath.Join(home, "src", "app")
+	if err := os.MkdirAll(repoPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, repoPath, "init", "-q", "-b", "main")
+	commitFile(t, repoPath, "shared.txt", "base\n")
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app", LocalPath: repoPath, DefaultBranch: "main"}
+
+	benches := map[string]string{"BENCH-001": "behind", "BENCH-002": "dirty", "BENCH-003": "conflict"}
+	for id, name := range benches {
+		runGit(t, repoPath, "worktree", "add", "-q", "-b", "ml/"+name, filepath.Join(home, "wb", name))
+		workbenchRepo.workbenches[id] = &secondary.WorkbenchRecord{ID: id, Name: name, WorkshopID: "WORK-001", RepoID: "REPO-001", Status: "active"}
+	}
+	commitFile(t, filepath.Join(home, "wb", "conflict"), "shared.txt", "theirs\n")
+	commitFile(t, filepath.Join(home, "wb", "dirty"), "notes.txt", "draft\n")
+	if err := os.WriteFile(filepath.Join(home, "wb", "dirty", "notes.txt"), []byte("wip\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	commitFile(t, repoPath, "shared.txt", "ours\n")
+
+	// Created after main moved on, so already up to date
+	runGit(t, repoPath, "worktree", "add", "-q", "-b", "ml/current", filepath.Join(home, "wb", "current"))
+	workbenchRepo.workbenches["BENCH-004"] = &secondary.WorkbenchRecord{ID: "BENCH-004", Name: "current", WorkshopID: "WORK-001", RepoID: "REPO-001", Status: "active"}
+
+	results, err := service.SyncWorkbenches(ctx, primary.SyncWorkbenchesRequest{})
+	if err != nil {
+		t.Fatalf("SyncWorkbenches failed: %v", err)
+	}
+
+	byID := make(map[string]*primary.WorkbenchSyncResult)
+	for _, r := range results {
+		byID[r.WorkbenchID] = r
+	}
+	want := map[string]string{
+		"BENCH-001": primary.SyncStatusRebased,
+		"BENCH-002": primary.SyncStatusSkippedDirty,
+		"BENCH-003": primary.SyncStatusConflicts,
+		"BENCH-004": primary.SyncStatusUpToDate,
+	}
+	for id, status := range want {
+		r, ok := byID[id]
+		if !ok {
+			t.Fatalf("no result for %s", id)
+		}
+		if r.Status != status {
+			t.Errorf("%s status = %q, want %q (reason: %s)", id, r.Status, status, r.Reason)
+		}
+	}
+	if c := byID["BENCH-003"].Conflicts; len(c) != 1 || c[0] != "shared.txt" {
+		t.Errorf("BENCH-003 conflicts = %v, want [shared.txt]", c)
+	}
+
+	// Conflicting rebase was aborted, leaving the branch untouched
+	data, _ := os.ReadFile(filepath.Join(home, "wb", "conflict", "shared.txt"))
+	if string(data) != "theirs\n" {
+		t.Errorf("conflict workbench shared.txt = %q, want untouched", data)
+	}
+
+	// Autostash syncs the dirty workbench and keeps its changes
+	results, err = service.SyncWorkbenches(ctx, primary.SyncWorkbenchesRequest{Strategy: primary.SyncStrategyMerge, Autostash: true})
+	if err != nil {
+		t.Fatalf("SyncWorkbenches failed: %v", err)
+	}
+	for _, r := range results {
+		if r.WorkbenchID == "BENCH-002" && r.Status != primary.SyncStatusMerged {
+			t.Errorf("BENCH-002 status = %q, want %q (reason: %s)", r.Status, primary.SyncStatusMerged, r.Reason)
+		}
+	}
+	if data, _ := os.ReadFile(filepath.Join(home, "wb", "dirty", "notes.txt")); string(data) != "wip\n" {
+		t.Errorf("dirty workbench notes.txt = %q, want stashed change restored", data)
+	}
+}
+
+func TestWorkbenchService_SyncWorkbenches_MasterDefaultBranch(t *testing.T) {
+	home := setupGitHome(t)
+
+	service, workbenchRepo, _, repoRepo, _, _ := newTestWorkbenchService()
+	ctx := context.Background()
+
+	repoPath := filepath.Join(home, "src", "app")
+	if err := os.MkdirAll(repoPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, repoPath, "init", "-q", "-b", "master")
+	commitFile(t, repoPath, "shared.txt", "base\n")
+	// The ledger default, not what the repo uses
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app", LocalPath: repoPath, DefaultBranch: "main"}
+
+	for id, name := range map[string]string{"BENCH-001": "behind", "BENCH-002": "pushed"} {
+		runGit(t, repoPath, "worktree", "add", "-q", "-b", "ml/"+name, filepath.Join(home, "wb", name))
+		workbenchRepo.workbenches[id] = &secondary.WorkbenchRecord{ID: id, Name: name, WorkshopID: "WORK-001", RepoID: "REPO-001", Status: "active"}
+	}
+	// BENCH-002 tracks a branch that has a commit it doesn't
+	runGit(t, repoPath, "branch", "ml/pushed-upstream", "ml/pushed")
+	commitFile(t, repoPath, "shared.txt", "master moved\n")
+	pushed := filepath.Join(home, "wb", "pushed")
+	runGit(t, pushed, "checkout", "-q", "ml/pushed-upstream")
+	commitFile(t, pushed, "other.txt", "pushed\n")
+	runGit(t, pushed, "checkout", "-q", "ml/pushed")
+	runGit(t, pushed, "branch", "-q", "--set-upstream-to", "ml/pushed-upstream")
+
+	results, err := service.SyncWorkbenches(ctx, primary.SyncWorkbenchesRequest{})
+	if err != nil {
+		t.Fatalf("SyncWorkbenches failed: %v", err)
+	}
+	for _, r := range results {
+		switch r.WorkbenchID {
+		case "BENCH-001":
+			if r.Status != primary.SyncStatusRebased || r.BaseRef != "master" {
+				t.Errorf("BENCH-001 = %s onto %q, want rebased onto master (reason: %s)", r.Status, r.BaseRef, r.Reason)
+			}
+		case "BENCH-002":
+			if r.Status != primary.SyncStatusSkipped || !strings.Contains(r.Reason, "behind its upstream") {
+				t.Errorf("BENCH-002 = %s (%s), want skipped as behind its upstream", r.Status, r.Reason)
+			}
+		}
+	}
+}
+
+func TestWorkbenchService_SyncWorkbenches_UnknownStrategy(t *testing.T) {
+	service, _, _, _, _, _ := newTestWorkbenchService()
+
+	_, err := service.SyncWorkbenches(context.Background(), primary.SyncWorkbenchesRequest{Strategy: "squash"})
+	if err == nil {
+		t.Fatal("expected error for unknown strategy")
+	}
+}
+
+// ============================================================================
+// StartBranch / ReturnToHomeBranch Tests
+// ============================================================================
+
+func TestWorkbenchService_StartBranchAndReturnHome(t *testing.T) {
+	home := setupGitHome(t)
+	service, workbenchRepo, _, repoRepo, _, _ := newTestWorkbenchService()
+	ctx := context.Background()
+
+	repoPath := filepath.Join(home, "src", "app")
+	if err := os.MkdirAll(repoPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, repoPath, "init", "-q", "-b", "main")
+	commitFile(t, repoPath, "README.md", "app\n")
+	wbPath := filepath.Join(home, "wb", "app-001")
+	runGit(t, repoPath, "worktree", "add", "-q", "-b", "ml/app-001", wbPath)
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app", LocalPath: repoPath, DefaultBranch: "main"}
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{
+		ID: "BENCH-001", Name: "app-001", RepoID: "REPO-001", Status: "active",
+		HomeBranch: "ml/app-001", CurrentBranch: "ml/app-001",
+	}
+
+	// Start two shipment branches; only the first gets merged
+	for i, branch := range []string{"ml/SHIP-002-unmerged", "ml/SHIP-001-merged"} {
+		if _, err := service.StartBranch(ctx, primary.StartBranchRequest{WorkbenchID: "BENCH-001", Branch: branch}); err != nil {
+			t.Fatalf("StartBranch(%s) failed: %v", branch, err)
+		}
+		commitFile(t, wbPath, fmt.Sprintf("work-%d.txt", i), "work\n")
+	}
+	if got := workbenchRepo.workbenches["BENCH-001"].CurrentBranch; got != "ml/SHIP-001-merged" {
+		t.Errorf("CurrentBranch = %q, want ml/SHIP-001-merged", got)
+	}
+	runGit(t, repoPath, "merge", "-q", "--no-edit", "ml/SHIP-001-merged")
+
+	resp, err := service.ReturnToHomeBranch(ctx, primary.ReturnToHomeBranchRequest{WorkbenchID: "BENCH-001", DeleteBranch: "ml/SHIP-001-merged"})
+	if err != nil {
+		t.Fatalf("ReturnToHomeBranch failed: %v", err)
+	}
+	if resp.CurrentBranch != "ml/app-001" || !resp.BranchDeleted {
+		t.Errorf("resp = %+v, want home branch checked out and merged branch deleted", resp)
+	}
+	if got := workbenchRepo.workbenches["BENCH-001"].CurrentBranch; got != "ml/app-001" {
+		t.Errorf("CurrentBranch = %q, want ml/app-001", got)
+	}
+
+	resp, err = service.ReturnToHomeBranch(ctx, primary.ReturnToHomeBranchRequest{WorkbenchID: "BENCH-001", DeleteBranch: "ml/SHIP-002-unmerged"})
+	if err != nil {
+		t.Fatalf("ReturnToHomeBranch failed: %v", err)
+	}
+	if resp.BranchDeleted || resp.KeptReason == "" {
+		t.Errorf("resp = %+v, want unmerged branch kept with a reason", resp)
+	}
+}
+
+func TestWorkbenchService_BootstrapWorkbench(t *testing.T) {
+	home := setupGitHome(t)
+
+	service, workbenchRepo, _, repoRepo, _, _ := newTestWorkbenchService()
+	events := &mockEventWriter{}
+	service.eventWriter = events
+	ctx := context.Background()
+
+	repoPath := filepath.Join(home, "src", "app")
+	if err := os.MkdirAll(filepath.Join(repoPath, ".orc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, repoPath, "init", "-q", "-b", "main")
+	commitFile(t, repoPath, ".orc/repo.json", `{"bootstrap": ["cp \"$ORC_REPO_PATH/.env\" .env", "echo $ORC_WORKBENCH_ID > bench.txt"]}`)
+	if err := os.WriteFile(filepath.Join(repoPath, ".env"), []byte("SECRET=1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app", LocalPath: repoPath, DefaultBranch: "main"}
+
+	wbPath := coreworkbench.ComputePath("bench")
+	runGit(t, repoPath, "worktree", "add", "-q", "-b", "ml/bench", wbPath)
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{ID: "BENCH-001", Name: "bench", WorkshopID: "WORK-001", RepoID: "REPO-001", Status: "active"}
+
+	// Steps from the checked-in config are only listed until adopted
+	result, err := service.BootstrapWorkbench(ctx, "BENCH-001")
+	if err != nil {
+		t.Fatalf("BootstrapWorkbench failed: %v", err)
+	}
+	if result.Source != ".orc/repo.json" || len(result.Pending) != 2 || len(result.Steps) != 0 {
+		t.Fatalf("result = %+v, want two pending steps from .orc/repo.json", result)
+	}
+	if _, err := os.Stat(filepath.Join(wbPath, "bench.txt")); err == nil {
+		t.Fatal("checked-in steps ran without being adopted")
+	}
+	if len(events.operational) != 0 {
+		t.Errorf("events = %+v, want none for pending steps", events.operational)
+	}
+
+	// Adopted onto the record, they run
+	repoService := NewRepoService(repoRepo, &mockTransactor{}, nil, nil)
+	if _, err := repoService.AdoptBootstrapFile(ctx, "REPO-001"); err != nil {
+		t.Fatalf("AdoptBootstrapFile failed: %v", err)
+	}
+	result, err = service.BootstrapWorkbench(ctx, "BENCH-001")
+	if err != nil {
+		t.Fatalf("BootstrapWorkbench failed: %v", err)
+	}
+	if result.Failed || result.Source != "repo record" || len(result.Steps) != 2 {
+		t.Fatalf("result = %+v, want two successful adopted steps", result)
+	}
+	if data, _ := os.ReadFile(filepath.Join(wbPath, ".env")); string(data) != "SECRET=1\n" {
+		t.Errorf(".env = %q, want copied from the main clone", data)
+	}
+	if data, _ := os.ReadFile(filepath.Join(wbPath, "bench.txt")); string(data) != "BENCH-001\n" {
+		t.Errorf("bench.txt = %q, want the workbench ID", data)
+	}
+	if len(events.operational) != 2 || events.operational[0].Source != "workbench-bootstrap" || events.operational[0].Level != "info" {
+		t.Errorf("events = %+v, want one info event per step", events.operational)
+	}
+
+	// Steps on the repo record override the file; a failing step stops the run
+	repoRepo.repos["REPO-001"].BootstrapJSON = `["echo broken >&2; exit 3", "touch never.txt"]`
+	events.operational = nil
+	result, err = service.BootstrapWorkbench(ctx, "BENCH-001")
+	if err != nil {
+		t.Fatalf("BootstrapWorkbench failed: %v", err)
+	}
+	if !result.Failed || result.Source != "repo record" || len(result.Steps) != 1 {
+		t.Fatalf("result = %+v, want a failed run stopped after the first record step", result)
+	}
+	if result.Steps[0].Output != "broken\n" {
+		t.Errorf("step output = %q, want captured stderr", result.Steps[0].Output)
+	}
+	if _, err := os.Stat(filepath.Join(wbPath, "never.txt")); err == nil {
+		t.Error("step after the failure should not have run")
+	}
+	if len(events.operational) != 1 || events.operational[0].Level != "error" || events.operational[0].Data["output"] != "broken\n" {
+		t.Errorf("events = %+v, want one error event with the output", events.operational)
+	}
+}
+
+func TestWorkbenchService_BootstrapWorkbench_NoSteps(t *testing.T) {
+	home := setupGitHome(t)
+
+	service, workbenchRepo, _, repoRepo, _, _ := newTestWorkbenchService()
+	ctx := context.Background()
+
+	repoPath := filepath.Join(home, "src", "app")
+	if err := os.MkdirAll(repoPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, repoPath, "init", "-q", "-b", "main")
+	commitFile(t, repoPath, "README.md", "app\n")
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app", LocalPath: repoPath}
+	runGit(t, repoPath, "worktree", "add", "-q", "-b", "ml/bench", coreworkbench.ComputePath("bench"))
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{ID: "BENCH-001", Name: "bench", WorkshopID: "WORK-001", RepoID: "REPO-001", Status: "active"}
+
+	if _, err := service.BootstrapWorkbench(ctx, "BENCH-001"); err == nil || !strings.Contains(err.Error(), "defines no bootstrap steps") {
+		t.Errorf("err = %v, want no bootstrap steps error", err)
+	}
+}
+
+func TestWorkbenchService_RelocateWorkbenches(t *testing.T) {
+	home := setupGitHome(t)
+
+	service, workbenchRepo, workshopRepo, repoRepo, _, workspace := newTestWorkbenchService()
+	ctx := context.Background()
+	workshopRepo.workshops["WORK-001"] = &secondary.WorkshopRecord{ID: "WORK-001", FactoryID: "FACT-001"}
+
+	repoPath := filepath.Join(home, "src", "app")
+	if err := os.MkdirAll(repoPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, repoPath, "init", "-q", "-b", "main")
+	commitFile(t, repoPath, "README.md", "app\n")
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app", LocalPath: repoPath}
+
+	// A worktree, a plain directory and a workbench whose directory is gone, all under ~/wb
+	runGit(t, repoPath, "worktree", "add", "-q", "-b", "ml/app-001", filepath.Join(home, "wb", "app-001"))
+	if err := os.MkdirAll(filepath.Join(home, "wb", "scratch"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{ID: "BENCH-001", Name: "app-001", WorkshopID: "WORK-001", RepoID: "REPO-001", Status: "active"}
+	workbenchRepo.workbenches["BENCH-002"] = &secondary.WorkbenchRecord{ID: "BENCH-002", Name: "scratch", WorkshopID: "WORK-001", Status: "active"}
+	workbenchRepo.workbenches["BENCH-003"] = &secondary.WorkbenchRecord{ID: "BENCH-003", Name: "ghost", WorkshopID: "WORK-001", Status: "active"}
+
+	volume := filepath.Join(home, "vol", "wb")
+	req := primary.RelocateWorkbenchesRequest{
+		Default: primary.WorkbenchLayout{WorktreeRoot: volume, Layout: "by-repo", NamePattern: "{repo}-{number}"},
+		DryRun:  true,
+	}
+
+	statuses := func(results []*primary.WorkbenchRelocation) map[string]string {
+		byID := make(map[string]string)
+		for _, r := range results {
+			byID[r.WorkbenchID] = r.Status
+		}
+		return byID
+	}
+
+	results, err := service.RelocateWorkbenches(ctx, req)
+	if err != nil {
+		t.Fatalf("RelocateWorkbenches (dry run) failed: %v", err)
+	}
+	if got := statuses(results); got["BENCH-001"] != primary.RelocateWouldMove || got["BENCH-003"] != primary.RelocateMissing {
+		t.Errorf("dry run statuses = %v, want would-move and missing", got)
+	}
+	if _, err := os.Stat(filepath.Join(home, "wb", "app-001")); err != nil {
+		t.Fatalf("dry run moved the worktree: %v", err)
+	}
+
+	req.DryRun = false
+	results, err = service.RelocateWorkbenches(ctx, req)
+	if err != nil {
+		t.Fatalf("RelocateWorkbenches failed: %v", err)
+	}
+	if got := statuses(results); got["BENCH-001"] != primary.RelocateMoved || got["BENCH-002"] != primary.RelocateMoved {
+		t.Fatalf("statuses = %v, want both directories moved (%+v)", got, results)
+	}
+
+	// Only the git worktree goes through git; the plain directory is moved as-is
+	if len(workspace.movedWorktrees) != 1 || workspace.movedWorktrees[0] != filepath.Join(home, "wb", "app-001") {
+		t.Errorf("moved worktrees = %v, want only app-001", workspace.movedWorktrees)
+	}
+	if _, err := os.Stat(filepath.Join(volume, "app", "app-001", "README.md")); err != nil {
+		t.Errorf("worktree not moved under its repo directory: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(volume, coreworkbench.NoRepoDir, "scratch")); err != nil {
+		t.Errorf("plain workbench directory not moved: %v", err)
+	}
+	if len(workspace.removedEmptyDirs) != 0 {
+		t.Errorf("worktree roots should be kept, removed %v", workspace.removedEmptyDirs)
+	}
+
+	// Re-running against the old layout finds everything already in place
+	results, _ = service.RelocateWorkbenches(ctx, req)
+	if got := statuses(results); got["BENCH-001"] != primary.RelocateDone || got["BENCH-002"] != primary.RelocateDone {
+		t.Errorf("re-run statuses = %v, want already-moved", got)
+	}
+}
+
+func TestWorkbenchService_RelocateWorkbenches_InvalidLayout(t *testing.T) {
+	service, _, _, _, _, _ := newTestWorkbenchService()
+
+	_, err := service.RelocateWorkbenches(context.Background(), primary.RelocateWorkbenchesRequest{
+		Default: primary.WorkbenchLayout{WorktreeRoot: "relative/wb", Layout: "flat", NamePattern: "{repo}-{number}"},
+	})
+	if err == nil {
+		t.Error("expected error for a relative worktree root")
+	}
+}
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"github.com/example/orc/internal/core/effects"
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+)
+
+// worktreeAddEffect builds the effect that creates a workbench's worktree, restricted to
+// the workbench's sparse checkout paths when it has any.
+func worktreeAddEffect(repoPath, branch, wbPath, sparseJSON string) (effects.GitEffect, error) {
+	paths, err := coreworkbench.ParseSparsePaths(sparseJSON)
+	if err != nil {
+		return effects.GitEffect{}, err
+	}
+	return effects.GitEffect{
+		Operation: "worktree_add",
+		RepoPath:  repoPath,
+		Args:      append([]string{branch, wbPath}, paths...),
+	}, nil
+}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/example/orc/internal/config"
 	"github.com/example/orc/internal/core/effects"
-	coreworkbench "github.com/example/orc/internal/core/workbench"
 	coreworkshop "github.com/example/orc/internal/core/workshop"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
@@ -24,7 +23,10 @@ type WorkshopServiceImpl struct {
 	tmuxAdapter      secondary.TMuxAdapter
 	workspaceAdapter secondary.WorkspaceAdapter
 	executor         EffectExecutor
+	gitService       *GitService
 	transactor       secondary.Transactor
+	eventWriter      secondary.EventWriter // optional: records bootstrap output
+	locator          *WorkbenchLocator     // optional: nil uses the default layout
 }
 
 // NewWorkshopService creates a new WorkshopService with injected dependencies.
@@ -37,6 +39,8 @@ func NewWorkshopService(
 	workspaceAdapter secondary.WorkspaceAdapter,
 	executor EffectExecutor,
 	transactor secondary.Transactor,
+	eventWriter secondary.EventWriter,
+	locator *WorkbenchLocator,
 ) *WorkshopServiceImpl {
 	return &WorkshopServiceImpl{
 		factoryRepo:      factoryRepo,
@@ -46,7 +50,10 @@ func NewWorkshopService(
 		tmuxAdapter:      tmuxAdapter,
 		workspaceAdapter: workspaceAdapter,
 		executor:         executor,
+		gitService:       NewGitService(),
 		transactor:       transactor,
+		eventWriter:      eventWriter,
+		locator:          locator,
 	}
 }
 
@@ -237,7 +244,7 @@ func (s *WorkshopServiceImpl) PlanOpenWorkshop(ctx context.Context, req primary.
 				repoName = repo.Name
 			}
 		}
-		wbPath := coreworkbench.ComputePath(wb.Name)
+		wbPath := s.locator.Path(ctx, wb)
 		wbInputs = append(wbInputs, coreworkshop.WorkbenchPlanInput{
 			ID:             wb.ID,
 			Name:           wb.Name,
@@ -286,11 +293,16 @@ func (s *WorkshopServiceImpl) ApplyOpenWorkshop(ctx context.Context, plan *prima
 	}
 
 	// 1. Create workbenches if needed
+	var bootstraps []*primary.BootstrapResult
 	workbenches, _ := s.workbenchRepo.List(ctx, plan.WorkshopID)
 	for _, wb := range workbenches {
-		if err := s.ensureWorktreeExists(ctx, wb); err != nil {
+		bootstrap, err := s.ensureWorktreeExists(ctx, wb)
+		if err != nil {
 			return nil, fmt.Errorf("failed to create worktree for %s: %w", wb.Name, err)
 		}
+		if bootstrap != nil {
+			bootstraps = append(bootstraps, bootstrap)
+		}
 	}
 
 	// 3. TMux lifecycle removed - now handled by gotmux via `orc tmux apply`
@@ -305,6 +317,7 @@ func (s *WorkshopServiceImpl) ApplyOpenWorkshop(ctx context.Context, plan *prima
 		SessionName:        plan.SessionName,
 		SessionAlreadyOpen: sessionAlreadyOpen,
 		AttachInstructions: s.tmuxAdapter.AttachInstructions(plan.SessionName),
+		Bootstrap:          bootstraps,
 	}, nil
 }
 
@@ -319,45 +332,51 @@ func (s *WorkshopServiceImpl) OpenWorkshop(ctx context.Context, req primary.Open
 }
 
 // ensureWorktreeExists creates a worktree and IMP config if they don't exist.
-// All effects are batched into a single execute call for atomicity.
-func (s *WorkshopServiceImpl) ensureWorktreeExists(ctx context.Context, wb *secondary.WorkbenchRecord) error {
+// All effects are batched into a single execute call for atomicity. A newly created
+// worktree is then bootstrapped; its result is returned (nil when none ran).
+func (s *WorkshopServiceImpl) ensureWorktreeExists(ctx context.Context, wb *secondary.WorkbenchRecord) (*primary.BootstrapResult, error) {
 	// Compute path from name
-	wbPath := coreworkbench.ComputePath(wb.Name)
+	wbPath := s.locator.Path(ctx, wb)
 
 	// Check if worktree already exists
 	exists, err := s.workspaceAdapter.WorktreeExists(ctx, wbPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var effs []effects.Effect
+	var created *secondary.RepoRecord
 
 	// 1. Build worktree effect (only if needed)
-	if !exists {
-		if wb.RepoID == "" {
+	if wb.RepoID == "" {
+		if !exists {
 			// No repo linked - just create the directory via FileEffect
 			effs = append(effs, effects.FileEffect{
 				Operation: "mkdir",
 				Path:      wbPath,
 				Mode:      0755,
 			})
-		} else {
-			// Get repo name
-			repo, err := s.repoRepo.GetByID(ctx, wb.RepoID)
+		}
+	} else if repo, err := s.repoRepo.GetByID(ctx, wb.RepoID); err != nil {
+		if !exists {
+			return nil, fmt.Errorf("repo %s not found: %w", wb.RepoID, err)
+		}
+	} else {
+		// Create worktree via GitEffect
+		if !exists {
+			eff, err := worktreeAddEffect(repo.LocalPath, wb.HomeBranch, wbPath, wb.SparseJSON)
 			if err != nil {
-				return fmt.Errorf("repo %s not found: %w", wb.RepoID, err)
+				return nil, err
 			}
-
-			// Create worktree via GitEffect
-			effs = append(effs, effects.GitEffect{
-				Operation: "worktree_add",
-				RepoPath:  repo.LocalPath,
-				Args:      []string{wb.HomeBranch, wbPath},
-			})
+			effs = append(effs, eff)
+			created = repo
 		}
+
+		// 2. Install the commit trailer hook (shared by every worktree of the repo)
+		effs = append(effs, commitHookEffects(s.gitService, repo.LocalPath)...)
 	}
 
-	// 2. Build config effects (always - idempotent)
+	// 3. Build config effects (always - idempotent)
 	orcDir := filepath.Join(wbPath, ".orc")
 	configPath := filepath.Join(orcDir, "config.json")
 	cfg := &config.Config{
@@ -366,18 +385,29 @@ func (s *WorkshopServiceImpl) ensureWorktreeExists(ctx context.Context, wb *seco
 	}
 	configJSON, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
-		return fmt.Errorf("failed to marshal config: %w", err)
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
 	}
 	effs = append(effs,
 		effects.FileEffect{Operation: "mkdir", Path: orcDir, Mode: 0755},
 		effects.FileEffect{Operation: "write", Path: configPath, Content: configJSON, Mode: 0644},
 	)
 
-	// 3. Execute ALL effects in one batch
+	// 4. Execute ALL effects in one batch
 	if len(effs) > 0 {
-		return s.executor.Execute(ctx, effs)
+		if err := s.executor.Execute(ctx, effs); err != nil {
+			return nil, err
+		}
 	}
-	return nil
+
+	// 5. Bootstrap the new worktree
+	if created == nil {
+		return nil, nil
+	}
+	result, err := runBootstrap(ctx, s.eventWriter, wb, created, wbPath)
+	if err != nil {
+		return nil, fmt.Errorf("worktree created but bootstrap could not start: %w", err)
+	}
+	return result, nil
 }
 
 // CloseWorkshop kills the workshop's TMux session.
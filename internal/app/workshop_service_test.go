@@ -275,6 +275,14 @@ func (m *mockWorkbenchRepositoryForWorkshop) WorkshopExists(ctx context.Context,
 	return true, nil
 }
 
+func (m *mockWorkbenchRepositoryForWorkshop) UpdateSparse(ctx context.Context, id, sparseJSON string) error {
+	if wb, ok := m.workbenches[id]; ok {
+		wb.SparseJSON = sparseJSON
+		return nil
+	}
+	return errors.New("workbench not found")
+}
+
 func (m *mockWorkbenchRepositoryForWorkshop) UpdateFocusedID(ctx context.Context, id, focusedID string) error {
 	if wb, ok := m.workbenches[id]; ok {
 		wb.FocusedID = focusedID
@@ -339,6 +347,18 @@ func (m *mockRepoRepositoryForWorkshop) HasActivePRs(ctx context.Context, repoID
 	return false, nil
 }
 
+func (m *mockRepoRepositoryForWorkshop) UpdateSparse(ctx context.Context, id, sparseJSON string) error {
+	return nil
+}
+
+func (m *mockRepoRepositoryForWorkshop) UpdateMerge(ctx context.Context, id, mergeJSON string) error {
+	return nil
+}
+
+func (m *mockRepoRepositoryForWorkshop) UpdateBootstrap(ctx context.Context, id, bootstrapJSON string) error {
+	return nil
+}
+
 // mockTMuxAdapter implements secondary.TMuxAdapter for testing.
 type mockTMuxAdapter struct {
 	sessions       map[string]bool
@@ -520,6 +540,8 @@ func newTestWorkshopService() (*WorkshopServiceImpl, *mockWorkshopRepository, *m
 		workspaceAdapter,
 		executor,
 		&mockTransactor{},
+		nil,
+		nil,
 	)
 	return service, workshopRepo, factoryRepo, tmuxAdapter
 }
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+var changelogCmd = &cobra.Command{
+	Use:   "changelog [commission-id]",
+	Short: "Generate a changelog from shipped work",
+	Long: `Build a Keep a Changelog release section from the ledger: the closed tasks of
+shipments whose PRs were merged, or that were closed without a PR, grouped by task
+type:
+
+  implementation  → Added
+  fix             → Fixed
+  documentation   → Documentation
+  maintenance     → Maintenance
+  (untyped)       → Changed
+
+Research tasks are left out. A shipment without any other closed tasks is listed
+under Changed by its title.
+
+Without a commission the changelog covers the commission in context; with only
+--since it covers every commission.
+
+Examples:
+  orc changelog COMM-001
+  orc changelog COMM-001 --since 2026-03-01 --version 1.4.0
+  orc changelog --since 2026-03-01 --format json
+  orc changelog COMM-001 --version 1.4.0 --prepend BENCH-014`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		since, _ := cmd.Flags().GetString("since")
+		version, _ := cmd.Flags().GetString("version")
+		format, _ := cmd.Flags().GetString("format")
+		prepend, _ := cmd.Flags().GetString("prepend")
+
+		var commissionID string
+		if len(args) > 0 {
+			commissionID = args[0]
+		} else if since == "" {
+			commissionID = orccontext.GetContextCommissionID()
+			if commissionID == "" {
+				return fmt.Errorf("no commission context detected\nHint: Pass a commission ID or --since")
+			}
+		}
+		if err := validateEntityID(commissionID, "commission"); err != nil {
+			return err
+		}
+
+		req := primary.ChangelogRequest{
+			CommissionID: commissionID,
+			Since:        since,
+			Version:      version,
+			Format:       format,
+		}
+
+		if prepend != "" {
+			path, err := wire.ReportService().PrependChangelog(ctx, req, prepend)
+			if err != nil {
+				return fmt.Errorf("failed to update changelog: %w", err)
+			}
+			fmt.Printf("✓ Updated %s\n", path)
+			return nil
+		}
+
+		out, err := wire.ReportService().RenderChangelog(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to generate changelog: %w", err)
+		}
+		fmt.Print(out)
+		return nil
+	},
+}
+
+func init() {
+	changelogCmd.Flags().String("since", "", "Only include work shipped on or after this date (YYYY-MM-DD or RFC3339)")
+	changelogCmd.Flags().String("version", "", "Release version for the heading (default: Unreleased)")
+	changelogCmd.Flags().StringP("format", "f", "markdown", "Output format: markdown or json")
+	changelogCmd.Flags().String("prepend", "", "Prepend the release to CHANGELOG.md in this workbench instead of printing it")
+}
+
+// ChangelogCmd returns the changelog command
+func ChangelogCmd() *cobra.Command {
+	return changelogCmd
+}
@@ -162,6 +162,26 @@ var commissionUnpinCmd = &cobra.Command{
 	},
 }
 
+// printCommissionLifecycle reports what closing a shipment or tome did to its commission.
+func printCommissionLifecycle(result *primary.CommissionLifecycleResult) {
+	if result == nil {
+		return
+	}
+	if result.Error != "" {
+		fmt.Printf("Warning: failed to sync commission %s status: %s\n", result.CommissionID, result.Error)
+		return
+	}
+	switch result.Action {
+	case "activate":
+		fmt.Printf("✓ Commission %s activated (%s → %s)\n", result.CommissionID, result.PreviousStatus, result.NewStatus)
+	case "complete":
+		fmt.Printf("✓ Commission %s completed: all shipments and tomes are closed\n", result.CommissionID)
+	case "prompt-complete":
+		fmt.Printf("💡 All shipments and tomes in %s are closed, but it is pinned.\n", result.CommissionID)
+		fmt.Printf("   Complete it with: orc commission unpin %s && orc commission complete %s\n", result.CommissionID, result.CommissionID)
+	}
+}
+
 // CommissionCmd returns the commission command
 func CommissionCmd() *cobra.Command {
 	// Add flags
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+var commitCmd = &cobra.Command{
+	Use:   "commit",
+	Short: "Link git commits to tasks and shipments",
+	Long: `Link git commits to ORC work through commit message trailers:
+
+  Orc-Task: TASK-101
+  Orc-Shipment: SHIP-042
+
+Workbench repos get a prepare-commit-msg hook that adds these trailers for the
+workbench's focused shipment and claimed task. 'orc commit scan' reads the
+trailers from every local branch and records the commits in the ledger, where
+'orc task show', 'orc shipment show', and 'orc summary' pick them up.`,
+}
+
+var commitScanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Record trailer-linked commits from workbench repos",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		workshopID, _ := cmd.Flags().GetString("workshop")
+
+		result, err := wire.CommitService().ScanCommits(ctx, primary.ScanCommitsRequest{WorkshopID: workshopID})
+		if err != nil {
+			return fmt.Errorf("failed to scan commits: %w", err)
+		}
+
+		fmt.Printf("✓ Scanned %d repo(s): %d linked commit(s)\n", result.Repos, result.Linked)
+		if result.Skipped > 0 {
+			fmt.Printf("  %d commit(s) reference tasks or shipments not in the ledger\n", result.Skipped)
+		}
+		return nil
+	},
+}
+
+var commitListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List linked commits",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		taskID, _ := cmd.Flags().GetString("task")
+		shipmentID, _ := cmd.Flags().GetString("shipment")
+
+		commits, err := wire.CommitService().ListCommits(ctx, primary.CommitFilters{
+			TaskID:     taskID,
+			ShipmentID: shipmentID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list commits: %w", err)
+		}
+
+		if len(commits) == 0 {
+			fmt.Println("No linked commits found. Run 'orc commit scan' to pick up new commits.")
+			return nil
+		}
+		for _, c := range commits {
+			links := c.TaskID
+			if links == "" {
+				links = c.ShipmentID
+			}
+			fmt.Printf("%s  %-9s %s  (%s, %s)\n", shortHash(c.SHA), links, c.Subject, c.Author, formatDiffStat(c))
+		}
+		return nil
+	},
+}
+
+var commitPrepareMsgCmd = &cobra.Command{
+	Use:    "prepare-msg <message-file> [source]",
+	Short:  "Add Orc trailers to a commit message (prepare-commit-msg hook)",
+	Hidden: true,
+	Args:   cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+
+		cwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		workbench, _ := wire.WorkbenchService().GetWorkbenchByPath(ctx, cwd)
+		if workbench == nil {
+			return nil // Not a workbench: leave the message alone
+		}
+
+		messageFile := args[0]
+		if !filepath.IsAbs(messageFile) {
+			messageFile = filepath.Join(cwd, messageFile)
+		}
+		source := ""
+		if len(args) == 2 {
+			source = args[1]
+		}
+
+		return wire.CommitService().PrepareCommitMessage(ctx, primary.PrepareCommitMessageRequest{
+			WorkbenchID: workbench.ID,
+			WorkDir:     cwd,
+			MessageFile: messageFile,
+			Source:      source,
+		})
+	},
+}
+
+// printLinkedCommits lists commits under a task or shipment in 'show' output.
+func printLinkedCommits(commits []*primary.Commit) {
+	if len(commits) == 0 {
+		return
+	}
+	fmt.Printf("\nLinked commits (%d):\n", len(commits))
+	for _, c := range commits {
+		fmt.Printf("  %s %s  (%s, %s)\n", shortHash(c.SHA), c.Subject, c.Author, formatDiffStat(c))
+	}
+}
+
+// formatDiffStat renders a commit's diffstat like git's --shortstat, compactly.
+func formatDiffStat(c *primary.Commit) string {
+	return fmt.Sprintf("%s, +%d -%d", pluralize(c.FilesChanged, "file", "files"), c.Insertions, c.Deletions)
+}
+
+func init() {
+	commitScanCmd.Flags().StringP("workshop", "w", "", "Only scan repos of this workshop's workbenches")
+
+	commitListCmd.Flags().String("task", "", "Filter by task")
+	commitListCmd.Flags().String("shipment", "", "Filter by shipment")
+
+	commitCmd.AddCommand(commitScanCmd)
+	commitCmd.AddCommand(commitListCmd)
+	commitCmd.AddCommand(commitPrepareMsgCmd)
+}
+
+// CommitCmd returns the commit command
+func CommitCmd() *cobra.Command {
+	return commitCmd
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/example/orc/internal/config"
 	"github.com/example/orc/internal/version"
 )
 
@@ -33,7 +34,7 @@ func DoctorCmd() *cobra.Command {
 		Long: `Comprehensive environment health check for ORC.
 
 Validates:
-- Directory structure (~/.orc/, ~/wb/)
+- Directory structure (~/.orc/, worktree root)
 - ORC repo freshness (commits behind origin/master)
 - Glue deployment (skills, hooks, tmux scripts)
 - Hook configuration in Claude Code settings
@@ -150,10 +151,13 @@ func checkDirectories() CheckResult {
 		missing = append(missing, "~/.orc/orc.db")
 	}
 
-	// Check ~/wb/
+	// Check the worktree root (~/wb/ unless workspace.json says otherwise)
 	wbDir := filepath.Join(homeDir, "wb")
+	if cfg, err := config.LoadWorkspaceConfig(orcDir); err == nil {
+		wbDir = cfg.LayoutFor("").WorktreeRoot
+	}
 	if _, err := os.Stat(wbDir); os.IsNotExist(err) {
-		missing = append(missing, "~/wb/")
+		missing = append(missing, wbDir+"/")
 	}
 
 	if len(missing) > 0 {
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/config"
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// maintenanceStampFile records the last successful maintenance run, next to the database.
+const maintenanceStampFile = "maintenance.last"
+
+// startupMaintenanceInterval throttles opportunistic maintenance on startup.
+const startupMaintenanceInterval = 24 * time.Hour
+
+var maintenanceCmd = &cobra.Command{
+	Use:   "maintenance",
+	Short: "Retention and archival",
+	Long: `Keep the ledger small by applying a retention policy.
+
+The policy is read from retention.json next to the database (~/.orc/retention.json):
+
+  {
+    "archive_shipments_after_days": 60,
+    "prune_events_after_days": 30,
+    "compact_hook_payloads_after_days": 7,
+    "run_on_startup": false
+  }
+
+Archived shipments (with their tasks, plans, notes, and PR) move to archive.db
+and remain visible with 'orc shipment list --include-archived'.
+Set a value to 0 to disable that step.`,
+}
+
+var maintenanceRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Apply the retention policy now",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		policy, orcDir, err := loadRetentionPolicy()
+		if err != nil {
+			return err
+		}
+		if cmd.Flags().Changed("archive-after") {
+			policy.ArchiveShipmentsAfterDays, _ = cmd.Flags().GetInt("archive-after")
+		}
+		if cmd.Flags().Changed("prune-events-after") {
+			policy.PruneEventsAfterDays, _ = cmd.Flags().GetInt("prune-events-after")
+		}
+		if cmd.Flags().Changed("compact-payloads-after") {
+			policy.CompactHookPayloadsAfterDays, _ = cmd.Flags().GetInt("compact-payloads-after")
+		}
+
+		result, err := wire.MaintenanceService().RunMaintenance(ctx, maintenanceRequest(policy, dryRun))
+		if err != nil {
+			return fmt.Errorf("failed to run maintenance: %w", err)
+		}
+
+		if !dryRun {
+			touchMaintenanceStamp(orcDir)
+		}
+		printMaintenanceResult(result)
+		return nil
+	},
+}
+
+// RunStartupMaintenance applies the retention policy opportunistically.
+// Only runs when run_on_startup is set and the last run is older than a day.
+// Silently ignores errors: maintenance must never block the command the user asked for.
+func RunStartupMaintenance() {
+	path, err := db.GetDBPath()
+	if err != nil {
+		return
+	}
+	orcDir := filepath.Dir(path)
+
+	policy, err := config.LoadRetentionPolicy(orcDir)
+	if err != nil || !policy.RunOnStartup {
+		return
+	}
+	if info, err := os.Stat(filepath.Join(orcDir, maintenanceStampFile)); err == nil && time.Since(info.ModTime()) < startupMaintenanceInterval {
+		return
+	}
+
+	// Stamp first so a failing run is not retried on every command
+	touchMaintenanceStamp(orcDir)
+	_, _ = wire.MaintenanceService().RunMaintenance(NewContext(), maintenanceRequest(policy, false))
+}
+
+func loadRetentionPolicy() (*config.RetentionPolicy, string, error) {
+	path, err := db.GetDBPath()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve database path: %w", err)
+	}
+	orcDir := filepath.Dir(path)
+
+	policy, err := config.LoadRetentionPolicy(orcDir)
+	if err != nil {
+		return nil, "", err
+	}
+	return policy, orcDir, nil
+}
+
+func maintenanceRequest(policy *config.RetentionPolicy, dryRun bool) primary.MaintenanceRequest {
+	return primary.MaintenanceRequest{
+		ArchiveShipmentsAfterDays:    policy.ArchiveShipmentsAfterDays,
+		PruneEventsAfterDays:         policy.PruneEventsAfterDays,
+		CompactHookPayloadsAfterDays: policy.CompactHookPayloadsAfterDays,
+		DryRun:                       dryRun,
+	}
+}
+
+func touchMaintenanceStamp(orcDir string) {
+	_ = os.WriteFile(filepath.Join(orcDir, maintenanceStampFile), []byte(time.Now().Format(time.RFC3339)+"\n"), 0644)
+}
+
+func printMaintenanceResult(result *primary.MaintenanceResult) {
+	if result.DryRun {
+		fmt.Println("Dry run: nothing was changed.")
+		if len(result.ArchivedShipments) == 0 {
+			fmt.Println("No shipments would be archived.")
+		} else {
+			fmt.Printf("Would archive %d shipment(s): %s\n", len(result.ArchivedShipments), strings.Join(result.ArchivedShipments, ", "))
+		}
+	} else {
+		if len(result.ArchivedShipments) == 0 {
+			fmt.Println("📦 No shipments archived.")
+		} else {
+			fmt.Printf("📦 Archived %d shipment(s): %s\n", len(result.ArchivedShipments), strings.Join(result.ArchivedShipments, ", "))
+			fmt.Printf("   %d tasks, %d plans, %d notes, %d PRs moved to archive\n",
+				result.ArchivedTasks, result.ArchivedPlans, result.ArchivedNotes, result.ArchivedPRs)
+		}
+		fmt.Printf("🧹 Pruned %d operational events\n", result.PrunedEvents)
+		fmt.Printf("🗜  Compacted %d hook payloads\n", result.CompactedHookPayloads)
+	}
+
+	for _, s := range result.SkippedShipments {
+		fmt.Printf("   kept %s: %s\n", s.ShipmentID, s.Reason)
+	}
+}
+
+// MaintenanceCmd returns the maintenance command
+func MaintenanceCmd() *cobra.Command {
+	maintenanceRunCmd.Flags().Bool("dry-run", false, "Show what would be archived without changing anything")
+	maintenanceRunCmd.Flags().Int("archive-after", 0, "Override: archive shipments closed more than N days ago (0 disables)")
+	maintenanceRunCmd.Flags().Int("prune-events-after", 0, "Override: prune operational events older than N days (0 disables)")
+	maintenanceRunCmd.Flags().Int("compact-payloads-after", 0, "Override: compact hook payloads older than N days (0 disables)")
+
+	maintenanceCmd.AddCommand(maintenanceRunCmd)
+
+	return maintenanceCmd
+}
@@ -3,11 +3,17 @@ package cli
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"text/tabwriter"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
+	"github.com/example/orc/internal/config"
 	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/db"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/wire"
 )
@@ -28,29 +34,91 @@ func PRCmd() *cobra.Command {
 	cmd.AddCommand(prMergeCmd())
 	cmd.AddCommand(prCloseCmd())
 	cmd.AddCommand(prLinkCmd())
+	cmd.AddCommand(prSyncCmd())
+	cmd.AddCommand(prReviewsCmd())
+	cmd.AddCommand(prStackCmd())
 
 	return cmd
 }
 
 func prCreateCmd() *cobra.Command {
-	var repoID, branch, targetBranch, description, url string
+	var repoID, branch, targetBranch, ontoPRID, description, url string
 	var number int
-	var draft bool
+	var labels, reviewers []string
+	var draft, ready, bodyFromReport, noPush, recordOnly bool
 
 	cmd := &cobra.Command{
 		Use:   "create [shipment-id] [title]",
-		Short: "Create a new pull request for a shipment",
-		Long: `Create a new pull request linked to a shipment.
+		Short: "Push a shipment branch and open a pull request for it",
+		Long: `Push the shipment branch from its assigned workbench, open a pull request
+through the repo's provider (see orc pr sync) and record its number and URL.
+
+The repo, branch and title default to the shipment's. Unless --description is
+given, the body is generated from the shipment's spec note and tasks (override
+it with ~/.orc/templates/pr-body.md.tmpl). Draft, labels and reviewers defaults
+come from the "create" block of pr.json:
+
+  {"create": {"draft": true, "labels": ["orc"], "reviewers": ["alice"]}}
+
+If an open PR already exists for the branch it is recorded instead.
+--record-only (implied by --url or --number) only writes the ledger row.
+--onto stacks the PR on another PR of the commission, targeting its branch
+(see orc pr stack).
 
 Examples:
-  orc pr create SHIP-001 "Add authentication feature" --repo REPO-001 --branch feature/auth
-  orc pr create SHIP-001 "Fix bug" --repo REPO-001 --branch fix/bug --draft
-  orc pr create SHIP-001 "Update docs" --repo REPO-001 --branch docs/update --target main`,
-		Args: cobra.ExactArgs(2),
+  orc pr create SHIP-001
+  orc pr create SHIP-001 "Add authentication" --label auth --reviewer bob
+  orc pr create SHIP-001 --repo REPO-002 --ready
+  orc pr create SHIP-001 --no-push --target develop
+  orc pr create SHIP-002 --onto PR-001
+  orc pr create SHIP-001 "Add auth" --repo REPO-001 --branch feature/auth --record-only`,
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := NewContext()
 			shipmentID := args[0]
-			title := args[1]
+			title := ""
+			if len(args) > 1 {
+				title = args[1]
+			}
+
+			if description != "" && bodyFromReport {
+				return fmt.Errorf("--description and --body-from-report are mutually exclusive")
+			}
+			if draft && ready {
+				return fmt.Errorf("--draft and --ready are mutually exclusive")
+			}
+			if targetBranch != "" && ontoPRID != "" {
+				return fmt.Errorf("--target and --onto are mutually exclusive")
+			}
+			open := !recordOnly && url == "" && number == 0
+
+			if open {
+				cfg, err := loadPRConfig()
+				if err != nil {
+					return err
+				}
+				draft = draft || (cfg.Create.Draft && !ready)
+				labels = appendUnique(cfg.Create.Labels, labels...)
+				reviewers = appendUnique(cfg.Create.Reviewers, reviewers...)
+			}
+
+			switch {
+			case bodyFromReport:
+				report, err := wire.ReportService().RenderShipmentReport(ctx, primary.RenderReportRequest{
+					ShipmentID: shipmentID,
+					Format:     primary.ReportFormatMarkdown,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to generate PR description: %w", err)
+				}
+				description = report
+			case open && description == "":
+				body, err := wire.ReportService().RenderPRBody(ctx, shipmentID)
+				if err != nil {
+					return fmt.Errorf("failed to generate PR description: %w", err)
+				}
+				description = body
+			}
 
 			resp, err := wire.PRService().CreatePR(ctx, primary.CreatePRRequest{
 				ShipmentID:   shipmentID,
@@ -59,48 +127,90 @@ Examples:
 				Description:  description,
 				Branch:       branch,
 				TargetBranch: targetBranch,
+				ParentPRID:   ontoPRID,
 				Draft:        draft,
 				URL:          url,
 				Number:       number,
+				Open:         open,
+				Push:         !noPush,
+				Labels:       labels,
+				Reviewers:    reviewers,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to create PR: %w", err)
 			}
 
-			status := "open"
-			if draft {
-				status = "draft"
+			pr := resp.PR
+			if resp.Pushed {
+				fmt.Printf("✓ Pushed %s\n", pr.Branch)
+			}
+			switch {
+			case resp.Adopted:
+				fmt.Printf("✓ Recorded existing PR #%d as %s: %s\n", pr.Number, resp.PRID, pr.Title)
+			case open:
+				fmt.Printf("✓ Opened PR #%d as %s: %s\n", pr.Number, resp.PRID, pr.Title)
+			default:
+				fmt.Printf("✓ Created PR %s: %s\n", resp.PRID, pr.Title)
 			}
-
-			fmt.Printf("✓ Created PR %s: %s\n", resp.PRID, title)
 			fmt.Printf("  Shipment: %s\n", shipmentID)
-			fmt.Printf("  Repository: %s\n", repoID)
-			fmt.Printf("  Branch: %s\n", branch)
-			if targetBranch != "" {
-				fmt.Printf("  Target: %s\n", targetBranch)
+			fmt.Printf("  Repository: %s\n", pr.RepoID)
+			fmt.Printf("  Branch: %s\n", pr.Branch)
+			if pr.TargetBranch != "" {
+				fmt.Printf("  Target: %s\n", pr.TargetBranch)
 			}
-			fmt.Printf("  Status: %s\n", status)
-			if url != "" {
-				fmt.Printf("  URL: %s\n", url)
+			if pr.ParentPRID != "" {
+				fmt.Printf("  Stacked on: %s\n", pr.ParentPRID)
+			}
+			fmt.Printf("  Status: %s\n", pr.Status)
+			if pr.URL != "" {
+				fmt.Printf("  URL: %s\n", pr.URL)
+			}
+			if resp.Warning != "" {
+				fmt.Printf("  ⚠ %s\n", resp.Warning)
 			}
 
 			return nil
 		},
 	}
 
-	cmd.Flags().StringVarP(&repoID, "repo", "r", "", "Repository ID (required)")
-	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch name (required)")
+	cmd.Flags().StringVarP(&repoID, "repo", "r", "", "Repository ID (default: the shipment's repo)")
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch name (default: the shipment's branch)")
 	cmd.Flags().StringVarP(&targetBranch, "target", "t", "", "Target branch (default: repo default)")
-	cmd.Flags().StringVarP(&description, "description", "d", "", "PR description")
-	cmd.Flags().StringVarP(&url, "url", "u", "", "External PR URL (for linking)")
-	cmd.Flags().IntVarP(&number, "number", "n", 0, "GitHub PR number")
+	cmd.Flags().StringVar(&ontoPRID, "onto", "", "Stack on this PR, targeting its branch")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "PR description (default: generated from the spec note and tasks)")
+	cmd.Flags().BoolVar(&bodyFromReport, "body-from-report", false, "Use the shipment report (orc shipment report) as the PR description")
+	cmd.Flags().StringVarP(&url, "url", "u", "", "External PR URL (for linking; implies --record-only)")
+	cmd.Flags().IntVarP(&number, "number", "n", 0, "PR number (for linking; implies --record-only)")
 	cmd.Flags().BoolVar(&draft, "draft", false, "Create as draft PR")
-	cmd.MarkFlagRequired("repo")
-	cmd.MarkFlagRequired("branch")
+	cmd.Flags().BoolVar(&ready, "ready", false, "Open as ready for review even if pr.json defaults to drafts")
+	cmd.Flags().StringArrayVarP(&labels, "label", "l", nil, "Label to add (repeatable)")
+	cmd.Flags().StringArrayVar(&reviewers, "reviewer", nil, "Reviewer username to request (repeatable)")
+	cmd.Flags().BoolVar(&noPush, "no-push", false, "Do not push the branch before opening the PR")
+	cmd.Flags().BoolVar(&recordOnly, "record-only", false, "Only record the PR in the ledger; do not push or open it")
 
 	return cmd
 }
 
+// loadPRConfig reads pr.json from the ORC data directory.
+func loadPRConfig() (*config.PRConfig, error) {
+	path, err := db.GetDBPath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve database path: %w", err)
+	}
+	return config.LoadPRConfig(filepath.Dir(path))
+}
+
+// appendUnique appends the values not already in list.
+func appendUnique(list []string, values ...string) []string {
+	out := append([]string(nil), list...)
+	for _, v := range values {
+		if !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 func prListCmd() *cobra.Command {
 	var shipmentID, repoID, commissionID, status string
 	var all bool
@@ -196,6 +306,9 @@ func prShowCmd() *cobra.Command {
 			if pr.TargetBranch != "" {
 				fmt.Printf("  Target: %s\n", pr.TargetBranch)
 			}
+			if pr.ParentPRID != "" {
+				fmt.Printf("  Stacked on: %s (see: orc pr stack show %s)\n", pr.ParentPRID, pr.ID)
+			}
 			if pr.URL != "" {
 				fmt.Printf("  URL: %s\n", pr.URL)
 			}
@@ -213,6 +326,41 @@ func prShowCmd() *cobra.Command {
 			if pr.ClosedAt != "" {
 				fmt.Printf("  Closed: %s\n", pr.ClosedAt)
 			}
+			if pr.ReviewDecision != "" {
+				fmt.Printf("  Review: %s\n", pr.ReviewDecision)
+			}
+			if pr.Mergeable != "" {
+				fmt.Printf("  Mergeable: %s\n", pr.Mergeable)
+			}
+			if pr.SyncedAt != "" {
+				fmt.Printf("  Synced: %s\n", pr.SyncedAt)
+			} else {
+				fmt.Printf("  Synced: never (run: orc pr sync %s)\n", pr.ID)
+			}
+
+			checks, err := wire.PRService().ListPRChecks(ctx, prID)
+			if err != nil {
+				return fmt.Errorf("failed to list checks: %w", err)
+			}
+			if len(checks) > 0 {
+				fmt.Println("  Checks:")
+				for _, c := range checks {
+					line := fmt.Sprintf("    %s %s", colorizeCheckState(c.State), c.Name)
+					if c.Conclusion != "" {
+						line += " (" + c.Conclusion
+					} else {
+						line += " (" + c.Status
+					}
+					if c.Required {
+						line += ", required"
+					}
+					line += ")"
+					if c.URL != "" {
+						line += " " + c.URL
+					}
+					fmt.Println(line)
+				}
+			}
 
 			return nil
 		},
@@ -264,39 +412,116 @@ func prApproveCmd() *cobra.Command {
 }
 
 func prMergeCmd() *cobra.Command {
-	return &cobra.Command{
+	var force, ledgerOnly bool
+	var strategy string
+
+	cmd := &cobra.Command{
 		Use:   "merge [pr-id]",
 		Short: "Merge a PR",
 		Long: `Merge a PR and complete its associated shipment.
 
 This command:
-1. Updates the PR status to 'merged'
-2. Automatically completes the associated shipment
+1. Lands the PR locally, if the repo has a merge strategy (see below)
+2. Updates the PR status to 'merged'
+3. Automatically completes the associated shipment once its last open PR merges,
+   then offers to return the workbench to its home branch (--return-home,
+   --keep-branch)
+
+Repos with a merge strategy (orc repo merge) have the PR merged into its target branch in the repo's main checkout, as a merge
+commit, a squashed commit or rebased commits. The repo's verify commands run on
+the result, then the target branch is pushed. If any step fails the checkout is
+reset and the ledger is left as it was. Without a strategy, orc only records a
+merge done elsewhere (e.g., on GitHub). A "merge" config checked in to
+.orc/repo.json must be adopted first (orc repo merge --from-file); until then
+merging is refused unless --strategy or --ledger-only is given, and its verify
+commands are not run.
+
+Merging is refused while required CI checks are failing as of the last
+orc pr sync; --force merges anyway.
 
 Examples:
-  orc pr merge PR-001`,
+  orc pr merge PR-001
+  orc pr merge PR-001 --strategy squash
+  orc pr merge PR-001 --ledger-only   # Already merged by hand
+  orc pr merge PR-001 --force`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := NewContext()
 			prID := args[0]
 
+			if strategy != "" && ledgerOnly {
+				return fmt.Errorf("--strategy and --ledger-only are mutually exclusive")
+			}
+
 			// Get PR to show shipment info
 			pr, err := wire.PRService().GetPR(ctx, prID)
 			if err != nil {
 				return fmt.Errorf("failed to get PR: %w", err)
 			}
 
-			err = wire.PRService().MergePR(ctx, prID)
+			resp, err := wire.PRService().MergePR(ctx, primary.MergePRRequest{
+				PRID:       prID,
+				Force:      force,
+				Strategy:   strategy,
+				LedgerOnly: ledgerOnly,
+			})
+			if resp != nil && len(resp.Verify) > 0 {
+				printVerifySteps(resp.Verify)
+			}
+			if resp != nil && len(resp.PendingVerify) > 0 {
+				fmt.Printf("Verify not run: .orc/repo.json asks to run %d command(s):\n", len(resp.PendingVerify))
+				for _, command := range resp.PendingVerify {
+					fmt.Printf("  - %s\n", command)
+				}
+				fmt.Printf("Review them, then adopt with: orc repo merge %s --from-file\n", pr.RepoID)
+			}
 			if err != nil {
 				return fmt.Errorf("failed to merge PR: %w", err)
 			}
 
+			if resp.Strategy != "" {
+				fmt.Printf("✓ Landed %s on %s (%s, from %s) at %s\n", pr.Branch, resp.TargetBranch, resp.Strategy, resp.Source, shortHash(resp.Commit))
+				if resp.Pushed {
+					fmt.Printf("✓ Pushed %s\n", resp.TargetBranch)
+				}
+			}
 			fmt.Printf("✓ Merged PR %s\n", prID)
-			fmt.Printf("  ✓ Completed shipment %s\n", pr.ShipmentID)
+			if resp.ShipmentCompleted {
+				fmt.Printf("  ✓ Completed shipment %s\n", pr.ShipmentID)
+				printCommissionLifecycle(resp.Commission)
+				offerReturnHome(ctx, cmd, pr.ShipmentID)
+			}
 
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Merge even though required checks are failing")
+	cmd.Flags().StringVar(&strategy, "strategy", "", "Land the PR locally with this strategy: merge, squash or rebase (default: the repo's)")
+	cmd.Flags().BoolVar(&ledgerOnly, "ledger-only", false, "Only record the merge; do not land the PR even if the repo has a merge strategy")
+	cmd.Flags().Bool("return-home", false, "When the shipment completes, return the workbench to its home branch without asking")
+	cmd.Flags().Bool("keep-branch", false, "When the shipment completes, leave the workbench on the shipment branch")
+
+	return cmd
+}
+
+// printVerifySteps prints each verify command of a local merge, with the output of a failed one.
+func printVerifySteps(steps []*primary.PRVerifyStep) {
+	fmt.Println("Verify:")
+	for _, step := range steps {
+		if step.Success {
+			fmt.Printf("  ✓ %s (%s)\n", step.Command, step.Duration)
+			continue
+		}
+		fmt.Printf("  ✗ %s (%s)\n", step.Command, step.Duration)
+		output := strings.TrimRight(step.Output, "\n")
+		if output == "" {
+			continue
+		}
+		for _, line := range strings.Split(output, "\n") {
+			fmt.Printf("      %s\n", line)
+		}
+	}
 }
 
 func prCloseCmd() *cobra.Command {
@@ -356,3 +581,429 @@ Examples:
 
 	return cmd
 }
+
+func prReviewsCmd() *cobra.Command {
+	var doImport, tasks bool
+	var noteType string
+
+	cmd := &cobra.Command{
+		Use:   "reviews [pr-id]",
+		Short: "Show PR review threads, or import them as notes",
+		Long: `Show the review threads of a PR on its provider, with the notes they were
+imported as.
+
+With --import, each unresolved thread not imported yet becomes a note on the
+PR's shipment (type concern, or bug with --type bug) holding the conversation,
+the comment URL and the file/line. --tasks also creates a follow-up task per
+thread for the imps. Re-running only imports new threads.
+
+Imported threads stay linked: on orc pr sync, closing the note resolves the
+thread on the provider and a thread resolved there closes the note (Gitea
+cannot resolve threads through its API, so resolve those in the web UI).
+
+Examples:
+  orc pr reviews PR-001
+  orc pr reviews PR-001 --import
+  orc pr reviews PR-001 --import --type bug --tasks`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			prID := args[0]
+
+			if !doImport {
+				if tasks || cmd.Flags().Changed("type") {
+					return fmt.Errorf("--type and --tasks require --import")
+				}
+				threads, err := wire.PRService().ListPRReviews(ctx, prID)
+				if err != nil {
+					return fmt.Errorf("failed to list reviews: %w", err)
+				}
+				if len(threads) == 0 {
+					fmt.Printf("No review threads on %s.\n", prID)
+					return nil
+				}
+
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "STATE\tLOCATION\tAUTHOR\tCOMMENT\tNOTE")
+				fmt.Fprintln(w, "-----\t--------\t------\t-------\t----")
+				for _, t := range threads {
+					state := "open"
+					if t.Resolved {
+						state = "resolved"
+					}
+					note := t.NoteID
+					if note == "" {
+						note = "-"
+					}
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", state, reviewLocation(t), t.Author, truncate(t.Body, 50), note)
+				}
+				w.Flush()
+				return nil
+			}
+
+			resp, err := wire.PRService().ImportPRReviews(ctx, primary.ImportPRReviewsRequest{
+				PRID:        prID,
+				NoteType:    noteType,
+				CreateTasks: tasks,
+			})
+			if resp != nil {
+				for _, t := range resp.Imported {
+					fmt.Printf("✓ %s: %s", t.NoteID, reviewLocation(t))
+					if t.TaskID != "" {
+						fmt.Printf(" (task %s)", t.TaskID)
+					}
+					fmt.Println()
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("failed to import reviews: %w", err)
+			}
+			fmt.Printf("Imported %d review thread(s) from %s", len(resp.Imported), prID)
+			if resp.Skipped > 0 {
+				fmt.Printf(", skipped %d resolved or already imported", resp.Skipped)
+			}
+			fmt.Println()
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&doImport, "import", false, "Import unresolved threads as notes on the shipment")
+	cmd.Flags().StringVar(&noteType, "type", "concern", "Note type for imported threads: concern or bug")
+	cmd.Flags().BoolVar(&tasks, "tasks", false, "Also create a follow-up task per imported thread")
+
+	return cmd
+}
+
+// reviewLocation formats where a review thread sits, for listings.
+func reviewLocation(t *primary.PRReviewThread) string {
+	switch {
+	case t.Path == "":
+		return "(PR)"
+	case t.Line == 0:
+		return t.Path
+	}
+	return fmt.Sprintf("%s:%d", t.Path, t.Line)
+}
+
+// threadChangeText describes a review thread sync action.
+func threadChangeText(action string) string {
+	switch action {
+	case primary.PRThreadResolved:
+		return "resolved its review thread"
+	case primary.PRThreadReopened:
+		return "reopened its review thread"
+	case primary.PRThreadNoteResolved:
+		return "closed (thread resolved on the provider)"
+	case primary.PRThreadNoteReopened:
+		return "reopened (thread reopened on the provider)"
+	}
+	return action
+}
+
+func prStackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stack",
+		Short: "Review large shipments as a stack of small PRs",
+		Long: `Stack PRs on each other so a large change can be reviewed in small pieces.
+
+A stacked PR targets the branch of the PR below it. PRs in a stack belong to
+the same commission and repository, usually one per shipment. Create one with
+orc pr create --onto, or stack an existing PR with orc pr stack onto.
+
+When a PR in the stack changes or merges, orc pr stack restack rebases every
+branch above it onto its parent, in each shipment's workbench, and retargets
+PRs whose parent merged onto the next open PR (or the stack's base branch).`,
+	}
+
+	cmd.AddCommand(prStackShowCmd())
+	cmd.AddCommand(prStackOntoCmd())
+	cmd.AddCommand(prStackDetachCmd())
+	cmd.AddCommand(prStackRestackCmd())
+
+	return cmd
+}
+
+func prStackShowCmd() *cobra.Command {
+	var commissionID string
+
+	cmd := &cobra.Command{
+		Use:   "show [pr-id]",
+		Short: "Show a PR's stack, or every stack of the commission",
+		Long: `Show stacks bottom first, each PR indented under the PR it is stacked on.
+
+Examples:
+  orc pr stack show PR-002
+  orc pr stack show --commission COMM-001`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			prID := ""
+			if len(args) == 1 {
+				prID = args[0]
+				p, err := wire.PRService().GetPR(ctx, prID)
+				if err != nil {
+					return fmt.Errorf("failed to get PR: %w", err)
+				}
+				commissionID = p.CommissionID
+			}
+			if commissionID == "" {
+				commissionID = orccontext.GetContextCommissionID()
+			}
+			if commissionID == "" {
+				return fmt.Errorf("specify a PR ID or --commission")
+			}
+
+			stacks, err := wire.PRService().ListPRStacks(ctx, commissionID)
+			if err != nil {
+				return fmt.Errorf("failed to list stacks: %w", err)
+			}
+			if prID != "" {
+				stacks = slices.DeleteFunc(stacks, func(st *primary.PRStack) bool {
+					return !slices.ContainsFunc(st.Entries, func(e primary.PRStackEntry) bool { return e.PR.ID == prID })
+				})
+				if len(stacks) == 0 {
+					fmt.Printf("%s is not part of a stack. Stack it with: orc pr stack onto %s <parent-pr-id>\n", prID, prID)
+					return nil
+				}
+			}
+			if len(stacks) == 0 {
+				fmt.Printf("No stacked PRs in %s.\n", commissionID)
+				return nil
+			}
+
+			for i, st := range stacks {
+				if i > 0 {
+					fmt.Println()
+				}
+				base := st.BaseBranch
+				if base == "" {
+					base = "(default branch)"
+				}
+				fmt.Printf("Stack on %s (%s)\n", base, st.RepoID)
+				for _, e := range st.Entries {
+					line := fmt.Sprintf("  %s%s [%s] %s", strings.Repeat("  ", e.Depth), e.PR.ID, e.PR.Status, e.PR.Branch)
+					if e.PR.Number > 0 {
+						line += fmt.Sprintf(" #%d", e.PR.Number)
+					}
+					line += " - " + e.PR.Title
+					if e.PR.ID == prID {
+						line = color.New(color.Bold).Sprint(line)
+					}
+					fmt.Println(line)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&commissionID, "commission", "c", "", "Commission ID (default: from context)")
+
+	return cmd
+}
+
+func prStackOntoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "onto [pr-id] [parent-pr-id]",
+		Short: "Stack a PR on another PR",
+		Long: `Stack a PR on another PR of the same commission and repository, targeting
+the parent's branch. Opened PRs are retargeted on their provider.
+
+The branch itself is not moved; run orc pr stack restack to rebase it.
+
+Examples:
+  orc pr stack onto PR-002 PR-001`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			if err := wire.PRService().StackPR(ctx, primary.StackPRRequest{PRID: args[0], ParentPRID: args[1]}); err != nil {
+				return fmt.Errorf("failed to stack PR: %w", err)
+			}
+
+			fmt.Printf("✓ Stacked %s on %s\n", args[0], args[1])
+			fmt.Printf("  Rebase it with: orc pr stack restack %s\n", args[0])
+			return nil
+		},
+	}
+}
+
+func prStackDetachCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "detach [pr-id]",
+		Short: "Take a PR off its stack",
+		Long: `Take a PR off its stack, retargeting it onto the stack's base branch.
+PRs stacked on it stay stacked on it.
+
+Examples:
+  orc pr stack detach PR-002`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			if err := wire.PRService().StackPR(ctx, primary.StackPRRequest{PRID: args[0]}); err != nil {
+				return fmt.Errorf("failed to detach PR: %w", err)
+			}
+
+			fmt.Printf("✓ Detached %s from its stack\n", args[0])
+			return nil
+		},
+	}
+}
+
+func prStackRestackCmd() *cobra.Command {
+	var noPush bool
+
+	cmd := &cobra.Command{
+		Use:   "restack [pr-id]",
+		Short: "Rebase each branch of a PR's stack onto its parent",
+		Long: `Rebase every open PR of the stack onto its parent, bottom first, each in the
+workbench assigned to its shipment, then force-push it (with lease).
+
+PRs stacked on a merged or closed PR move down onto the nearest open PR below
+it, or onto the stack's base branch, and are retargeted on their provider.
+Every workbench must have its branch checked out with no uncommitted changes.
+A rebase that conflicts is aborted and stops the restack.
+
+Examples:
+  orc pr stack restack PR-002
+  orc pr stack restack PR-002 --no-push`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			resp, err := wire.PRService().RestackPRs(ctx, primary.RestackPRsRequest{PRID: args[0], Push: !noPush})
+			if resp != nil {
+				for _, step := range resp.Steps {
+					if len(step.Conflicts) > 0 {
+						fmt.Printf("✗ %s: %s conflicts with %s\n", step.PRID, step.Branch, step.Onto)
+						continue
+					}
+					fmt.Printf("✓ %s: rebased %s onto %s\n", step.PRID, step.Branch, step.Onto)
+					if step.Pushed {
+						fmt.Printf("    pushed %s\n", step.Branch)
+					}
+					if step.Retargeted {
+						fmt.Printf("    retargeted to %s\n", step.Onto)
+					}
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("failed to restack: %w", err)
+			}
+			if len(resp.Steps) == 0 {
+				fmt.Println("Nothing to restack: every PR in the stack is merged or closed.")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&noPush, "no-push", false, "Rebase locally without force-pushing")
+
+	return cmd
+}
+
+func prSyncCmd() *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "sync [pr-id]",
+		Short: "Pull PR state from GitHub, GitLab or Gitea into the ledger",
+		Long: `Pull state, number, review decision, mergeability and merged/closed timestamps
+from each PR's provider into the ledger, recording an audit event for every change.
+A PR merged in the web UI is marked merged and completes its shipment.
+
+The provider is chosen from the repo's URL (or its clone's origin remote):
+github.com and gitlab.com work out of the box; other hosts are listed in
+pr.json next to the ORC database. Tokens are read from GITHUB_TOKEN,
+GITLAB_TOKEN or GITEA_TOKEN (or the provider's token_env).
+
+PRs without a number are matched by branch. Review threads imported with
+orc pr reviews --import follow their notes: closing a note resolves its thread,
+and a thread resolved on the provider closes its note.
+
+Examples:
+  orc pr sync PR-001
+  orc pr sync --all    # every PR not yet merged or closed`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			req := primary.SyncPRsRequest{All: all}
+			if len(args) == 1 {
+				req.PRID = args[0]
+			}
+			if (req.PRID == "") == !all {
+				return fmt.Errorf("specify a PR ID or --all")
+			}
+
+			results, err := wire.PRService().SyncPRs(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to sync PRs: %w", err)
+			}
+			if len(results) == 0 {
+				fmt.Println("No open PRs to sync.")
+				return nil
+			}
+
+			failed := 0
+			for _, r := range results {
+				label := r.PRID
+				if r.Number > 0 {
+					label = fmt.Sprintf("%s (#%d)", r.PRID, r.Number)
+				}
+				switch {
+				case r.Error != "":
+					failed++
+					fmt.Printf("✗ %s: %s\n", label, r.Error)
+				case len(r.Changes) == 0 && len(r.Threads) == 0:
+					fmt.Printf("✓ %s: up to date\n", label)
+				default:
+					fmt.Printf("✓ %s:\n", label)
+					for _, c := range r.Changes {
+						fmt.Printf("    %s: %s → %s\n", c.Field, syncValue(c.Old), syncValue(c.New))
+					}
+					for _, c := range r.Threads {
+						fmt.Printf("    %s: %s\n", c.NoteID, threadChangeText(c.Action))
+					}
+				}
+				if r.ShipmentCompleted {
+					fmt.Printf("    ✓ Completed shipment %s\n", r.ShipmentID)
+					printCommissionLifecycle(r.Commission)
+					offerReturnHome(ctx, cmd, r.ShipmentID)
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d PRs failed to sync", failed, len(results))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Sync every PR not yet merged or closed")
+	cmd.Flags().Bool("return-home", false, "When a shipment completes, return its workbench to its home branch without asking")
+	cmd.Flags().Bool("keep-branch", false, "When a shipment completes, leave its workbench on the shipment branch")
+
+	return cmd
+}
+
+// colorizeCheckState marks a CI check state: green ✓ passing, red ✗ failing, yellow … pending.
+func colorizeCheckState(state string) string {
+	switch state {
+	case primary.PRCheckPassing:
+		return color.New(color.FgHiGreen).Sprint("✓")
+	case primary.PRCheckFailing:
+		return color.New(color.FgHiRed).Sprint("✗")
+	default:
+		return color.New(color.FgHiYellow).Sprint("…")
+	}
+}
+
+// syncValue renders an empty synced field.
+func syncValue(v string) string {
+	if v == "" {
+		return "(none)"
+	}
+	return v
+}
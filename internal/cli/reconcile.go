@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// ReconcileCmd returns the reconcile command
+func ReconcileCmd() *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "reconcile [workshop-id]",
+		Short: "Reconcile workbench ledger with disk, git worktrees, and tmux",
+		Long: `Compare the ledger (workbench rows, home/current branch, status) with the
+filesystem under the worktree roots, 'git worktree list' of each repo, .orc/config.json
+place IDs, and live tmux windows, then fix the drift.
+
+Fixes applied:
+- Prune stale git worktree entries
+- Remove clean worktrees orc does not know (or that belong to archived workbenches)
+- Recreate missing worktrees for active workbenches
+- Update stale current_branch from the actual checkout
+- Rewrite missing or wrong .orc/config.json
+
+Reported only (needs a human):
+- Orphan worktrees with uncommitted changes
+- Directories under the worktree roots that are not workbenches or worktrees
+- Missing tmux windows (fix with 'orc tmux apply')
+
+With a workshop ID, only that workshop's workbenches are checked and orphan
+detection is skipped.
+
+Without --yes, shows a plan and prompts for confirmation.
+
+Examples:
+  orc reconcile                 # Check everything, prompt before applying
+  orc reconcile WORK-001 --yes  # One workshop, apply immediately`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			req := primary.ReconcileRequest{}
+			if len(args) == 1 {
+				req.WorkshopID = args[0]
+			}
+
+			plan, err := wire.ReconcileService().PlanReconcile(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to plan reconcile: %w", err)
+			}
+
+			printReconcilePlan(plan)
+
+			if plan.NothingToDo {
+				fmt.Println("\nNothing to do.")
+				return nil
+			}
+
+			if !yes {
+				fmt.Print("\nApply? [y/n] ")
+				reader := bufio.NewReader(os.Stdin)
+				response, _ := reader.ReadString('\n')
+				response = strings.TrimSpace(strings.ToLower(response))
+				if response != "y" && response != "yes" {
+					fmt.Println("Canceled.")
+					return nil
+				}
+			}
+
+			result, err := wire.ReconcileService().ApplyReconcile(ctx, plan)
+			if err != nil {
+				if result != nil && len(result.Applied) > 0 {
+					fmt.Printf("Applied %d action(s) before failing.\n", len(result.Applied))
+				}
+				return fmt.Errorf("reconcile failed: %w", err)
+			}
+
+			fmt.Printf("\n✓ Applied %d action(s)\n", len(result.Applied))
+			if len(result.Skipped) > 0 {
+				fmt.Printf("  %d item(s) need manual attention (see above)\n", len(result.Skipped))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&yes, "yes", false, "Apply immediately without confirmation")
+
+	return cmd
+}
+
+// printReconcilePlan displays the reconcile plan, fixes first, then manual items.
+func printReconcilePlan(plan *primary.ReconcilePlan) {
+	if plan.WorkshopID != "" {
+		fmt.Printf("orc reconcile %s\n", plan.WorkshopID)
+	} else {
+		fmt.Println("orc reconcile")
+	}
+
+	var fixes, manual []primary.ReconcileAction
+	for _, a := range plan.Actions {
+		if a.Type == primary.ReconcileManual {
+			manual = append(manual, a)
+		} else {
+			fixes = append(fixes, a)
+		}
+	}
+
+	if len(fixes) == 0 && len(manual) == 0 {
+		fmt.Println("Ledger, worktrees, and tmux are in sync.")
+		return
+	}
+
+	if len(fixes) > 0 {
+		fmt.Println("\nActions:")
+		for i, a := range fixes {
+			fmt.Printf("  %d. [%s] %s\n", i+1, a.Type, a.Description)
+		}
+	}
+
+	if len(manual) > 0 {
+		fmt.Println("\nNeeds attention:")
+		for _, a := range manual {
+			fmt.Printf("  ⚠️  %s\n", a.Description)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -26,21 +28,32 @@ func RepoCmd() *cobra.Command {
 	cmd.AddCommand(repoArchiveCmd())
 	cmd.AddCommand(repoRestoreCmd())
 	cmd.AddCommand(repoDeleteCmd())
+	cmd.AddCommand(repoStatusCmd())
+	cmd.AddCommand(repoFetchCmd())
+	cmd.AddCommand(repoBootstrapCmd())
+	cmd.AddCommand(repoSparseCmd())
+	cmd.AddCommand(repoMergeCmd())
 
 	return cmd
 }
 
 func repoCreateCmd() *cobra.Command {
 	var url, localPath, defaultBranch string
+	var clone bool
 
 	cmd := &cobra.Command{
 		Use:   "create [name]",
 		Short: "Create a new repository configuration",
 		Long: `Create a new repository configuration.
 
+With --clone, the repository is cloned first into --path, or ~/src/<name> where
+workbenches expect it, and the default branch is detected from the clone unless
+--default-branch is given.
+
 Examples:
   orc repo create orc --url git@github.com:org/orc.git
   orc repo create intercom --url git@github.com:org/intercom.git --path ~/src/intercom
+  orc repo create api --url git@github.com:org/api.git --clone
   orc repo create api --default-branch develop`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -52,6 +65,7 @@ Examples:
 				URL:           url,
 				LocalPath:     localPath,
 				DefaultBranch: defaultBranch,
+				Clone:         clone,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to create repository: %w", err)
@@ -72,11 +86,166 @@ Examples:
 
 	cmd.Flags().StringVarP(&url, "url", "u", "", "Repository URL (e.g., git@github.com:org/repo.git)")
 	cmd.Flags().StringVarP(&localPath, "path", "p", "", "Local path to repository")
-	cmd.Flags().StringVarP(&defaultBranch, "default-branch", "b", "main", "Default branch name")
+	cmd.Flags().StringVarP(&defaultBranch, "default-branch", "b", "", "Default branch name (default: main, or detected with --clone)")
+	cmd.Flags().BoolVar(&clone, "clone", false, "Clone --url into --path (default: ~/src/<name>) before registering it")
 
 	return cmd
 }
 
+func repoStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status [repo-id]",
+		Short: "Show the health of local repository clones",
+		Long: `Show the health of local repository clones: when each was last fetched,
+whether the local default branch has drifted from origin, how many worktrees
+(workbenches) it has and whether it has uncommitted changes.
+
+Without a repo ID, every active repository is shown.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			repoIDs, err := resolveRepoIDs(ctx, args)
+			if err != nil {
+				return err
+			}
+
+			var healths []*primary.RepoHealth
+			for _, id := range repoIDs {
+				health, err := wire.RepoService().GetRepoHealth(ctx, id)
+				if err != nil {
+					return fmt.Errorf("failed to inspect %s: %w", id, err)
+				}
+				healths = append(healths, health)
+			}
+			printRepoHealth(healths)
+			return nil
+		},
+	}
+}
+
+func repoFetchCmd() *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "fetch [repo-id]",
+		Short: "Fetch origin in local repository clones",
+		Long: `Fetch origin in a repository's local clone, or in every active repository
+with --all, then show their health.
+
+Examples:
+  orc repo fetch REPO-001
+  orc repo fetch --all`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			if all == (len(args) == 1) {
+				return fmt.Errorf("specify a repo ID or --all")
+			}
+			repoIDs, err := resolveRepoIDs(ctx, args)
+			if err != nil {
+				return err
+			}
+
+			var healths []*primary.RepoHealth
+			failed := 0
+			for _, id := range repoIDs {
+				health, err := wire.RepoService().GetRepoHealth(ctx, id)
+				if err != nil {
+					fmt.Printf("✗ %s: %v\n", id, err)
+					failed++
+					continue
+				}
+				// --all skips repos that are not cloned here; status reports them
+				if all && (!health.Cloned || !health.HasOrigin) {
+					healths = append(healths, health)
+					continue
+				}
+				if fetched, err := wire.RepoService().FetchRepo(ctx, id); err != nil {
+					fmt.Printf("✗ %s: %v\n", id, err)
+					failed++
+				} else {
+					health = fetched
+				}
+				healths = append(healths, health)
+			}
+			printRepoHealth(healths)
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d repositories could not be fetched", failed, len(repoIDs))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Fetch every active repository")
+
+	return cmd
+}
+
+// resolveRepoIDs returns the given repo ID, or every active repository when none is given.
+func resolveRepoIDs(ctx context.Context, args []string) ([]string, error) {
+	if len(args) == 1 {
+		return args, nil
+	}
+	repos, err := wire.RepoService().ListRepos(ctx, primary.RepoFilters{Status: primary.RepoStatusActive})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+	ids := make([]string, len(repos))
+	for i, r := range repos {
+		ids[i] = r.ID
+	}
+	return ids, nil
+}
+
+// printRepoHealth prints one row per clone, followed by the issues found.
+func printRepoHealth(healths []*primary.RepoHealth) {
+	if len(healths) == 0 {
+		fmt.Println("No repositories found.")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tBRANCH\tFETCHED\tDRIFT\tWORKTREES\tDIRTY")
+	fmt.Fprintln(w, "--\t----\t------\t-------\t-----\t---------\t-----")
+	for _, h := range healths {
+		if !h.Cloned {
+			fmt.Fprintf(w, "%s\t%s\t%s\tnot cloned\t-\t-\t-\n", h.RepoID, h.Name, h.DefaultBranch)
+			continue
+		}
+		fetched := "never"
+		if !h.HasOrigin {
+			fetched = "no remote"
+		} else if h.FetchAge != "" {
+			fetched = h.FetchAge
+			if fetched != "just now" {
+				fetched += " ago"
+			}
+		}
+		drift := "in sync"
+		switch {
+		case !h.HasOrigin:
+			drift = "-"
+		case h.Ahead > 0 && h.Behind > 0:
+			drift = fmt.Sprintf("↑%d ↓%d", h.Ahead, h.Behind)
+		case h.Ahead > 0:
+			drift = fmt.Sprintf("↑%d", h.Ahead)
+		case h.Behind > 0:
+			drift = fmt.Sprintf("↓%d", h.Behind)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\n", h.RepoID, h.Name, h.DefaultBranch, fetched, drift, h.Worktrees, h.DirtyFiles)
+	}
+	w.Flush()
+
+	for _, h := range healths {
+		for _, issue := range h.Issues {
+			fmt.Printf("⚠️  %s (%s): %s\n", h.RepoID, h.Name, issue)
+		}
+	}
+}
+
 func repoListCmd() *cobra.Command {
 	var status string
 	var all bool
@@ -161,6 +330,21 @@ func repoShowCmd() *cobra.Command {
 				fmt.Printf("  Local Path: %s\n", repo.LocalPath)
 			}
 			fmt.Printf("  Default Branch: %s\n", repo.DefaultBranch)
+			if len(repo.Bootstrap) > 0 {
+				fmt.Println("  Bootstrap:")
+				for _, step := range repo.Bootstrap {
+					fmt.Printf("    %s\n", step)
+				}
+			}
+			if len(repo.SparsePaths) > 0 {
+				fmt.Printf("  Sparse Paths: %s\n", strings.Join(repo.SparsePaths, ", "))
+			}
+			if repo.Merge != nil {
+				fmt.Printf("  Merge Strategy: %s\n", repo.Merge.Strategy)
+				for _, command := range repo.Merge.Verify {
+					fmt.Printf("    verify: %s\n", command)
+				}
+			}
 			fmt.Printf("  Created: %s\n", repo.CreatedAt)
 			fmt.Printf("  Updated: %s\n", repo.UpdatedAt)
 
@@ -169,6 +353,256 @@ func repoShowCmd() *cobra.Command {
 	}
 }
 
+func repoBootstrapCmd() *cobra.Command {
+	var steps []string
+	var clear, fromFile bool
+
+	cmd := &cobra.Command{
+		Use:   "bootstrap [repo-id]",
+		Short: "Show or set the setup steps run in new workbenches",
+		Long: `Show or set a repository's bootstrap steps: shell commands run in order in
+every new worktree of the repo (e.g. make deps, npm ci, cp .env.example .env).
+
+Steps run via sh in the worktree, with ORC_WORKBENCH_ID, ORC_REPO_ID and
+ORC_REPO_PATH (the main clone) set.
+
+A repo can also check steps in as {"bootstrap": [...]} in .orc/repo.json.
+Those come with the code, so they never run on their own: new workbenches
+list them instead. Review the file, then --from-file copies its steps onto
+the repo record. Later edits to the file are not picked up until adopted again.
+
+Examples:
+  orc repo bootstrap REPO-001
+  orc repo bootstrap REPO-001 --step "npm ci" --step "cp .env.example .env"
+  orc repo bootstrap REPO-001 --step 'ln -s "$ORC_REPO_PATH/.cache" .cache'
+  orc repo bootstrap REPO-001 --from-file
+  orc repo bootstrap REPO-001 --clear`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			repoID := args[0]
+
+			modes := 0
+			for _, set := range []bool{clear, len(steps) > 0, fromFile} {
+				if set {
+					modes++
+				}
+			}
+			if modes > 1 {
+				return fmt.Errorf("specify only one of --step, --from-file and --clear")
+			}
+
+			if fromFile {
+				adopted, err := wire.RepoService().AdoptBootstrapFile(ctx, repoID)
+				if err != nil {
+					return fmt.Errorf("failed to adopt bootstrap steps: %w", err)
+				}
+				fmt.Printf("✓ Adopted %d bootstrap step(s) from .orc/repo.json on %s:\n", len(adopted), repoID)
+				for i, step := range adopted {
+					fmt.Printf("  %d. %s\n", i+1, step)
+				}
+				return nil
+			}
+
+			if clear || len(steps) > 0 {
+				if err := wire.RepoService().SetBootstrapSteps(ctx, repoID, steps); err != nil {
+					return fmt.Errorf("failed to set bootstrap steps: %w", err)
+				}
+				if clear {
+					fmt.Printf("✓ Cleared bootstrap steps of %s\n", repoID)
+				} else {
+					fmt.Printf("✓ Set %d bootstrap step(s) on %s\n", len(steps), repoID)
+				}
+				return nil
+			}
+
+			repo, err := wire.RepoService().GetRepo(ctx, repoID)
+			if err != nil {
+				return fmt.Errorf("failed to get repository: %w", err)
+			}
+			if len(repo.Bootstrap) == 0 {
+				fmt.Printf("No bootstrap steps on %s. Adopt a checked-in .orc/repo.json with: orc repo bootstrap %s --from-file\n", repoID, repoID)
+				return nil
+			}
+			fmt.Printf("Bootstrap steps for %s:\n", repoID)
+			for i, step := range repo.Bootstrap {
+				fmt.Printf("  %d. %s\n", i+1, step)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&steps, "step", nil, "Shell command to run (repeatable, in order; replaces existing steps)")
+	cmd.Flags().BoolVar(&clear, "clear", false, "Remove the steps stored on the repo record")
+	cmd.Flags().BoolVar(&fromFile, "from-file", false, "Adopt the steps checked in to the clone's .orc/repo.json")
+
+	return cmd
+}
+
+func repoSparseCmd() *cobra.Command {
+	var paths []string
+	var clear bool
+
+	cmd := &cobra.Command{
+		Use:   "sparse [repo-id]",
+		Short: "Show or set the directories new workbenches check out",
+		Long: `Show or set a repository's sparse paths: the directories new worktrees of the
+repo check out (git cone-mode sparse checkout), plus files at the repository
+root. Meant for monorepos where a workbench only touches a few services.
+
+The paths apply to workbenches created afterwards, unless 'orc workbench
+create --sparse' names others. Adjust an existing workbench with
+'orc workbench sparse'.
+
+Examples:
+  orc repo sparse REPO-001
+  orc repo sparse REPO-001 --path services/api --path libs/auth
+  orc repo sparse REPO-001 --clear`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			repoID := args[0]
+
+			if clear && len(paths) > 0 {
+				return fmt.Errorf("specify either --path or --clear, not both")
+			}
+
+			if clear || len(paths) > 0 {
+				if err := wire.RepoService().SetSparsePaths(ctx, repoID, paths); err != nil {
+					return fmt.Errorf("failed to set sparse paths: %w", err)
+				}
+				if clear {
+					fmt.Printf("✓ Cleared sparse paths of %s (new workbenches get a full checkout)\n", repoID)
+				} else {
+					fmt.Printf("✓ Set %d sparse path(s) on %s\n", len(paths), repoID)
+				}
+				return nil
+			}
+
+			repo, err := wire.RepoService().GetRepo(ctx, repoID)
+			if err != nil {
+				return fmt.Errorf("failed to get repository: %w", err)
+			}
+			if len(repo.SparsePaths) == 0 {
+				fmt.Printf("No sparse paths on %s; new workbenches get a full checkout.\n", repoID)
+				return nil
+			}
+			fmt.Printf("Sparse paths for %s:\n", repoID)
+			for _, path := range repo.SparsePaths {
+				fmt.Printf("  %s\n", path)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&paths, "path", nil, "Directory to check out (repeatable; replaces existing paths)")
+	cmd.Flags().BoolVar(&clear, "clear", false, "Remove the sparse paths so new workbenches get a full checkout")
+
+	return cmd
+}
+
+func repoMergeCmd() *cobra.Command {
+	var strategy string
+	var verify []string
+	var clear, fromFile bool
+
+	cmd := &cobra.Command{
+		Use:   "merge [repo-id]",
+		Short: "Show or set how orc pr merge lands PRs locally",
+		Long: `Show or set a repository's merge strategy. With one, orc pr merge lands PRs
+itself in the repo's main checkout instead of only recording a merge done on
+the provider. Meant for solo repos without a hosted review flow.
+
+Strategies:
+  merge    a merge commit (--no-ff)
+  squash   one commit with the PR's changes, titled after the PR
+  rebase   the PR's commits replayed on top of the target branch
+
+Verify commands run via sh in the main checkout on the merged result, with
+ORC_PR_ID, ORC_REPO_ID, ORC_BRANCH and ORC_TARGET_BRANCH set. If one fails,
+the merge is undone.
+
+A repo can also check the config in as
+{"merge": {"strategy": "...", "verify": [...]}} in .orc/repo.json. It comes
+with the code, so it never applies on its own: orc pr merge refuses to land
+PRs until it is adopted. Review the file, then --from-file copies it onto the
+repo record. Later edits to the file are not picked up until adopted again.
+
+Examples:
+  orc repo merge REPO-001
+  orc repo merge REPO-001 --strategy squash --verify "make test"
+  orc repo merge REPO-001 --from-file
+  orc repo merge REPO-001 --clear`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			repoID := args[0]
+
+			modes := 0
+			for _, set := range []bool{clear, strategy != "", fromFile} {
+				if set {
+					modes++
+				}
+			}
+			if modes > 1 {
+				return fmt.Errorf("specify only one of --strategy, --from-file and --clear")
+			}
+			if strategy == "" && len(verify) > 0 {
+				return fmt.Errorf("--verify needs --strategy")
+			}
+
+			if fromFile {
+				adopted, err := wire.RepoService().AdoptMergeFile(ctx, repoID)
+				if err != nil {
+					return fmt.Errorf("failed to adopt merge config: %w", err)
+				}
+				fmt.Printf("✓ Adopted merge strategy %s from .orc/repo.json on %s (%d verify command(s))\n", adopted.Strategy, repoID, len(adopted.Verify))
+				for i, command := range adopted.Verify {
+					fmt.Printf("  %d. %s\n", i+1, command)
+				}
+				return nil
+			}
+
+			if clear || strategy != "" {
+				var cfg *primary.RepoMergeConfig
+				if strategy != "" {
+					cfg = &primary.RepoMergeConfig{Strategy: strategy, Verify: verify}
+				}
+				if err := wire.RepoService().SetMergeConfig(ctx, repoID, cfg); err != nil {
+					return fmt.Errorf("failed to set merge strategy: %w", err)
+				}
+				if clear {
+					fmt.Printf("✓ Cleared merge strategy of %s (PRs are only recorded as merged)\n", repoID)
+				} else {
+					fmt.Printf("✓ PRs of %s now land with %s (%d verify command(s))\n", repoID, strategy, len(verify))
+				}
+				return nil
+			}
+
+			repo, err := wire.RepoService().GetRepo(ctx, repoID)
+			if err != nil {
+				return fmt.Errorf("failed to get repository: %w", err)
+			}
+			if repo.Merge == nil {
+				fmt.Printf("No merge strategy on %s. Adopt a checked-in .orc/repo.json with: orc repo merge %s --from-file\n", repoID, repoID)
+				return nil
+			}
+			fmt.Printf("Merge strategy for %s: %s\n", repoID, repo.Merge.Strategy)
+			for i, command := range repo.Merge.Verify {
+				fmt.Printf("  %d. %s\n", i+1, command)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&strategy, "strategy", "", "merge, squash or rebase")
+	cmd.Flags().StringArrayVar(&verify, "verify", nil, "Command to run on the merged result before pushing (repeatable, in order; replaces existing commands)")
+	cmd.Flags().BoolVar(&clear, "clear", false, "Remove the merge strategy stored on the repo record")
+	cmd.Flags().BoolVar(&fromFile, "from-file", false, "Adopt the merge config checked in to the clone's .orc/repo.json")
+
+	return cmd
+}
+
 func repoUpdateCmd() *cobra.Command {
 	var url, localPath, defaultBranch string
 
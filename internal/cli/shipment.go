@@ -1,11 +1,17 @@
 package cli
 
 import (
+	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"text/tabwriter"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
 	orccontext "github.com/example/orc/internal/context"
 	"github.com/example/orc/internal/ports/primary"
@@ -68,19 +74,33 @@ var shipmentListCmd = &cobra.Command{
 		ctx := NewContext()
 		commissionID, _ := cmd.Flags().GetString("commission")
 		status, _ := cmd.Flags().GetString("status")
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
 		// Get commission from context if not specified
 		if commissionID == "" {
 			commissionID = orccontext.GetContextCommissionID()
 		}
 
-		shipments, err := wire.ShipmentService().ListShipments(ctx, primary.ShipmentFilters{
+		filters := primary.ShipmentFilters{
 			CommissionID: commissionID,
 			Status:       status,
-		})
+		}
+		shipments, err := wire.ShipmentService().ListShipments(ctx, filters)
 		if err != nil {
 			return fmt.Errorf("failed to list shipments: %w", err)
 		}
 
+		archivedIDs := map[string]bool{}
+		if includeArchived {
+			archived, err := wire.MaintenanceService().ListArchivedShipments(ctx, filters)
+			if err != nil {
+				return fmt.Errorf("failed to list archived shipments: %w", err)
+			}
+			for _, s := range archived {
+				archivedIDs[s.ID] = true
+			}
+			shipments = append(shipments, archived...)
+		}
+
 		if len(shipments) == 0 {
 			fmt.Println("No shipments found.")
 			return nil
@@ -94,6 +114,9 @@ var shipmentListCmd = &cobra.Command{
 			if s.Pinned {
 				pinnedMark = " [pinned]"
 			}
+			if archivedIDs[s.ID] {
+				pinnedMark += " [archived]"
+			}
 			fmt.Fprintf(w, "%s\t%s%s\t%s\t%s\n", s.ID, s.Title, pinnedMark, s.Status, s.CommissionID)
 		}
 		w.Flush()
@@ -108,13 +131,22 @@ var shipmentShowCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := NewContext()
 		shipmentID := args[0]
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
 
+		archived := false
 		shipment, err := wire.ShipmentService().GetShipment(ctx, shipmentID)
+		if err != nil && includeArchived {
+			shipment, err = wire.MaintenanceService().GetArchivedShipment(ctx, shipmentID)
+			archived = err == nil
+		}
 		if err != nil {
 			return fmt.Errorf("shipment not found: %w", err)
 		}
 
 		fmt.Printf("Shipment: %s\n", shipment.ID)
+		if archived {
+			fmt.Printf("Archived: yes\n")
+		}
 		fmt.Printf("Title: %s\n", shipment.Title)
 		if shipment.Description != "" {
 			fmt.Printf("Description: %s\n", shipment.Description)
@@ -124,11 +156,44 @@ var shipmentShowCmd = &cobra.Command{
 		if shipment.AssignedWorkbenchID != "" {
 			fmt.Printf("Assigned Workbench: %s\n", shipment.AssignedWorkbenchID)
 		}
-		if shipment.RepoID != "" {
+		var repos []*primary.ShipmentRepo
+		if !archived {
+			repos, _ = wire.ShipmentService().ListShipmentRepos(ctx, shipmentID)
+		}
+		if len(repos) > 1 {
+			fmt.Printf("Repositories:\n")
+			for _, r := range repos {
+				line := fmt.Sprintf("  %s  %s", r.RepoID, r.Branch)
+				if r.AssignedWorkbenchID != "" {
+					line += fmt.Sprintf("  (%s)", r.AssignedWorkbenchID)
+				}
+				if r.Primary {
+					line += "  [primary]"
+				}
+				fmt.Println(line)
+			}
+		} else if shipment.RepoID != "" {
 			fmt.Printf("Repository: %s\n", shipment.RepoID)
 		}
 		if shipment.Branch != "" {
 			fmt.Printf("Branch: %s\n", shipment.Branch)
+			if !archived {
+				refresh, _ := cmd.Flags().GetBool("refresh")
+				stat, err := loadShipmentDiffStat(ctx, shipmentID, refresh)
+				if err != nil && refresh {
+					fmt.Printf("Diff: unavailable (%v)\n", err)
+				} else if stat != nil && len(stat.Repos) == 1 {
+					fmt.Printf("Diff: %s, +%d -%d vs %s (computed %s)\n",
+						pluralize(stat.FilesChanged, "file", "files"), stat.Insertions, stat.Deletions, stat.Repos[0].BaseRef, stat.ComputedAt)
+				} else if stat != nil {
+					fmt.Printf("Diff: %s, +%d -%d across %d repos (computed %s)\n",
+						pluralize(stat.FilesChanged, "file", "files"), stat.Insertions, stat.Deletions, len(stat.Repos), stat.ComputedAt)
+					for _, r := range stat.Repos {
+						fmt.Printf("  %s: %s, +%d -%d vs %s\n", r.RepoID,
+							pluralize(r.FilesChanged, "file", "files"), r.Insertions, r.Deletions, r.BaseRef)
+					}
+				}
+			}
 		}
 		if shipment.Pinned {
 			fmt.Printf("Pinned: yes\n")
@@ -139,7 +204,12 @@ var shipmentShowCmd = &cobra.Command{
 		}
 
 		// Show tasks
-		tasks, err := wire.ShipmentService().GetShipmentTasks(ctx, shipmentID)
+		var tasks []*primary.Task
+		if archived {
+			tasks, err = wire.MaintenanceService().GetArchivedShipmentTasks(ctx, shipmentID)
+		} else {
+			tasks, err = wire.ShipmentService().GetShipmentTasks(ctx, shipmentID)
+		}
 		if err != nil {
 			return fmt.Errorf("failed to get tasks: %w", err)
 		}
@@ -152,10 +222,119 @@ var shipmentShowCmd = &cobra.Command{
 			}
 		}
 
+		// Archived shipments keep their commits in the archive ledger only
+		if !archived {
+			if commits, err := wire.CommitService().ListCommits(ctx, primary.CommitFilters{ShipmentID: shipmentID}); err == nil {
+				printLinkedCommits(commits)
+			}
+		}
+
 		return nil
 	},
 }
 
+var shipmentFilesCmd = &cobra.Command{
+	Use:   "files [shipment-id]",
+	Short: "Show the files a shipment's branch touches, as a per-directory heatmap",
+	Long: `Show the files a shipment's branch changes relative to its merge base with
+the default branch, grouped by directory and ordered by churn.
+
+Stats are cached in the ledger when first computed; use --refresh after new
+commits to recompute them from git.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		shipmentID := args[0]
+		refresh, _ := cmd.Flags().GetBool("refresh")
+		depth, _ := cmd.Flags().GetInt("depth")
+		showFiles, _ := cmd.Flags().GetBool("files")
+
+		if _, err := loadShipmentDiffStat(ctx, shipmentID, refresh); err != nil {
+			return fmt.Errorf("failed to compute diff stats: %w", err)
+		}
+		files, err := wire.DiffStatService().GetShipmentFiles(ctx, primary.GetShipmentFilesRequest{
+			ShipmentID: shipmentID,
+			Depth:      depth,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get touched files: %w", err)
+		}
+		if files == nil || len(files.Files) == 0 {
+			fmt.Printf("%s has no changes against its merge base\n", shipmentID)
+			return nil
+		}
+
+		stat := files.Stat
+		multiRepo := len(stat.Repos) > 1
+		if multiRepo {
+			fmt.Printf("%s: %s, +%d -%d across %d repos\n", shipmentID,
+				pluralize(stat.FilesChanged, "file", "files"), stat.Insertions, stat.Deletions, len(stat.Repos))
+			for _, r := range stat.Repos {
+				fmt.Printf("  %s: %s, +%d -%d vs %s (merge base %s, head %s)\n", r.RepoID,
+					pluralize(r.FilesChanged, "file", "files"), r.Insertions, r.Deletions,
+					r.BaseRef, shortHash(r.MergeBase), shortHash(r.HeadCommit))
+			}
+			fmt.Println()
+		} else {
+			r := stat.Repos[0]
+			fmt.Printf("%s: %s, +%d -%d vs %s (merge base %s, head %s)\n\n", shipmentID,
+				pluralize(stat.FilesChanged, "file", "files"), stat.Insertions, stat.Deletions,
+				r.BaseRef, shortHash(r.MergeBase), shortHash(r.HeadCommit))
+		}
+		// Multi-repo paths are prefixed with their repo, e.g. REPO-002:internal/app
+		label := func(repoID, path string) string {
+			if multiRepo {
+				return repoID + ":" + path
+			}
+			return path
+		}
+
+		maxChurn := 0
+		for _, d := range files.Directories {
+			maxChurn = max(maxChurn, d.Insertions+d.Deletions)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, d := range files.Directories {
+			fmt.Fprintf(w, "  %s\t+%d/-%d\t%s\t%s\n", label(d.RepoID, d.Dir), d.Insertions, d.Deletions,
+				pluralize(d.Files, "file", "files"), heatBar(d.Insertions+d.Deletions, maxChurn))
+		}
+		w.Flush()
+
+		if showFiles {
+			fmt.Println()
+			w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			for _, f := range files.Files {
+				fmt.Fprintf(w, "  %s\t+%d/-%d\n", label(f.RepoID, f.Path), f.Insertions, f.Deletions)
+			}
+			w.Flush()
+		}
+		return nil
+	},
+}
+
+// loadShipmentDiffStat returns a shipment's cached diff stats, computing them
+// when asked to or when nothing is cached yet.
+func loadShipmentDiffStat(ctx context.Context, shipmentID string, refresh bool) (*primary.ShipmentDiffStat, error) {
+	if !refresh {
+		stat, err := wire.DiffStatService().GetShipmentDiffStat(ctx, shipmentID)
+		if err != nil || stat != nil {
+			return stat, err
+		}
+	}
+	return wire.DiffStatService().RefreshShipmentDiffStat(ctx, shipmentID)
+}
+
+// heatBar renders churn as a bar scaled against the largest churn, at least one cell wide.
+func heatBar(churn, maxChurn int) string {
+	const width = 20
+	if maxChurn == 0 {
+		return ""
+	}
+	cells := max(1, churn*width/maxChurn)
+	return color.New(color.FgRed).Sprint(strings.Repeat("█", cells))
+}
+
 var shipmentCompleteCmd = &cobra.Command{
 	Use:   "complete [shipment-id]",
 	Short: "Mark shipment as complete",
@@ -165,12 +344,14 @@ var shipmentCompleteCmd = &cobra.Command{
 		shipmentID := args[0]
 		force, _ := cmd.Flags().GetBool("force")
 
-		err := wire.ShipmentService().CompleteShipment(ctx, shipmentID, force)
+		lifecycle, err := wire.ShipmentService().CompleteShipment(ctx, shipmentID, force)
 		if err != nil {
 			return fmt.Errorf("failed to complete shipment: %w", err)
 		}
 
 		fmt.Printf("🏁 Shipment %s marked as complete\n", shipmentID)
+		printCommissionLifecycle(lifecycle)
+		offerReturnHome(ctx, cmd, shipmentID)
 		return nil
 	},
 }
@@ -250,12 +431,17 @@ var shipmentAssignCmd = &cobra.Command{
 		shipmentID := args[0]
 		workbenchID := args[1]
 
-		err := wire.ShipmentService().AssignShipmentToWorkbench(ctx, shipmentID, workbenchID)
+		resp, err := wire.ShipmentService().AssignShipmentToWorkbench(ctx, shipmentID, workbenchID)
 		if err != nil {
 			return fmt.Errorf("failed to assign shipment: %w", err)
 		}
 
 		fmt.Printf("🔗 Shipment %s assigned to workbench %s\n", shipmentID, workbenchID)
+		if resp.Warning != "" {
+			fmt.Printf("   ⚠️  %s\n", resp.Warning)
+		} else if resp.Branch != "" {
+			fmt.Printf("   Checked out branch %s (%s)\n", resp.Branch, resp.RepoID)
+		}
 		return nil
 	},
 }
@@ -279,16 +465,156 @@ Backwards transitions require --force flag.`,
 			return fmt.Errorf("--set flag is required")
 		}
 
-		err := wire.ShipmentService().SetStatus(ctx, shipmentID, status, force)
+		lifecycle, err := wire.ShipmentService().SetStatus(ctx, shipmentID, status, force)
 		if err != nil {
 			return fmt.Errorf("failed to set status: %w", err)
 		}
 
 		fmt.Printf("⚡ Shipment %s status set to '%s'\n", shipmentID, status)
+		printCommissionLifecycle(lifecycle)
+		if status == "closed" {
+			offerReturnHome(ctx, cmd, shipmentID)
+		}
 		return nil
 	},
 }
 
+// offerReturnHome offers to move the assigned workbench off a closed shipment's branch:
+// check out the workbench's home branch and delete the shipment branch if it was merged.
+// Asks interactively; --return-home answers yes and --keep-branch skips the offer.
+func offerReturnHome(ctx context.Context, cmd *cobra.Command, shipmentID string) {
+	if keep, _ := cmd.Flags().GetBool("keep-branch"); keep {
+		return
+	}
+	shipment, err := wire.ShipmentService().GetShipment(ctx, shipmentID)
+	if err != nil || shipment.AssignedWorkbenchID == "" || shipment.Branch == "" {
+		return
+	}
+	wb, err := wire.WorkbenchService().GetWorkbench(ctx, shipment.AssignedWorkbenchID)
+	if err != nil || wb.HomeBranch == "" || wb.CurrentBranch != shipment.Branch {
+		return
+	}
+
+	returnHome, _ := cmd.Flags().GetBool("return-home")
+	if !returnHome {
+		if !term.IsTerminal(int(os.Stdin.Fd())) {
+			fmt.Printf("💡 %s is still on %s. Return it with: orc workbench checkout %s %s\n", wb.ID, shipment.Branch, wb.ID, wb.HomeBranch)
+			return
+		}
+		fmt.Printf("\nReturn %s to %s and delete %s if merged? [y/n] ", wb.ID, wb.HomeBranch, shipment.Branch)
+		reader := bufio.NewReader(os.Stdin)
+		response, _ := reader.ReadString('\n')
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			return
+		}
+	}
+
+	resp, err := wire.WorkbenchService().ReturnToHomeBranch(ctx, primary.ReturnToHomeBranchRequest{
+		WorkbenchID:  wb.ID,
+		DeleteBranch: shipment.Branch,
+	})
+	if err != nil {
+		fmt.Printf("Warning: failed to return %s to %s: %v\n", wb.ID, wb.HomeBranch, err)
+		return
+	}
+	fmt.Printf("🏠 %s switched from %s to %s\n", wb.ID, resp.PreviousBranch, resp.CurrentBranch)
+	if resp.StashApplied {
+		fmt.Println("   (stashed changes have been reapplied)")
+	}
+	if resp.BranchDeleted {
+		fmt.Printf("   Deleted merged branch %s\n", shipment.Branch)
+	} else if resp.KeptReason != "" {
+		fmt.Printf("   Kept branch %s: %s\n", shipment.Branch, resp.KeptReason)
+	}
+}
+
+func shipmentRepoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repo",
+		Short: "Manage the repositories a shipment spans",
+		Long: `Manage the repositories a shipment spans.
+
+A shipment's first repository is its primary repo: tasks follow the workbench
+assigned on it. Each additional repository gets its own branch (the shipment's
+branch name by default), its own workbench and its own PR. The shipment closes
+once every repository's PR is merged.`,
+	}
+
+	var branch string
+	addCmd := &cobra.Command{
+		Use:   "add [shipment-id] [repo-id]",
+		Short: "Add a repository to a shipment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			repo, err := wire.ShipmentService().AddShipmentRepo(ctx, primary.AddShipmentRepoRequest{
+				ShipmentID: args[0],
+				RepoID:     args[1],
+				Branch:     branch,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add repository: %w", err)
+			}
+
+			fmt.Printf("✓ Added %s to shipment %s on branch %s\n", repo.RepoID, repo.ShipmentID, repo.Branch)
+			if repo.Primary {
+				fmt.Println("   (primary repository)")
+			}
+			fmt.Printf("   Assign a workbench on %s with: orc shipment assign %s BENCH-xxx\n", repo.RepoID, repo.ShipmentID)
+			return nil
+		},
+	}
+	addCmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch name (default: the shipment's branch)")
+
+	removeCmd := &cobra.Command{
+		Use:   "remove [shipment-id] [repo-id]",
+		Short: "Remove an additional repository from a shipment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			if err := wire.ShipmentService().RemoveShipmentRepo(ctx, args[0], args[1]); err != nil {
+				return fmt.Errorf("failed to remove repository: %w", err)
+			}
+			fmt.Printf("✓ Removed %s from shipment %s\n", args[1], args[0])
+			return nil
+		},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list [shipment-id]",
+		Short: "List the repositories a shipment spans",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			repos, err := wire.ShipmentService().ListShipmentRepos(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to list repositories: %w", err)
+			}
+			if len(repos) == 0 {
+				fmt.Println("No repositories linked.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "REPO\tBRANCH\tWORKBENCH\tPRIMARY")
+			fmt.Fprintln(w, "----\t------\t---------\t-------")
+			for _, r := range repos {
+				primaryMark := ""
+				if r.Primary {
+					primaryMark = "yes"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.RepoID, r.Branch, r.AssignedWorkbenchID, primaryMark)
+			}
+			w.Flush()
+			return nil
+		},
+	}
+
+	cmd.AddCommand(addCmd, removeCmd, listCmd)
+	return cmd
+}
+
 func shipmentMoveCmd() *cobra.Command {
 	var toCommission string
 	cmd := &cobra.Command{
@@ -314,6 +640,149 @@ func shipmentMoveCmd() *cobra.Command {
 	return cmd
 }
 
+var shipmentImportCmd = &cobra.Command{
+	Use:   "import [shipment-id] [plan.md]",
+	Short: "Import tasks and notes from a markdown plan",
+	Long: `Import tasks and notes from a structured markdown document.
+
+Format:
+  # Title                     Document title (not imported)
+  ## Heading                  Becomes a task; following text is its description
+  - [ ] item                  Under a heading: subtask (kept in the description)
+                              Before any heading: a task of its own ("- [x]" imports it closed)
+  ` + "```orc" + `                      Metadata for the current task:
+  type: implementation          type, priority, depends-on (#N or TASK-xxx)
+  ` + "```" + `
+  > decision: Title           Becomes a decision note; following > lines are content
+
+Everything is created in a single transaction. Use --dry-run to preview.
+Pass "-" as the file to read from stdin.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		shipmentID := args[0]
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		var content []byte
+		var err error
+		if args[1] == "-" {
+			content, err = io.ReadAll(os.Stdin)
+		} else {
+			content, err = os.ReadFile(args[1])
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read plan: %w", err)
+		}
+
+		resp, err := wire.ShipmentService().ImportPlan(ctx, primary.ImportPlanRequest{
+			ShipmentID: shipmentID,
+			Content:    string(content),
+			DryRun:     dryRun,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to import plan: %w", err)
+		}
+
+		if resp.DryRun {
+			fmt.Printf("Dry run: would import into %s\n", resp.ShipmentID)
+		} else {
+			fmt.Printf("📥 Imported into %s\n", resp.ShipmentID)
+		}
+
+		if len(resp.Tasks) > 0 {
+			fmt.Printf("\nTasks (%d):\n", len(resp.Tasks))
+			for _, t := range resp.Tasks {
+				id := t.ID
+				if id == "" {
+					id = fmt.Sprintf("#%d", t.Ref)
+				}
+				var details []string
+				if t.Done {
+					details = append(details, "done")
+				}
+				if t.Type != "" {
+					details = append(details, t.Type)
+				}
+				if t.Priority != "" {
+					details = append(details, t.Priority)
+				}
+				if t.SubtaskCount > 0 {
+					details = append(details, fmt.Sprintf("%d subtasks", t.SubtaskCount))
+				}
+				if len(t.DependsOn) > 0 {
+					details = append(details, "depends on "+strings.Join(t.DependsOn, ", "))
+				}
+				line := fmt.Sprintf("  %s: %s", id, t.Title)
+				if len(details) > 0 {
+					line += " [" + strings.Join(details, "; ") + "]"
+				}
+				fmt.Println(line)
+			}
+		}
+
+		if len(resp.Notes) > 0 {
+			fmt.Printf("\nNotes (%d):\n", len(resp.Notes))
+			for _, n := range resp.Notes {
+				id := n.ID
+				if id == "" {
+					id = "(new)"
+				}
+				fmt.Printf("  %s: %s [%s]\n", id, n.Title, n.Type)
+			}
+		}
+
+		if resp.DryRun {
+			fmt.Println()
+			fmt.Println("Run without --dry-run to create these records.")
+		}
+		return nil
+	},
+}
+
+var shipmentReportCmd = &cobra.Command{
+	Use:   "report [shipment-id]",
+	Short: "Generate a shipment report (PR description)",
+	Long: `Assemble a shipment's title, spec, decisions, tasks, plans, branch commits
+and PR info into a single document.
+
+Templates:
+  markdown and html output use Go templates. Override the built-in defaults
+  with --template, or by placing shipment-report.md.tmpl / shipment-report.html.tmpl
+  in ~/.orc/templates/.
+
+Examples:
+  orc shipment report SHIP-001
+  orc shipment report SHIP-001 --format json
+  orc shipment report SHIP-001 --format html -o report.html`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		format, _ := cmd.Flags().GetString("format")
+		templatePath, _ := cmd.Flags().GetString("template")
+		output, _ := cmd.Flags().GetString("output")
+
+		report, err := wire.ReportService().RenderShipmentReport(ctx, primary.RenderReportRequest{
+			ShipmentID:   args[0],
+			Format:       format,
+			TemplatePath: templatePath,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to generate report: %w", err)
+		}
+
+		if output != "" {
+			if err := os.WriteFile(output, []byte(report), 0644); err != nil {
+				return fmt.Errorf("failed to write report: %w", err)
+			}
+			fmt.Printf("✓ Wrote report for %s to %s\n", args[0], output)
+			return nil
+		}
+
+		fmt.Print(report)
+		return nil
+	},
+}
+
 func init() {
 	// shipment create flags
 	shipmentCreateCmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
@@ -324,6 +793,14 @@ func init() {
 	// shipment list flags
 	shipmentListCmd.Flags().StringP("commission", "c", "", "Filter by commission")
 	shipmentListCmd.Flags().StringP("status", "s", "", "Filter by status (draft, ready, in-progress, closed)")
+	shipmentListCmd.Flags().Bool("include-archived", false, "Include shipments moved to the archive ledger")
+	shipmentShowCmd.Flags().Bool("include-archived", false, "Look up the shipment in the archive ledger if it is not in the main ledger")
+	shipmentShowCmd.Flags().Bool("refresh", false, "Recompute the branch diff stats from git")
+
+	// shipment files flags
+	shipmentFilesCmd.Flags().Bool("refresh", false, "Recompute the diff stats from git")
+	shipmentFilesCmd.Flags().Int("depth", 2, "Directory levels to group by (0 for full paths)")
+	shipmentFilesCmd.Flags().Bool("files", false, "Also list every touched file")
 
 	// shipment update flags
 	shipmentUpdateCmd.Flags().String("title", "", "New title")
@@ -332,15 +809,28 @@ func init() {
 
 	// Flags for complete command
 	shipmentCompleteCmd.Flags().BoolP("force", "f", false, "Complete even if tasks are incomplete")
+	shipmentCompleteCmd.Flags().Bool("return-home", false, "Return the workbench to its home branch without asking")
+	shipmentCompleteCmd.Flags().Bool("keep-branch", false, "Leave the workbench on the shipment branch")
 
 	// Flags for status command
 	shipmentStatusCmd.Flags().String("set", "", "Status to set (required)")
 	shipmentStatusCmd.Flags().Bool("force", false, "Allow backwards transitions")
+	shipmentStatusCmd.Flags().Bool("return-home", false, "When closing, return the workbench to its home branch without asking")
+	shipmentStatusCmd.Flags().Bool("keep-branch", false, "When closing, leave the workbench on the shipment branch")
+
+	// Flags for import command
+	shipmentImportCmd.Flags().Bool("dry-run", false, "Preview what would be created without writing")
+
+	// shipment report flags
+	shipmentReportCmd.Flags().StringP("format", "f", "markdown", "Output format: markdown, json, or html")
+	shipmentReportCmd.Flags().String("template", "", "Path to a Go template overriding the default")
+	shipmentReportCmd.Flags().StringP("output", "o", "", "Write the report to a file instead of stdout")
 
 	// Register subcommands
 	shipmentCmd.AddCommand(shipmentCreateCmd)
 	shipmentCmd.AddCommand(shipmentListCmd)
 	shipmentCmd.AddCommand(shipmentShowCmd)
+	shipmentCmd.AddCommand(shipmentFilesCmd)
 	shipmentCmd.AddCommand(shipmentCompleteCmd)
 	shipmentCmd.AddCommand(shipmentUpdateCmd)
 	shipmentCmd.AddCommand(shipmentPinCmd)
@@ -348,6 +838,9 @@ func init() {
 	shipmentCmd.AddCommand(shipmentAssignCmd)
 	shipmentCmd.AddCommand(shipmentStatusCmd)
 	shipmentCmd.AddCommand(shipmentMoveCmd())
+	shipmentCmd.AddCommand(shipmentRepoCmd())
+	shipmentCmd.AddCommand(shipmentImportCmd)
+	shipmentCmd.AddCommand(shipmentReportCmd)
 }
 
 // ShipmentCmd returns the shipment command
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/example/orc/internal/config"
+	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/wire"
 )
 
@@ -20,6 +21,7 @@ func StatusCmd() *cobra.Command {
 - Active commission, shipments, and tasks
 - Current focus (if any)
 - Role (GOBLIN or IMP)
+- Pane health of the workshop's agents (recorded by orc tmux monitor)
 
 This provides a focused view of "where am I right now?"`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -82,6 +84,9 @@ This provides a focused view of "where am I right now?"`,
 					}
 					fmt.Println()
 				}
+
+				// Show agent pane health of the workshop, as recorded by orc tmux monitor
+				printWorkshopPaneHealth(NewContext(), cfg.PlaceID)
 			}
 
 			return nil
@@ -90,3 +95,55 @@ This provides a focused view of "where am I right now?"`,
 
 	return cmd
 }
+
+// printWorkshopPaneHealth shows the pane states orc tmux monitor recorded for the
+// workshop of a workbench. Prints nothing when the session isn't running.
+func printWorkshopPaneHealth(ctx context.Context, workbenchID string) {
+	wb, err := wire.WorkbenchService().GetWorkbench(ctx, workbenchID)
+	if err != nil || wb == nil || wb.WorkshopID == "" {
+		return
+	}
+	workshop, err := wire.WorkshopService().GetWorkshop(ctx, wb.WorkshopID)
+	if err != nil {
+		return
+	}
+	adapter, err := wire.NewGotmuxAdapterWithSocket(resolveFactorySocket(ctx, wb.WorkshopID))
+	if err != nil {
+		return
+	}
+	panes, err := adapter.InspectPanes(workshop.Name, 0)
+	if err != nil {
+		return
+	}
+
+	var monitored []wire.PaneInspection
+	for _, p := range panes {
+		if p.State != "" {
+			monitored = append(monitored, p)
+		}
+	}
+	if len(monitored) == 0 {
+		fmt.Printf("🩺 Panes: not monitored (run: orc tmux monitor %s)\n", wb.WorkshopID)
+		fmt.Println()
+		return
+	}
+
+	fmt.Printf("🩺 Panes (%s):\n", wb.WorkshopID)
+	for _, p := range monitored {
+		name := p.Window
+		if p.BenchID != "" {
+			name = p.BenchID
+		}
+		if p.Role != "" {
+			name += " " + p.Role
+		} else {
+			name += fmt.Sprintf(".%d", p.Index)
+		}
+		marker := " "
+		if p.State == primary.PaneStateWaiting || p.State == primary.PaneStateCrashed {
+			marker = "⚠"
+		}
+		fmt.Printf("  %s %s: %s\n", marker, name, p.State)
+	}
+	fmt.Println()
+}
@@ -673,6 +673,15 @@ func renderShipment(w *strings.Builder, ship primary.ShipmentSummary, workshopFo
 	if ship.NoteCount > 0 {
 		taskInfo += fmt.Sprintf(", %s", pluralize(ship.NoteCount, "note", "notes"))
 	}
+	if ship.CommitCount > 0 {
+		taskInfo += fmt.Sprintf(", %s", pluralize(ship.CommitCount, "commit", "commits"))
+	}
+	if ship.DiffBadge != "" {
+		taskInfo += ", " + ship.DiffBadge
+	}
+	if ship.ChecksState != "" {
+		taskInfo += ", " + colorizeChecksBadge(ship.ChecksState)
+	}
 	taskInfo += ")"
 	pinnedMark := ""
 	if ship.Pinned {
@@ -715,7 +724,11 @@ func renderShipment(w *strings.Builder, ship primary.ShipmentSummary, workshopFo
 			if task.Status != "" && task.Status != "open" {
 				statusMark = colorizeStatus(task.Status) + " - "
 			}
-			fmt.Fprintf(w, "%s%s - %s%s\n", tPrefix, colorizeID(task.ID), statusMark, task.Title)
+			commitInfo := ""
+			if task.CommitCount > 0 {
+				commitInfo = fmt.Sprintf(" (%s)", pluralize(task.CommitCount, "commit", "commits"))
+			}
+			fmt.Fprintf(w, "%s%s - %s%s%s\n", tPrefix, colorizeID(task.ID), statusMark, task.Title, commitInfo)
 			// Render task children (plans)
 			renderTaskChildren(w, task, taskChildPrefix)
 			childIdx++
@@ -794,6 +807,19 @@ func colorizeShipmentStatus(status string) string {
 	}
 }
 
+// colorizeChecksBadge formats the CI check rollup of a shipment's PRs: green when
+// passing, red when failing, yellow while running.
+func colorizeChecksBadge(state string) string {
+	switch state {
+	case primary.PRCheckPassing:
+		return color.New(color.FgHiGreen).Sprint("CI ✓")
+	case primary.PRCheckFailing:
+		return color.New(color.FgHiRed).Sprint("CI ✗")
+	default:
+		return color.New(color.FgHiYellow).Sprint("CI …")
+	}
+}
+
 // colorizePlanStatus formats plan status with semantic color and marker
 func colorizePlanStatus(status string) string {
 	upper := strings.ToUpper(status)
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bufio"
 	"fmt"
 	"os"
 	"strings"
@@ -199,10 +200,23 @@ var taskShowCmd = &cobra.Command{
 		if task.CompletedAt != "" {
 			fmt.Printf("Completed: %s\n", task.CompletedAt)
 		}
+		if task.StartCommit != "" {
+			end := task.EndCommit
+			if end == "" {
+				end = "HEAD"
+			} else {
+				end = shortHash(end)
+			}
+			fmt.Printf("Commits: %s..%s\n", shortHash(task.StartCommit), end)
+		}
 		if task.Tag != nil {
 			fmt.Printf("Tag: %s\n", task.Tag.Name)
 		}
 
+		if commits, err := wire.CommitService().ListCommits(ctx, primary.CommitFilters{TaskID: taskID}); err == nil {
+			printLinkedCommits(commits)
+		}
+
 		return nil
 	},
 }
@@ -251,11 +265,21 @@ var taskCompleteCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := NewContext()
 		taskID := args[0]
+		mode, _ := cmd.Flags().GetString("checkpoint")
 
-		err := wire.TaskService().CompleteTask(ctx, taskID)
+		checkpoint, err := wire.TaskService().CompleteTask(ctx, primary.CompleteTaskRequest{
+			TaskID:     taskID,
+			Checkpoint: mode,
+		})
 		if err != nil {
 			return fmt.Errorf("failed to complete task: %w", err)
 		}
+		if checkpoint != nil && checkpoint.Committed {
+			fmt.Printf("✓ Committed checkpoint %s\n", shortHash(checkpoint.EndCommit))
+		}
+		if checkpoint != nil && checkpoint.Tag != "" {
+			fmt.Printf("✓ Tagged %s as %s\n", shortHash(checkpoint.EndCommit), checkpoint.Tag)
+		}
 
 		fmt.Printf("✓ Task %s marked as complete\n", taskID)
 		fmt.Println()
@@ -537,6 +561,101 @@ Requires --force flag to confirm deletion.`,
 	},
 }
 
+var taskCommitsCmd = &cobra.Command{
+	Use:   "commits [task-id]",
+	Short: "List commits made while working on a task",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		taskID := args[0]
+
+		commits, err := wire.TaskService().GetTaskCommits(ctx, taskID)
+		if err != nil {
+			return fmt.Errorf("failed to list task commits: %w", err)
+		}
+
+		if len(commits) == 0 {
+			fmt.Printf("No commits for %s yet.\n", taskID)
+			return nil
+		}
+		for _, c := range commits {
+			fmt.Printf("%s  %s  %s\n", shortHash(c.Hash), c.Subject, c.Author)
+		}
+		return nil
+	},
+}
+
+var taskDiffCmd = &cobra.Command{
+	Use:   "diff [task-id]",
+	Short: "Show the diff of a task's commit range",
+	Long: `Show what changed in the workbench while working on a task.
+
+For a completed task the diff covers the recorded commit range. For an open task
+it runs from the checkpoint taken at claim time to the working tree.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		taskID := args[0]
+		stat, _ := cmd.Flags().GetBool("stat")
+
+		diff, err := wire.TaskService().GetTaskDiff(ctx, taskID, stat)
+		if err != nil {
+			return fmt.Errorf("failed to diff task: %w", err)
+		}
+		fmt.Print(diff)
+		return nil
+	},
+}
+
+var taskRollbackCmd = &cobra.Command{
+	Use:   "rollback [task-id]",
+	Short: "Reset the workbench to the checkpoint taken when the task was claimed",
+	Long: `Hard-reset the task's workbench to the commit recorded when the task was claimed.
+
+Every commit after the checkpoint and all uncommitted changes are discarded,
+including work from tasks claimed later in the same workbench.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		taskID := args[0]
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		preview, err := wire.TaskService().PreviewTaskRollback(ctx, taskID)
+		if err != nil {
+			return fmt.Errorf("cannot roll back task: %w", err)
+		}
+
+		fmt.Printf("Rolling back %s resets %s (%s) to %s\n", taskID, preview.WorkbenchID, preview.WorkbenchPath, shortHash(preview.StartCommit))
+		if len(preview.Discarded) > 0 {
+			fmt.Printf("Discards %d commit(s):\n", len(preview.Discarded))
+			for _, c := range preview.Discarded {
+				fmt.Printf("  %s  %s\n", shortHash(c.Hash), c.Subject)
+			}
+		}
+		if preview.DirtyFiles > 0 {
+			fmt.Printf("Discards uncommitted changes in %d file(s)\n", preview.DirtyFiles)
+		}
+
+		if !yes {
+			fmt.Print("\nRoll back? [y/n] ")
+			reader := bufio.NewReader(os.Stdin)
+			response, _ := reader.ReadString('\n')
+			response = strings.TrimSpace(strings.ToLower(response))
+			if response != "y" && response != "yes" {
+				fmt.Println("Canceled.")
+				return nil
+			}
+		}
+
+		if err := wire.TaskService().RollbackTask(ctx, taskID); err != nil {
+			return fmt.Errorf("failed to roll back task: %w", err)
+		}
+
+		fmt.Printf("✓ %s reset to %s\n", preview.WorkbenchID, shortHash(preview.StartCommit))
+		return nil
+	},
+}
+
 func init() {
 	// task create flags
 	taskCreateCmd.Flags().String("shipment", "", "Shipment ID")
@@ -550,6 +669,15 @@ func init() {
 	taskListCmd.Flags().StringP("status", "s", "", "Filter by status (open, in-progress, blocked, closed)")
 	taskListCmd.Flags().String("tag", "", "Filter by tag")
 
+	// task complete flags
+	taskCompleteCmd.Flags().String("checkpoint", "", "Commit (commit) or tag (tag) the workbench before completing")
+
+	// task diff flags
+	taskDiffCmd.Flags().Bool("stat", false, "Show a diffstat instead of the full patch")
+
+	// task rollback flags
+	taskRollbackCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+
 	// task update flags
 	taskUpdateCmd.Flags().String("title", "", "New title")
 	taskUpdateCmd.Flags().StringP("description", "d", "", "New description")
@@ -580,6 +708,9 @@ func init() {
 	taskCmd.AddCommand(taskUntagCmd)
 	taskCmd.AddCommand(taskMoveCmd)
 	taskCmd.AddCommand(taskDeleteCmd)
+	taskCmd.AddCommand(taskCommitsCmd)
+	taskCmd.AddCommand(taskDiffCmd)
+	taskCmd.AddCommand(taskRollbackCmd)
 }
 
 // TaskCmd returns the task command
@@ -602,3 +733,11 @@ func getStatusIcon(status string) string {
 		return "📋"
 	}
 }
+
+// shortHash abbreviates a commit hash for display.
+func shortHash(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
@@ -40,6 +40,9 @@ func TmuxCmd() *cobra.Command {
 	cmd.AddCommand(
 		tmuxConnectCmd(),
 		tmuxApplyCmd(),
+		tmuxSnapshotCmd(),
+		tmuxRestoreCmd(),
+		tmuxMonitorCmd(),
 		tmuxEnrichCmd(),
 		tmuxArchiveWorkbenchCmd(),
 	)
@@ -119,9 +122,28 @@ tmux sessions.
 
 Actions performed:
 - Create session if it doesn't exist
-- Add windows for missing workbenches (single goblin pane each)
+- Add windows for missing workbenches, laid out by tmux.json
+- Add layout panes missing from existing workbench windows
 - Apply ORC enrichment (bindings, pane titles)
 
+Layouts live in tmux.json next to the ORC database (~/.orc/tmux.json): a
+global layout plus per-workshop and per-repo overrides. The most specific wins
+(workshop, then repo, then global); without one, each window is a single goblin
+pane running orc connect:
+
+  {
+    "layout": {"panes": [
+      {"role": "goblin"},
+      {"role": "tests", "split": "right", "size": "40%", "command": "make watch"},
+      {"role": "logs", "split": "below", "size": "30%", "command": "tail -F app.log",
+       "env": {"LOG_LEVEL": "debug"}}
+    ]},
+    "workshops": {"WORK-002": {"panes": [{"role": "goblin"}]}}
+  }
+
+Panes with a role the layout doesn't have are reported but left alone; panes
+without a role are never touched.
+
 Without --yes, shows a plan and prompts for confirmation.
 With --yes, applies immediately (useful for scripts and automation).
 
@@ -147,18 +169,25 @@ Examples:
 				return fmt.Errorf("failed to list workbenches: %w", err)
 			}
 
-			// 3. Filter active workbenches and validate paths
+			// 3. Filter active workbenches, validate paths and resolve their layouts
+			layouts, err := wire.LoadTmuxConfig()
+			if err != nil {
+				return err
+			}
 			var desired []wire.DesiredWorkbench
 			for _, wb := range workbenches {
 				if wb.Status == "active" {
 					if _, err := os.Stat(wb.Path); os.IsNotExist(err) {
 						return fmt.Errorf("worktree path does not exist for %s: %s\nRun: orc infra apply %s", wb.ID, wb.Path, workshopID)
 					}
+					layout, source := layouts.LayoutFor(workshopID, wb.RepoID)
 					desired = append(desired, wire.DesiredWorkbench{
-						Name:       wb.Name,
-						Path:       wb.Path,
-						ID:         wb.ID,
-						WorkshopID: workshopID,
+						Name:         wb.Name,
+						Path:         wb.Path,
+						ID:           wb.ID,
+						WorkshopID:   workshopID,
+						Layout:       layout,
+						LayoutSource: source,
 					})
 				}
 			}
@@ -225,12 +254,22 @@ func printApplyPlan(plan *wire.ApplyPlan, workshopID string) {
 		fmt.Printf("Session: %s (will create)\n", plan.SessionName)
 	}
 
-	// Show window summaries
+	// Show window summaries, with the layout diff of workbench windows
 	for _, ws := range plan.WindowSummary {
+		layout := ""
+		if ws.Layout != "" {
+			layout = ", layout: " + ws.Layout
+		}
 		if ws.Healthy {
-			fmt.Printf("Window: %s (%d panes, healthy)\n", ws.Name, ws.PaneCount)
+			fmt.Printf("Window: %s (%d panes, healthy%s)\n", ws.Name, ws.PaneCount, layout)
 		} else {
-			fmt.Printf("Window: %s (%d panes)\n", ws.Name, ws.PaneCount)
+			fmt.Printf("Window: %s (%d panes%s)\n", ws.Name, ws.PaneCount, layout)
+		}
+		for _, role := range ws.MissingPanes {
+			fmt.Printf("  + %s\n", role)
+		}
+		for _, role := range ws.ExtraPanes {
+			fmt.Printf("  ~ %s (not in layout, left alone)\n", role)
 		}
 	}
 
@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+func tmuxMonitorCmd() *cobra.Command {
+	var (
+		interval time.Duration
+		lines    int
+		once     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "monitor [workshop-id]",
+		Short: "Watch workbench panes and flag stuck or crashed agents",
+		Long: `Periodically inspect every pane of a workshop's tmux session and classify it:
+
+  working   output is moving, or the agent reports it is busy
+  waiting   a prompt is on screen (permission prompt, y/n, press enter)
+  idle      a process runs but nothing happens
+  crashed   the agent exited, or the pane's process died
+  shell     only a shell runs
+
+Each state change is printed and recorded as an operational event (source
+pane-monitor; see orc events). States are kept in tmux options, so they survive
+monitor restarts and show up in orc status and the status bar: the summary on
+the right ([1 waiting, 4 working]) and " !waiting" or " !crashed" after the
+name of a window that needs a human.
+
+Run it in a spare pane; it polls until interrupted.
+
+Examples:
+  orc tmux monitor WORK-001
+  orc tmux monitor WORK-001 --interval 10s
+  orc tmux monitor WORK-001 --once    # Classify once and print every pane`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			workshopID := args[0]
+			ctx := NewContext()
+
+			if err := validateEntityID(workshopID, "workshop"); err != nil {
+				return err
+			}
+			workshop, err := wire.WorkshopService().GetWorkshop(ctx, workshopID)
+			if err != nil {
+				return fmt.Errorf("workshop not found: %s", workshopID)
+			}
+			if interval < time.Second {
+				return fmt.Errorf("--interval must be at least 1s")
+			}
+
+			gotmuxAdapter, err := wire.NewGotmuxAdapterWithSocket(resolveFactorySocket(ctx, workshopID))
+			if err != nil {
+				return fmt.Errorf("failed to create gotmux adapter: %w", err)
+			}
+
+			m := &paneMonitor{
+				ctx:        ctx,
+				workshopID: workshopID,
+				session:    workshop.Name,
+				lines:      lines,
+				adapter:    gotmuxAdapter,
+				self:       os.Getenv("TMUX_PANE"),
+			}
+
+			if once {
+				report, err := m.poll()
+				if err != nil {
+					return err
+				}
+				printPaneHealth(report)
+				return nil
+			}
+
+			fmt.Printf("Monitoring %s (%s) every %s, Ctrl-C to stop\n", workshop.Name, workshopID, interval)
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			lastErr := ""
+			for {
+				report, err := m.poll()
+				switch {
+				case err != nil:
+					// Keep polling: the session may come back (orc tmux restore)
+					if err.Error() != lastErr {
+						fmt.Printf("%s  %v\n", time.Now().Format("15:04:05"), err)
+						lastErr = err.Error()
+					}
+				default:
+					lastErr = ""
+					for _, t := range report.Transitions {
+						fmt.Printf("%s  %s\n", time.Now().Format("15:04:05"), t.Change)
+					}
+				}
+				<-ticker.C
+			}
+		},
+	}
+
+	cmd.Flags().DurationVar(&interval, "interval", 5*time.Second, "Time between polls")
+	cmd.Flags().IntVar(&lines, "lines", 40, "Lines of each pane's screen to inspect")
+	cmd.Flags().BoolVar(&once, "once", false, "Poll once, print every pane and exit")
+
+	return cmd
+}
+
+// paneMonitor polls a workshop session and records pane health.
+type paneMonitor struct {
+	ctx        context.Context
+	workshopID string
+	session    string
+	lines      int
+	adapter    *wire.GotmuxAdapter
+	self       string                // The monitor's own pane, left out
+	known      []*primary.PaneHealth // Panes of the previous poll
+}
+
+// poll inspects the panes, classifies them and writes the states back to tmux.
+func (m *paneMonitor) poll() (*primary.PaneHealthReport, error) {
+	panes, err := m.adapter.InspectPanes(m.session, m.lines)
+	if err != nil {
+		return nil, err
+	}
+
+	req := primary.AssessPanesRequest{WorkshopID: m.workshopID, Known: m.known}
+	for _, p := range panes {
+		if p.PaneID == m.self {
+			continue
+		}
+		req.Panes = append(req.Panes, primary.PaneObservation{
+			PaneID:         p.PaneID,
+			Window:         p.Window,
+			Index:          p.Index,
+			Role:           p.Role,
+			BenchID:        p.BenchID,
+			CurrentCommand: p.CurrentCommand,
+			StartCommand:   p.StartCommand,
+			Dead:           p.Dead,
+			Content:        p.Content,
+			PreviousState:  p.State,
+			PreviousDigest: p.Digest,
+		})
+	}
+	report, err := wire.PaneHealthService().AssessPanes(m.ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assess panes: %w", err)
+	}
+	m.known = report.Panes
+
+	updates := make([]wire.PaneHealthUpdate, len(report.Panes))
+	for i, p := range report.Panes {
+		updates[i] = wire.PaneHealthUpdate{PaneID: p.PaneID, State: p.State, Digest: p.Digest}
+	}
+	alerts := make([]wire.WindowAlert, len(report.Windows))
+	for i, w := range report.Windows {
+		alerts[i] = wire.WindowAlert{Window: w.Window}
+		if w.Alert {
+			alerts[i].Alert = w.State
+		}
+	}
+	if err := m.adapter.SetPaneHealth(m.session, updates, alerts, report.Summary); err != nil {
+		return nil, err
+	}
+	if err := m.adapter.ShowPaneHealth(m.session); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// printPaneHealth prints every pane of a report.
+func printPaneHealth(report *primary.PaneHealthReport) {
+	if len(report.Panes) == 0 {
+		fmt.Println("No panes")
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "WINDOW\tPANE\tROLE\tSTATE\tCOMMAND\tPROMPT")
+	fmt.Fprintln(w, "------\t----\t----\t-----\t-------\t------")
+	for _, p := range report.Panes {
+		role := p.Role
+		if role == "" {
+			role = "-"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n", p.Window, p.Index, role, p.State, p.CurrentCommand, p.Prompt)
+	}
+	_ = w.Flush()
+	fmt.Printf("\n%s\n", report.Summary)
+}
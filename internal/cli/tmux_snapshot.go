@@ -0,0 +1,234 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+func tmuxSnapshotCmd() *cobra.Command {
+	var list bool
+
+	cmd := &cobra.Command{
+		Use:   "snapshot [workshop-id]",
+		Short: "Save a workshop's tmux session to the ledger",
+		Long: `Capture a workshop's tmux session and store it in the ledger, so that
+orc tmux restore can rebuild it after a reboot or a tmux server crash.
+
+The snapshot records every window with its layout, and every pane with its
+working directory, start command and @ options (@pane_role, @bench_id,
+@orc_* ...). Hand-made splits are kept, unlike orc tmux apply, which only
+knows the layouts in tmux.json.
+
+Examples:
+  orc tmux snapshot WORK-001          # Save the running session
+  orc tmux snapshot WORK-001 --list   # List saved snapshots`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			workshopID := args[0]
+			ctx := NewContext()
+
+			if err := validateEntityID(workshopID, "workshop"); err != nil {
+				return err
+			}
+			workshop, err := wire.WorkshopService().GetWorkshop(ctx, workshopID)
+			if err != nil {
+				return fmt.Errorf("workshop not found: %s", workshopID)
+			}
+
+			if list {
+				snapshots, err := wire.TmuxSnapshotService().ListSnapshots(ctx, workshopID)
+				if err != nil {
+					return fmt.Errorf("failed to list snapshots: %w", err)
+				}
+				if len(snapshots) == 0 {
+					fmt.Printf("No snapshots for %s\n", workshopID)
+					return nil
+				}
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "ID\tSESSION\tWINDOWS\tPANES\tTAKEN")
+				fmt.Fprintln(w, "--\t-------\t-------\t-----\t-----")
+				for _, s := range snapshots {
+					fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", s.ID, s.SessionName, s.WindowCount, s.PaneCount, s.CreatedAt)
+				}
+				return w.Flush()
+			}
+
+			gotmuxAdapter, err := wire.NewGotmuxAdapterWithSocket(resolveFactorySocket(ctx, workshopID))
+			if err != nil {
+				return fmt.Errorf("failed to create gotmux adapter: %w", err)
+			}
+			snapshot, err := gotmuxAdapter.Snapshot(workshop.Name)
+			if err != nil {
+				return fmt.Errorf("failed to capture session: %w\nHint: Start it with: orc tmux apply %s", err, workshopID)
+			}
+			content, err := json.Marshal(snapshot)
+			if err != nil {
+				return fmt.Errorf("failed to encode snapshot: %w", err)
+			}
+
+			saved, err := wire.TmuxSnapshotService().SaveSnapshot(ctx, primary.SaveTmuxSnapshotRequest{
+				WorkshopID:  workshopID,
+				SessionName: snapshot.Session,
+				WindowCount: len(snapshot.Windows),
+				PaneCount:   snapshot.PaneCount(),
+				Content:     string(content),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to save snapshot: %w", err)
+			}
+
+			fmt.Printf("✓ Saved %s: %s (%d windows, %d panes)\n", saved.ID, saved.SessionName, saved.WindowCount, saved.PaneCount)
+			fmt.Printf("  Restore with: orc tmux restore %s\n", workshopID)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&list, "list", false, "List saved snapshots instead of taking one")
+
+	return cmd
+}
+
+func tmuxRestoreCmd() *cobra.Command {
+	var (
+		snapshotID string
+		yes        bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restore [workshop-id]",
+		Short: "Rebuild a workshop's tmux session from a snapshot",
+		Long: `Rebuild a workshop's tmux session from a snapshot taken with orc tmux snapshot,
+e.g. after a reboot or a tmux server crash.
+
+Missing windows are recreated with their saved layout; each pane is started in
+its saved directory with its saved start command, so agents are relaunched
+with their last command, and its @ options are set again. Windows that already
+exist are left alone. The session is enriched afterwards.
+
+Uses the workshop's newest snapshot unless --snapshot names one.
+
+Without --yes, shows a plan and prompts for confirmation.
+
+Examples:
+  orc tmux restore WORK-001
+  orc tmux restore WORK-001 --snapshot SNAP-004 --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			workshopID := args[0]
+			ctx := NewContext()
+
+			if err := validateEntityID(workshopID, "workshop"); err != nil {
+				return err
+			}
+			workshop, err := wire.WorkshopService().GetWorkshop(ctx, workshopID)
+			if err != nil {
+				return fmt.Errorf("workshop not found: %s", workshopID)
+			}
+
+			var saved *primary.TmuxSnapshot
+			if snapshotID != "" {
+				saved, err = wire.TmuxSnapshotService().GetSnapshot(ctx, snapshotID)
+				if err != nil {
+					return err
+				}
+				if saved.WorkshopID != workshopID {
+					return fmt.Errorf("snapshot %s belongs to %s, not %s", snapshotID, saved.WorkshopID, workshopID)
+				}
+			} else {
+				saved, err = wire.TmuxSnapshotService().GetLatestSnapshot(ctx, workshopID)
+				if err != nil {
+					return fmt.Errorf("failed to get snapshot: %w", err)
+				}
+				if saved == nil {
+					return fmt.Errorf("workshop %s has no snapshots\nHint: Take one with: orc tmux snapshot %s", workshopID, workshopID)
+				}
+			}
+
+			var snapshot wire.SessionSnapshot
+			if err := json.Unmarshal([]byte(saved.Content), &snapshot); err != nil {
+				return fmt.Errorf("failed to decode snapshot %s: %w", saved.ID, err)
+			}
+			// Restore into the workshop's current session name, in case it was renamed
+			snapshot.Session = workshop.Name
+
+			gotmuxAdapter, err := wire.NewGotmuxAdapterWithSocket(resolveFactorySocket(ctx, workshopID))
+			if err != nil {
+				return fmt.Errorf("failed to create gotmux adapter: %w", err)
+			}
+			plan, err := gotmuxAdapter.PlanRestore(&snapshot)
+			if err != nil {
+				return fmt.Errorf("failed to compute plan: %w", err)
+			}
+
+			printRestorePlan(plan, workshopID, saved)
+
+			if len(plan.Create) == 0 {
+				fmt.Println("\nNothing to do.")
+				return nil
+			}
+
+			if !yes {
+				fmt.Print("\nRestore? [y/n] ")
+				reader := bufio.NewReader(os.Stdin)
+				response, _ := reader.ReadString('\n')
+				response = strings.TrimSpace(strings.ToLower(response))
+				if response != "y" && response != "yes" {
+					fmt.Println("Canceled.")
+					return nil
+				}
+			}
+
+			if err := gotmuxAdapter.ExecuteRestore(plan); err != nil {
+				return fmt.Errorf("restore failed: %w", err)
+			}
+
+			fmt.Printf("\n✓ Restored %d windows\n", len(plan.Create))
+			fmt.Printf("  Attach with: orc tmux connect %s\n", workshopID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&snapshotID, "snapshot", "", "Snapshot to restore (default: the newest)")
+	cmd.Flags().BoolVar(&yes, "yes", false, "Restore immediately without confirmation")
+
+	return cmd
+}
+
+// printRestorePlan displays the windows a restore recreates, with the command
+// each pane is relaunched with.
+func printRestorePlan(plan *wire.RestorePlan, workshopID string, saved *primary.TmuxSnapshot) {
+	fmt.Printf("orc tmux restore %s (%s, taken %s)\n", workshopID, saved.ID, saved.CreatedAt)
+
+	if plan.SessionExists {
+		fmt.Printf("Session: %s (exists)\n", plan.Snapshot.Session)
+	} else {
+		fmt.Printf("Session: %s (will create)\n", plan.Snapshot.Session)
+	}
+
+	for _, name := range plan.Existing {
+		fmt.Printf("Window: %s (exists, left alone)\n", name)
+	}
+	for _, w := range plan.Create {
+		fmt.Printf("Window: %s (%d panes, will create)\n", w.Name, len(w.Panes))
+		for _, p := range w.Panes {
+			label := p.Options["@pane_role"]
+			if label == "" {
+				label = fmt.Sprintf("pane %d", p.Index)
+			}
+			command := p.StartCommand
+			if command == "" {
+				command = "(shell)"
+			}
+			fmt.Printf("  + %s: %s in %s\n", label, command, p.Path)
+		}
+	}
+}
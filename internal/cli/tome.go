@@ -158,12 +158,13 @@ var tomeCloseCmd = &cobra.Command{
 		ctx := NewContext()
 		tomeID := args[0]
 
-		err := wire.TomeService().CloseTome(ctx, tomeID)
+		lifecycle, err := wire.TomeService().CloseTome(ctx, tomeID)
 		if err != nil {
 			return fmt.Errorf("failed to close tome: %w", err)
 		}
 
 		fmt.Printf("✓ Tome %s marked as closed\n", tomeID)
+		printCommissionLifecycle(lifecycle)
 		return nil
 	},
 }
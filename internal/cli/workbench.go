@@ -3,10 +3,14 @@ package cli
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
+	"github.com/example/orc/internal/config"
+	"github.com/example/orc/internal/db"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/wire"
 )
@@ -27,6 +31,10 @@ func WorkbenchCmd() *cobra.Command {
 	cmd.AddCommand(workbenchArchiveCmd())
 	cmd.AddCommand(workbenchCheckoutCmd())
 	cmd.AddCommand(workbenchStatusCmd())
+	cmd.AddCommand(workbenchSyncCmd())
+	cmd.AddCommand(workbenchBootstrapCmd())
+	cmd.AddCommand(workbenchRelocateCmd())
+	cmd.AddCommand(workbenchSparseCmd())
 
 	return cmd
 }
@@ -34,22 +42,32 @@ func WorkbenchCmd() *cobra.Command {
 func workbenchCreateCmd() *cobra.Command {
 	var workshopID string
 	var repoID string
+	var sparsePaths []string
 
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new workbench in a workshop",
 		Long: `Create a new workbench with database record, git worktree, and config file.
 
-The workbench name is auto-generated as {repo}-{number} based on the
-linked repo. The workbench will be located at ~/wb/<name>.
+The workbench name is auto-generated from the linked repo, as {repo}-{number}
+by default. The workbench will be located at ~/wb/<name> unless the workspace
+layout says otherwise (see 'orc workbench relocate').
 
 This command creates:
 - Database record
 - Git worktree (or directory if no repo)
 - .orc/config.json file
 
+A new worktree is then bootstrapped with the repo's setup steps, if any
+(see 'orc repo bootstrap' and .orc/repo.json).
+
+With --sparse, the worktree only checks out the given directories (git
+cone-mode sparse checkout), plus files at the repository root. Without it,
+the repo's sparse paths apply (see 'orc repo sparse'), else a full checkout.
+
 Examples:
-  orc workbench create --workshop WORK-001 --repo-id REPO-001`,
+  orc workbench create --workshop WORK-001 --repo-id REPO-001
+  orc workbench create --workshop WORK-001 --repo-id REPO-001 --sparse services/api --sparse libs/auth`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := NewContext()
@@ -64,9 +82,10 @@ Examples:
 			// Create workbench via service (creates DB, worktree, and config immediately)
 			// Name is auto-generated from repo name + bench number
 			resp, err := wire.WorkbenchService().CreateWorkbench(ctx, primary.CreateWorkbenchRequest{
-				Name:       "", // Auto-generated
-				WorkshopID: workshopID,
-				RepoID:     repoID,
+				Name:        "", // Auto-generated
+				WorkshopID:  workshopID,
+				RepoID:      repoID,
+				SparsePaths: sparsePaths,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to create workbench: %w", err)
@@ -76,6 +95,17 @@ Examples:
 			fmt.Printf("✓ Created workbench %s: %s\n", workbench.ID, workbench.Name)
 			fmt.Printf("  Workshop: %s\n", workbench.WorkshopID)
 			fmt.Printf("  Path: %s\n", workbench.Path)
+			if len(workbench.SparsePaths) > 0 {
+				fmt.Printf("  Sparse: %s\n", strings.Join(workbench.SparsePaths, ", "))
+			}
+
+			if resp.Bootstrap != nil {
+				fmt.Println()
+				printBootstrapResult(resp.Bootstrap)
+				if resp.Bootstrap.Failed {
+					fmt.Printf("\nFix the failing step, then re-run: orc workbench bootstrap %s\n", workbench.ID)
+				}
+			}
 
 			return nil
 		},
@@ -83,6 +113,7 @@ Examples:
 
 	cmd.Flags().StringVarP(&workshopID, "workshop", "w", "", "Workshop ID (required)")
 	cmd.Flags().StringVar(&repoID, "repo-id", "", "Repo ID for name generation (required)")
+	cmd.Flags().StringArrayVar(&sparsePaths, "sparse", nil, "Only check out this directory (repeatable; cone-mode sparse checkout)")
 	_ = cmd.MarkFlagRequired("workshop")
 	_ = cmd.MarkFlagRequired("repo-id")
 
@@ -162,6 +193,12 @@ func workbenchShowCmd() *cobra.Command {
 			if workbench.CurrentBranch != "" {
 				fmt.Printf("Current Branch: %s\n", workbench.CurrentBranch)
 			}
+			if len(workbench.SparsePaths) > 0 {
+				fmt.Println("Sparse Paths:")
+				for _, path := range workbench.SparsePaths {
+					fmt.Printf("  %s\n", path)
+				}
+			}
 			fmt.Printf("Created: %s\n", workbench.CreatedAt)
 
 			return nil
@@ -350,3 +387,356 @@ Examples:
 
 	return cmd
 }
+
+func workbenchSyncCmd() *cobra.Command {
+	var workshopID string
+	var all bool
+	var strategy string
+	var autostash bool
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Rebase workbench branches onto their repo's default branch",
+		Long: `Fetch and rebase (or merge) each active workbench's branch onto its
+repo's default branch, so branches don't drift until ship-deploy time.
+When the repo's configured default branch doesn't exist (e.g. a master-based
+repo), the branch git reports as the default is used.
+
+Branches behind their upstream are skipped: pull first, so the sync doesn't
+leave pushed commits out. Workbenches with uncommitted changes are skipped unless --autostash is given,
+in which case changes are stashed around the sync and reapplied.
+On conflicts the rebase/merge is aborted and the conflicting files are listed;
+the workbench is left exactly as it was.
+
+Examples:
+  orc workbench sync --all
+  orc workbench sync --workshop WORK-001
+  orc workbench sync --all --strategy merge --autostash`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			if all == (workshopID != "") {
+				return fmt.Errorf("specify exactly one of --all or --workshop")
+			}
+
+			results, err := wire.WorkbenchService().SyncWorkbenches(ctx, primary.SyncWorkbenchesRequest{
+				WorkshopID: workshopID,
+				Strategy:   strategy,
+				Autostash:  autostash,
+			})
+			if err != nil {
+				return fmt.Errorf("sync failed: %w", err)
+			}
+
+			if len(results) == 0 {
+				fmt.Println("No active workbenches to sync.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "ID\tNAME\tBRANCH\tRESULT\tDETAIL")
+			fmt.Fprintln(w, "--\t----\t------\t------\t------")
+
+			var conflicted []*primary.WorkbenchSyncResult
+			for _, r := range results {
+				detail := r.Reason
+				switch r.Status {
+				case primary.SyncStatusRebased, primary.SyncStatusMerged:
+					detail = fmt.Sprintf("%d commit(s) from %s", r.Behind, r.BaseRef)
+				case primary.SyncStatusUpToDate:
+					detail = r.BaseRef
+				case primary.SyncStatusConflicts:
+					conflicted = append(conflicted, r)
+					detail = fmt.Sprintf("%d file(s), %s", len(r.Conflicts), r.Reason)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.WorkbenchID, r.WorkbenchName, r.Branch, r.Status, detail)
+			}
+			w.Flush()
+
+			for _, r := range conflicted {
+				fmt.Printf("\n%s (%s) conflicts with %s:\n", r.WorkbenchID, r.Branch, r.BaseRef)
+				for _, f := range r.Conflicts {
+					fmt.Printf("  %s\n", f)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "Sync active workbenches in every workshop")
+	cmd.Flags().StringVarP(&workshopID, "workshop", "w", "", "Sync active workbenches in one workshop")
+	cmd.Flags().StringVar(&strategy, "strategy", primary.SyncStrategyRebase, "How to integrate the default branch: rebase or merge")
+	cmd.Flags().BoolVar(&autostash, "autostash", false, "Stash uncommitted changes around the sync instead of skipping dirty workbenches")
+
+	return cmd
+}
+
+func workbenchBootstrapCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "bootstrap [workbench-id]",
+		Short: "Re-run the repo's setup steps in a workbench",
+		Long: `Run the linked repo's bootstrap steps (e.g. make deps, npm ci) in a workbench.
+
+Steps run automatically when a workbench's worktree is created; use this to
+re-run them after a failure or after the steps change. Only steps on the
+repo record run: steps checked in to .orc/repo.json are listed until you
+review and adopt them with 'orc repo bootstrap REPO-xxx --from-file'.
+Each step's output is recorded as an operational event (orc events).
+
+Examples:
+  orc workbench bootstrap BENCH-001`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			result, err := wire.WorkbenchService().BootstrapWorkbench(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to bootstrap workbench: %w", err)
+			}
+
+			printBootstrapResult(result)
+			if result.Failed {
+				return fmt.Errorf("bootstrap of %s failed", result.WorkbenchID)
+			}
+			return nil
+		},
+	}
+}
+
+func workbenchSparseCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sparse [workbench-id] [list|add|remove] [path...]",
+		Short: "List or change the directories a workbench checks out",
+		Long: `List or change a workbench's sparse checkout: the directories its worktree
+checks out (git cone mode), in addition to files at the repository root.
+
+add and remove update the worktree immediately. Removing the last path
+restores a full checkout. Files outside the new set that have local changes
+are left in place by git.
+
+Examples:
+  orc workbench sparse BENCH-001
+  orc workbench sparse BENCH-001 add services/billing
+  orc workbench sparse BENCH-001 remove services/api libs/legacy`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			workbenchID := args[0]
+
+			action := "list"
+			if len(args) > 1 {
+				action = args[1]
+			}
+			paths := args[min(len(args), 2):]
+
+			req := primary.UpdateSparsePathsRequest{WorkbenchID: workbenchID}
+			switch action {
+			case "list":
+				if len(paths) > 0 {
+					return fmt.Errorf("list takes no paths")
+				}
+				workbench, err := wire.WorkbenchService().GetWorkbench(ctx, workbenchID)
+				if err != nil {
+					return fmt.Errorf("workbench not found: %w", err)
+				}
+				printSparsePaths(workbench.ID, workbench.SparsePaths)
+				return nil
+			case "add":
+				req.Add = paths
+			case "remove":
+				req.Remove = paths
+			default:
+				return fmt.Errorf("unknown action %q: use list, add or remove", action)
+			}
+			if len(paths) == 0 {
+				return fmt.Errorf("%s requires at least one path", action)
+			}
+
+			result, err := wire.WorkbenchService().UpdateSparsePaths(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to update sparse checkout: %w", err)
+			}
+			fmt.Printf("✓ Updated sparse checkout of %s\n", workbenchID)
+			printSparsePaths(workbenchID, result)
+			return nil
+		},
+	}
+}
+
+// printSparsePaths prints a workbench's sparse checkout directories.
+func printSparsePaths(workbenchID string, paths []string) {
+	if len(paths) == 0 {
+		fmt.Printf("%s has a full checkout.\n", workbenchID)
+		return
+	}
+	fmt.Printf("Sparse paths for %s:\n", workbenchID)
+	for _, path := range paths {
+		fmt.Printf("  %s\n", path)
+	}
+}
+
+// printBootstrapResult prints each bootstrap step, with the output of a failed step.
+// Steps pending adoption from .orc/repo.json are listed with the command to adopt them.
+func printBootstrapResult(result *primary.BootstrapResult) {
+	if len(result.Pending) > 0 {
+		fmt.Printf("Bootstrap not run: %s asks to run %d step(s):\n", result.Source, len(result.Pending))
+		for _, command := range result.Pending {
+			fmt.Printf("  - %s\n", command)
+		}
+		fmt.Printf("Review them, then adopt with: orc repo bootstrap %s --from-file\n", result.RepoID)
+		return
+	}
+	fmt.Printf("Bootstrap (%s):\n", result.Source)
+	for _, step := range result.Steps {
+		if step.Success {
+			fmt.Printf("  ✓ %s (%s)\n", step.Command, step.Duration)
+			continue
+		}
+		fmt.Printf("  ✗ %s (%s)\n", step.Command, step.Duration)
+		output := strings.TrimRight(step.Output, "\n")
+		if output == "" {
+			continue
+		}
+		for _, line := range strings.Split(output, "\n") {
+			fmt.Printf("      %s\n", line)
+		}
+	}
+}
+
+func workbenchRelocateCmd() *cobra.Command {
+	var root, layout, namePattern, factoryID string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "relocate",
+		Short: "Change the workbench root or layout and move existing workbenches",
+		Long: `Change where workbenches live and move the existing ones to match.
+
+The workspace layout is stored in workspace.json next to the ORC database
+(~/.orc/workspace.json) and can be set globally or per factory:
+
+  --root          Directory holding workbenches (default ~/wb)
+  --layout        flat: {root}/{name}, by-repo: {root}/{repo}/{name}
+  --name-pattern  Names of new workbenches, e.g. {repo}-{number} (the default)
+
+Worktrees are moved with 'git worktree move' (copied and repaired when the new
+root is on another volume); workbenches without a repo are moved as plain
+directories. The layout is only saved once every workbench is in place, and
+re-running after a failure picks up where it stopped. Use this command rather
+than editing workspace.json by hand, which would strand existing worktrees.
+
+Existing workbench names are kept; the name pattern applies to new workbenches.
+
+Examples:
+  orc workbench relocate --root /Volumes/code/wb --dry-run
+  orc workbench relocate --root /Volumes/code/wb --layout by-repo
+  orc workbench relocate --factory FACT-002 --root /mnt/fast/wb
+  orc workbench relocate --name-pattern "wb{number}-{repo}"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			if root == "" && layout == "" && namePattern == "" {
+				return fmt.Errorf("specify at least one of --root, --layout or --name-pattern")
+			}
+			if factoryID != "" {
+				if _, err := wire.FactoryService().GetFactory(ctx, factoryID); err != nil {
+					return fmt.Errorf("invalid --factory: %w", err)
+				}
+			}
+
+			path, err := db.GetDBPath()
+			if err != nil {
+				return fmt.Errorf("failed to resolve database path: %w", err)
+			}
+			orcDir := filepath.Dir(path)
+			cfg, err := config.LoadWorkspaceConfig(orcDir)
+			if err != nil {
+				return err
+			}
+
+			// Apply the flags to the global layout or to one factory's overrides
+			edit := cfg.WorkspaceLayout
+			if factoryID != "" {
+				edit = cfg.Factories[factoryID]
+			}
+			if root != "" {
+				if edit.WorktreeRoot, err = filepath.Abs(config.ExpandHome(root)); err != nil {
+					return fmt.Errorf("invalid --root: %w", err)
+				}
+			}
+			if layout != "" {
+				edit.Layout = layout
+			}
+			if namePattern != "" {
+				edit.NamePattern = namePattern
+			}
+			if factoryID == "" {
+				cfg.WorkspaceLayout = edit
+			} else {
+				if cfg.Factories == nil {
+					cfg.Factories = make(map[string]config.WorkspaceLayout)
+				}
+				cfg.Factories[factoryID] = edit
+			}
+
+			req := primary.RelocateWorkbenchesRequest{Default: workbenchLayout(cfg.LayoutFor("")), DryRun: dryRun}
+			for id := range cfg.Factories {
+				if req.Factories == nil {
+					req.Factories = make(map[string]primary.WorkbenchLayout)
+				}
+				req.Factories[id] = workbenchLayout(cfg.LayoutFor(id))
+			}
+
+			results, err := wire.WorkbenchService().RelocateWorkbenches(ctx, req)
+			if err != nil {
+				return fmt.Errorf("relocation failed: %w", err)
+			}
+
+			blocked := 0
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "ID\tNAME\tRESULT\tPATH")
+			fmt.Fprintln(w, "--\t----\t------\t----")
+			for _, r := range results {
+				path := r.To
+				switch r.Status {
+				case primary.RelocateMoved, primary.RelocateWouldMove:
+					path = fmt.Sprintf("%s → %s", r.From, r.To)
+				case primary.RelocateConflict, primary.RelocateFailed:
+					blocked++
+					path = fmt.Sprintf("%s → %s: %s", r.From, r.To, r.Reason)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.WorkbenchID, r.WorkbenchName, r.Status, path)
+			}
+			w.Flush()
+
+			if dryRun {
+				fmt.Println("\nDry run: nothing moved and the layout was not saved.")
+				return nil
+			}
+			if blocked > 0 {
+				return fmt.Errorf("%d workbench(es) could not be moved; the layout was not saved. Fix them and re-run", blocked)
+			}
+
+			if err := config.SaveWorkspaceConfig(orcDir, cfg); err != nil {
+				return err
+			}
+			fmt.Printf("\n✓ Saved layout to %s\n", filepath.Join(orcDir, config.WorkspaceFileName))
+			fmt.Println("Open workshop sessions still point at the old paths; re-apply them with: orc tmux apply <workshop-id>")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&root, "root", "", "Directory holding workbenches")
+	cmd.Flags().StringVar(&layout, "layout", "", "Directory layout: flat or by-repo")
+	cmd.Flags().StringVar(&namePattern, "name-pattern", "", "Pattern for new workbench names ({repo}, {number})")
+	cmd.Flags().StringVar(&factoryID, "factory", "", "Set the layout of one factory instead of the global layout")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the moves without making them")
+
+	return cmd
+}
+
+func workbenchLayout(l config.WorkspaceLayout) primary.WorkbenchLayout {
+	return primary.WorkbenchLayout{WorktreeRoot: l.WorktreeRoot, Layout: l.Layout, NamePattern: l.NamePattern}
+}
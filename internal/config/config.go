@@ -122,13 +122,13 @@ func GetRoleFromPlaceID(placeID string) string {
 	return ""
 }
 
-// DefaultWorkspacePath returns the default workspace path for a commission.
+// DefaultWorkspacePath returns the workspace path for a commission under the default
+// repo root. Use WorkspaceConfig.CommissionWorkspacePath to honor workspace.json.
 func DefaultWorkspacePath(commissionID string) (string, error) {
-	home, err := os.UserHomeDir()
-	if err != nil {
+	if _, err := os.UserHomeDir(); err != nil {
 		return "", fmt.Errorf("failed to get home directory: %w", err)
 	}
-	return filepath.Join(home, "src", "commissions", commissionID), nil
+	return DefaultWorkspaceConfig().CommissionWorkspacePath(commissionID), nil
 }
 
 // workshopIDPattern matches WORK-XXX format in path segments
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PRFileName is the pull request provider file, stored next to the ORC database.
+const PRFileName = "pr.json"
+
+// Pull request provider types.
+const (
+	ProviderGitHub = "github"
+	ProviderGitLab = "gitlab"
+	ProviderGitea  = "gitea"
+)
+
+// PRProviderConfig tells ORC how to reach the pull requests of repositories hosted on Host.
+// The API token is read from the TokenEnv environment variable; it is never stored.
+type PRProviderConfig struct {
+	Host     string `json:"host"`                // Remote host, e.g., github.com or git.example.com
+	Type     string `json:"type"`                // github, gitlab or gitea
+	APIURL   string `json:"api_url,omitempty"`   // REST API base; derived from host and type when empty
+	TokenEnv string `json:"token_env,omitempty"` // Defaults to GITHUB_TOKEN, GITLAB_TOKEN or GITEA_TOKEN
+}
+
+// PRCreateConfig holds the defaults applied by orc pr create when opening a PR.
+type PRCreateConfig struct {
+	Draft     bool     `json:"draft,omitempty"`     // Open PRs as drafts unless --ready is passed
+	Labels    []string `json:"labels,omitempty"`    // Added to every PR, along with --label
+	Reviewers []string `json:"reviewers,omitempty"` // Requested on every PR, along with --reviewer
+}
+
+// PRConfig lists the pull request providers by host and the defaults for new PRs.
+// github.com and gitlab.com are known without configuration; entries here override them.
+type PRConfig struct {
+	Providers []PRProviderConfig `json:"providers,omitempty"`
+	Create    PRCreateConfig     `json:"create,omitempty"`
+}
+
+// DefaultPRConfig returns the configuration used when no pr.json exists.
+func DefaultPRConfig() *PRConfig {
+	return &PRConfig{}
+}
+
+// LoadPRConfig reads pr.json from the ORC data directory (e.g., ~/.orc).
+// A missing file yields DefaultPRConfig.
+func LoadPRConfig(orcDir string) (*PRConfig, error) {
+	cfg := DefaultPRConfig()
+
+	data, err := os.ReadFile(filepath.Join(orcDir, PRFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("failed to read PR config: %w", err)
+	}
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse PR config: %w", err)
+	}
+	for i, p := range cfg.Providers {
+		if p.Host == "" {
+			return nil, fmt.Errorf("invalid %s: provider %d has no host", PRFileName, i+1)
+		}
+		switch p.Type {
+		case ProviderGitHub, ProviderGitLab, ProviderGitea:
+		default:
+			return nil, fmt.Errorf("invalid %s: provider %s has unknown type %q (use github, gitlab or gitea)", PRFileName, p.Host, p.Type)
+		}
+	}
+
+	return cfg, nil
+}
+
+// ResolvedProviders returns every known provider with defaults filled in: the configured
+// ones first, then github.com and gitlab.com unless configured explicitly.
+func (c *PRConfig) ResolvedProviders() []PRProviderConfig {
+	builtin := []PRProviderConfig{
+		{Host: "github.com", Type: ProviderGitHub},
+		{Host: "gitlab.com", Type: ProviderGitLab},
+	}
+
+	seen := make(map[string]bool)
+	var providers []PRProviderConfig
+	for _, p := range append(append([]PRProviderConfig{}, c.Providers...), builtin...) {
+		host := strings.ToLower(p.Host)
+		if seen[host] {
+			continue
+		}
+		seen[host] = true
+		p.Host = host
+		providers = append(providers, p.withDefaults())
+	}
+	return providers
+}
+
+// withDefaults fills in the API URL and token variable of a provider.
+func (p PRProviderConfig) withDefaults() PRProviderConfig {
+	if p.APIURL == "" {
+		switch {
+		case p.Type == ProviderGitHub && p.Host == "github.com":
+			p.APIURL = "https://api.github.com"
+		case p.Type == ProviderGitHub:
+			p.APIURL = "https://" + p.Host + "/api/v3" // GitHub Enterprise Server
+		case p.Type == ProviderGitLab:
+			p.APIURL = "https://" + p.Host + "/api/v4"
+		case p.Type == ProviderGitea:
+			p.APIURL = "https://" + p.Host + "/api/v1"
+		}
+	}
+	p.APIURL = strings.TrimSuffix(p.APIURL, "/")
+	if p.TokenEnv == "" {
+		p.TokenEnv = strings.ToUpper(p.Type) + "_TOKEN"
+	}
+	return p
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPRConfig_ResolvedProvidersDefaults(t *testing.T) {
+	cfg, err := LoadPRConfig(t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadPRConfig failed: %v", err)
+	}
+
+	providers := cfg.ResolvedProviders()
+	if len(providers) != 2 {
+		t.Fatalf("expected github.com and gitlab.com, got %+v", providers)
+	}
+	if p := providers[0]; p.Host != "github.com" || p.APIURL != "https://api.github.com" || p.TokenEnv != "GITHUB_TOKEN" {
+		t.Errorf("github.com = %+v", p)
+	}
+	if p := providers[1]; p.Host != "gitlab.com" || p.APIURL != "https://gitlab.com/api/v4" || p.TokenEnv != "GITLAB_TOKEN" {
+		t.Errorf("gitlab.com = %+v", p)
+	}
+}
+
+func TestPRConfig_ConfiguredProviders(t *testing.T) {
+	dir := t.TempDir()
+	content := `{"providers": [
+		{"host": "git.example.com", "type": "gitea"},
+		{"host": "GitHub.com", "type": "github", "token_env": "ORC_GH_TOKEN"},
+		{"host": "ghe.example.com", "type": "github", "api_url": "https://ghe.example.com/api/v3/"}
+	], "create": {"draft": true, "labels": ["orc"], "reviewers": ["alice"]}}`
+	if err := os.WriteFile(filepath.Join(dir, PRFileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadPRConfig(dir)
+	if err != nil {
+		t.Fatalf("LoadPRConfig failed: %v", err)
+	}
+	if !cfg.Create.Draft || len(cfg.Create.Labels) != 1 || cfg.Create.Reviewers[0] != "alice" {
+		t.Errorf("Create = %+v", cfg.Create)
+	}
+	providers := cfg.ResolvedProviders()
+	if len(providers) != 4 {
+		t.Fatalf("expected 3 configured providers plus gitlab.com, got %+v", providers)
+	}
+	if p := providers[0]; p.APIURL != "https://git.example.com/api/v1" || p.TokenEnv != "GITEA_TOKEN" {
+		t.Errorf("gitea = %+v", p)
+	}
+	if p := providers[1]; p.Host != "github.com" || p.TokenEnv != "ORC_GH_TOKEN" || p.APIURL != "https://api.github.com" {
+		t.Errorf("configured github.com should override the builtin, got %+v", p)
+	}
+	if p := providers[2]; p.APIURL != "https://ghe.example.com/api/v3" {
+		t.Errorf("ghe = %+v", p)
+	}
+}
+
+func TestLoadPRConfig_InvalidType(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, PRFileName), []byte(`{"providers": [{"host": "x.example.com", "type": "bitbucket"}]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPRConfig(dir); err == nil {
+		t.Error("expected error for unknown provider type")
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// RetentionFileName is the retention policy file, stored next to the ORC database.
+const RetentionFileName = "retention.json"
+
+// RetentionPolicy controls how long data stays in the main ledger.
+// A value of 0 disables that step.
+type RetentionPolicy struct {
+	ArchiveShipmentsAfterDays    int  `json:"archive_shipments_after_days"`     // Closed shipments move to the archive ledger
+	PruneEventsAfterDays         int  `json:"prune_events_after_days"`          // Operational events are deleted; audit events are kept
+	CompactHookPayloadsAfterDays int  `json:"compact_hook_payloads_after_days"` // Hook event payloads are dropped, summaries kept
+	RunOnStartup                 bool `json:"run_on_startup"`                   // Run maintenance opportunistically from any orc command
+}
+
+// DefaultRetentionPolicy returns the policy used when no retention file exists.
+func DefaultRetentionPolicy() *RetentionPolicy {
+	return &RetentionPolicy{
+		ArchiveShipmentsAfterDays:    60,
+		PruneEventsAfterDays:         30,
+		CompactHookPayloadsAfterDays: 7,
+		RunOnStartup:                 false,
+	}
+}
+
+// LoadRetentionPolicy reads retention.json from the ORC data directory (e.g., ~/.orc).
+// Missing file or missing fields fall back to DefaultRetentionPolicy values.
+func LoadRetentionPolicy(orcDir string) (*RetentionPolicy, error) {
+	policy := DefaultRetentionPolicy()
+
+	data, err := os.ReadFile(filepath.Join(orcDir, RetentionFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return policy, nil
+		}
+		return nil, fmt.Errorf("failed to read retention policy: %w", err)
+	}
+
+	if err := json.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("failed to parse retention policy: %w", err)
+	}
+
+	if policy.ArchiveShipmentsAfterDays < 0 || policy.PruneEventsAfterDays < 0 || policy.CompactHookPayloadsAfterDays < 0 {
+		return nil, fmt.Errorf("invalid retention policy: day values must not be negative")
+	}
+
+	return policy, nil
+}
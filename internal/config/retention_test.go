@@ -0,0 +1,55 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadRetentionPolicy_DefaultsWhenMissing(t *testing.T) {
+	policy, err := LoadRetentionPolicy(t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadRetentionPolicy failed: %v", err)
+	}
+
+	if *policy != *DefaultRetentionPolicy() {
+		t.Errorf("expected defaults, got %+v", policy)
+	}
+}
+
+func TestLoadRetentionPolicy_PartialOverride(t *testing.T) {
+	dir := t.TempDir()
+	content := `{"archive_shipments_after_days": 90, "compact_hook_payloads_after_days": 0, "run_on_startup": true}`
+	if err := os.WriteFile(filepath.Join(dir, RetentionFileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	policy, err := LoadRetentionPolicy(dir)
+	if err != nil {
+		t.Fatalf("LoadRetentionPolicy failed: %v", err)
+	}
+
+	if policy.ArchiveShipmentsAfterDays != 90 {
+		t.Errorf("expected archive days 90, got %d", policy.ArchiveShipmentsAfterDays)
+	}
+	if policy.PruneEventsAfterDays != 30 {
+		t.Errorf("expected default prune days 30, got %d", policy.PruneEventsAfterDays)
+	}
+	if policy.CompactHookPayloadsAfterDays != 0 {
+		t.Errorf("expected compaction disabled, got %d", policy.CompactHookPayloadsAfterDays)
+	}
+	if !policy.RunOnStartup {
+		t.Error("expected run_on_startup to be true")
+	}
+}
+
+func TestLoadRetentionPolicy_RejectsNegative(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, RetentionFileName), []byte(`{"prune_events_after_days": -1}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadRetentionPolicy(dir); err == nil {
+		t.Error("expected error for negative retention value")
+	}
+}
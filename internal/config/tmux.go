@@ -0,0 +1,195 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// TmuxFileName is the tmux layout file, stored next to the ORC database.
+const TmuxFileName = "tmux.json"
+
+// GoblinPaneRole is the @pane_role of the pane running the workbench agent.
+// Every layout has exactly one; its command defaults to "orc connect".
+const GoblinPaneRole = "goblin"
+
+// GoblinPaneCommand is the command of the goblin pane unless the layout sets one.
+const GoblinPaneCommand = "orc connect"
+
+// Pane split directions, relative to the pane being split.
+const (
+	SplitRight = "right"
+	SplitLeft  = "left"
+	SplitBelow = "below"
+	SplitAbove = "above"
+)
+
+// TmuxPane is one pane of a workbench window. Panes are created in order: the
+// first fills the window, each later one splits an earlier pane.
+type TmuxPane struct {
+	Role    string            `json:"role"`              // Set as @pane_role; unique within the layout
+	Command string            `json:"command,omitempty"` // Start command; empty for a shell
+	Split   string            `json:"split,omitempty"`   // right, left, below or above; not set on the first pane
+	Target  string            `json:"target,omitempty"`  // Role of the pane to split; defaults to the previous pane
+	Size    string            `json:"size,omitempty"`    // Cells ("20") or a percentage ("30%") of the split pane
+	Dir     string            `json:"dir,omitempty"`     // Working directory relative to the workbench
+	Env     map[string]string `json:"env,omitempty"`     // Extra environment for the pane
+}
+
+// TmuxLayout describes the panes of a workbench window.
+type TmuxLayout struct {
+	Panes []TmuxPane `json:"panes"`
+}
+
+// TmuxConfig holds the global workbench window layout plus per-workshop and
+// per-repo overrides. The most specific layout wins: workshop, then repo, then
+// global, then DefaultTmuxLayout.
+type TmuxConfig struct {
+	Layout    *TmuxLayout            `json:"layout,omitempty"`
+	Workshops map[string]*TmuxLayout `json:"workshops,omitempty"` // Keyed by workshop ID (WORK-xxx)
+	Repos     map[string]*TmuxLayout `json:"repos,omitempty"`     // Keyed by repo ID (REPO-xxx)
+}
+
+// DefaultTmuxLayout is a single goblin pane running orc connect.
+func DefaultTmuxLayout() *TmuxLayout {
+	return &TmuxLayout{Panes: []TmuxPane{{Role: GoblinPaneRole, Command: GoblinPaneCommand}}}
+}
+
+var (
+	paneSizeRe = regexp.MustCompile(`^[1-9][0-9]*%?$`)
+	paneRoleRe = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+)
+
+// LoadTmuxConfig reads tmux.json from the ORC data directory (e.g., ~/.orc).
+// A missing file yields an empty config, so every workbench gets DefaultTmuxLayout.
+func LoadTmuxConfig(orcDir string) (*TmuxConfig, error) {
+	cfg := &TmuxConfig{}
+
+	data, err := os.ReadFile(filepath.Join(orcDir, TmuxFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("failed to read tmux config: %w", err)
+	}
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse tmux config: %w", err)
+	}
+	if cfg.Layout != nil {
+		if err := cfg.Layout.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid %s: layout: %w", TmuxFileName, err)
+		}
+	}
+	for _, scope := range []map[string]*TmuxLayout{cfg.Workshops, cfg.Repos} {
+		for _, id := range sortedLayoutKeys(scope) {
+			if err := scope[id].Validate(); err != nil {
+				return nil, fmt.Errorf("invalid %s: %s: %w", TmuxFileName, id, err)
+			}
+		}
+	}
+
+	return cfg, nil
+}
+
+// LayoutFor returns the layout of a workbench window and where it came from
+// ("workshop WORK-001", "repo REPO-002", "global" or "default").
+func (c *TmuxConfig) LayoutFor(workshopID, repoID string) (*TmuxLayout, string) {
+	if layout, ok := c.Workshops[workshopID]; ok && workshopID != "" {
+		return layout, "workshop " + workshopID
+	}
+	if layout, ok := c.Repos[repoID]; ok && repoID != "" {
+		return layout, "repo " + repoID
+	}
+	if c.Layout != nil {
+		return c.Layout, "global"
+	}
+	return DefaultTmuxLayout(), "default"
+}
+
+// Validate checks that a layout can be built: unique roles, exactly one goblin
+// pane, and every split naming a valid direction and an earlier pane.
+func (l *TmuxLayout) Validate() error {
+	if l == nil || len(l.Panes) == 0 {
+		return fmt.Errorf("layout has no panes")
+	}
+	seen := make(map[string]bool, len(l.Panes))
+	goblins := 0
+	for i, pane := range l.Panes {
+		if !paneRoleRe.MatchString(pane.Role) {
+			return fmt.Errorf("pane %d: invalid role %q (use lowercase letters, digits, - and _)", i+1, pane.Role)
+		}
+		if seen[pane.Role] {
+			return fmt.Errorf("pane %d: duplicate role %q", i+1, pane.Role)
+		}
+		if pane.Role == GoblinPaneRole {
+			goblins++
+		}
+		if i == 0 {
+			if pane.Split != "" || pane.Target != "" || pane.Size != "" {
+				return fmt.Errorf("pane %s: the first pane fills the window and cannot set split, target or size", pane.Role)
+			}
+		} else {
+			switch pane.Split {
+			case SplitRight, SplitLeft, SplitBelow, SplitAbove:
+			default:
+				return fmt.Errorf("pane %s: invalid split %q (use right, left, below or above)", pane.Role, pane.Split)
+			}
+			if pane.Target != "" && !seen[pane.Target] {
+				return fmt.Errorf("pane %s: target %q is not an earlier pane", pane.Role, pane.Target)
+			}
+		}
+		if pane.Size != "" && !paneSizeRe.MatchString(pane.Size) {
+			return fmt.Errorf("pane %s: invalid size %q (use cells, e.g. 20, or a percentage, e.g. 30%%)", pane.Role, pane.Size)
+		}
+		if dir := filepath.Clean(pane.Dir); filepath.IsAbs(dir) || dir == ".." || strings.HasPrefix(dir, "../") {
+			return fmt.Errorf("pane %s: dir %q must be inside the workbench", pane.Role, pane.Dir)
+		}
+		seen[pane.Role] = true
+	}
+	if goblins != 1 {
+		return fmt.Errorf("layout needs exactly one %q pane", GoblinPaneRole)
+	}
+	return nil
+}
+
+// PaneCommand is the start command of a pane, defaulting the goblin pane to
+// orc connect.
+func (p TmuxPane) PaneCommand() string {
+	if p.Command == "" && p.Role == GoblinPaneRole {
+		return GoblinPaneCommand
+	}
+	return p.Command
+}
+
+// SplitTarget is the role of the pane this pane splits: its target, or the pane
+// before it.
+func (l *TmuxLayout) SplitTarget(i int) string {
+	if l.Panes[i].Target != "" || i == 0 {
+		return l.Panes[i].Target
+	}
+	return l.Panes[i-1].Role
+}
+
+// Roles lists the pane roles of a layout in order.
+func (l *TmuxLayout) Roles() []string {
+	roles := make([]string, len(l.Panes))
+	for i, pane := range l.Panes {
+		roles[i] = pane.Role
+	}
+	return roles
+}
+
+// sortedLayoutKeys returns the keys of a layout map in order, for stable errors.
+func sortedLayoutKeys(m map[string]*TmuxLayout) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
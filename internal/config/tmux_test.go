@@ -0,0 +1,115 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadTmuxConfig_DefaultWhenMissing(t *testing.T) {
+	cfg, err := LoadTmuxConfig(t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadTmuxConfig failed: %v", err)
+	}
+
+	layout, source := cfg.LayoutFor("WORK-001", "REPO-001")
+	if source != "default" || len(layout.Panes) != 1 || layout.Panes[0].PaneCommand() != "orc connect" {
+		t.Errorf("expected the default single goblin pane, got %+v (%s)", layout, source)
+	}
+}
+
+func TestLoadTmuxConfig_Precedence(t *testing.T) {
+	dir := t.TempDir()
+	content := `{
+  "layout": {"panes": [{"role": "goblin"}, {"role": "shell", "split": "below", "size": "30%"}]},
+  "repos": {"REPO-002": {"panes": [{"role": "goblin"}, {"role": "tests", "split": "right", "command": "make watch"}]}},
+  "workshops": {"WORK-003": {"panes": [{"role": "goblin", "command": "orc connect --role goblin"}]}}
+}`
+	if err := os.WriteFile(filepath.Join(dir, TmuxFileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadTmuxConfig(dir)
+	if err != nil {
+		t.Fatalf("LoadTmuxConfig failed: %v", err)
+	}
+
+	tests := []struct {
+		workshop, repo string
+		source         string
+		roles          string
+	}{
+		{"WORK-001", "REPO-001", "global", "goblin,shell"},
+		{"WORK-001", "REPO-002", "repo REPO-002", "goblin,tests"},
+		{"WORK-003", "REPO-002", "workshop WORK-003", "goblin"},
+	}
+	for _, tt := range tests {
+		layout, source := cfg.LayoutFor(tt.workshop, tt.repo)
+		if source != tt.source || strings.Join(layout.Roles(), ",") != tt.roles {
+			t.Errorf("LayoutFor(%s, %s) = %v (%s), want %s (%s)", tt.workshop, tt.repo, layout.Roles(), source, tt.roles, tt.source)
+		}
+	}
+}
+
+func TestTmuxLayout_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		panes   []TmuxPane
+		wantErr string
+	}{
+		{"single goblin", []TmuxPane{{Role: "goblin"}}, ""},
+		{"three panes", []TmuxPane{{Role: "goblin"}, {Role: "tests", Split: "right", Size: "40%"}, {Role: "logs", Split: "below", Target: "goblin", Size: "12", Dir: "logs"}}, ""},
+		{"no panes", nil, "no panes"},
+		{"no goblin", []TmuxPane{{Role: "shell"}}, "exactly one"},
+		{"duplicate role", []TmuxPane{{Role: "goblin"}, {Role: "goblin", Split: "right"}}, "duplicate"},
+		{"first pane split", []TmuxPane{{Role: "goblin", Split: "right"}}, "first pane"},
+		{"missing split", []TmuxPane{{Role: "goblin"}, {Role: "tests"}}, "invalid split"},
+		{"later target", []TmuxPane{{Role: "goblin"}, {Role: "tests", Split: "right", Target: "logs"}, {Role: "logs", Split: "below"}}, "not an earlier pane"},
+		{"bad size", []TmuxPane{{Role: "goblin"}, {Role: "tests", Split: "right", Size: "half"}}, "invalid size"},
+		{"bad role", []TmuxPane{{Role: "Goblin Pane"}}, "invalid role"},
+		{"dir outside", []TmuxPane{{Role: "goblin", Dir: "../other"}}, "inside the workbench"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&TmuxLayout{Panes: tt.panes}).Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoadTmuxConfig_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	content := `{"repos": {"REPO-001": {"panes": [{"role": "shell"}]}}}`
+	if err := os.WriteFile(filepath.Join(dir, TmuxFileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := LoadTmuxConfig(dir)
+	if err == nil || !strings.Contains(err.Error(), "REPO-001") {
+		t.Errorf("expected an error naming REPO-001, got %v", err)
+	}
+}
+
+func TestTmuxLayout_SplitTarget(t *testing.T) {
+	layout := &TmuxLayout{Panes: []TmuxPane{
+		{Role: "goblin"},
+		{Role: "tests", Split: "right"},
+		{Role: "logs", Split: "below", Target: "goblin"},
+	}}
+	if got := layout.SplitTarget(1); got != "goblin" {
+		t.Errorf("expected tests to split the previous pane, got %q", got)
+	}
+	if got := layout.SplitTarget(2); got != "goblin" {
+		t.Errorf("expected logs to split its target, got %q", got)
+	}
+}
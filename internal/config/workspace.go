@@ -0,0 +1,109 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// WorkspaceFileName is the workspace layout file, stored next to the ORC database.
+const WorkspaceFileName = "workspace.json"
+
+// WorkspaceLayout controls where workbench worktrees are created and how they are named.
+// Empty fields inherit from the global layout (and from the defaults).
+type WorkspaceLayout struct {
+	WorktreeRoot string `json:"worktree_root,omitempty"` // e.g., ~/wb or /Volumes/code/wb
+	Layout       string `json:"layout,omitempty"`        // "flat" ({root}/{name}) or "by-repo" ({root}/{repo}/{name})
+	NamePattern  string `json:"name_pattern,omitempty"`  // e.g., {repo}-{number}
+}
+
+// WorkspaceConfig is the global workspace layout plus per-factory overrides.
+type WorkspaceConfig struct {
+	WorkspaceLayout
+	RepoRoot  string                     `json:"repo_root,omitempty"` // Where repos are cloned and commission workspaces live
+	Factories map[string]WorkspaceLayout `json:"factories,omitempty"` // Keyed by factory ID (FACT-xxx)
+}
+
+// DefaultWorkspaceConfig returns the layout used when no workspace file exists.
+func DefaultWorkspaceConfig() *WorkspaceConfig {
+	return &WorkspaceConfig{
+		WorkspaceLayout: WorkspaceLayout{
+			WorktreeRoot: "~/wb",
+			Layout:       "flat",
+			NamePattern:  "{repo}-{number}",
+		},
+		RepoRoot: "~/src",
+	}
+}
+
+// LoadWorkspaceConfig reads workspace.json from the ORC data directory (e.g., ~/.orc).
+// Missing file or missing fields fall back to DefaultWorkspaceConfig values.
+func LoadWorkspaceConfig(orcDir string) (*WorkspaceConfig, error) {
+	cfg := DefaultWorkspaceConfig()
+
+	data, err := os.ReadFile(filepath.Join(orcDir, WorkspaceFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("failed to read workspace config: %w", err)
+	}
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse workspace config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// SaveWorkspaceConfig writes workspace.json to the ORC data directory.
+func SaveWorkspaceConfig(orcDir string, cfg *WorkspaceConfig) error {
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal workspace config: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(orcDir, WorkspaceFileName), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write workspace config: %w", err)
+	}
+
+	return nil
+}
+
+// LayoutFor returns the effective layout of a factory: its overrides on top of the
+// global layout, with ~ expanded in the worktree root. An empty ID yields the global layout.
+func (c *WorkspaceConfig) LayoutFor(factoryID string) WorkspaceLayout {
+	layout := c.WorkspaceLayout
+	if override, ok := c.Factories[factoryID]; ok {
+		if override.WorktreeRoot != "" {
+			layout.WorktreeRoot = override.WorktreeRoot
+		}
+		if override.Layout != "" {
+			layout.Layout = override.Layout
+		}
+		if override.NamePattern != "" {
+			layout.NamePattern = override.NamePattern
+		}
+	}
+	layout.WorktreeRoot = ExpandHome(layout.WorktreeRoot)
+	return layout
+}
+
+// CommissionWorkspacePath returns the workspace path of a commission under the repo root.
+func (c *WorkspaceConfig) CommissionWorkspacePath(commissionID string) string {
+	return filepath.Join(ExpandHome(c.RepoRoot), "commissions", commissionID)
+}
+
+// ExpandHome replaces a leading ~ with the user's home directory.
+func ExpandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadWorkspaceConfig_DefaultsWhenMissing(t *testing.T) {
+	cfg, err := LoadWorkspaceConfig(t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadWorkspaceConfig failed: %v", err)
+	}
+
+	home, _ := os.UserHomeDir()
+	layout := cfg.LayoutFor("")
+	if layout.WorktreeRoot != filepath.Join(home, "wb") || layout.Layout != "flat" || layout.NamePattern != "{repo}-{number}" {
+		t.Errorf("expected default layout, got %+v", layout)
+	}
+}
+
+func TestLoadWorkspaceConfig_FactoryOverride(t *testing.T) {
+	dir := t.TempDir()
+	content := `{"worktree_root": "/vol/code/wb", "factories": {"FACT-002": {"layout": "by-repo"}}}`
+	if err := os.WriteFile(filepath.Join(dir, WorkspaceFileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadWorkspaceConfig(dir)
+	if err != nil {
+		t.Fatalf("LoadWorkspaceConfig failed: %v", err)
+	}
+
+	global := cfg.LayoutFor("FACT-001")
+	if global.WorktreeRoot != "/vol/code/wb" || global.Layout != "flat" {
+		t.Errorf("expected global root with default layout, got %+v", global)
+	}
+	factory := cfg.LayoutFor("FACT-002")
+	if factory.WorktreeRoot != "/vol/code/wb" || factory.Layout != "by-repo" || factory.NamePattern != "{repo}-{number}" {
+		t.Errorf("expected by-repo layout inheriting the global root, got %+v", factory)
+	}
+}
+
+func TestSaveWorkspaceConfig_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	cfg := DefaultWorkspaceConfig()
+	cfg.Factories = map[string]WorkspaceLayout{"FACT-002": {WorktreeRoot: "/vol/wb"}}
+
+	if err := SaveWorkspaceConfig(dir, cfg); err != nil {
+		t.Fatalf("SaveWorkspaceConfig failed: %v", err)
+	}
+	got, err := LoadWorkspaceConfig(dir)
+	if err != nil {
+		t.Fatalf("LoadWorkspaceConfig failed: %v", err)
+	}
+	if got.LayoutFor("FACT-002").WorktreeRoot != "/vol/wb" {
+		t.Errorf("expected factory override to round-trip, got %+v", got.Factories)
+	}
+}
+
+func TestLoadWorkspaceConfig_InvalidJSON(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, WorkspaceFileName), []byte(`{"layout": `), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadWorkspaceConfig(dir); err == nil {
+		t.Error("expected error for malformed workspace config")
+	}
+}
@@ -0,0 +1,306 @@
+// Package changelog builds release changelogs from shipped work in the ledger.
+package changelog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Section types. Task types map onto these; untyped tasks land in Changed.
+const (
+	SectionAdded         = "added"
+	SectionFixed         = "fixed"
+	SectionChanged       = "changed"
+	SectionDocumentation = "documentation"
+	SectionMaintenance   = "maintenance"
+)
+
+// sectionOrder is the order sections appear in a changelog.
+var sectionOrder = []string{SectionAdded, SectionFixed, SectionChanged, SectionDocumentation, SectionMaintenance}
+
+var sectionHeadings = map[string]string{
+	SectionAdded:         "Added",
+	SectionFixed:         "Fixed",
+	SectionChanged:       "Changed",
+	SectionDocumentation: "Documentation",
+	SectionMaintenance:   "Maintenance",
+}
+
+// taskSections maps task types onto changelog sections. Research tasks don't ship
+// anything and are left out.
+var taskSections = map[string]string{
+	"implementation": SectionAdded,
+	"fix":            SectionFixed,
+	"documentation":  SectionDocumentation,
+	"maintenance":    SectionMaintenance,
+	"":               SectionChanged,
+}
+
+// Shipment is a shipment with the PRs and tasks a changelog is built from.
+type Shipment struct {
+	ID          string
+	Title       string
+	Closed      bool
+	CompletedAt string // RFC3339
+	PRs         []PR
+	Tasks       []Task
+}
+
+// PR is a pull request for a shipment.
+type PR struct {
+	ID       string
+	Number   int
+	URL      string
+	Merged   bool
+	MergedAt string // RFC3339
+}
+
+// Task is a task on a shipment.
+type Task struct {
+	ID     string
+	Title  string
+	Type   string
+	Closed bool
+}
+
+// Changelog is the shipped work grouped into sections.
+type Changelog struct {
+	Shipments []string // IDs of the shipments included, oldest first
+	Sections  []Section
+}
+
+// Section is one group of entries, e.g. "Added".
+type Section struct {
+	Type    string
+	Heading string
+	Entries []Entry
+}
+
+// Entry is one line of the changelog.
+type Entry struct {
+	Title      string
+	TaskID     string // Empty when the entry stands for a whole shipment
+	ShipmentID string
+	PRs        []PR
+}
+
+// Shipped reports when a shipment shipped: the latest merge of its PRs, or its
+// completion when it has no PRs. A closed shipment whose PRs were all closed
+// without merging did not ship.
+func Shipped(s Shipment) (time.Time, bool) {
+	var shipped time.Time
+	merged := false
+	for _, pr := range s.PRs {
+		if !pr.Merged {
+			continue
+		}
+		merged = true
+		if t, err := time.Parse(time.RFC3339, pr.MergedAt); err == nil && t.After(shipped) {
+			shipped = t
+		}
+	}
+	if merged {
+		if shipped.IsZero() {
+			shipped, _ = time.Parse(time.RFC3339, s.CompletedAt)
+		}
+		return shipped, true
+	}
+	if !s.Closed || len(s.PRs) > 0 {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, s.CompletedAt)
+	return t, err == nil
+}
+
+// Build groups the closed tasks of shipments that shipped at or after since (zero
+// for no bound) into sections. A shipped shipment without changelog-worthy tasks
+// is listed under Changed by its own title.
+func Build(shipments []Shipment, since time.Time) *Changelog {
+	type shipped struct {
+		shipment Shipment
+		at       time.Time
+	}
+	var included []shipped
+	for _, s := range shipments {
+		at, ok := Shipped(s)
+		if !ok || (!since.IsZero() && at.Before(since)) {
+			continue
+		}
+		included = append(included, shipped{s, at})
+	}
+	sort.SliceStable(included, func(i, j int) bool {
+		if !included[i].at.Equal(included[j].at) {
+			return included[i].at.Before(included[j].at)
+		}
+		return included[i].shipment.ID < included[j].shipment.ID
+	})
+
+	cl := &Changelog{}
+	entries := make(map[string][]Entry)
+	for _, inc := range included {
+		s := inc.shipment
+		cl.Shipments = append(cl.Shipments, s.ID)
+		var merged []PR
+		for _, pr := range s.PRs {
+			if pr.Merged {
+				merged = append(merged, pr)
+			}
+		}
+
+		added := false
+		for _, task := range s.Tasks {
+			section, ok := taskSections[task.Type]
+			if !task.Closed || !ok {
+				continue
+			}
+			entries[section] = append(entries[section], Entry{
+				Title:      task.Title,
+				TaskID:     task.ID,
+				ShipmentID: s.ID,
+				PRs:        merged,
+			})
+			added = true
+		}
+		if !added {
+			entries[SectionChanged] = append(entries[SectionChanged], Entry{
+				Title:      s.Title,
+				ShipmentID: s.ID,
+				PRs:        merged,
+			})
+		}
+	}
+
+	for _, section := range sectionOrder {
+		if len(entries[section]) == 0 {
+			continue
+		}
+		cl.Sections = append(cl.Sections, Section{
+			Type:    section,
+			Heading: sectionHeadings[section],
+			Entries: entries[section],
+		})
+	}
+	return cl
+}
+
+// ParseSince parses a --since value: a date (2006-01-02, taken as UTC midnight)
+// or an RFC3339 timestamp.
+func ParseSince(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q (use YYYY-MM-DD or an RFC3339 timestamp)", value)
+}
+
+// Heading is the Keep a Changelog heading for a release: "## [Unreleased]" without
+// a version, "## [1.2.0] - 2026-03-01" with one.
+func Heading(version, date string) string {
+	if version == "" {
+		return "## [Unreleased]"
+	}
+	return fmt.Sprintf("## [%s] - %s", version, date)
+}
+
+// RenderMarkdown renders a changelog as a Keep a Changelog release section.
+func RenderMarkdown(cl *Changelog, version, date string) string {
+	var b strings.Builder
+	b.WriteString(Heading(version, date))
+	b.WriteString("\n")
+	for _, section := range cl.Sections {
+		fmt.Fprintf(&b, "\n### %s\n\n", section.Heading)
+		for _, entry := range section.Entries {
+			fmt.Fprintf(&b, "- %s (%s)\n", entry.Title, entryRefs(entry))
+		}
+	}
+	return b.String()
+}
+
+// entryRefs lists the ledger and PR references for an entry.
+func entryRefs(entry Entry) string {
+	var refs []string
+	if entry.TaskID != "" {
+		refs = append(refs, entry.TaskID)
+	}
+	refs = append(refs, entry.ShipmentID)
+	for _, pr := range entry.PRs {
+		switch {
+		case pr.Number > 0 && pr.URL != "":
+			refs = append(refs, fmt.Sprintf("[#%d](%s)", pr.Number, pr.URL))
+		case pr.Number > 0:
+			refs = append(refs, fmt.Sprintf("#%d", pr.Number))
+		default:
+			refs = append(refs, pr.ID)
+		}
+	}
+	return strings.Join(refs, ", ")
+}
+
+// changelogPreamble starts a CHANGELOG.md that doesn't exist yet.
+const changelogPreamble = `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/).
+
+`
+
+// Prepend adds a release section to the top of an existing CHANGELOG.md, below its
+// title and preamble. A release with the same heading is replaced, so regenerating
+// the Unreleased section doesn't stack copies of it.
+func Prepend(existing, release string) string {
+	release = strings.TrimRight(release, "\n") + "\n"
+	if strings.TrimSpace(existing) == "" {
+		return changelogPreamble + release
+	}
+
+	lines := strings.SplitAfter(existing, "\n")
+	heading := strings.TrimSpace(strings.SplitN(release, "\n", 2)[0])
+	key := releaseKey(heading)
+
+	insert := -1
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "## ") {
+			continue
+		}
+		if insert < 0 {
+			insert = i
+		}
+		if releaseKey(strings.TrimSpace(line)) != key {
+			continue
+		}
+		// Replace the matching release up to the next one
+		end := len(lines)
+		for j := i + 1; j < len(lines); j++ {
+			if strings.HasPrefix(lines[j], "## ") {
+				end = j
+				break
+			}
+		}
+		rest := strings.Join(lines[end:], "")
+		if rest != "" {
+			rest = "\n" + rest
+		}
+		return strings.Join(lines[:i], "") + release + rest
+	}
+
+	if insert < 0 {
+		return strings.TrimRight(existing, "\n") + "\n\n" + release
+	}
+	return strings.Join(lines[:insert], "") + release + "\n" + strings.Join(lines[insert:], "")
+}
+
+// releaseKey is the bracketed version of a release heading ("Unreleased", "1.2.0").
+func releaseKey(heading string) string {
+	heading = strings.TrimPrefix(heading, "## ")
+	if strings.HasPrefix(heading, "[") {
+		if end := strings.Index(heading, "]"); end > 0 {
+			return heading[1:end]
+		}
+	}
+	return heading
+}
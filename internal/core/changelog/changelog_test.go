@@ -0,0 +1,177 @@
+package changelog
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestShipped(t *testing.T) {
+	tests := []struct {
+		name     string
+		shipment Shipment
+		want     string
+		ok       bool
+	}{
+		{
+			name:     "closed without PRs",
+			shipment: Shipment{Closed: true, CompletedAt: "2026-03-01T10:00:00Z"},
+			want:     "2026-03-01T10:00:00Z",
+			ok:       true,
+		},
+		{
+			name:     "open without PRs",
+			shipment: Shipment{CompletedAt: ""},
+		},
+		{
+			name: "merged PR on an open shipment",
+			shipment: Shipment{PRs: []PR{
+				{ID: "PR-001", Merged: true, MergedAt: "2026-03-02T10:00:00Z"},
+				{ID: "PR-002", Merged: true, MergedAt: "2026-03-04T10:00:00Z"},
+			}},
+			want: "2026-03-04T10:00:00Z",
+			ok:   true,
+		},
+		{
+			name: "closed with unmerged PRs",
+			shipment: Shipment{Closed: true, CompletedAt: "2026-03-01T10:00:00Z", PRs: []PR{
+				{ID: "PR-001"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Shipped(tt.shipment)
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && got.Format(time.RFC3339) != tt.want {
+				t.Errorf("shipped = %s, want %s", got.Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	shipments := []Shipment{
+		{
+			ID: "SHIP-002", Title: "Login", Closed: true, CompletedAt: "2026-03-05T00:00:00Z",
+			PRs: []PR{{ID: "PR-001", Number: 41, URL: "https://example.com/pull/41", Merged: true, MergedAt: "2026-03-04T00:00:00Z"}},
+			Tasks: []Task{
+				{ID: "TASK-001", Title: "Investigate providers", Type: "research", Closed: true},
+				{ID: "TASK-002", Title: "Add login", Type: "implementation", Closed: true},
+				{ID: "TASK-003", Title: "Fix redirect", Type: "fix", Closed: true},
+				{ID: "TASK-004", Title: "Login docs", Type: "documentation"},
+			},
+		},
+		{
+			ID: "SHIP-001", Title: "Bump deps", Closed: true, CompletedAt: "2026-03-02T00:00:00Z",
+			Tasks: []Task{{ID: "TASK-005", Title: "Investigate", Type: "research", Closed: true}},
+		},
+		{ID: "SHIP-003", Title: "Old work", Closed: true, CompletedAt: "2026-01-01T00:00:00Z"},
+		{ID: "SHIP-004", Title: "In flight"},
+	}
+
+	cl := Build(shipments, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	if !reflect.DeepEqual(cl.Shipments, []string{"SHIP-001", "SHIP-002"}) {
+		t.Errorf("shipments = %v", cl.Shipments)
+	}
+	var headings []string
+	for _, s := range cl.Sections {
+		headings = append(headings, s.Heading)
+	}
+	if !reflect.DeepEqual(headings, []string{"Added", "Fixed", "Changed"}) {
+		t.Fatalf("sections = %v", headings)
+	}
+	if e := cl.Sections[0].Entries[0]; e.TaskID != "TASK-002" || len(e.PRs) != 1 {
+		t.Errorf("added entry = %+v", e)
+	}
+	if e := cl.Sections[2].Entries[0]; e.Title != "Bump deps" || e.TaskID != "" {
+		t.Errorf("changed entry = %+v", e)
+	}
+
+	if all := Build(shipments, time.Time{}); len(all.Shipments) != 3 {
+		t.Errorf("unbounded shipments = %v", all.Shipments)
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	cl := &Changelog{Sections: []Section{
+		{Type: SectionAdded, Heading: "Added", Entries: []Entry{
+			{Title: "Add login", TaskID: "TASK-002", ShipmentID: "SHIP-002", PRs: []PR{{ID: "PR-001", Number: 41, URL: "https://example.com/pull/41"}}},
+		}},
+		{Type: SectionChanged, Heading: "Changed", Entries: []Entry{
+			{Title: "Bump deps", ShipmentID: "SHIP-001", PRs: []PR{{ID: "PR-002"}}},
+		}},
+	}}
+
+	want := `## [1.2.0] - 2026-03-06
+
+### Added
+
+- Add login (TASK-002, SHIP-002, [#41](https://example.com/pull/41))
+
+### Changed
+
+- Bump deps (SHIP-001, PR-002)
+`
+	if got := RenderMarkdown(cl, "1.2.0", "2026-03-06"); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	if got := RenderMarkdown(&Changelog{}, "", ""); got != "## [Unreleased]\n" {
+		t.Errorf("unreleased = %q", got)
+	}
+}
+
+func TestPrepend(t *testing.T) {
+	release := "## [Unreleased]\n\n### Added\n\n- New\n"
+
+	tests := []struct {
+		name     string
+		existing string
+		want     string
+	}{
+		{
+			name:     "new file",
+			existing: "",
+			want:     changelogPreamble + release,
+		},
+		{
+			name:     "above earlier releases",
+			existing: "# Changelog\n\nIntro.\n\n## [1.0.0] - 2026-01-01\n\n- Old\n",
+			want:     "# Changelog\n\nIntro.\n\n" + release + "\n## [1.0.0] - 2026-01-01\n\n- Old\n",
+		},
+		{
+			name:     "replaces the same release",
+			existing: "# Changelog\n\n## [Unreleased]\n\n- Stale\n\n## [1.0.0] - 2026-01-01\n\n- Old\n",
+			want:     "# Changelog\n\n" + release + "\n## [1.0.0] - 2026-01-01\n\n- Old\n",
+		},
+		{
+			name:     "no releases yet",
+			existing: "# Changelog\n",
+			want:     "# Changelog\n\n" + release,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Prepend(tt.existing, release); got != tt.want {
+				t.Errorf("got:\n%q\nwant:\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	if got, err := ParseSince("2026-03-01"); err != nil || !got.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("date: %v, %v", got, err)
+	}
+	if _, err := ParseSince("2026-03-01T10:00:00+02:00"); err != nil {
+		t.Errorf("rfc3339: %v", err)
+	}
+	if _, err := ParseSince("last week"); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,59 @@
+package commission
+
+// StatusInitial is the schema default for commissions created outside the service layer.
+const StatusInitial CommissionStatus = "initial"
+
+// LifecycleContext describes a commission and the state of its containers.
+// Populated by the caller from a rollup of shipment and tome statuses.
+type LifecycleContext struct {
+	CommissionID        string
+	Status              CommissionStatus
+	IsPinned            bool
+	InProgressShipments int
+	OpenShipments       int // Shipments not yet closed (includes in-progress)
+	ClosedShipments     int
+	OpenTomes           int
+	ClosedTomes         int
+}
+
+// LifecycleAction is the status change a commission should undergo.
+type LifecycleAction string
+
+const (
+	// LifecycleNone means the commission status already matches its containers.
+	LifecycleNone LifecycleAction = ""
+	// LifecycleActivate means work has started on a commission that is not active.
+	LifecycleActivate LifecycleAction = "activate"
+	// LifecycleComplete means all containers are closed and the commission can auto-complete.
+	LifecycleComplete LifecycleAction = "complete"
+	// LifecyclePromptComplete means all containers are closed but the commission is pinned,
+	// so completion is left to the user.
+	LifecyclePromptComplete LifecycleAction = "prompt-complete"
+)
+
+// EvaluateLifecycle determines how a commission's status should follow its containers.
+// Rules:
+//   - A commission that is initial, paused, or complete activates when a shipment is in progress
+//   - An active commission completes when it has at least one container and all are closed
+//   - Pinned commissions are never auto-completed; the caller should prompt instead
+//   - Archived commissions are never changed
+func EvaluateLifecycle(ctx LifecycleContext) LifecycleAction {
+	switch ctx.Status {
+	case StatusInitial, StatusPaused, StatusComplete:
+		if ctx.InProgressShipments > 0 {
+			return LifecycleActivate
+		}
+		return LifecycleNone
+	case StatusActive:
+		total := ctx.OpenShipments + ctx.ClosedShipments + ctx.OpenTomes + ctx.ClosedTomes
+		if total == 0 || ctx.OpenShipments > 0 || ctx.OpenTomes > 0 {
+			return LifecycleNone
+		}
+		if ctx.IsPinned {
+			return LifecyclePromptComplete
+		}
+		return LifecycleComplete
+	default:
+		return LifecycleNone
+	}
+}
@@ -0,0 +1,70 @@
+package commission
+
+import "testing"
+
+func TestEvaluateLifecycle(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  LifecycleContext
+		want LifecycleAction
+	}{
+		{
+			name: "paused commission activates when a shipment starts",
+			ctx:  LifecycleContext{Status: StatusPaused, InProgressShipments: 1, OpenShipments: 1},
+			want: LifecycleActivate,
+		},
+		{
+			name: "initial commission activates when a shipment starts",
+			ctx:  LifecycleContext{Status: StatusInitial, InProgressShipments: 1, OpenShipments: 2},
+			want: LifecycleActivate,
+		},
+		{
+			name: "complete commission reactivates when a shipment starts",
+			ctx:  LifecycleContext{Status: StatusComplete, InProgressShipments: 1, OpenShipments: 1, ClosedShipments: 3},
+			want: LifecycleActivate,
+		},
+		{
+			name: "paused commission without started shipments stays paused",
+			ctx:  LifecycleContext{Status: StatusPaused, OpenShipments: 2},
+			want: LifecycleNone,
+		},
+		{
+			name: "active commission with all containers closed completes",
+			ctx:  LifecycleContext{Status: StatusActive, ClosedShipments: 2, ClosedTomes: 1},
+			want: LifecycleComplete,
+		},
+		{
+			name: "pinned commission with all containers closed prompts",
+			ctx:  LifecycleContext{Status: StatusActive, IsPinned: true, ClosedShipments: 2},
+			want: LifecyclePromptComplete,
+		},
+		{
+			name: "active commission with open shipment stays active",
+			ctx:  LifecycleContext{Status: StatusActive, OpenShipments: 1, ClosedShipments: 2},
+			want: LifecycleNone,
+		},
+		{
+			name: "active commission with open tome stays active",
+			ctx:  LifecycleContext{Status: StatusActive, ClosedShipments: 2, OpenTomes: 1},
+			want: LifecycleNone,
+		},
+		{
+			name: "empty active commission stays active",
+			ctx:  LifecycleContext{Status: StatusActive},
+			want: LifecycleNone,
+		},
+		{
+			name: "archived commission is never changed",
+			ctx:  LifecycleContext{Status: StatusArchived, InProgressShipments: 1, OpenShipments: 1},
+			want: LifecycleNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EvaluateLifecycle(tt.ctx); got != tt.want {
+				t.Errorf("EvaluateLifecycle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package git
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// FileStat is the line churn of one file in a diff.
+type FileStat struct {
+	Path       string
+	Insertions int
+	Deletions  int
+}
+
+// DirStat is the line churn of the files under one directory.
+type DirStat struct {
+	Dir        string // "." for files at the repository root
+	Files      int
+	Insertions int
+	Deletions  int
+}
+
+// Churn returns the number of changed lines in the directory.
+func (d DirStat) Churn() int {
+	return d.Insertions + d.Deletions
+}
+
+// AggregateByDir groups file stats by directory, cut to at most depth path segments
+// (depth < 1 means the full directory). Directories are ordered by churn, largest first,
+// then by name.
+func AggregateByDir(files []FileStat, depth int) []DirStat {
+	byDir := make(map[string]*DirStat)
+	for _, f := range files {
+		dir := truncateDir(path.Dir(f.Path), depth)
+		stat, ok := byDir[dir]
+		if !ok {
+			stat = &DirStat{Dir: dir}
+			byDir[dir] = stat
+		}
+		stat.Files++
+		stat.Insertions += f.Insertions
+		stat.Deletions += f.Deletions
+	}
+
+	dirs := make([]DirStat, 0, len(byDir))
+	for _, stat := range byDir {
+		dirs = append(dirs, *stat)
+	}
+	sort.Slice(dirs, func(i, j int) bool {
+		if dirs[i].Churn() != dirs[j].Churn() {
+			return dirs[i].Churn() > dirs[j].Churn()
+		}
+		return dirs[i].Dir < dirs[j].Dir
+	})
+	return dirs
+}
+
+// truncateDir keeps the first depth segments of a slash-separated directory.
+func truncateDir(dir string, depth int) string {
+	if dir == "." || depth < 1 {
+		return dir
+	}
+	segments := strings.Split(dir, "/")
+	if len(segments) > depth {
+		segments = segments[:depth]
+	}
+	return strings.Join(segments, "/")
+}
+
+// FormatBadge renders insertions and deletions as a compact "+120/-40" badge.
+func FormatBadge(insertions, deletions int) string {
+	return fmt.Sprintf("+%d/-%d", insertions, deletions)
+}
@@ -0,0 +1,72 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAggregateByDir(t *testing.T) {
+	files := []FileStat{
+		{Path: "README.md", Insertions: 2, Deletions: 1},
+		{Path: "internal/app/login.go", Insertions: 80, Deletions: 10},
+		{Path: "internal/app/login_test.go", Insertions: 40, Deletions: 0},
+		{Path: "internal/cli/login.go", Insertions: 15, Deletions: 5},
+		{Path: "docs/login.md", Insertions: 20, Deletions: 0},
+	}
+
+	tests := []struct {
+		name  string
+		depth int
+		want  []DirStat
+	}{
+		{
+			name:  "top-level directories",
+			depth: 1,
+			want: []DirStat{
+				{Dir: "internal", Files: 3, Insertions: 135, Deletions: 15},
+				{Dir: "docs", Files: 1, Insertions: 20},
+				{Dir: ".", Files: 1, Insertions: 2, Deletions: 1},
+			},
+		},
+		{
+			name:  "two levels",
+			depth: 2,
+			want: []DirStat{
+				{Dir: "internal/app", Files: 2, Insertions: 120, Deletions: 10},
+				{Dir: "docs", Files: 1, Insertions: 20},
+				{Dir: "internal/cli", Files: 1, Insertions: 15, Deletions: 5},
+				{Dir: ".", Files: 1, Insertions: 2, Deletions: 1},
+			},
+		},
+		{
+			name:  "full depth",
+			depth: 0,
+			want: []DirStat{
+				{Dir: "internal/app", Files: 2, Insertions: 120, Deletions: 10},
+				{Dir: "docs", Files: 1, Insertions: 20},
+				{Dir: "internal/cli", Files: 1, Insertions: 15, Deletions: 5},
+				{Dir: ".", Files: 1, Insertions: 2, Deletions: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AggregateByDir(files, tt.depth); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AggregateByDir() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAggregateByDir_Empty(t *testing.T) {
+	if got := AggregateByDir(nil, 1); len(got) != 0 {
+		t.Errorf("AggregateByDir(nil) = %+v, want none", got)
+	}
+}
+
+func TestFormatBadge(t *testing.T) {
+	if got := FormatBadge(120, 40); got != "+120/-40" {
+		t.Errorf("FormatBadge() = %q, want %q", got, "+120/-40")
+	}
+}
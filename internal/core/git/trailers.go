@@ -0,0 +1,99 @@
+package git
+
+import (
+	"strings"
+)
+
+// Commit trailers that link a commit to ORC work.
+const (
+	TrailerTask     = "Orc-Task"
+	TrailerShipment = "Orc-Shipment"
+)
+
+// CommitLinks are the ORC entities a commit message refers to.
+type CommitLinks struct {
+	TaskID     string
+	ShipmentID string
+}
+
+// ParseTrailers extracts Orc-Task and Orc-Shipment from the trailer block of a
+// commit message (its last paragraph). Keys match case-insensitively, as in git;
+// the first occurrence of each key wins.
+func ParseTrailers(message string) CommitLinks {
+	var links CommitLinks
+
+	paragraphs := strings.Split(strings.TrimSpace(strings.ReplaceAll(message, "\r\n", "\n")), "\n\n")
+	if len(paragraphs) < 2 {
+		return links // A subject line alone has no trailer block
+	}
+
+	for _, line := range strings.Split(paragraphs[len(paragraphs)-1], "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		switch {
+		case strings.EqualFold(key, TrailerTask) && links.TaskID == "":
+			links.TaskID = value
+		case strings.EqualFold(key, TrailerShipment) && links.ShipmentID == "":
+			links.ShipmentID = value
+		}
+	}
+	return links
+}
+
+// ClaimedTask is an in-progress task assigned to the committing workbench.
+type ClaimedTask struct {
+	ID         string
+	ShipmentID string
+	ClaimedAt  string // RFC3339; compared lexically
+}
+
+// ResolveCommitLinks picks the trailers to insert for a commit made in a workbench.
+// Rules:
+//   - The task is the most recently claimed one, preferring tasks in the focused shipment
+//   - The shipment is the focused shipment, or else the chosen task's shipment
+//   - Focus on anything other than a shipment (tome, commission) is ignored
+func ResolveCommitLinks(focusedID string, claimed []ClaimedTask) CommitLinks {
+	focusedShipment := ""
+	if strings.HasPrefix(focusedID, "SHIP-") {
+		focusedShipment = focusedID
+	}
+
+	var best *ClaimedTask
+	bestInFocus := false
+	for i := range claimed {
+		t := &claimed[i]
+		inFocus := focusedShipment != "" && t.ShipmentID == focusedShipment
+		switch {
+		case best == nil,
+			inFocus && !bestInFocus,
+			inFocus == bestInFocus && t.ClaimedAt > best.ClaimedAt:
+			best = t
+			bestInFocus = inFocus
+		}
+	}
+
+	links := CommitLinks{ShipmentID: focusedShipment}
+	if best != nil {
+		links.TaskID = best.ID
+		if links.ShipmentID == "" {
+			links.ShipmentID = best.ShipmentID
+		}
+	}
+	return links
+}
+
+// FormatTrailers renders links as "Key: value" trailer lines, omitting empty values.
+func FormatTrailers(links CommitLinks) []string {
+	var trailers []string
+	if links.TaskID != "" {
+		trailers = append(trailers, TrailerTask+": "+links.TaskID)
+	}
+	if links.ShipmentID != "" {
+		trailers = append(trailers, TrailerShipment+": "+links.ShipmentID)
+	}
+	return trailers
+}
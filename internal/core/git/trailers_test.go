@@ -0,0 +1,120 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTrailers(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    CommitLinks
+	}{
+		{
+			name:    "task and shipment trailers",
+			message: "Add login form\n\nWires the form to the API.\n\nOrc-Task: TASK-101\nOrc-Shipment: SHIP-042\n",
+			want:    CommitLinks{TaskID: "TASK-101", ShipmentID: "SHIP-042"},
+		},
+		{
+			name:    "keys are case-insensitive",
+			message: "Fix typo\n\norc-task: TASK-7",
+			want:    CommitLinks{TaskID: "TASK-7"},
+		},
+		{
+			name:    "mixed with other trailers",
+			message: "Refactor\n\nSigned-off-by: A <a@example.com>\nOrc-Shipment: SHIP-001",
+			want:    CommitLinks{ShipmentID: "SHIP-001"},
+		},
+		{
+			name:    "mentions in the body are not trailers",
+			message: "Refactor\n\nOrc-Task: TASK-1 was wrong here\n\nReviewed-by: B",
+			want:    CommitLinks{},
+		},
+		{
+			name:    "subject line only",
+			message: "Orc-Task: TASK-1",
+			want:    CommitLinks{},
+		},
+		{
+			name:    "first occurrence wins",
+			message: "Split\n\nOrc-Task: TASK-1\nOrc-Task: TASK-2",
+			want:    CommitLinks{TaskID: "TASK-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseTrailers(tt.message); got != tt.want {
+				t.Errorf("ParseTrailers() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveCommitLinks(t *testing.T) {
+	tests := []struct {
+		name      string
+		focusedID string
+		claimed   []ClaimedTask
+		want      CommitLinks
+	}{
+		{
+			name:      "focused shipment and its claimed task",
+			focusedID: "SHIP-042",
+			claimed:   []ClaimedTask{{ID: "TASK-101", ShipmentID: "SHIP-042", ClaimedAt: "2026-01-01T10:00:00Z"}},
+			want:      CommitLinks{TaskID: "TASK-101", ShipmentID: "SHIP-042"},
+		},
+		{
+			name:      "task in the focused shipment beats a newer task elsewhere",
+			focusedID: "SHIP-042",
+			claimed: []ClaimedTask{
+				{ID: "TASK-101", ShipmentID: "SHIP-042", ClaimedAt: "2026-01-01T10:00:00Z"},
+				{ID: "TASK-200", ShipmentID: "SHIP-050", ClaimedAt: "2026-01-02T10:00:00Z"},
+			},
+			want: CommitLinks{TaskID: "TASK-101", ShipmentID: "SHIP-042"},
+		},
+		{
+			name: "most recently claimed task without focus",
+			claimed: []ClaimedTask{
+				{ID: "TASK-101", ShipmentID: "SHIP-042", ClaimedAt: "2026-01-01T10:00:00Z"},
+				{ID: "TASK-200", ShipmentID: "SHIP-050", ClaimedAt: "2026-01-02T10:00:00Z"},
+			},
+			want: CommitLinks{TaskID: "TASK-200", ShipmentID: "SHIP-050"},
+		},
+		{
+			name:      "focused shipment without a claimed task",
+			focusedID: "SHIP-042",
+			want:      CommitLinks{ShipmentID: "SHIP-042"},
+		},
+		{
+			name:      "tome focus is ignored",
+			focusedID: "TOME-003",
+			claimed:   []ClaimedTask{{ID: "TASK-101", ClaimedAt: "2026-01-01T10:00:00Z"}},
+			want:      CommitLinks{TaskID: "TASK-101"},
+		},
+		{
+			name: "nothing to link",
+			want: CommitLinks{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveCommitLinks(tt.focusedID, tt.claimed); got != tt.want {
+				t.Errorf("ResolveCommitLinks() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatTrailers(t *testing.T) {
+	got := FormatTrailers(CommitLinks{TaskID: "TASK-101", ShipmentID: "SHIP-042"})
+	want := []string{"Orc-Task: TASK-101", "Orc-Shipment: SHIP-042"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FormatTrailers() = %v, want %v", got, want)
+	}
+	if got := FormatTrailers(CommitLinks{}); len(got) != 0 {
+		t.Errorf("FormatTrailers(empty) = %v, want none", got)
+	}
+}
@@ -0,0 +1,200 @@
+// Package panehealth classifies what the process in a tmux pane is doing, from
+// its current command and the tail of its screen.
+package panehealth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Pane states, from most to least urgent.
+const (
+	StateCrashed = "crashed" // The agent exited or the pane's process died
+	StateWaiting = "waiting" // Stuck on a prompt (permission, y/n, press enter)
+	StateWorking = "working" // Output is moving or the agent reports it is busy
+	StateIdle    = "idle"    // A process is running but nothing is happening
+	StateShell   = "shell"   // Only a shell; no agent or other process running
+)
+
+// severity orders states for Worst and Summary; higher is more urgent.
+var severity = map[string]int{
+	StateShell:   1,
+	StateIdle:    2,
+	StateWorking: 3,
+	StateWaiting: 4,
+	StateCrashed: 5,
+}
+
+// Observation is one poll of a pane.
+type Observation struct {
+	CurrentCommand string // pane_current_command
+	Dead           bool   // pane_dead: the process exited and remain-on-exit kept the pane
+	Agent          bool   // The pane runs (or should run) an agent, e.g. the goblin pane
+	Content        string // Tail of the visible screen
+	PreviousState  string // State from the last poll; empty on the first
+	PreviousDigest string // Digest of the content at the last poll
+}
+
+// shells are the commands a pane shows when nothing but its shell is running.
+var shells = map[string]bool{
+	"sh": true, "bash": true, "zsh": true, "fish": true, "dash": true,
+	"ksh": true, "tcsh": true, "csh": true, "nu": true,
+}
+
+// IsShell reports whether a pane's current command is an interactive shell.
+// Login shells show up with a leading dash (-zsh).
+func IsShell(command string) bool {
+	return shells[filepath.Base(strings.TrimPrefix(command, "-"))]
+}
+
+// promptMarkers are lines that mean the process waits for a human: agent
+// permission prompts and generic confirmations.
+var promptMarkers = []string{
+	"do you want to proceed",
+	"do you want to make this edit",
+	"do you want to create",
+	"do you want to allow",
+	"would you like to",
+	"❯ 1. yes",
+	"(y/n)",
+	"[y/n]",
+	"press enter to continue",
+}
+
+// busyMarkers are shown by agents while they think or run tools.
+var busyMarkers = []string{
+	"esc to interrupt",
+}
+
+// tailLines is how much of the screen prompts and busy markers are looked for
+// in; older output has scrolled out of relevance.
+const tailLines = 15
+
+// Classify decides the state of a pane. A dead pane has crashed. A shell is
+// "shell", unless the pane's agent was running at the last poll, in which case
+// the agent crashed back to its shell. A running process is waiting when a
+// prompt is on screen, working when the agent says it is busy or the screen
+// changed since the last poll, and idle otherwise.
+func Classify(o Observation) string {
+	if o.Dead {
+		return StateCrashed
+	}
+	if o.CurrentCommand == "" || IsShell(o.CurrentCommand) {
+		if o.Agent && (o.PreviousState == StateCrashed || isRunning(o.PreviousState)) {
+			return StateCrashed
+		}
+		return StateShell
+	}
+
+	tail := strings.ToLower(Tail(o.Content, tailLines))
+	if containsAny(tail, promptMarkers) {
+		return StateWaiting
+	}
+	if containsAny(tail, busyMarkers) {
+		return StateWorking
+	}
+	if o.PreviousDigest != "" && o.PreviousDigest != Digest(o.Content) {
+		return StateWorking
+	}
+	return StateIdle
+}
+
+// Gone is the state of an agent pane that disappeared since the last poll:
+// without remain-on-exit tmux closes a pane when its process exits, so a
+// running agent that vanished crashed.
+func Gone(previousState string) string {
+	if isRunning(previousState) {
+		return StateCrashed
+	}
+	return ""
+}
+
+// isRunning reports whether a state means a process was running.
+func isRunning(state string) bool {
+	return state == StateWorking || state == StateWaiting || state == StateIdle
+}
+
+// Digest fingerprints a pane's screen so the next poll can tell whether it moved.
+func Digest(content string) string {
+	sum := sha256.Sum256([]byte(strings.TrimRight(content, "\n ")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Tail returns the last n non-blank lines of a screen.
+func Tail(content string, n int) string {
+	lines := strings.Split(content, "\n")
+	var kept []string
+	for i := len(lines) - 1; i >= 0 && len(kept) < n; i-- {
+		if strings.TrimSpace(lines[i]) != "" {
+			kept = append(kept, lines[i])
+		}
+	}
+	for i, j := 0, len(kept)-1; i < j; i, j = i+1, j-1 {
+		kept[i], kept[j] = kept[j], kept[i]
+	}
+	return strings.Join(kept, "\n")
+}
+
+// PromptLine returns the prompt a waiting pane shows, for reports.
+func PromptLine(content string) string {
+	for _, line := range strings.Split(Tail(content, tailLines), "\n") {
+		if containsAny(strings.ToLower(line), promptMarkers) {
+			return strings.TrimSpace(line)
+		}
+	}
+	return ""
+}
+
+// Alert reports whether a state needs a human.
+func Alert(state string) bool {
+	return state == StateWaiting || state == StateCrashed
+}
+
+// Level is the operational event level of a transition into a state.
+func Level(state string) string {
+	switch state {
+	case StateCrashed:
+		return "error"
+	case StateWaiting:
+		return "warn"
+	}
+	return "info"
+}
+
+// Worst returns the most urgent of some states, or "" for none.
+func Worst(states []string) string {
+	worst := ""
+	for _, s := range states {
+		if severity[s] > severity[worst] {
+			worst = s
+		}
+	}
+	return worst
+}
+
+// Summary counts states, most urgent first: "1 crashed, 2 waiting, 3 working".
+func Summary(states []string) string {
+	counts := make(map[string]int)
+	for _, s := range states {
+		counts[s]++
+	}
+	var parts []string
+	for _, s := range []string{StateCrashed, StateWaiting, StateWorking, StateIdle, StateShell} {
+		if counts[s] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[s], s))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func containsAny(s string, markers []string) bool {
+	for _, m := range markers {
+		if strings.Contains(s, m) {
+			return true
+		}
+	}
+	return false
+}
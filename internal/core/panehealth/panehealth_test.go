@@ -0,0 +1,102 @@
+package panehealth
+
+import "testing"
+
+const permissionPrompt = `● Bash(rm -rf build)
+  ⎿  Running…
+
+ Bash command
+   rm -rf build
+ Do you want to proceed?
+ ❯ 1. Yes
+   2. No, and tell Claude what to do differently (esc)
+`
+
+func TestClassify(t *testing.T) {
+	screen := "> refactor the parser\n● Reading internal/parser.go\n"
+
+	tests := []struct {
+		name string
+		obs  Observation
+		want string
+	}{
+		{"dead pane", Observation{CurrentCommand: "claude", Dead: true}, StateCrashed},
+		{"plain shell", Observation{CurrentCommand: "zsh"}, StateShell},
+		{"login shell", Observation{CurrentCommand: "-bash"}, StateShell},
+		{"no command", Observation{}, StateShell},
+		{"agent pane never launched", Observation{CurrentCommand: "bash", Agent: true}, StateShell},
+		{"agent exited to shell", Observation{CurrentCommand: "bash", Agent: true, PreviousState: StateWorking}, StateCrashed},
+		{"crashed agent stays crashed", Observation{CurrentCommand: "bash", Agent: true, PreviousState: StateCrashed}, StateCrashed},
+		{"non-agent command finished", Observation{CurrentCommand: "bash", PreviousState: StateWorking}, StateShell},
+		{"permission prompt", Observation{CurrentCommand: "claude", Content: permissionPrompt}, StateWaiting},
+		{"y/n prompt", Observation{CurrentCommand: "apt", Content: "Continue? [Y/n] "}, StateWaiting},
+		{"busy agent", Observation{CurrentCommand: "claude", Content: "✻ Thinking… (12s · esc to interrupt)\n"}, StateWorking},
+		{"screen moved", Observation{CurrentCommand: "make", Content: screen, PreviousDigest: Digest("earlier")}, StateWorking},
+		{"screen still", Observation{CurrentCommand: "claude", Content: screen, PreviousDigest: Digest(screen)}, StateIdle},
+		{"first poll", Observation{CurrentCommand: "claude", Content: screen}, StateIdle},
+		{"old prompt scrolled away", Observation{CurrentCommand: "claude", Content: "Do you want to proceed?\n" + repeatLines(20), PreviousDigest: Digest("x")}, StateWorking},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.obs); got != tt.want {
+				t.Errorf("Classify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGone(t *testing.T) {
+	tests := []struct {
+		previous string
+		want     string
+	}{
+		{StateWorking, StateCrashed},
+		{StateWaiting, StateCrashed},
+		{StateIdle, StateCrashed},
+		{StateShell, ""},
+		{StateCrashed, ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Gone(tt.previous); got != tt.want {
+			t.Errorf("Gone(%q) = %q, want %q", tt.previous, got, tt.want)
+		}
+	}
+}
+
+func TestPromptLine(t *testing.T) {
+	if got := PromptLine(permissionPrompt); got != "Do you want to proceed?" {
+		t.Errorf("PromptLine() = %q", got)
+	}
+	if got := PromptLine("all quiet\n"); got != "" {
+		t.Errorf("expected no prompt, got %q", got)
+	}
+}
+
+func TestWorstAndSummary(t *testing.T) {
+	states := []string{StateWorking, StateIdle, StateWaiting, StateWorking, StateShell}
+	if got := Worst(states); got != StateWaiting {
+		t.Errorf("Worst() = %q, want waiting", got)
+	}
+	if got := Worst(nil); got != "" {
+		t.Errorf("Worst(nil) = %q, want empty", got)
+	}
+	if got := Summary(states); got != "1 waiting, 2 working, 1 idle, 1 shell" {
+		t.Errorf("Summary() = %q", got)
+	}
+}
+
+func TestTail(t *testing.T) {
+	if got := Tail("a\n\nb\nc\n\n", 2); got != "b\nc" {
+		t.Errorf("Tail() = %q, want %q", got, "b\nc")
+	}
+}
+
+func repeatLines(n int) string {
+	s := ""
+	for i := 0; i < n; i++ {
+		s += "output line\n"
+	}
+	return s
+}
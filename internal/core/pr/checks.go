@@ -0,0 +1,58 @@
+package pr
+
+// Check states, from a single check or rolled up over a PR's checks.
+const (
+	CheckPassing = "passing"
+	CheckFailing = "failing"
+	CheckPending = "pending"
+)
+
+// Check is a CI check on a PR as of the last sync.
+type Check struct {
+	Name       string
+	Status     string // queued, in_progress, completed
+	Conclusion string // Set once completed
+	Required   bool
+}
+
+// CheckState classifies a check. Completed checks that neither failed nor need
+// action (neutral, skipped) count as passing.
+func CheckState(c Check) string {
+	if c.Status != "completed" {
+		return CheckPending
+	}
+	switch c.Conclusion {
+	case "failure", "timed_out", "cancelled", "action_required":
+		return CheckFailing
+	}
+	return CheckPassing
+}
+
+// ChecksState rolls up a PR's checks: failing if any check fails, pending if
+// any is still running, passing otherwise. Empty when the PR has no checks.
+func ChecksState(checks []Check) string {
+	if len(checks) == 0 {
+		return ""
+	}
+	state := CheckPassing
+	for _, c := range checks {
+		switch CheckState(c) {
+		case CheckFailing:
+			return CheckFailing
+		case CheckPending:
+			state = CheckPending
+		}
+	}
+	return state
+}
+
+// FailingRequiredChecks returns the names of the required checks that failed.
+func FailingRequiredChecks(checks []Check) []string {
+	var names []string
+	for _, c := range checks {
+		if c.Required && CheckState(c) == CheckFailing {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
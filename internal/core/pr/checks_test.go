@@ -0,0 +1,71 @@
+package pr
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCheckState(t *testing.T) {
+	tests := []struct {
+		check Check
+		want  string
+	}{
+		{Check{Status: "queued"}, CheckPending},
+		{Check{Status: "in_progress"}, CheckPending},
+		{Check{Status: "completed", Conclusion: "success"}, CheckPassing},
+		{Check{Status: "completed", Conclusion: "neutral"}, CheckPassing},
+		{Check{Status: "completed", Conclusion: "skipped"}, CheckPassing},
+		{Check{Status: "completed", Conclusion: "failure"}, CheckFailing},
+		{Check{Status: "completed", Conclusion: "timed_out"}, CheckFailing},
+		{Check{Status: "completed", Conclusion: "cancelled"}, CheckFailing},
+		{Check{Status: "completed", Conclusion: "action_required"}, CheckFailing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.check.Status+"/"+tt.check.Conclusion, func(t *testing.T) {
+			if got := CheckState(tt.check); got != tt.want {
+				t.Errorf("CheckState() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChecksState(t *testing.T) {
+	pass := Check{Name: "a", Status: "completed", Conclusion: "success"}
+	fail := Check{Name: "b", Status: "completed", Conclusion: "failure"}
+	running := Check{Name: "c", Status: "in_progress"}
+
+	tests := []struct {
+		name   string
+		checks []Check
+		want   string
+	}{
+		{"no checks", nil, ""},
+		{"all passing", []Check{pass, pass}, CheckPassing},
+		{"one running", []Check{pass, running}, CheckPending},
+		{"failure wins over running", []Check{running, fail, pass}, CheckFailing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChecksState(tt.checks); got != tt.want {
+				t.Errorf("ChecksState() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFailingRequiredChecks(t *testing.T) {
+	checks := []Check{
+		{Name: "test", Status: "completed", Conclusion: "failure", Required: true},
+		{Name: "flaky", Status: "completed", Conclusion: "failure"},
+		{Name: "build", Status: "in_progress", Required: true},
+		{Name: "lint", Status: "completed", Conclusion: "timed_out", Required: true},
+		{Name: "docs", Status: "completed", Conclusion: "success", Required: true},
+	}
+
+	got := FailingRequiredChecks(checks)
+	if want := []string{"test", "lint"}; !slices.Equal(got, want) {
+		t.Errorf("FailingRequiredChecks() = %v, want %v", got, want)
+	}
+}
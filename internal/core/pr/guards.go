@@ -2,7 +2,11 @@
 // Guards are pure functions that evaluate preconditions without side effects.
 package pr
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
 
 // GuardResult represents the outcome of a guard evaluation.
 type GuardResult struct {
@@ -20,12 +24,13 @@ func (r GuardResult) Error() error {
 
 // CreatePRContext provides context for PR creation guards.
 type CreatePRContext struct {
-	ShipmentID     string
-	RepoID         string
-	ShipmentExists bool
-	ShipmentStatus string // "draft", "ready", "in-progress", "closed"
-	ShipmentHasPR  bool
-	RepoExists     bool
+	ShipmentID      string
+	RepoID          string
+	ShipmentExists  bool
+	ShipmentStatus  string // "draft", "ready", "in-progress", "closed"
+	ShipmentHasPR   bool   // Shipment already has a PR for this repo
+	RepoExists      bool
+	ShipmentRepoIDs []string // Repos the shipment spans; empty when it declares none
 }
 
 // OpenPRContext provides context for opening a draft PR.
@@ -42,8 +47,23 @@ type ApprovePRContext struct {
 
 // MergePRContext provides context for merging a PR.
 type MergePRContext struct {
-	PRID   string
-	Status string
+	PRID          string
+	Status        string
+	FailingChecks []string // Required CI checks failing as of the last sync
+	Force         bool     // Merge despite failing checks
+}
+
+// LocalMergeContext provides context for landing a PR in its repository's main checkout.
+type LocalMergeContext struct {
+	PRID           string
+	RepoID         string
+	Branch         string
+	TargetBranch   string
+	CheckoutPath   string // The repo's main checkout; empty when it has none
+	CheckoutBranch string // Branch checked out there
+	DirtyFiles     int    // Tracked files with uncommitted changes there
+	BranchExists   bool   // The PR branch exists locally or on origin
+	Ahead          int    // Commits on the PR branch that are not on the target branch
 }
 
 // ClosePRContext provides context for closing a PR.
@@ -52,12 +72,33 @@ type ClosePRContext struct {
 	Status string
 }
 
+// ImportReviewsContext provides context for importing review threads as notes.
+type ImportReviewsContext struct {
+	PRID     string
+	Number   int    // Provider PR number; 0 when not yet known
+	NoteType string // Type the imported notes will get
+}
+
+// StackPRContext provides context for stacking a PR on another PR.
+type StackPRContext struct {
+	PRID               string // Empty for a PR being created
+	Status             string
+	RepoID             string
+	CommissionID       string
+	ParentID           string
+	ParentStatus       string
+	ParentRepoID       string
+	ParentCommissionID string
+	ParentAncestors    []string // PRs below the parent in its stack
+}
+
 // CanCreatePR evaluates whether a PR can be created.
 // Rules:
 // - Shipment must exist
 // - Shipment must be active
-// - Shipment must not already have a PR
+// - Shipment must not already have a PR for the repository
 // - Repository must exist
+// - Repository must be part of the shipment, if the shipment declares repos
 func CanCreatePR(ctx CreatePRContext) GuardResult {
 	// Rule 1: Shipment must exist
 	if !ctx.ShipmentExists {
@@ -75,11 +116,11 @@ func CanCreatePR(ctx CreatePRContext) GuardResult {
 		}
 	}
 
-	// Rule 3: Shipment must not already have a PR
+	// Rule 3: Shipment must not already have a PR for this repo
 	if ctx.ShipmentHasPR {
 		return GuardResult{
 			Allowed: false,
-			Reason:  fmt.Sprintf("shipment %s already has a PR", ctx.ShipmentID),
+			Reason:  fmt.Sprintf("shipment %s already has a PR for %s", ctx.ShipmentID, ctx.RepoID),
 		}
 	}
 
@@ -91,6 +132,15 @@ func CanCreatePR(ctx CreatePRContext) GuardResult {
 		}
 	}
 
+	// Rule 5: Repository must be one the shipment spans
+	if len(ctx.ShipmentRepoIDs) > 0 && !slices.Contains(ctx.ShipmentRepoIDs, ctx.RepoID) {
+		return GuardResult{
+			Allowed: false,
+			Reason: fmt.Sprintf("repository %s is not part of shipment %s. Add it with: orc shipment repo add %s %s",
+				ctx.RepoID, ctx.ShipmentID, ctx.ShipmentID, ctx.RepoID),
+		}
+	}
+
 	return GuardResult{Allowed: true}
 }
 
@@ -125,6 +175,7 @@ func CanApprovePR(ctx ApprovePRContext) GuardResult {
 // CanMergePR evaluates whether a PR can be merged.
 // Rules:
 // - Status must be "open" or "approved"
+// - No required check may be failing, unless forced
 func CanMergePR(ctx MergePRContext) GuardResult {
 	if ctx.Status != "open" && ctx.Status != "approved" {
 		return GuardResult{
@@ -133,6 +184,65 @@ func CanMergePR(ctx MergePRContext) GuardResult {
 		}
 	}
 
+	if len(ctx.FailingChecks) > 0 && !ctx.Force {
+		return GuardResult{
+			Allowed: false,
+			Reason: fmt.Sprintf("required checks failing on %s: %s. Fix them and run: orc pr sync %s (or merge anyway with --force)",
+				ctx.PRID, strings.Join(ctx.FailingChecks, ", "), ctx.PRID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// CanMergeLocally evaluates whether orc can land a PR itself.
+// Rules:
+// - The repo must have a main checkout, on the target branch and clean
+// - The PR branch must exist and have commits the target branch lacks
+func CanMergeLocally(ctx LocalMergeContext) GuardResult {
+	if ctx.CheckoutPath == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("repo %s has no local clone to merge in. Set one with: orc repo update %s --path <dir>", ctx.RepoID, ctx.RepoID),
+		}
+	}
+
+	if ctx.Branch == ctx.TargetBranch {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("PR %s would merge %s into itself", ctx.PRID, ctx.Branch),
+		}
+	}
+
+	if ctx.CheckoutBranch != ctx.TargetBranch {
+		return GuardResult{
+			Allowed: false,
+			Reason: fmt.Sprintf("the main checkout %s is on %s, not %s. Switch it with: git -C %s switch %s",
+				ctx.CheckoutPath, ctx.CheckoutBranch, ctx.TargetBranch, ctx.CheckoutPath, ctx.TargetBranch),
+		}
+	}
+
+	if ctx.DirtyFiles > 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("the main checkout %s has %d uncommitted change(s); commit or stash them first", ctx.CheckoutPath, ctx.DirtyFiles),
+		}
+	}
+
+	if !ctx.BranchExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("branch %s of %s not found locally or on origin", ctx.Branch, ctx.PRID),
+		}
+	}
+
+	if ctx.Ahead == 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("nothing to merge: %s has no commits that are not on %s", ctx.Branch, ctx.TargetBranch),
+		}
+	}
+
 	return GuardResult{Allowed: true}
 }
 
@@ -149,3 +259,70 @@ func CanClosePR(ctx ClosePRContext) GuardResult {
 
 	return GuardResult{Allowed: true}
 }
+
+// CanStackPR evaluates whether a PR can be stacked on another PR.
+// Rules:
+// - A PR cannot be stacked on itself or on a PR stacked on it
+// - The PR must not be merged or closed
+// - The parent must not be merged or closed
+// - Both PRs must be in the same repository and commission
+func CanStackPR(ctx StackPRContext) GuardResult {
+	if ctx.PRID != "" && (ctx.PRID == ctx.ParentID || slices.Contains(ctx.ParentAncestors, ctx.PRID)) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot stack %s on %s: %s would end up below itself", ctx.PRID, ctx.ParentID, ctx.PRID),
+		}
+	}
+
+	if isSettled(ctx.Status) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("can only stack open or draft PRs (current status: %s)", ctx.Status),
+		}
+	}
+
+	if isSettled(ctx.ParentStatus) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot stack on %s: it is already %s", ctx.ParentID, ctx.ParentStatus),
+		}
+	}
+
+	if ctx.ParentRepoID != ctx.RepoID {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot stack on %s: it is a PR on %s, not %s", ctx.ParentID, ctx.ParentRepoID, ctx.RepoID),
+		}
+	}
+
+	if ctx.ParentCommissionID != ctx.CommissionID {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot stack on %s: it belongs to commission %s, not %s", ctx.ParentID, ctx.ParentCommissionID, ctx.CommissionID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// CanImportReviews evaluates whether a PR's review threads can be imported.
+// Rules:
+// - PR must have a provider number
+// - Notes must be of type "concern" or "bug"
+func CanImportReviews(ctx ImportReviewsContext) GuardResult {
+	if ctx.Number == 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("PR %s has no provider number yet. Run: orc pr sync %s", ctx.PRID, ctx.PRID),
+		}
+	}
+
+	if ctx.NoteType != "concern" && ctx.NoteType != "bug" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("review notes must be of type concern or bug (got %q)", ctx.NoteType),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
@@ -60,6 +60,31 @@ func TestCanCreatePR(t *testing.T) {
 			wantAllowed: false,
 			wantReason:  "can only create PR for in-progress shipments (current status: closed)",
 		},
+		{
+			name: "can create PR for a second repo of the shipment",
+			ctx: CreatePRContext{
+				ShipmentID:      "SHIP-001",
+				RepoID:          "REPO-002",
+				ShipmentExists:  true,
+				ShipmentStatus:  "in-progress",
+				RepoExists:      true,
+				ShipmentRepoIDs: []string{"REPO-001", "REPO-002"},
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "cannot create PR for a repo outside the shipment",
+			ctx: CreatePRContext{
+				ShipmentID:      "SHIP-001",
+				RepoID:          "REPO-003",
+				ShipmentExists:  true,
+				ShipmentStatus:  "in-progress",
+				RepoExists:      true,
+				ShipmentRepoIDs: []string{"REPO-001", "REPO-002"},
+			},
+			wantAllowed: false,
+			wantReason:  "repository REPO-003 is not part of shipment SHIP-001. Add it with: orc shipment repo add SHIP-001 REPO-003",
+		},
 		{
 			name: "cannot create PR when shipment already has PR",
 			ctx: CreatePRContext{
@@ -71,7 +96,7 @@ func TestCanCreatePR(t *testing.T) {
 				RepoExists:     true,
 			},
 			wantAllowed: false,
-			wantReason:  "shipment SHIP-001 already has a PR",
+			wantReason:  "shipment SHIP-001 already has a PR for REPO-001",
 		},
 		{
 			name: "cannot create PR for non-existent repo",
@@ -247,6 +272,26 @@ func TestCanMergePR(t *testing.T) {
 			wantAllowed: false,
 			wantReason:  "can only merge open or approved PRs (current status: merged)",
 		},
+		{
+			name: "cannot merge while required checks fail",
+			ctx: MergePRContext{
+				PRID:          "PR-001",
+				Status:        "approved",
+				FailingChecks: []string{"test", "lint"},
+			},
+			wantAllowed: false,
+			wantReason:  "required checks failing on PR-001: test, lint. Fix them and run: orc pr sync PR-001 (or merge anyway with --force)",
+		},
+		{
+			name: "can force merge despite failing checks",
+			ctx: MergePRContext{
+				PRID:          "PR-001",
+				Status:        "open",
+				FailingChecks: []string{"test"},
+				Force:         true,
+			},
+			wantAllowed: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -337,3 +382,198 @@ func TestGuardResult_Error(t *testing.T) {
 		}
 	})
 }
+
+func TestCanMergeLocally(t *testing.T) {
+	ready := LocalMergeContext{
+		PRID:           "PR-001",
+		RepoID:         "REPO-001",
+		Branch:         "ml/SHIP-001-login",
+		TargetBranch:   "main",
+		CheckoutPath:   "/src/app",
+		CheckoutBranch: "main",
+		BranchExists:   true,
+		Ahead:          2,
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(*LocalMergeContext)
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can merge a clean checkout on the target branch",
+			modify:      func(*LocalMergeContext) {},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot merge without a local clone",
+			modify:      func(c *LocalMergeContext) { c.CheckoutPath = "" },
+			wantAllowed: false,
+			wantReason:  "repo REPO-001 has no local clone to merge in. Set one with: orc repo update REPO-001 --path <dir>",
+		},
+		{
+			name:        "cannot merge a branch into itself",
+			modify:      func(c *LocalMergeContext) { c.Branch = "main" },
+			wantAllowed: false,
+			wantReason:  "PR PR-001 would merge main into itself",
+		},
+		{
+			name:        "cannot merge while the checkout is on another branch",
+			modify:      func(c *LocalMergeContext) { c.CheckoutBranch = "develop" },
+			wantAllowed: false,
+			wantReason:  "the main checkout /src/app is on develop, not main. Switch it with: git -C /src/app switch main",
+		},
+		{
+			name:        "cannot merge into a dirty checkout",
+			modify:      func(c *LocalMergeContext) { c.DirtyFiles = 3 },
+			wantAllowed: false,
+			wantReason:  "the main checkout /src/app has 3 uncommitted change(s); commit or stash them first",
+		},
+		{
+			name:        "cannot merge a missing branch",
+			modify:      func(c *LocalMergeContext) { c.BranchExists = false },
+			wantAllowed: false,
+			wantReason:  "branch ml/SHIP-001-login of PR-001 not found locally or on origin",
+		},
+		{
+			name:        "cannot merge a branch with nothing new",
+			modify:      func(c *LocalMergeContext) { c.Ahead = 0 },
+			wantAllowed: false,
+			wantReason:  "nothing to merge: ml/SHIP-001-login has no commits that are not on main",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := ready
+			tt.modify(&ctx)
+			result := CanMergeLocally(ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanStackPR(t *testing.T) {
+	valid := StackPRContext{
+		PRID:               "PR-002",
+		Status:             "open",
+		RepoID:             "REPO-001",
+		CommissionID:       "COMM-001",
+		ParentID:           "PR-001",
+		ParentStatus:       "draft",
+		ParentRepoID:       "REPO-001",
+		ParentCommissionID: "COMM-001",
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(*StackPRContext)
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can stack on an open PR in the same repo",
+			modify:      func(c *StackPRContext) {},
+			wantAllowed: true,
+		},
+		{
+			name:        "can stack a PR being created",
+			modify:      func(c *StackPRContext) { c.PRID = "" },
+			wantAllowed: true,
+		},
+		{
+			name:       "cannot stack on itself",
+			modify:     func(c *StackPRContext) { c.ParentID = "PR-002" },
+			wantReason: "cannot stack PR-002 on PR-002: PR-002 would end up below itself",
+		},
+		{
+			name:       "cannot stack on a PR stacked on it",
+			modify:     func(c *StackPRContext) { c.ParentAncestors = []string{"PR-002"} },
+			wantReason: "cannot stack PR-002 on PR-001: PR-002 would end up below itself",
+		},
+		{
+			name:       "cannot stack a merged PR",
+			modify:     func(c *StackPRContext) { c.Status = "merged" },
+			wantReason: "can only stack open or draft PRs (current status: merged)",
+		},
+		{
+			name:       "cannot stack on a closed PR",
+			modify:     func(c *StackPRContext) { c.ParentStatus = "closed" },
+			wantReason: "cannot stack on PR-001: it is already closed",
+		},
+		{
+			name:       "cannot stack across repos",
+			modify:     func(c *StackPRContext) { c.ParentRepoID = "REPO-002" },
+			wantReason: "cannot stack on PR-001: it is a PR on REPO-002, not REPO-001",
+		},
+		{
+			name:       "cannot stack across commissions",
+			modify:     func(c *StackPRContext) { c.ParentCommissionID = "COMM-002" },
+			wantReason: "cannot stack on PR-001: it belongs to commission COMM-002, not COMM-001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := valid
+			tt.modify(&ctx)
+			result := CanStackPR(ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanImportReviews(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         ImportReviewsContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can import as concerns",
+			ctx:         ImportReviewsContext{PRID: "PR-001", Number: 7, NoteType: "concern"},
+			wantAllowed: true,
+		},
+		{
+			name:        "can import as bugs",
+			ctx:         ImportReviewsContext{PRID: "PR-001", Number: 7, NoteType: "bug"},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot import without a PR number",
+			ctx:         ImportReviewsContext{PRID: "PR-001", NoteType: "concern"},
+			wantAllowed: false,
+			wantReason:  "PR PR-001 has no provider number yet. Run: orc pr sync PR-001",
+		},
+		{
+			name:        "cannot import as other note types",
+			ctx:         ImportReviewsContext{PRID: "PR-001", Number: 7, NoteType: "idea"},
+			wantAllowed: false,
+			wantReason:  `review notes must be of type concern or bug (got "idea")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanImportReviews(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package pr
+
+import "fmt"
+
+// MergeCommitMessage is the message of the merge commit that lands a PR locally.
+func MergeCommitMessage(prID string, number int, title, branch string) string {
+	msg := fmt.Sprintf("Merge branch '%s' (%s)", branch, mergeRef(prID, number))
+	if title != "" {
+		msg += "\n\n" + title
+	}
+	return msg
+}
+
+// SquashCommitMessage is the message of the single commit a squash merge lands,
+// titled after the PR.
+func SquashCommitMessage(prID string, number int, title, branch string) string {
+	if title == "" {
+		title = branch
+	}
+	return fmt.Sprintf("%s (%s)\n\nSquashed from %s.", title, mergeRef(prID, number), branch)
+}
+
+// mergeRef names a PR in a commit message, with its provider number when it has one.
+func mergeRef(prID string, number int) string {
+	if number > 0 {
+		return fmt.Sprintf("%s, #%d", prID, number)
+	}
+	return prID
+}
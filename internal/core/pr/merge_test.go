@@ -0,0 +1,24 @@
+package pr
+
+import "testing"
+
+func TestMergeCommitMessages(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"merge", MergeCommitMessage("PR-001", 0, "Add login", "ml/login"), "Merge branch 'ml/login' (PR-001)\n\nAdd login"},
+		{"merge with number", MergeCommitMessage("PR-001", 41, "", "ml/login"), "Merge branch 'ml/login' (PR-001, #41)"},
+		{"squash", SquashCommitMessage("PR-001", 41, "Add login", "ml/login"), "Add login (PR-001, #41)\n\nSquashed from ml/login."},
+		{"squash without title", SquashCommitMessage("PR-001", 0, "", "ml/login"), "ml/login (PR-001)\n\nSquashed from ml/login."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
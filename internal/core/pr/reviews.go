@@ -0,0 +1,118 @@
+package pr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxReviewTitleLen bounds the title of a note imported from a review thread.
+const maxReviewTitleLen = 72
+
+// ReviewComment is one comment of a review thread.
+type ReviewComment struct {
+	Author string
+	Body   string
+}
+
+// ReviewThread is a provider review thread as imported into a note.
+type ReviewThread struct {
+	URL      string
+	Path     string // Empty for PR-level threads
+	Line     int    // 0 when not on a line
+	Outdated bool
+	Comments []ReviewComment // Oldest first
+}
+
+// ReviewLocation formats a thread's file and line ("auth.go:42"), or "" for PR-level threads.
+func ReviewLocation(path string, line int) string {
+	switch {
+	case path == "":
+		return ""
+	case line == 0:
+		return path
+	}
+	return fmt.Sprintf("%s:%d", path, line)
+}
+
+// ReviewNoteTitle titles a note after the location and first line of a thread's
+// opening comment, shortened to fit a list row.
+func ReviewNoteTitle(t ReviewThread) string {
+	summary := ""
+	if len(t.Comments) > 0 {
+		summary, _, _ = strings.Cut(strings.TrimSpace(t.Comments[0].Body), "\n")
+		summary = strings.TrimSpace(summary)
+	}
+	if summary == "" {
+		summary = "review comment"
+	}
+
+	title := summary
+	if loc := ReviewLocation(t.Path, t.Line); loc != "" {
+		title = loc + ": " + summary
+	}
+	if runes := []rune(title); len(runes) > maxReviewTitleLen {
+		title = strings.TrimSpace(string(runes[:maxReviewTitleLen-1])) + "…"
+	}
+	return title
+}
+
+// ReviewNoteContent renders a thread as note content: the conversation, then
+// the link and location an imp needs to find it.
+func ReviewNoteContent(t ReviewThread) string {
+	var b strings.Builder
+	for i, c := range t.Comments {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		author := c.Author
+		if author == "" {
+			author = "unknown"
+		}
+		fmt.Fprintf(&b, "@%s:\n%s", author, strings.TrimSpace(c.Body))
+	}
+
+	b.WriteString("\n\n")
+	if t.URL != "" {
+		fmt.Fprintf(&b, "Review comment: %s\n", t.URL)
+	}
+	if loc := ReviewLocation(t.Path, t.Line); loc != "" {
+		fmt.Fprintf(&b, "Location: %s", loc)
+		if t.Outdated {
+			b.WriteString(" (outdated)")
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimLeft(b.String(), "\n")
+}
+
+// ThreadAction is what a sync does to bring an imported review thread and its
+// note back in agreement.
+type ThreadAction string
+
+// Thread sync actions.
+const (
+	ThreadInSync        ThreadAction = ""
+	ThreadResolveRemote ThreadAction = "resolve_remote" // Note was resolved: resolve the thread
+	ThreadReopenRemote  ThreadAction = "reopen_remote"  // Note was reopened: reopen the thread
+	ThreadResolveNote   ThreadAction = "resolve_note"   // Thread was resolved: resolve the note
+	ThreadReopenNote    ThreadAction = "reopen_note"    // Thread was reopened: reopen the note
+)
+
+// PlanThreadSync compares a note and its review thread against the resolution
+// state both agreed on at the last sync. The side that changed since then wins;
+// if both changed they already agree again.
+func PlanThreadSync(agreed, noteResolved, threadResolved bool) ThreadAction {
+	noteChanged := noteResolved != agreed
+	threadChanged := threadResolved != agreed
+	switch {
+	case noteChanged && !threadChanged && noteResolved:
+		return ThreadResolveRemote
+	case noteChanged && !threadChanged:
+		return ThreadReopenRemote
+	case threadChanged && !noteChanged && threadResolved:
+		return ThreadResolveNote
+	case threadChanged && !noteChanged:
+		return ThreadReopenNote
+	}
+	return ThreadInSync
+}
@@ -0,0 +1,69 @@
+package pr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReviewNoteTitle(t *testing.T) {
+	tests := []struct {
+		name   string
+		thread ReviewThread
+		want   string
+	}{
+		{"line comment", ReviewThread{Path: "auth.go", Line: 42, Comments: []ReviewComment{{Body: "Check the error\nIt can be nil"}}}, "auth.go:42: Check the error"},
+		{"file comment", ReviewThread{Path: "auth.go", Comments: []ReviewComment{{Body: "Split this file"}}}, "auth.go: Split this file"},
+		{"PR-level comment", ReviewThread{Comments: []ReviewComment{{Body: "  Needs a changelog entry  "}}}, "Needs a changelog entry"},
+		{"empty body", ReviewThread{Path: "a.go", Line: 1}, "a.go:1: review comment"},
+		{"long body", ReviewThread{Comments: []ReviewComment{{Body: strings.Repeat("word ", 30)}}}, strings.TrimSpace(strings.Repeat("word ", 15)[:71]) + "…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReviewNoteTitle(tt.thread); got != tt.want {
+				t.Errorf("ReviewNoteTitle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReviewNoteContent(t *testing.T) {
+	got := ReviewNoteContent(ReviewThread{
+		URL:      "https://github.com/acme/app/pull/7#discussion_r1",
+		Path:     "auth.go",
+		Line:     42,
+		Outdated: true,
+		Comments: []ReviewComment{{Author: "bob", Body: "Check the error"}, {Body: "Done?"}},
+	})
+	want := "@bob:\nCheck the error\n\n@unknown:\nDone?\n\n" +
+		"Review comment: https://github.com/acme/app/pull/7#discussion_r1\n" +
+		"Location: auth.go:42 (outdated)\n"
+	if got != want {
+		t.Errorf("ReviewNoteContent() = %q, want %q", got, want)
+	}
+}
+
+func TestPlanThreadSync(t *testing.T) {
+	tests := []struct {
+		name                                 string
+		agreed, noteResolved, threadResolved bool
+		want                                 ThreadAction
+	}{
+		{"both open", false, false, false, ThreadInSync},
+		{"note resolved", false, true, false, ThreadResolveRemote},
+		{"thread resolved", false, false, true, ThreadResolveNote},
+		{"both resolved", false, true, true, ThreadInSync},
+		{"both still resolved", true, true, true, ThreadInSync},
+		{"note reopened", true, false, true, ThreadReopenRemote},
+		{"thread reopened", true, true, false, ThreadReopenNote},
+		{"both reopened", true, false, false, ThreadInSync},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlanThreadSync(tt.agreed, tt.noteResolved, tt.threadResolved); got != tt.want {
+				t.Errorf("PlanThreadSync() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package pr
+
+import "sort"
+
+// StackPR is a PR as a member of a stack of PRs linked by ParentID.
+type StackPR struct {
+	ID           string
+	ParentID     string // PR whose branch this one targets; empty at the bottom
+	Branch       string
+	TargetBranch string
+	Status       string
+}
+
+// StackEntry is a PR placed in a stack. Depth is 0 for the bottom PR.
+type StackEntry struct {
+	PR    StackPR
+	Depth int
+}
+
+// Stacks groups PRs into stacks, each ordered bottom first with every PR followed
+// by the PRs stacked on it. PRs with nothing stacked on them and no parent are
+// not part of a stack. Stacks are ordered by their bottom PR's ID.
+func Stacks(prs []StackPR) [][]StackEntry {
+	byID := make(map[string]StackPR, len(prs))
+	for _, p := range prs {
+		byID[p.ID] = p
+	}
+	children := make(map[string][]StackPR)
+	var roots []StackPR
+	for _, p := range prs {
+		if _, ok := byID[p.ParentID]; ok && p.ParentID != p.ID {
+			children[p.ParentID] = append(children[p.ParentID], p)
+		} else {
+			roots = append(roots, p)
+		}
+	}
+	byIDOrder := func(list []StackPR) {
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	}
+	byIDOrder(roots)
+
+	var stacks [][]StackEntry
+	for _, root := range roots {
+		if len(children[root.ID]) == 0 {
+			continue
+		}
+		var stack []StackEntry
+		seen := make(map[string]bool)
+		var walk func(p StackPR, depth int)
+		walk = func(p StackPR, depth int) {
+			if seen[p.ID] {
+				return
+			}
+			seen[p.ID] = true
+			stack = append(stack, StackEntry{PR: p, Depth: depth})
+			kids := children[p.ID]
+			byIDOrder(kids)
+			for _, c := range kids {
+				walk(c, depth+1)
+			}
+		}
+		walk(root, 0)
+		stacks = append(stacks, stack)
+	}
+	return stacks
+}
+
+// StackContaining returns the stack that prID is part of, or nil.
+func StackContaining(prs []StackPR, prID string) []StackEntry {
+	for _, stack := range Stacks(prs) {
+		for _, e := range stack {
+			if e.PR.ID == prID {
+				return stack
+			}
+		}
+	}
+	return nil
+}
+
+// Ancestors returns the IDs of the PRs below prID in its stack, nearest first.
+func Ancestors(prs []StackPR, prID string) []string {
+	byID := make(map[string]StackPR, len(prs))
+	for _, p := range prs {
+		byID[p.ID] = p
+	}
+	var ids []string
+	seen := map[string]bool{prID: true}
+	for p, ok := byID[prID]; ok && p.ParentID != ""; p, ok = byID[p.ParentID] {
+		if seen[p.ParentID] {
+			break
+		}
+		seen[p.ParentID] = true
+		ids = append(ids, p.ParentID)
+	}
+	return ids
+}
+
+// StackBase returns the branch the bottom of prID's stack targets: the branch a
+// PR ends up targeting once every PR below it is merged.
+func StackBase(prs []StackPR, prID string) string {
+	byID := make(map[string]StackPR, len(prs))
+	for _, p := range prs {
+		byID[p.ID] = p
+	}
+	bottom := byID[prID]
+	if ancestors := Ancestors(prs, prID); len(ancestors) > 0 {
+		bottom = byID[ancestors[len(ancestors)-1]]
+	}
+	return bottom.TargetBranch
+}
+
+// RestackStep moves one PR branch onto its (possibly new) parent.
+type RestackStep struct {
+	PRID     string
+	Branch   string
+	ParentID string // New parent; empty when the PR now targets the stack's base branch
+	Onto     string // Branch to rebase onto: the parent's branch, or the base branch
+	Upstream string // Old parent's branch, whose commits are not replayed; empty for the bottom PR
+	Retarget bool   // The PR's parent or target branch changes
+}
+
+// PlanRestack plans rebasing each open PR of a stack onto its parent, bottom
+// first so every parent is moved before the PRs stacked on it. Merged and closed
+// PRs are skipped, and the PRs stacked on them move down onto the nearest open
+// PR below, or onto the stack's base branch.
+func PlanRestack(stack []StackEntry) []RestackStep {
+	byID := make(map[string]StackPR, len(stack))
+	prs := make([]StackPR, len(stack))
+	for i, e := range stack {
+		byID[e.PR.ID] = e.PR
+		prs[i] = e.PR
+	}
+
+	var steps []RestackStep
+	for _, e := range stack {
+		p := e.PR
+		if isSettled(p.Status) {
+			continue
+		}
+
+		parentID := p.ParentID
+		for parent, ok := byID[parentID]; ok && isSettled(parent.Status); parent, ok = byID[parentID] {
+			parentID = parent.ParentID
+		}
+		if _, ok := byID[parentID]; !ok {
+			parentID = ""
+		}
+
+		step := RestackStep{PRID: p.ID, Branch: p.Branch, ParentID: parentID}
+		if parentID != "" {
+			step.Onto = byID[parentID].Branch
+		} else {
+			step.Onto = StackBase(prs, p.ID)
+		}
+		if old, ok := byID[p.ParentID]; ok {
+			step.Upstream = old.Branch
+		}
+		step.Retarget = parentID != p.ParentID || step.Onto != p.TargetBranch
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+func isSettled(status string) bool {
+	return status == "merged" || status == "closed"
+}
@@ -0,0 +1,96 @@
+package pr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStacks(t *testing.T) {
+	prs := []StackPR{
+		{ID: "PR-004", ParentID: "PR-002", Branch: "d"},
+		{ID: "PR-001", Branch: "a", TargetBranch: "main"},
+		{ID: "PR-003", ParentID: "PR-001", Branch: "c"},
+		{ID: "PR-002", ParentID: "PR-001", Branch: "b"},
+		{ID: "PR-005", Branch: "e", TargetBranch: "main"}, // Alone: not a stack
+	}
+
+	stacks := Stacks(prs)
+	if len(stacks) != 1 {
+		t.Fatalf("got %d stacks, want 1", len(stacks))
+	}
+	var got []string
+	var depths []int
+	for _, e := range stacks[0] {
+		got = append(got, e.PR.ID)
+		depths = append(depths, e.Depth)
+	}
+	if want := []string{"PR-001", "PR-002", "PR-004", "PR-003"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+	if want := []int{0, 1, 2, 1}; !reflect.DeepEqual(depths, want) {
+		t.Errorf("depths = %v, want %v", depths, want)
+	}
+
+	if StackContaining(prs, "PR-004") == nil || StackContaining(prs, "PR-005") != nil {
+		t.Error("StackContaining should find PR-004 and not the lone PR-005")
+	}
+	if got := Ancestors(prs, "PR-004"); !reflect.DeepEqual(got, []string{"PR-002", "PR-001"}) {
+		t.Errorf("Ancestors = %v", got)
+	}
+	if got := StackBase(prs, "PR-004"); got != "main" {
+		t.Errorf("StackBase = %q, want main", got)
+	}
+}
+
+func TestPlanRestack(t *testing.T) {
+	tests := []struct {
+		name string
+		prs  []StackPR
+		want []RestackStep
+	}{
+		{
+			name: "base moved: every branch moves onto its parent",
+			prs: []StackPR{
+				{ID: "PR-001", Branch: "a", TargetBranch: "main", Status: "open"},
+				{ID: "PR-002", ParentID: "PR-001", Branch: "b", TargetBranch: "a", Status: "open"},
+			},
+			want: []RestackStep{
+				{PRID: "PR-001", Branch: "a", Onto: "main"},
+				{PRID: "PR-002", Branch: "b", ParentID: "PR-001", Onto: "a", Upstream: "a"},
+			},
+		},
+		{
+			name: "bottom merged: next PR moves onto the base",
+			prs: []StackPR{
+				{ID: "PR-001", Branch: "a", TargetBranch: "main", Status: "merged"},
+				{ID: "PR-002", ParentID: "PR-001", Branch: "b", TargetBranch: "a", Status: "open"},
+				{ID: "PR-003", ParentID: "PR-002", Branch: "c", TargetBranch: "b", Status: "draft"},
+			},
+			want: []RestackStep{
+				{PRID: "PR-002", Branch: "b", Onto: "main", Upstream: "a", Retarget: true},
+				{PRID: "PR-003", Branch: "c", ParentID: "PR-002", Onto: "b", Upstream: "b"},
+			},
+		},
+		{
+			name: "middle merged: top PR moves onto the bottom",
+			prs: []StackPR{
+				{ID: "PR-001", Branch: "a", TargetBranch: "main", Status: "open"},
+				{ID: "PR-002", ParentID: "PR-001", Branch: "b", TargetBranch: "a", Status: "merged"},
+				{ID: "PR-003", ParentID: "PR-002", Branch: "c", TargetBranch: "b", Status: "open"},
+			},
+			want: []RestackStep{
+				{PRID: "PR-001", Branch: "a", Onto: "main"},
+				{PRID: "PR-003", Branch: "c", ParentID: "PR-001", Onto: "a", Upstream: "b", Retarget: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlanRestack(StackContaining(tt.prs, tt.prs[0].ID))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PlanRestack() =\n  %+v\nwant\n  %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package pr
+
+import (
+	"strconv"
+	"time"
+)
+
+// Provider-reported PR states (mirrors secondary.ProviderPRState*).
+const (
+	RemoteOpen   = "open"
+	RemoteClosed = "closed"
+	RemoteMerged = "merged"
+)
+
+// SyncState holds the provider-owned fields of a PR, either as stored in the
+// ledger or as reported by the provider. Timestamps are RFC3339; empty means null.
+type SyncState struct {
+	Number         int
+	URL            string
+	Status         string // Ledger status: draft, open, approved, merged, closed
+	ReviewDecision string
+	Mergeable      string
+	MergedAt       string
+	ClosedAt       string
+}
+
+// RemotePR is a pull request as reported by its provider.
+type RemotePR struct {
+	Number         int
+	URL            string
+	State          string // RemoteOpen, RemoteClosed or RemoteMerged
+	Draft          bool
+	ReviewDecision string
+	Mergeable      string
+	MergedAt       string
+	ClosedAt       string
+}
+
+// FieldChange is one ledger field changed by a sync.
+type FieldChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// StatusFromRemote maps a provider PR onto the ledger status lifecycle:
+// merged and closed are terminal, an open draft stays draft, an open PR with an
+// approving review decision is approved, and anything else open is open.
+func StatusFromRemote(remote RemotePR) string {
+	switch {
+	case remote.State == RemoteMerged:
+		return "merged"
+	case remote.State == RemoteClosed:
+		return "closed"
+	case remote.Draft:
+		return "draft"
+	case remote.ReviewDecision == "approved":
+		return "approved"
+	}
+	return "open"
+}
+
+// PlanSync computes the ledger state after syncing from the provider and the
+// field changes it implies. The provider is the source of truth for every field
+// in SyncState, except that an unknown number or URL never erases a known one.
+// Timestamps that name the same instant in different formats are not changes.
+func PlanSync(ledger SyncState, remote RemotePR) (SyncState, []FieldChange) {
+	next := SyncState{
+		Number:         remote.Number,
+		URL:            remote.URL,
+		Status:         StatusFromRemote(remote),
+		ReviewDecision: remote.ReviewDecision,
+		Mergeable:      remote.Mergeable,
+		MergedAt:       remote.MergedAt,
+		ClosedAt:       remote.ClosedAt,
+	}
+	if next.Number == 0 {
+		next.Number = ledger.Number
+	}
+	if next.URL == "" {
+		next.URL = ledger.URL
+	}
+	if sameInstant(ledger.MergedAt, next.MergedAt) {
+		next.MergedAt = ledger.MergedAt
+	}
+	if sameInstant(ledger.ClosedAt, next.ClosedAt) {
+		next.ClosedAt = ledger.ClosedAt
+	}
+
+	var changes []FieldChange
+	add := func(field, old, new string) {
+		if old != new {
+			changes = append(changes, FieldChange{Field: field, Old: old, New: new})
+		}
+	}
+	add("number", formatNumber(ledger.Number), formatNumber(next.Number))
+	add("url", ledger.URL, next.URL)
+	add("status", ledger.Status, next.Status)
+	add("review_decision", ledger.ReviewDecision, next.ReviewDecision)
+	add("mergeable", ledger.Mergeable, next.Mergeable)
+	add("merged_at", ledger.MergedAt, next.MergedAt)
+	add("closed_at", ledger.ClosedAt, next.ClosedAt)
+
+	return next, changes
+}
+
+// sameInstant reports whether two RFC3339 timestamps are equal in time.
+func sameInstant(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	return errA == nil && errB == nil && ta.Equal(tb)
+}
+
+func formatNumber(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
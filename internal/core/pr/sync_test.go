@@ -0,0 +1,91 @@
+package pr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStatusFromRemote(t *testing.T) {
+	tests := []struct {
+		name   string
+		remote RemotePR
+		want   string
+	}{
+		{"merged", RemotePR{State: RemoteMerged, ReviewDecision: "approved"}, "merged"},
+		{"closed", RemotePR{State: RemoteClosed, Draft: true}, "closed"},
+		{"open draft", RemotePR{State: RemoteOpen, Draft: true, ReviewDecision: "approved"}, "draft"},
+		{"open approved", RemotePR{State: RemoteOpen, ReviewDecision: "approved"}, "approved"},
+		{"open changes requested", RemotePR{State: RemoteOpen, ReviewDecision: "changes_requested"}, "open"},
+		{"open", RemotePR{State: RemoteOpen}, "open"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StatusFromRemote(tt.remote); got != tt.want {
+				t.Errorf("StatusFromRemote() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanSync(t *testing.T) {
+	tests := []struct {
+		name        string
+		ledger      SyncState
+		remote      RemotePR
+		wantState   SyncState
+		wantChanges []FieldChange
+	}{
+		{
+			name:      "merged in the web UI",
+			ledger:    SyncState{Number: 7, URL: "u", Status: "open", Mergeable: "mergeable"},
+			remote:    RemotePR{Number: 7, URL: "u", State: RemoteMerged, ReviewDecision: "approved", MergedAt: "2026-03-01T10:00:00Z", ClosedAt: "2026-03-01T10:00:00Z"},
+			wantState: SyncState{Number: 7, URL: "u", Status: "merged", ReviewDecision: "approved", MergedAt: "2026-03-01T10:00:00Z", ClosedAt: "2026-03-01T10:00:00Z"},
+			wantChanges: []FieldChange{
+				{"status", "open", "merged"},
+				{"review_decision", "", "approved"},
+				{"mergeable", "mergeable", ""},
+				{"merged_at", "", "2026-03-01T10:00:00Z"},
+				{"closed_at", "", "2026-03-01T10:00:00Z"},
+			},
+		},
+		{
+			name:        "found by branch",
+			ledger:      SyncState{Status: "draft"},
+			remote:      RemotePR{Number: 12, URL: "https://x/pull/12", State: RemoteOpen, Mergeable: "unknown"},
+			wantState:   SyncState{Number: 12, URL: "https://x/pull/12", Status: "open", Mergeable: "unknown"},
+			wantChanges: []FieldChange{{"number", "", "12"}, {"url", "", "https://x/pull/12"}, {"status", "draft", "open"}, {"mergeable", "", "unknown"}},
+		},
+		{
+			name:      "same instant in another zone is unchanged",
+			ledger:    SyncState{Number: 7, URL: "u", Status: "closed", ClosedAt: "2026-03-01T11:00:00+01:00"},
+			remote:    RemotePR{Number: 7, URL: "u", State: RemoteClosed, ClosedAt: "2026-03-01T10:00:00Z"},
+			wantState: SyncState{Number: 7, URL: "u", Status: "closed", ClosedAt: "2026-03-01T11:00:00+01:00"},
+		},
+		{
+			name:        "reopened clears closed_at",
+			ledger:      SyncState{Number: 7, URL: "u", Status: "closed", ClosedAt: "2026-03-01T10:00:00Z"},
+			remote:      RemotePR{Number: 7, URL: "u", State: RemoteOpen, Mergeable: "mergeable"},
+			wantState:   SyncState{Number: 7, URL: "u", Status: "open", Mergeable: "mergeable"},
+			wantChanges: []FieldChange{{"status", "closed", "open"}, {"mergeable", "", "mergeable"}, {"closed_at", "2026-03-01T10:00:00Z", ""}},
+		},
+		{
+			name:      "missing URL keeps the known one",
+			ledger:    SyncState{Number: 7, URL: "u", Status: "approved", ReviewDecision: "approved"},
+			remote:    RemotePR{Number: 7, State: RemoteOpen, ReviewDecision: "approved"},
+			wantState: SyncState{Number: 7, URL: "u", Status: "approved", ReviewDecision: "approved"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, changes := PlanSync(tt.ledger, tt.remote)
+			if state != tt.wantState {
+				t.Errorf("PlanSync() state = %+v, want %+v", state, tt.wantState)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("PlanSync() changes = %+v, want %+v", changes, tt.wantChanges)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ConfigFile is the checked-in per-repo configuration, relative to the repository root.
+const ConfigFile = ".orc/repo.json"
+
+// Bootstrap step sources, reported with every bootstrap run.
+const (
+	BootstrapSourceRecord = "repo record"
+	BootstrapSourceFile   = ConfigFile
+)
+
+// Config is the content of a repository's checked-in .orc/repo.json.
+type Config struct {
+	// Bootstrap lists shell commands run in every new worktree, in order
+	// (e.g., "make deps", "cp -n .env.example .env").
+	Bootstrap []string `json:"bootstrap"`
+
+	// Merge makes orc pr merge land PRs locally (see MergeConfig).
+	Merge *MergeConfig `json:"merge,omitempty"`
+}
+
+// ParseConfig parses a repository's .orc/repo.json.
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ConfigFile, err)
+	}
+	if err := ValidateBootstrapSteps(cfg.Bootstrap); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ConfigFile, err)
+	}
+	if cfg.Merge != nil {
+		if err := ValidateMergeConfig(cfg.Merge); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", ConfigFile, err)
+		}
+	}
+	return &cfg, nil
+}
+
+// ParseBootstrapSteps decodes bootstrap steps stored on a repo record.
+// An empty string means no steps.
+func ParseBootstrapSteps(data string) ([]string, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var steps []string
+	if err := json.Unmarshal([]byte(data), &steps); err != nil {
+		return nil, fmt.Errorf("invalid bootstrap steps: %w", err)
+	}
+	return steps, nil
+}
+
+// FormatBootstrapSteps encodes bootstrap steps for a repo record.
+// No steps encode to the empty string.
+func FormatBootstrapSteps(steps []string) (string, error) {
+	if len(steps) == 0 {
+		return "", nil
+	}
+	if err := ValidateBootstrapSteps(steps); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(steps)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ValidateBootstrapSteps rejects blank steps.
+func ValidateBootstrapSteps(steps []string) error {
+	for i, step := range steps {
+		if strings.TrimSpace(step) == "" {
+			return fmt.Errorf("bootstrap step %d is empty", i+1)
+		}
+	}
+	return nil
+}
+
+// ResolveBootstrap picks the steps to run in a new worktree. Steps on the repo record
+// override the checked-in file so a machine can adapt setup without committing.
+// Returns the steps and their source, or no steps and an empty source.
+func ResolveBootstrap(recordSteps, fileSteps []string) ([]string, string) {
+	if len(recordSteps) > 0 {
+		return recordSteps, BootstrapSourceRecord
+	}
+	if len(fileSteps) > 0 {
+		return fileSteps, BootstrapSourceFile
+	}
+	return nil, ""
+}
+
+// TailOutput keeps the last max bytes of command output, marking the cut.
+func TailOutput(output string, max int) string {
+	if len(output) <= max {
+		return output
+	}
+	return "…" + output[len(output)-max:]
+}
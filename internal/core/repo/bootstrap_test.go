@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "bootstrap steps",
+			data: `{"bootstrap": ["make deps", "cp -n .env.example .env"]}`,
+			want: []string{"make deps", "cp -n .env.example .env"},
+		},
+		{
+			name: "no bootstrap",
+			data: `{}`,
+			want: nil,
+		},
+		{
+			name:    "malformed json",
+			data:    `{"bootstrap": "make deps"}`,
+			wantErr: true,
+		},
+		{
+			name:    "blank step",
+			data:    `{"bootstrap": ["make deps", "  "]}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := ParseConfig([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(cfg.Bootstrap, tt.want) {
+				t.Errorf("Bootstrap = %q, want %q", cfg.Bootstrap, tt.want)
+			}
+		})
+	}
+}
+
+func TestBootstrapStepsRoundTrip(t *testing.T) {
+	steps := []string{"npm ci", `ln -sfn "$HOME/.cache/npm" .npm`}
+	data, err := FormatBootstrapSteps(steps)
+	if err != nil {
+		t.Fatalf("FormatBootstrapSteps failed: %v", err)
+	}
+	got, err := ParseBootstrapSteps(data)
+	if err != nil {
+		t.Fatalf("ParseBootstrapSteps failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, steps) {
+		t.Errorf("round trip = %q, want %q", got, steps)
+	}
+
+	if data, _ := FormatBootstrapSteps(nil); data != "" {
+		t.Errorf("expected no steps to encode as empty, got %q", data)
+	}
+	if _, err := FormatBootstrapSteps([]string{""}); err == nil {
+		t.Error("expected error for a blank step")
+	}
+}
+
+func TestResolveBootstrap(t *testing.T) {
+	tests := []struct {
+		name       string
+		record     []string
+		file       []string
+		wantSteps  []string
+		wantSource string
+	}{
+		{"record overrides file", []string{"make deps-local"}, []string{"make deps"}, []string{"make deps-local"}, BootstrapSourceRecord},
+		{"file when record is empty", nil, []string{"make deps"}, []string{"make deps"}, BootstrapSourceFile},
+		{"nothing configured", nil, nil, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps, source := ResolveBootstrap(tt.record, tt.file)
+			if !reflect.DeepEqual(steps, tt.wantSteps) || source != tt.wantSource {
+				t.Errorf("ResolveBootstrap() = %q, %q; want %q, %q", steps, source, tt.wantSteps, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestTailOutput(t *testing.T) {
+	if got := TailOutput("short", 10); got != "short" {
+		t.Errorf("TailOutput() = %q, want unchanged", got)
+	}
+	if got := TailOutput("0123456789", 4); got != "…6789" {
+		t.Errorf("TailOutput() = %q, want %q", got, "…6789")
+	}
+}
@@ -27,6 +27,14 @@ type CreateRepoContext struct {
 	NameExists bool // true if a repo with this name already exists
 }
 
+// CloneRepoContext provides context for cloning a repository into the workspace.
+type CloneRepoContext struct {
+	Name         string
+	URL          string
+	TargetPath   string
+	TargetExists bool // true if something already exists at TargetPath
+}
+
 // ArchiveRepoContext provides context for repository archive guards.
 type ArchiveRepoContext struct {
 	RepoID string
@@ -69,6 +77,30 @@ func CanCreateRepo(ctx CreateRepoContext) GuardResult {
 	return GuardResult{Allowed: true}
 }
 
+// CanCloneRepo evaluates whether a repository can be cloned.
+// Rules:
+// - URL must be set
+// - Target path must not exist yet
+func CanCloneRepo(ctx CloneRepoContext) GuardResult {
+	// Rule 1: Need something to clone
+	if strings.TrimSpace(ctx.URL) == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot clone repository %q without --url", ctx.Name),
+		}
+	}
+
+	// Rule 2: Never clone over an existing directory
+	if ctx.TargetExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("%s already exists. Register it with --path instead of --clone", ctx.TargetPath),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
 // CanArchiveRepo evaluates whether a repository can be archived.
 // Rules:
 // - Status must be "active"
@@ -59,6 +59,45 @@ func TestCanCreateRepo(t *testing.T) {
 	}
 }
 
+func TestCanCloneRepo(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         CloneRepoContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can clone into a free path",
+			ctx:         CloneRepoContext{Name: "api", URL: "git@github.com:org/api.git", TargetPath: "/home/me/src/api"},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot clone without url",
+			ctx:         CloneRepoContext{Name: "api", TargetPath: "/home/me/src/api"},
+			wantAllowed: false,
+			wantReason:  `cannot clone repository "api" without --url`,
+		},
+		{
+			name:        "cannot clone over an existing directory",
+			ctx:         CloneRepoContext{Name: "api", URL: "git@github.com:org/api.git", TargetPath: "/home/me/src/api", TargetExists: true},
+			wantAllowed: false,
+			wantReason:  "/home/me/src/api already exists. Register it with --path instead of --clone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanCloneRepo(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
 func TestCanArchiveRepo(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"fmt"
+	"time"
+)
+
+// StaleFetchAge is how long a clone may go without a fetch before it is reported as stale.
+const StaleFetchAge = 7 * 24 * time.Hour
+
+// HealthContext describes the local clone of a repository.
+type HealthContext struct {
+	Cloned                bool
+	HasOrigin             bool      // Local-only clones have nothing to fetch or drift from
+	LastFetch             time.Time // Zero if the clone was never fetched
+	Now                   time.Time
+	DefaultBranch         string // Default branch recorded in the ledger
+	DetectedDefaultBranch string // Default branch the remote advertises
+	Behind                int    // Commits on origin/<default> missing from the local default branch
+	Ahead                 int    // Local default branch commits not on origin/<default>
+	DirtyFiles            int
+}
+
+// EvaluateHealth returns the problems with a repository's local clone, most urgent first.
+// An empty result means the clone is healthy.
+func EvaluateHealth(ctx HealthContext) []string {
+	if !ctx.Cloned {
+		return []string{"not cloned"}
+	}
+
+	var issues []string
+	if ctx.DetectedDefaultBranch != "" && ctx.DefaultBranch != "" && ctx.DetectedDefaultBranch != ctx.DefaultBranch {
+		issues = append(issues, fmt.Sprintf("default branch is %s on origin but %s in the ledger", ctx.DetectedDefaultBranch, ctx.DefaultBranch))
+	}
+	if ctx.HasOrigin {
+		if ctx.LastFetch.IsZero() {
+			issues = append(issues, "never fetched")
+		} else if ctx.Now.Sub(ctx.LastFetch) > StaleFetchAge {
+			issues = append(issues, fmt.Sprintf("last fetched %s ago", FormatAge(ctx.Now.Sub(ctx.LastFetch))))
+		}
+	}
+	if ctx.Ahead > 0 {
+		issues = append(issues, fmt.Sprintf("local %s has %d commit(s) not on origin", ctx.DefaultBranch, ctx.Ahead))
+	}
+	if ctx.Behind > 0 {
+		issues = append(issues, fmt.Sprintf("local %s is %d commit(s) behind origin", ctx.DefaultBranch, ctx.Behind))
+	}
+	if ctx.DirtyFiles > 0 {
+		issues = append(issues, fmt.Sprintf("%d uncommitted file(s)", ctx.DirtyFiles))
+	}
+	return issues
+}
+
+// FormatAge renders a duration coarsely for status output (e.g., "3d", "5h", "12m").
+func FormatAge(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm", int(d/time.Minute))
+	default:
+		return "just now"
+	}
+}
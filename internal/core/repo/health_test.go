@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEvaluateHealth(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		ctx  HealthContext
+		want []string
+	}{
+		{
+			name: "healthy clone",
+			ctx:  HealthContext{Cloned: true, HasOrigin: true, LastFetch: now.Add(-2 * time.Hour), Now: now, DefaultBranch: "main", DetectedDefaultBranch: "main"},
+			want: nil,
+		},
+		{
+			name: "missing clone",
+			ctx:  HealthContext{Now: now, DefaultBranch: "main"},
+			want: []string{"not cloned"},
+		},
+		{
+			name: "never fetched",
+			ctx:  HealthContext{Cloned: true, HasOrigin: true, Now: now, DefaultBranch: "main"},
+			want: []string{"never fetched"},
+		},
+		{
+			name: "stale and drifted",
+			ctx: HealthContext{
+				Cloned: true, HasOrigin: true, LastFetch: now.Add(-10 * 24 * time.Hour), Now: now,
+				DefaultBranch: "master", DetectedDefaultBranch: "main", Behind: 4, DirtyFiles: 2,
+			},
+			want: []string{
+				"default branch is main on origin but master in the ledger",
+				"last fetched 10d ago",
+				"local master is 4 commit(s) behind origin",
+				"2 uncommitted file(s)",
+			},
+		},
+		{
+			name: "local-only clone is never stale",
+			ctx:  HealthContext{Cloned: true, Now: now, DefaultBranch: "main"},
+			want: nil,
+		},
+		{
+			name: "unpushed commits on default branch",
+			ctx:  HealthContext{Cloned: true, HasOrigin: true, LastFetch: now, Now: now, DefaultBranch: "main", Ahead: 1},
+			want: []string{"local main has 1 commit(s) not on origin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EvaluateHealth(tt.ctx); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EvaluateHealth() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{30 * time.Second, "just now"},
+		{12 * time.Minute, "12m"},
+		{5*time.Hour + 59*time.Minute, "5h"},
+		{3*24*time.Hour + time.Hour, "3d"},
+	}
+	for _, tt := range tests {
+		if got := FormatAge(tt.d); got != tt.want {
+			t.Errorf("FormatAge(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Local merge strategies for orc pr merge.
+const (
+	MergeStrategyMerge  = "merge"  // Merge commit (--no-ff)
+	MergeStrategySquash = "squash" // One commit with the PR's changes
+	MergeStrategyRebase = "rebase" // Replay the PR's commits onto the target branch
+)
+
+// Merge config sources, reported with every local merge.
+const (
+	MergeSourceRecord = "repo record"
+	MergeSourceFile   = ConfigFile
+)
+
+// MergeConfig tells orc pr merge to land PRs itself, in the repository's main checkout,
+// instead of only recording a merge done elsewhere.
+type MergeConfig struct {
+	Strategy string `json:"strategy"`
+	// Verify lists shell commands run on the merged result before it is pushed
+	// (e.g., "make test"); any failure undoes the merge.
+	Verify []string `json:"verify,omitempty"`
+}
+
+// ValidateMergeStrategy rejects unknown strategies.
+func ValidateMergeStrategy(strategy string) error {
+	switch strategy {
+	case MergeStrategyMerge, MergeStrategySquash, MergeStrategyRebase:
+		return nil
+	}
+	return fmt.Errorf("unknown merge strategy %q (use merge, squash or rebase)", strategy)
+}
+
+// ValidateMergeConfig rejects unknown strategies and blank verify commands.
+func ValidateMergeConfig(cfg *MergeConfig) error {
+	if err := ValidateMergeStrategy(cfg.Strategy); err != nil {
+		return err
+	}
+	for i, command := range cfg.Verify {
+		if strings.TrimSpace(command) == "" {
+			return fmt.Errorf("verify command %d is empty", i+1)
+		}
+	}
+	return nil
+}
+
+// ParseMergeConfig decodes the merge config stored on a repo record.
+// An empty string means none.
+func ParseMergeConfig(data string) (*MergeConfig, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var cfg MergeConfig
+	if err := json.Unmarshal([]byte(data), &cfg); err != nil {
+		return nil, fmt.Errorf("invalid merge config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// FormatMergeConfig encodes a merge config for a repo record.
+// A nil config encodes to the empty string.
+func FormatMergeConfig(cfg *MergeConfig) (string, error) {
+	if cfg == nil {
+		return "", nil
+	}
+	if err := ValidateMergeConfig(cfg); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ResolveMerge picks the merge config for a repository. The repo record overrides the
+// checked-in file, like bootstrap steps. Returns nil and an empty source when neither
+// sets one, meaning orc pr merge only records the merge.
+func ResolveMerge(record, file *MergeConfig) (*MergeConfig, string) {
+	if record != nil {
+		return record, MergeSourceRecord
+	}
+	if file != nil {
+		return file, MergeSourceFile
+	}
+	return nil, ""
+}
@@ -0,0 +1,90 @@
+package repo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConfig_Merge(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *MergeConfig
+		wantErr bool
+	}{
+		{
+			name: "strategy and verify commands",
+			data: `{"merge": {"strategy": "squash", "verify": ["make test"]}}`,
+			want: &MergeConfig{Strategy: MergeStrategySquash, Verify: []string{"make test"}},
+		},
+		{
+			name: "no merge block",
+			data: `{"bootstrap": ["make deps"]}`,
+			want: nil,
+		},
+		{
+			name:    "unknown strategy",
+			data:    `{"merge": {"strategy": "octopus"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing strategy",
+			data:    `{"merge": {"verify": ["make test"]}}`,
+			wantErr: true,
+		},
+		{
+			name:    "blank verify command",
+			data:    `{"merge": {"strategy": "merge", "verify": [" "]}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := ParseConfig([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(cfg.Merge, tt.want) {
+				t.Errorf("Merge = %+v, want %+v", cfg.Merge, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeConfigRoundTrip(t *testing.T) {
+	cfg := &MergeConfig{Strategy: MergeStrategyRebase, Verify: []string{"go test ./...", "make lint"}}
+	data, err := FormatMergeConfig(cfg)
+	if err != nil {
+		t.Fatalf("FormatMergeConfig failed: %v", err)
+	}
+	got, err := ParseMergeConfig(data)
+	if err != nil {
+		t.Fatalf("ParseMergeConfig failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, cfg) {
+		t.Errorf("round trip = %+v, want %+v", got, cfg)
+	}
+
+	if data, _ := FormatMergeConfig(nil); data != "" {
+		t.Errorf("FormatMergeConfig(nil) = %q, want empty", data)
+	}
+	if _, err := FormatMergeConfig(&MergeConfig{Strategy: "fast-forward"}); err == nil {
+		t.Error("expected error for an unknown strategy")
+	}
+}
+
+func TestResolveMerge(t *testing.T) {
+	record := &MergeConfig{Strategy: MergeStrategyMerge}
+	file := &MergeConfig{Strategy: MergeStrategySquash}
+
+	if got, source := ResolveMerge(record, file); got != record || source != MergeSourceRecord {
+		t.Errorf("record and file: got %+v from %q, want the record", got, source)
+	}
+	if got, source := ResolveMerge(nil, file); got != file || source != MergeSourceFile {
+		t.Errorf("file only: got %+v from %q, want the file", got, source)
+	}
+	if got, source := ResolveMerge(nil, nil); got != nil || source != "" {
+		t.Errorf("neither: got %+v from %q, want nothing", got, source)
+	}
+}
@@ -4,6 +4,7 @@ package shipment
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -33,12 +34,21 @@ type TaskSummary struct {
 	Status string
 }
 
+// PRSummary contains minimal PR info for guard evaluation.
+// An empty ID stands for a repo of the shipment that has no PR yet.
+type PRSummary struct {
+	ID     string
+	RepoID string
+	Status string // "draft", "open", "approved", "merged", "closed"
+}
+
 // CloseShipmentContext provides context for shipment close guards.
 type CloseShipmentContext struct {
 	ShipmentID      string
 	IsPinned        bool
 	Tasks           []TaskSummary
-	ForceCompletion bool // Skip task check if explicitly forced
+	PRs             []PRSummary // One per repo the shipment spans, with or without a PR
+	ForceCompletion bool        // Skip task and abandoned-PR checks if explicitly forced
 }
 
 // StatusTransitionContext provides context for status transition guards.
@@ -73,7 +83,10 @@ func CanCreateShipment(ctx CreateShipmentContext) GuardResult {
 // CanCloseShipment evaluates whether a shipment can be closed.
 // Rules:
 // - Shipment must not be pinned
+// - No PR may still be in review (draft, open, or approved), even when forced
 // - All tasks must be closed (unless forced)
+// - PRs closed without merging block closing (unless forced)
+// - Repos without a PR block closing once another repo has one (unless forced)
 func CanCloseShipment(ctx CloseShipmentContext) GuardResult {
 	if ctx.IsPinned {
 		return GuardResult{
@@ -82,6 +95,26 @@ func CanCloseShipment(ctx CloseShipmentContext) GuardResult {
 		}
 	}
 
+	var inReview, abandoned, unopened []string
+	for _, pr := range ctx.PRs {
+		switch {
+		case pr.ID == "":
+			unopened = append(unopened, pr.RepoID)
+		case pr.Status == "merged":
+		case pr.Status == "closed":
+			abandoned = append(abandoned, fmt.Sprintf("%s in %s", pr.ID, pr.RepoID))
+		default:
+			inReview = append(inReview, fmt.Sprintf("%s in %s (%s)", pr.ID, pr.RepoID, pr.Status))
+		}
+	}
+	if len(inReview) > 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason: fmt.Sprintf("cannot close shipment: %d PR(s) not merged (%s). Merge or close them first",
+				len(inReview), strings.Join(inReview, ", ")),
+		}
+	}
+
 	// Check for non-closed tasks (unless force flag is set)
 	if !ctx.ForceCompletion {
 		var incomplete []string
@@ -97,6 +130,63 @@ func CanCloseShipment(ctx CloseShipmentContext) GuardResult {
 					len(incomplete), strings.Join(incomplete, ", ")),
 			}
 		}
+
+		if len(abandoned) > 0 {
+			return GuardResult{
+				Allowed: false,
+				Reason: fmt.Sprintf("cannot close shipment: %d PR(s) closed without merging (%s). Use --force to close anyway",
+					len(abandoned), strings.Join(abandoned, ", ")),
+			}
+		}
+
+		// A shipment closed without any PR is fine; one shipped through PRs needs one per repo
+		if len(unopened) > 0 && len(unopened) < len(ctx.PRs) {
+			return GuardResult{
+				Allowed: false,
+				Reason: fmt.Sprintf("cannot close shipment: %d repo(s) have no PR (%s). Open one with: orc pr create %s --repo <repo-id>, or use --force to close anyway",
+					len(unopened), strings.Join(unopened, ", "), ctx.ShipmentID),
+			}
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// AddRepoContext provides context for adding a repository to a shipment.
+type AddRepoContext struct {
+	ShipmentID     string
+	ShipmentStatus string
+	RepoID         string
+	RepoExists     bool
+	PrimaryRepoID  string   // The shipment's own repo_id, empty if none
+	LinkedRepoIDs  []string // Additional repos already in the shipment
+}
+
+// CanAddRepo evaluates whether a repository can be added to a shipment.
+// Rules:
+// - Shipment must not be closed
+// - Repository must exist
+// - Repository must not already be part of the shipment
+func CanAddRepo(ctx AddRepoContext) GuardResult {
+	if ctx.ShipmentStatus == "closed" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot add a repository to closed shipment %s", ctx.ShipmentID),
+		}
+	}
+
+	if !ctx.RepoExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("repository %s not found", ctx.RepoID),
+		}
+	}
+
+	if ctx.RepoID == ctx.PrimaryRepoID || slices.Contains(ctx.LinkedRepoIDs, ctx.RepoID) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("repository %s is already part of shipment %s", ctx.RepoID, ctx.ShipmentID),
+		}
 	}
 
 	return GuardResult{Allowed: true}
@@ -181,3 +271,82 @@ func CanAssignWorkbench(ctx AssignWorkbenchContext) GuardResult {
 
 	return GuardResult{Allowed: true}
 }
+
+// ImportPlanContext provides context for plan import guards.
+type ImportPlanContext struct {
+	ShipmentID     string
+	ShipmentStatus string
+	TaskCount      int
+	NoteCount      int
+}
+
+// CanImportPlan evaluates whether a plan document can be imported into a shipment.
+// Rules:
+// - Shipment must not be closed
+// - Document must contain at least one task or note
+func CanImportPlan(ctx ImportPlanContext) GuardResult {
+	if ctx.ShipmentStatus == "closed" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot import into closed shipment %s", ctx.ShipmentID),
+		}
+	}
+
+	if ctx.TaskCount == 0 && ctx.NoteCount == 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  "plan document contains no tasks or notes",
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// ArchiveShipmentContext provides context for shipment archival guards.
+type ArchiveShipmentContext struct {
+	ShipmentID string
+	Status     string
+	IsPinned   bool
+	HasOpenPR  bool
+	// HoldsNewestID is true when the shipment or one of its rows has the highest ID in its table.
+	// IDs are allocated as MAX+1, so moving that row out would let the ID be reissued.
+	HoldsNewestID bool
+}
+
+// CanArchiveShipment evaluates whether a shipment can be moved to the archive ledger.
+// Rules:
+// - Shipment must be closed
+// - Pinned shipments are kept in the main ledger
+// - Shipments with an open PR are kept until the PR is merged or closed
+// - Shipments holding the newest ID of any table are kept so IDs are never reissued
+func CanArchiveShipment(ctx ArchiveShipmentContext) GuardResult {
+	if ctx.Status != "closed" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("shipment %s is not closed (status: %s)", ctx.ShipmentID, ctx.Status),
+		}
+	}
+
+	if ctx.IsPinned {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("shipment %s is pinned", ctx.ShipmentID),
+		}
+	}
+
+	if ctx.HasOpenPR {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("shipment %s has an open PR", ctx.ShipmentID),
+		}
+	}
+
+	if ctx.HoldsNewestID {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("shipment %s holds the newest ID and is kept until newer work exists", ctx.ShipmentID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
@@ -95,6 +95,83 @@ func TestCanCloseShipment(t *testing.T) {
 			wantAllowed: false,
 			wantReason:  "cannot close shipment: 2 task(s) not closed (TASK-002, TASK-003). Use --force to close anyway",
 		},
+		{
+			name: "can close shipment with all PRs merged",
+			ctx: CloseShipmentContext{
+				ShipmentID: "SHIP-001",
+				PRs: []PRSummary{
+					{ID: "PR-001", RepoID: "REPO-001", Status: "merged"},
+					{ID: "PR-002", RepoID: "REPO-002", Status: "merged"},
+				},
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "cannot close shipment with a PR in review, even when forced",
+			ctx: CloseShipmentContext{
+				ShipmentID:      "SHIP-001",
+				ForceCompletion: true,
+				PRs: []PRSummary{
+					{ID: "PR-001", RepoID: "REPO-001", Status: "merged"},
+					{ID: "PR-002", RepoID: "REPO-002", Status: "approved"},
+				},
+			},
+			wantAllowed: false,
+			wantReason:  "cannot close shipment: 1 PR(s) not merged (PR-002 in REPO-002 (approved)). Merge or close them first",
+		},
+		{
+			name: "cannot close shipment with an abandoned PR",
+			ctx: CloseShipmentContext{
+				ShipmentID: "SHIP-001",
+				PRs: []PRSummary{
+					{ID: "PR-001", RepoID: "REPO-001", Status: "merged"},
+					{ID: "PR-002", RepoID: "REPO-002", Status: "closed"},
+				},
+			},
+			wantAllowed: false,
+			wantReason:  "cannot close shipment: 1 PR(s) closed without merging (PR-002 in REPO-002). Use --force to close anyway",
+		},
+		{
+			name: "can force close shipment with an abandoned PR",
+			ctx: CloseShipmentContext{
+				ShipmentID:      "SHIP-001",
+				ForceCompletion: true,
+				PRs:             []PRSummary{{ID: "PR-002", RepoID: "REPO-002", Status: "closed"}},
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "cannot close shipment with a repo that has no PR",
+			ctx: CloseShipmentContext{
+				ShipmentID: "SHIP-001",
+				PRs: []PRSummary{
+					{ID: "PR-001", RepoID: "REPO-001", Status: "merged"},
+					{RepoID: "REPO-002"},
+				},
+			},
+			wantAllowed: false,
+			wantReason:  "cannot close shipment: 1 repo(s) have no PR (REPO-002). Open one with: orc pr create SHIP-001 --repo <repo-id>, or use --force to close anyway",
+		},
+		{
+			name: "can close shipment without any PRs",
+			ctx: CloseShipmentContext{
+				ShipmentID: "SHIP-001",
+				PRs:        []PRSummary{{RepoID: "REPO-001"}, {RepoID: "REPO-002"}},
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "can force close shipment with a repo that has no PR",
+			ctx: CloseShipmentContext{
+				ShipmentID:      "SHIP-001",
+				ForceCompletion: true,
+				PRs: []PRSummary{
+					{ID: "PR-001", RepoID: "REPO-001", Status: "merged"},
+					{RepoID: "REPO-002"},
+				},
+			},
+			wantAllowed: true,
+		},
 		{
 			name: "can force close shipment with non-closed tasks",
 			ctx: CloseShipmentContext{
@@ -276,3 +353,195 @@ func TestGuardResult_Error(t *testing.T) {
 		}
 	})
 }
+
+func TestCanImportPlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         ImportPlanContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name: "can import tasks into draft shipment",
+			ctx: ImportPlanContext{
+				ShipmentID:     "SHIP-001",
+				ShipmentStatus: "draft",
+				TaskCount:      3,
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "can import notes only",
+			ctx: ImportPlanContext{
+				ShipmentID:     "SHIP-001",
+				ShipmentStatus: "in-progress",
+				NoteCount:      1,
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "cannot import into closed shipment",
+			ctx: ImportPlanContext{
+				ShipmentID:     "SHIP-001",
+				ShipmentStatus: "closed",
+				TaskCount:      3,
+			},
+			wantAllowed: false,
+			wantReason:  "cannot import into closed shipment SHIP-001",
+		},
+		{
+			name: "cannot import empty document",
+			ctx: ImportPlanContext{
+				ShipmentID:     "SHIP-001",
+				ShipmentStatus: "ready",
+			},
+			wantAllowed: false,
+			wantReason:  "plan document contains no tasks or notes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanImportPlan(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanArchiveShipment(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         ArchiveShipmentContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name: "can archive closed shipment",
+			ctx: ArchiveShipmentContext{
+				ShipmentID: "SHIP-001",
+				Status:     "closed",
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "cannot archive in-progress shipment",
+			ctx: ArchiveShipmentContext{
+				ShipmentID: "SHIP-001",
+				Status:     "in-progress",
+			},
+			wantAllowed: false,
+			wantReason:  "shipment SHIP-001 is not closed (status: in-progress)",
+		},
+		{
+			name: "cannot archive pinned shipment",
+			ctx: ArchiveShipmentContext{
+				ShipmentID: "SHIP-001",
+				Status:     "closed",
+				IsPinned:   true,
+			},
+			wantAllowed: false,
+			wantReason:  "shipment SHIP-001 is pinned",
+		},
+		{
+			name: "cannot archive shipment with open PR",
+			ctx: ArchiveShipmentContext{
+				ShipmentID: "SHIP-001",
+				Status:     "closed",
+				HasOpenPR:  true,
+			},
+			wantAllowed: false,
+			wantReason:  "shipment SHIP-001 has an open PR",
+		},
+		{
+			name: "cannot archive shipment holding the newest ID",
+			ctx: ArchiveShipmentContext{
+				ShipmentID:    "SHIP-001",
+				Status:        "closed",
+				HoldsNewestID: true,
+			},
+			wantAllowed: false,
+			wantReason:  "shipment SHIP-001 holds the newest ID and is kept until newer work exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanArchiveShipment(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanAddRepo(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         AddRepoContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name: "can add a second repository",
+			ctx: AddRepoContext{
+				ShipmentID: "SHIP-001", ShipmentStatus: "in-progress", RepoID: "REPO-002", RepoExists: true,
+				PrimaryRepoID: "REPO-001",
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "cannot add to closed shipment",
+			ctx: AddRepoContext{
+				ShipmentID: "SHIP-001", ShipmentStatus: "closed", RepoID: "REPO-002", RepoExists: true,
+			},
+			wantAllowed: false,
+			wantReason:  "cannot add a repository to closed shipment SHIP-001",
+		},
+		{
+			name: "cannot add missing repository",
+			ctx: AddRepoContext{
+				ShipmentID: "SHIP-001", ShipmentStatus: "draft", RepoID: "REPO-404",
+			},
+			wantAllowed: false,
+			wantReason:  "repository REPO-404 not found",
+		},
+		{
+			name: "cannot add the primary repository again",
+			ctx: AddRepoContext{
+				ShipmentID: "SHIP-001", ShipmentStatus: "draft", RepoID: "REPO-001", RepoExists: true,
+				PrimaryRepoID: "REPO-001",
+			},
+			wantAllowed: false,
+			wantReason:  "repository REPO-001 is already part of shipment SHIP-001",
+		},
+		{
+			name: "cannot add a linked repository again",
+			ctx: AddRepoContext{
+				ShipmentID: "SHIP-001", ShipmentStatus: "draft", RepoID: "REPO-002", RepoExists: true,
+				PrimaryRepoID: "REPO-001", LinkedRepoIDs: []string{"REPO-002"},
+			},
+			wantAllowed: false,
+			wantReason:  "repository REPO-002 is already part of shipment SHIP-001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanAddRepo(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
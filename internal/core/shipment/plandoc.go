@@ -0,0 +1,308 @@
+package shipment
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PlanDocument is the parsed form of a structured markdown plan (as produced by ship-plan).
+//
+// Format rules:
+//   - A level-1 heading is the document title and is not imported
+//   - Level-2+ headings become tasks; following paragraphs become the task description
+//   - Checklist items under a heading become subtasks (rendered into the task description)
+//   - Checklist items before any task heading become tasks of their own; checked
+//     ones ("- [x]") are imported as done
+//   - A fenced block with the "orc" info string sets metadata on the current task
+//     (type, priority, depends-on)
+//   - Blockquotes starting with "> decision:" become decision notes on the shipment
+type PlanDocument struct {
+	Title string
+	Tasks []PlannedTask
+	Notes []PlannedNote
+}
+
+// PlannedTask is a task parsed from a plan document.
+type PlannedTask struct {
+	Ref         int // 1-based position in the document, used by "#N" dependency references
+	Title       string
+	Description string
+	Type        string
+	Priority    string
+	DependsOn   []string // "#N" document references or existing TASK-xxx IDs
+	Subtasks    []PlannedSubtask
+	Done        bool // Checked top-level checklist item
+	Line        int
+}
+
+// PlannedSubtask is a checklist item nested under a task heading.
+type PlannedSubtask struct {
+	Title string
+	Done  bool
+}
+
+// PlannedNote is a note parsed from a tagged blockquote.
+type PlannedNote struct {
+	Title   string
+	Content string
+	Type    string
+	Line    int
+}
+
+var (
+	planHeadingRe   = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*#*\s*$`)
+	planChecklistRe = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.+)$`)
+	planNoteTagRe   = regexp.MustCompile(`^(?i)(decision)\s*:\s*(.*)$`)
+	planTaskIDRe    = regexp.MustCompile(`^TASK-\d+$`)
+	planDocRefRe    = regexp.MustCompile(`^#(\d+)$`)
+)
+
+var validPlanTaskTypes = map[string]bool{
+	"research":       true,
+	"implementation": true,
+	"fix":            true,
+	"documentation":  true,
+	"maintenance":    true,
+}
+
+var validPlanTaskPriorities = map[string]bool{
+	"low":    true,
+	"medium": true,
+	"high":   true,
+}
+
+// ParsePlanDocument parses a structured markdown plan into tasks and notes.
+// Returns an error describing the first malformed construct (with its line number).
+func ParsePlanDocument(content string) (*PlanDocument, error) {
+	doc := &PlanDocument{}
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	var (
+		current    *PlannedTask
+		note       *PlannedNote
+		desc       []string
+		inFence    bool
+		fenceInfo  string
+		fenceStart int
+		fenceBody  []string
+	)
+
+	flushDesc := func() {
+		if current != nil {
+			current.Description = strings.TrimSpace(strings.Join(desc, "\n"))
+		}
+		desc = nil
+	}
+	flushNote := func() {
+		if note != nil {
+			note.Content = strings.TrimSpace(note.Content)
+			doc.Notes = append(doc.Notes, *note)
+			note = nil
+		}
+	}
+	startTask := func(title string, line int) {
+		flushDesc()
+		doc.Tasks = append(doc.Tasks, PlannedTask{
+			Ref:   len(doc.Tasks) + 1,
+			Title: title,
+			Line:  line,
+		})
+		current = &doc.Tasks[len(doc.Tasks)-1]
+	}
+
+	for i, raw := range lines {
+		lineNo := i + 1
+		trimmed := strings.TrimSpace(raw)
+
+		// Fenced blocks: "orc" fences carry metadata, everything else is description
+		if strings.HasPrefix(trimmed, "```") {
+			if !inFence {
+				flushNote()
+				inFence = true
+				fenceInfo = strings.TrimSpace(strings.TrimPrefix(trimmed, "```"))
+				fenceStart = lineNo
+				fenceBody = nil
+				continue
+			}
+			inFence = false
+			if fenceInfo == "orc" {
+				if current == nil {
+					return nil, fmt.Errorf("line %d: metadata block must follow a task heading", fenceStart)
+				}
+				if err := applyPlanMetadata(current, fenceBody, fenceStart); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			desc = append(desc, "```"+fenceInfo)
+			desc = append(desc, fenceBody...)
+			desc = append(desc, "```")
+			continue
+		}
+		if inFence {
+			fenceBody = append(fenceBody, raw)
+			continue
+		}
+
+		// Blockquotes: tagged ones start notes, continuation lines extend the open note
+		if strings.HasPrefix(trimmed, ">") {
+			quoted := strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))
+			if m := planNoteTagRe.FindStringSubmatch(quoted); m != nil {
+				flushNote()
+				title := strings.TrimSpace(m[2])
+				if title == "" {
+					return nil, fmt.Errorf("line %d: %s note requires a title", lineNo, strings.ToLower(m[1]))
+				}
+				note = &PlannedNote{Title: title, Type: strings.ToLower(m[1]), Line: lineNo}
+				continue
+			}
+			if note != nil {
+				note.Content += quoted + "\n"
+				continue
+			}
+			desc = append(desc, raw)
+			continue
+		}
+		flushNote()
+
+		if m := planHeadingRe.FindStringSubmatch(trimmed); m != nil {
+			if len(m[1]) == 1 {
+				if doc.Title == "" && len(doc.Tasks) == 0 {
+					doc.Title = m[2]
+					continue
+				}
+			}
+			startTask(m[2], lineNo)
+			continue
+		}
+
+		if m := planChecklistRe.FindStringSubmatch(raw); m != nil {
+			title := strings.TrimSpace(m[2])
+			if current == nil {
+				startTask(title, lineNo)
+				current.Done = m[1] != " "
+				// Top-level checklist items have no body; detach so following text is not misattributed
+				flushDesc()
+				current = nil
+				continue
+			}
+			current.Subtasks = append(current.Subtasks, PlannedSubtask{
+				Title: title,
+				Done:  m[1] != " ",
+			})
+			continue
+		}
+
+		if current != nil {
+			desc = append(desc, raw)
+		}
+	}
+
+	if inFence {
+		return nil, fmt.Errorf("line %d: unterminated fenced block", fenceStart)
+	}
+	flushNote()
+	flushDesc()
+
+	if err := validatePlanDependencies(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// applyPlanMetadata applies "key: value" lines from an orc fence to a task.
+func applyPlanMetadata(task *PlannedTask, body []string, startLine int) error {
+	for i, raw := range body {
+		lineNo := startLine + i + 1
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return fmt.Errorf("line %d: expected 'key: value' in metadata block", lineNo)
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "type":
+			if !validPlanTaskTypes[value] {
+				return fmt.Errorf("line %d: invalid task type %q", lineNo, value)
+			}
+			task.Type = value
+		case "priority":
+			if !validPlanTaskPriorities[value] {
+				return fmt.Errorf("line %d: invalid priority %q (must be low, medium, or high)", lineNo, value)
+			}
+			task.Priority = value
+		case "depends-on", "depends_on":
+			for _, dep := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+				if !planDocRefRe.MatchString(dep) && !planTaskIDRe.MatchString(dep) {
+					return fmt.Errorf("line %d: invalid dependency %q (use #N or TASK-xxx)", lineNo, dep)
+				}
+				task.DependsOn = append(task.DependsOn, dep)
+			}
+		default:
+			return fmt.Errorf("line %d: unknown metadata key %q", lineNo, key)
+		}
+	}
+	return nil
+}
+
+// validatePlanDependencies checks that "#N" references point at other tasks in the document.
+func validatePlanDependencies(doc *PlanDocument) error {
+	for _, t := range doc.Tasks {
+		for _, dep := range t.DependsOn {
+			ref, ok := DocumentRef(dep)
+			if !ok {
+				continue
+			}
+			if ref < 1 || ref > len(doc.Tasks) {
+				return fmt.Errorf("line %d: task %q depends on %s, but the document has %d tasks", t.Line, t.Title, dep, len(doc.Tasks))
+			}
+			if ref == t.Ref {
+				return fmt.Errorf("line %d: task %q cannot depend on itself", t.Line, t.Title)
+			}
+		}
+	}
+	return nil
+}
+
+// RenderDescription returns the task description with subtasks appended as a checklist.
+func (t PlannedTask) RenderDescription() string {
+	if len(t.Subtasks) == 0 {
+		return t.Description
+	}
+	var sb strings.Builder
+	if t.Description != "" {
+		sb.WriteString(t.Description)
+		sb.WriteString("\n\n")
+	}
+	for i, st := range t.Subtasks {
+		mark := " "
+		if st.Done {
+			mark = "x"
+		}
+		fmt.Fprintf(&sb, "- [%s] %s", mark, st.Title)
+		if i < len(t.Subtasks)-1 {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// DocumentRef parses a "#N" dependency reference, returning the 1-based task position.
+func DocumentRef(dep string) (int, bool) {
+	m := planDocRefRe.FindStringSubmatch(dep)
+	if m == nil {
+		return 0, false
+	}
+	ref, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+	return ref, true
+}
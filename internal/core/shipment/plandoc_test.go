@@ -0,0 +1,166 @@
+package shipment
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const samplePlan = "# Plan: OAuth2 integration\n" +
+	"\n" +
+	"> decision: Use PKCE for all clients\n" +
+	"> Public clients cannot keep secrets.\n" +
+	"\n" +
+	"## Design token schema\n" +
+	"Tokens live in their own table.\n" +
+	"\n" +
+	"```orc\n" +
+	"type: research\n" +
+	"priority: high\n" +
+	"```\n" +
+	"\n" +
+	"- [ ] Access tokens\n" +
+	"- [x] Refresh tokens\n" +
+	"\n" +
+	"## Implement token store\n" +
+	"```orc\n" +
+	"type: implementation\n" +
+	"depends-on: #1, TASK-042\n" +
+	"```\n" +
+	"\n" +
+	"```go\n" +
+	"type Store interface{}\n" +
+	"```\n"
+
+func TestParsePlanDocument(t *testing.T) {
+	doc, err := ParsePlanDocument(samplePlan)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if doc.Title != "Plan: OAuth2 integration" {
+		t.Errorf("Title = %q", doc.Title)
+	}
+	if len(doc.Tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(doc.Tasks))
+	}
+
+	first := doc.Tasks[0]
+	if first.Title != "Design token schema" || first.Type != "research" || first.Priority != "high" {
+		t.Errorf("unexpected first task: %+v", first)
+	}
+	if first.Description != "Tokens live in their own table." {
+		t.Errorf("Description = %q", first.Description)
+	}
+	wantSubtasks := []PlannedSubtask{{Title: "Access tokens"}, {Title: "Refresh tokens", Done: true}}
+	if !reflect.DeepEqual(first.Subtasks, wantSubtasks) {
+		t.Errorf("Subtasks = %+v, want %+v", first.Subtasks, wantSubtasks)
+	}
+	wantDesc := "Tokens live in their own table.\n\n- [ ] Access tokens\n- [x] Refresh tokens"
+	if got := first.RenderDescription(); got != wantDesc {
+		t.Errorf("RenderDescription() = %q, want %q", got, wantDesc)
+	}
+
+	second := doc.Tasks[1]
+	if second.Ref != 2 || second.Type != "implementation" {
+		t.Errorf("unexpected second task: %+v", second)
+	}
+	if !reflect.DeepEqual(second.DependsOn, []string{"#1", "TASK-042"}) {
+		t.Errorf("DependsOn = %v", second.DependsOn)
+	}
+	if !strings.Contains(second.Description, "type Store interface{}") {
+		t.Errorf("expected non-metadata fence to be kept in description, got %q", second.Description)
+	}
+
+	if len(doc.Notes) != 1 {
+		t.Fatalf("expected 1 note, got %d", len(doc.Notes))
+	}
+	if doc.Notes[0].Type != "decision" || doc.Notes[0].Title != "Use PKCE for all clients" {
+		t.Errorf("unexpected note: %+v", doc.Notes[0])
+	}
+	if doc.Notes[0].Content != "Public clients cannot keep secrets." {
+		t.Errorf("note Content = %q", doc.Notes[0].Content)
+	}
+}
+
+func TestParsePlanDocument_TopLevelChecklist(t *testing.T) {
+	doc, err := ParsePlanDocument("- [ ] Write docs\n- [x] Update README\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(doc.Tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(doc.Tasks))
+	}
+	if doc.Tasks[0].Done {
+		t.Errorf("expected unchecked item to be open: %+v", doc.Tasks[0])
+	}
+	if doc.Tasks[1].Title != "Update README" || len(doc.Tasks[1].Subtasks) != 0 || !doc.Tasks[1].Done {
+		t.Errorf("expected checked item to be done: %+v", doc.Tasks[1])
+	}
+}
+
+func TestParsePlanDocument_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "invalid type",
+			content: "## Task\n```orc\ntype: nonsense\n```\n",
+			wantErr: `line 3: invalid task type "nonsense"`,
+		},
+		{
+			name:    "invalid priority",
+			content: "## Task\n```orc\npriority: urgent\n```\n",
+			wantErr: `line 3: invalid priority "urgent" (must be low, medium, or high)`,
+		},
+		{
+			name:    "unknown key",
+			content: "## Task\n```orc\nowner: me\n```\n",
+			wantErr: `line 3: unknown metadata key "owner"`,
+		},
+		{
+			name:    "metadata before any task",
+			content: "```orc\ntype: fix\n```\n",
+			wantErr: "line 1: metadata block must follow a task heading",
+		},
+		{
+			name:    "dependency out of range",
+			content: "## Task\n```orc\ndepends-on: #4\n```\n",
+			wantErr: `line 1: task "Task" depends on #4, but the document has 1 tasks`,
+		},
+		{
+			name:    "self dependency",
+			content: "## Task\n```orc\ndepends-on: #1\n```\n",
+			wantErr: `line 1: task "Task" cannot depend on itself`,
+		},
+		{
+			name:    "invalid dependency",
+			content: "## Task\n```orc\ndepends-on: SHIP-001\n```\n",
+			wantErr: `line 3: invalid dependency "SHIP-001" (use #N or TASK-xxx)`,
+		},
+		{
+			name:    "unterminated fence",
+			content: "## Task\n```go\nfunc main() {}\n",
+			wantErr: "line 2: unterminated fenced block",
+		},
+		{
+			name:    "decision without title",
+			content: "> decision:\n",
+			wantErr: "line 1: decision note requires a title",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePlanDocument(tt.content)
+			if err == nil {
+				t.Fatalf("expected error %q, got nil", tt.wantErr)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("error = %q, want %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
@@ -125,3 +125,33 @@ func CanTagTask(ctx TagTaskContext) GuardResult {
 
 	return GuardResult{Allowed: true}
 }
+
+// RollbackTaskContext provides context for resetting a workbench to a task's pre-claim checkpoint.
+type RollbackTaskContext struct {
+	TaskID          string
+	WorkbenchID     string // Workbench the task was claimed in
+	StartCommit     string // Workbench HEAD recorded at claim time
+	StartIsAncestor bool   // StartCommit is reachable from the workbench's current HEAD
+}
+
+// CanRollbackTask evaluates whether a workbench can be reset to a task's checkpoint.
+// Rules:
+// - Task must have been claimed in a workbench with a recorded checkpoint
+// - The checkpoint must be an ancestor of the current HEAD (same line of history)
+func CanRollbackTask(ctx RollbackTaskContext) GuardResult {
+	if ctx.WorkbenchID == "" || ctx.StartCommit == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("task %s has no checkpoint (it was not claimed in a git workbench)", ctx.TaskID),
+		}
+	}
+
+	if !ctx.StartIsAncestor {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("checkpoint %.7s of task %s is not in the history of %s's current branch", ctx.StartCommit, ctx.TaskID, ctx.WorkbenchID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
@@ -144,6 +144,58 @@ func TestCanTagTask(t *testing.T) {
 	}
 }
 
+func TestCanRollbackTask(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         RollbackTaskContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name: "can roll back to an ancestor checkpoint",
+			ctx: RollbackTaskContext{
+				TaskID:          "TASK-001",
+				WorkbenchID:     "BENCH-001",
+				StartCommit:     "0123456789abcdef",
+				StartIsAncestor: true,
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "cannot roll back without a checkpoint",
+			ctx: RollbackTaskContext{
+				TaskID:      "TASK-001",
+				WorkbenchID: "BENCH-001",
+			},
+			wantAllowed: false,
+			wantReason:  "task TASK-001 has no checkpoint (it was not claimed in a git workbench)",
+		},
+		{
+			name: "cannot roll back across branches",
+			ctx: RollbackTaskContext{
+				TaskID:          "TASK-001",
+				WorkbenchID:     "BENCH-001",
+				StartCommit:     "0123456789abcdef",
+				StartIsAncestor: false,
+			},
+			wantAllowed: false,
+			wantReason:  "checkpoint 0123456 of task TASK-001 is not in the history of BENCH-001's current branch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanRollbackTask(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
 func TestGuardResult_Error(t *testing.T) {
 	t.Run("allowed result returns nil error", func(t *testing.T) {
 		result := GuardResult{Allowed: true}
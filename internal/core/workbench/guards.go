@@ -72,3 +72,46 @@ func CanRenameWorkbench(workbenchExists bool, workbenchID string) GuardResult {
 	}
 	return GuardResult{Allowed: true}
 }
+
+// SyncWorkbenchContext provides context for syncing a workbench with its repo's default branch.
+type SyncWorkbenchContext struct {
+	WorkbenchID   string
+	HasRepo       bool
+	PathExists    bool
+	CurrentBranch string // Branch checked out in the workbench ("HEAD" when detached)
+	BaseBranch    string // Repo default branch
+}
+
+// CanSyncWorkbench evaluates whether a workbench branch can be synced with the default branch.
+// Rules:
+// - Workbench must be linked to a repo
+// - Workbench directory must exist
+// - HEAD must be on a branch, and not on the default branch itself
+func CanSyncWorkbench(ctx SyncWorkbenchContext) GuardResult {
+	if !ctx.HasRepo {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s is not linked to a repo", ctx.WorkbenchID),
+		}
+	}
+	if !ctx.PathExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s directory is missing (run: orc reconcile)", ctx.WorkbenchID),
+		}
+	}
+	if ctx.CurrentBranch == "" || ctx.CurrentBranch == "HEAD" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s is in detached HEAD state", ctx.WorkbenchID),
+		}
+	}
+	if ctx.CurrentBranch == ctx.BaseBranch {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s is on the default branch %s", ctx.WorkbenchID, ctx.BaseBranch),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
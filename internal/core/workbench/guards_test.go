@@ -148,6 +148,65 @@ func TestCanRenameWorkbench(t *testing.T) {
 	}
 }
 
+func TestCanSyncWorkbench(t *testing.T) {
+	base := SyncWorkbenchContext{
+		WorkbenchID:   "BENCH-001",
+		HasRepo:       true,
+		PathExists:    true,
+		CurrentBranch: "ml/SHIP-004-login",
+		BaseBranch:    "main",
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(*SyncWorkbenchContext)
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can sync feature branch",
+			modify:      func(*SyncWorkbenchContext) {},
+			wantAllowed: true,
+		},
+		{
+			name:       "cannot sync without repo",
+			modify:     func(c *SyncWorkbenchContext) { c.HasRepo = false },
+			wantReason: "workbench BENCH-001 is not linked to a repo",
+		},
+		{
+			name:       "cannot sync missing directory",
+			modify:     func(c *SyncWorkbenchContext) { c.PathExists = false },
+			wantReason: "workbench BENCH-001 directory is missing (run: orc reconcile)",
+		},
+		{
+			name:       "cannot sync detached HEAD",
+			modify:     func(c *SyncWorkbenchContext) { c.CurrentBranch = "HEAD" },
+			wantReason: "workbench BENCH-001 is in detached HEAD state",
+		},
+		{
+			name:       "cannot sync the default branch onto itself",
+			modify:     func(c *SyncWorkbenchContext) { c.CurrentBranch = "main" },
+			wantReason: "workbench BENCH-001 is on the default branch main",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := base
+			tt.modify(&ctx)
+			result := CanSyncWorkbench(ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanSyncWorkbench() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanSyncWorkbench() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
 func TestGuardResult_Error(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -0,0 +1,141 @@
+package workbench
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Layout styles: where a workbench directory sits under the worktree root.
+const (
+	LayoutFlat   = "flat"    // {root}/{name}
+	LayoutByRepo = "by-repo" // {root}/{repo}/{name}
+)
+
+// DefaultNamePattern generates workbench names such as orc-007.
+const DefaultNamePattern = "{repo}-{number}"
+
+// NoRepoDir holds workbenches without a repo in the by-repo layout.
+const NoRepoDir = "no-repo"
+
+// Layout describes where workbenches are created and how they are named.
+type Layout struct {
+	Root        string // Absolute worktree root (e.g., ~/wb)
+	Style       string // LayoutFlat or LayoutByRepo
+	NamePattern string // Auto-generated names; {repo} and {number} are substituted
+}
+
+// Layouts is the global layout plus per-factory overrides.
+type Layouts struct {
+	Default   Layout
+	Factories map[string]Layout // Keyed by factory ID
+}
+
+// For returns the layout that applies to workbenches in a factory.
+func (ls Layouts) For(factoryID string) Layout {
+	if l, ok := ls.Factories[factoryID]; ok {
+		return l
+	}
+	return ls.Default
+}
+
+// Roots returns each distinct root and style once, the default first, for scanning worktree roots.
+func (ls Layouts) Roots() []Layout {
+	seen := map[string]bool{ls.Default.Root + "\x00" + ls.Default.Style: true}
+	roots := []Layout{ls.Default}
+	for _, l := range ls.Factories {
+		if key := l.Root + "\x00" + l.Style; !seen[key] {
+			seen[key] = true
+			roots = append(roots, l)
+		}
+	}
+	return roots
+}
+
+// DefaultLayout returns the built-in layout: ~/wb/{repo}-{number}.
+func DefaultLayout() Layout {
+	home, _ := os.UserHomeDir()
+	return Layout{Root: filepath.Join(home, DefaultBasePath), Style: LayoutFlat, NamePattern: DefaultNamePattern}
+}
+
+// Path returns the directory of a workbench under this layout.
+func (l Layout) Path(workbenchName, repoName string) string {
+	if l.Style != LayoutByRepo {
+		return filepath.Join(l.Root, workbenchName)
+	}
+	if repoName == "" {
+		repoName = NoRepoDir
+	}
+	return filepath.Join(l.Root, repoName, workbenchName)
+}
+
+// Depth returns how many directory levels below the root a workbench sits.
+func (l Layout) Depth() int {
+	if l.Style == LayoutByRepo {
+		return 2
+	}
+	return 1
+}
+
+// Name generates a workbench name from the layout's pattern.
+func (l Layout) Name(repoName string, number int) string {
+	pattern := l.NamePattern
+	if pattern == "" {
+		pattern = DefaultNamePattern
+	}
+	return strings.NewReplacer(
+		"{repo}", repoName,
+		"{number}", fmt.Sprintf("%03d", number),
+	).Replace(pattern)
+}
+
+// ValidateLayout checks that a layout yields unique, single-segment workbench directories.
+func ValidateLayout(l Layout) error {
+	if !filepath.IsAbs(l.Root) {
+		return fmt.Errorf("worktree root must be an absolute path, got %q", l.Root)
+	}
+	if l.Style != LayoutFlat && l.Style != LayoutByRepo {
+		return fmt.Errorf("unknown layout %q (expected %s or %s)", l.Style, LayoutFlat, LayoutByRepo)
+	}
+	if !strings.Contains(l.NamePattern, "{number}") {
+		return fmt.Errorf("name pattern %q must contain {number} to keep names unique", l.NamePattern)
+	}
+	if strings.ContainsAny(l.NamePattern, `/\`) {
+		return fmt.Errorf("name pattern %q must not contain path separators", l.NamePattern)
+	}
+	return nil
+}
+
+// Relocation outcomes for a workbench when the layout changes.
+const (
+	RelocateMove      = "move"      // Directory exists at the old path; move it
+	RelocateUnchanged = "unchanged" // Old and new paths are the same
+	RelocateDone      = "done"      // Already at the new path
+	RelocateMissing   = "missing"   // Neither path exists; nothing to move
+	RelocateConflict  = "conflict"  // Both paths exist; needs a manual decision
+)
+
+// RelocateInput is the observed state of one workbench under the old and new layouts.
+type RelocateInput struct {
+	From       string
+	To         string
+	FromExists bool
+	ToExists   bool
+}
+
+// PlanRelocation decides what to do with a workbench directory when the layout changes.
+func PlanRelocation(in RelocateInput) string {
+	switch {
+	case filepath.Clean(in.From) == filepath.Clean(in.To):
+		return RelocateUnchanged
+	case in.FromExists && in.ToExists:
+		return RelocateConflict
+	case in.FromExists:
+		return RelocateMove
+	case in.ToExists:
+		return RelocateDone
+	default:
+		return RelocateMissing
+	}
+}
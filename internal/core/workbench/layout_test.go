@@ -0,0 +1,115 @@
+package workbench
+
+import "testing"
+
+func TestLayout_Path(t *testing.T) {
+	tests := []struct {
+		name     string
+		layout   Layout
+		wbName   string
+		repoName string
+		want     string
+	}{
+		{"flat", Layout{Root: "/vol/wb", Style: LayoutFlat}, "orc-007", "orc", "/vol/wb/orc-007"},
+		{"by repo", Layout{Root: "/vol/wb", Style: LayoutByRepo}, "orc-007", "orc", "/vol/wb/orc/orc-007"},
+		{"by repo without repo", Layout{Root: "/vol/wb", Style: LayoutByRepo}, "scratch", "", "/vol/wb/no-repo/scratch"},
+		{"empty style is flat", Layout{Root: "/vol/wb"}, "scratch", "orc", "/vol/wb/scratch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.layout.Path(tt.wbName, tt.repoName); got != tt.want {
+				t.Errorf("Path() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayout_Name(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"", "orc-007"},
+		{DefaultNamePattern, "orc-007"},
+		{"wb{number}-{repo}", "wb007-orc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			if got := (Layout{NamePattern: tt.pattern}).Name("orc", 7); got != tt.want {
+				t.Errorf("Name() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultLayout_MatchesComputePath(t *testing.T) {
+	if got, want := DefaultLayout().Path("orc-001", "orc"), ComputePath("orc-001"); got != want {
+		t.Errorf("DefaultLayout().Path() = %q, want %q", got, want)
+	}
+}
+
+func TestLayouts_For(t *testing.T) {
+	ls := Layouts{
+		Default:   Layout{Root: "/home/u/wb"},
+		Factories: map[string]Layout{"FACT-002": {Root: "/vol/wb"}},
+	}
+	if got := ls.For("FACT-002").Root; got != "/vol/wb" {
+		t.Errorf("For(FACT-002).Root = %q, want override", got)
+	}
+	if got := ls.For("FACT-001").Root; got != "/home/u/wb" {
+		t.Errorf("For(FACT-001).Root = %q, want default", got)
+	}
+	if roots := ls.Roots(); len(roots) != 2 || roots[0].Root != "/home/u/wb" {
+		t.Errorf("Roots() = %+v, want default first plus the override", roots)
+	}
+}
+
+func TestValidateLayout(t *testing.T) {
+	valid := Layout{Root: "/vol/wb", Style: LayoutByRepo, NamePattern: DefaultNamePattern}
+
+	tests := []struct {
+		name    string
+		modify  func(l *Layout)
+		wantErr bool
+	}{
+		{"valid", func(l *Layout) {}, false},
+		{"relative root", func(l *Layout) { l.Root = "wb" }, true},
+		{"unknown style", func(l *Layout) { l.Style = "nested" }, true},
+		{"pattern without number", func(l *Layout) { l.NamePattern = "{repo}" }, true},
+		{"pattern with separator", func(l *Layout) { l.NamePattern = "{repo}/{number}" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := valid
+			tt.modify(&l)
+			if err := ValidateLayout(l); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLayout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPlanRelocation(t *testing.T) {
+	tests := []struct {
+		name string
+		in   RelocateInput
+		want string
+	}{
+		{"same path", RelocateInput{From: "/wb/a", To: "/wb/a/", FromExists: true, ToExists: true}, RelocateUnchanged},
+		{"move", RelocateInput{From: "/wb/a", To: "/vol/a", FromExists: true}, RelocateMove},
+		{"already moved", RelocateInput{From: "/wb/a", To: "/vol/a", ToExists: true}, RelocateDone},
+		{"both exist", RelocateInput{From: "/wb/a", To: "/vol/a", FromExists: true, ToExists: true}, RelocateConflict},
+		{"neither exists", RelocateInput{From: "/wb/a", To: "/vol/a"}, RelocateMissing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlanRelocation(tt.in); got != tt.want {
+				t.Errorf("PlanRelocation() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
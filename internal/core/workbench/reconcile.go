@@ -0,0 +1,285 @@
+package workbench
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ReconcileInput contains pre-fetched ledger, filesystem, git, and tmux state.
+// All values must be gathered by the caller - no I/O in the planner.
+type ReconcileInput struct {
+	BasePaths   []string // Worktree roots (e.g., ~/wb), one per configured layout
+	Workbenches []ReconcileWorkbench
+	Worktrees   []ReconcileWorktree // Linked worktrees from `git worktree list` of each repo (main worktree excluded)
+	Directories []string            // Workbench-level directories under the BasePaths
+	Sessions    []ReconcileSession  // Live tmux sessions of the workshops in scope
+	// ScopeAll is true when every workbench is in scope. Orphan worktrees and unknown
+	// directories can only be detected against the full ledger.
+	ScopeAll bool
+}
+
+// ReconcileWorkbench is a workbench row with the observed state of its path.
+type ReconcileWorkbench struct {
+	ID            string
+	Name          string
+	WorkshopID    string
+	Status        string // Ledger status: active, archived
+	Path          string
+	RepoPath      string // Local path of the linked repo; empty if none
+	HomeBranch    string
+	CurrentBranch string // Ledger current_branch
+	DirExists     bool
+	ActualBranch  string // Branch checked out at Path; empty if not a git checkout
+	ConfigPlaceID string // place_id in Path/.orc/config.json; empty if missing or unreadable
+}
+
+// ReconcileWorktree is a linked worktree as reported by git.
+type ReconcileWorktree struct {
+	RepoPath string
+	Path     string
+	Branch   string
+	Prunable bool // Git's administrative entry points at a missing directory
+	Dirty    bool
+}
+
+// ReconcileSession is a live tmux session for a workshop.
+type ReconcileSession struct {
+	WorkshopID  string
+	SessionName string
+	Windows     []string
+}
+
+// ReconcileActionType identifies a reconcile fix.
+type ReconcileActionType string
+
+const (
+	// ReconcilePruneWorktrees runs `git worktree prune` for a repo with stale entries.
+	ReconcilePruneWorktrees ReconcileActionType = "prune-worktrees"
+	// ReconcileRemoveOrphan removes a clean worktree the ledger does not know (or has archived).
+	ReconcileRemoveOrphan ReconcileActionType = "remove-orphan"
+	// ReconcileRecreateWorktree recreates a missing worktree for an active workbench.
+	ReconcileRecreateWorktree ReconcileActionType = "recreate-worktree"
+	// ReconcileUpdateBranch records the branch actually checked out as current_branch.
+	ReconcileUpdateBranch ReconcileActionType = "update-branch"
+	// ReconcileWriteConfig rewrites .orc/config.json with the workbench place ID.
+	ReconcileWriteConfig ReconcileActionType = "write-config"
+	// ReconcileManual is drift that needs a human decision. It is reported, never applied.
+	ReconcileManual ReconcileActionType = "manual"
+)
+
+// ReconcileAction is a single planned fix.
+type ReconcileAction struct {
+	Type        ReconcileActionType
+	WorkbenchID string // Empty for orphans and repo-level actions
+	Path        string
+	RepoPath    string
+	Branch      string
+	Description string
+}
+
+// ReconcilePlan is the ordered list of fixes. Prunes run first so recreated
+// worktrees do not collide with stale git entries.
+type ReconcilePlan struct {
+	Actions     []ReconcileAction
+	NothingToDo bool // True when there is nothing to apply (manual items may remain)
+}
+
+// GenerateReconcilePlan compares the ledger with disk, git, and tmux and plans fixes.
+// This is a pure function - all input data must be pre-fetched.
+// Rules:
+//   - Stale git worktree entries are pruned per repo
+//   - Worktrees under a BasePath with no active workbench are removed if clean, reported if dirty
+//   - Active workbenches with a missing directory are recreated
+//   - A stale current_branch is updated from the checkout
+//   - A missing or mismatched config place_id is rewritten
+//   - Missing tmux windows, unknown directories, and non-git directories are reported
+func GenerateReconcilePlan(input ReconcileInput) ReconcilePlan {
+	var prunes, removals, fixes, manual []ReconcileAction
+
+	byPath := make(map[string]ReconcileWorkbench)
+	for _, wb := range input.Workbenches {
+		byPath[filepath.Clean(wb.Path)] = wb
+	}
+
+	// Git worktrees: stale entries and orphans
+	registered := make(map[string]bool)
+	prunedRepos := make(map[string]bool)
+	for _, wt := range input.Worktrees {
+		path := filepath.Clean(wt.Path)
+		if wt.Prunable {
+			if !prunedRepos[wt.RepoPath] {
+				prunedRepos[wt.RepoPath] = true
+				prunes = append(prunes, ReconcileAction{
+					Type:        ReconcilePruneWorktrees,
+					RepoPath:    wt.RepoPath,
+					Description: fmt.Sprintf("prune stale worktree entries in %s", wt.RepoPath),
+				})
+			}
+			continue
+		}
+		registered[path] = true
+
+		wb, known := byPath[path]
+		if known && wb.Status != "archived" {
+			continue
+		}
+		if !known && (!input.ScopeAll || !isUnderAny(path, input.BasePaths)) {
+			continue
+		}
+
+		reason := "not in the ledger"
+		if known {
+			reason = fmt.Sprintf("workbench %s is archived", wb.ID)
+		}
+		if wt.Dirty {
+			manual = append(manual, ReconcileAction{
+				Type:        ReconcileManual,
+				WorkbenchID: wb.ID,
+				Path:        wt.Path,
+				RepoPath:    wt.RepoPath,
+				Branch:      wt.Branch,
+				Description: fmt.Sprintf("orphan worktree %s (%s) has uncommitted changes", wt.Path, reason),
+			})
+			continue
+		}
+		removals = append(removals, ReconcileAction{
+			Type:        ReconcileRemoveOrphan,
+			WorkbenchID: wb.ID,
+			Path:        wt.Path,
+			RepoPath:    wt.RepoPath,
+			Branch:      wt.Branch,
+			Description: fmt.Sprintf("remove orphan worktree %s (%s)", wt.Path, reason),
+		})
+	}
+
+	// Ledger workbenches: missing directories, stale branches, configs, windows
+	sessions := make(map[string]ReconcileSession)
+	for _, s := range input.Sessions {
+		sessions[s.WorkshopID] = s
+	}
+	for _, wb := range input.Workbenches {
+		if wb.Status == "archived" {
+			continue
+		}
+
+		if !wb.DirExists {
+			branch := wb.CurrentBranch
+			if branch == "" {
+				branch = wb.HomeBranch
+			}
+			fixes = append(fixes, ReconcileAction{
+				Type:        ReconcileRecreateWorktree,
+				WorkbenchID: wb.ID,
+				Path:        wb.Path,
+				RepoPath:    wb.RepoPath,
+				Branch:      branch,
+				Description: fmt.Sprintf("recreate missing worktree for %s at %s", wb.ID, wb.Path),
+			})
+		} else {
+			if wb.RepoPath != "" && !registered[filepath.Clean(wb.Path)] && wb.ActualBranch == "" {
+				manual = append(manual, ReconcileAction{
+					Type:        ReconcileManual,
+					WorkbenchID: wb.ID,
+					Path:        wb.Path,
+					RepoPath:    wb.RepoPath,
+					Description: fmt.Sprintf("%s exists but is not a git worktree of %s", wb.Path, wb.RepoPath),
+				})
+			}
+			if wb.ActualBranch != "" && wb.ActualBranch != wb.CurrentBranch {
+				fixes = append(fixes, ReconcileAction{
+					Type:        ReconcileUpdateBranch,
+					WorkbenchID: wb.ID,
+					Path:        wb.Path,
+					Branch:      wb.ActualBranch,
+					Description: fmt.Sprintf("update %s current_branch %q → %q", wb.ID, wb.CurrentBranch, wb.ActualBranch),
+				})
+			}
+			if wb.ConfigPlaceID != wb.ID {
+				desc := fmt.Sprintf("write missing .orc/config.json for %s", wb.ID)
+				if wb.ConfigPlaceID != "" {
+					desc = fmt.Sprintf("fix .orc/config.json place_id %s → %s", wb.ConfigPlaceID, wb.ID)
+				}
+				fixes = append(fixes, ReconcileAction{
+					Type:        ReconcileWriteConfig,
+					WorkbenchID: wb.ID,
+					Path:        wb.Path,
+					Description: desc,
+				})
+			}
+		}
+
+		if session, ok := sessions[wb.WorkshopID]; ok && !contains(session.Windows, wb.Name) {
+			manual = append(manual, ReconcileAction{
+				Type:        ReconcileManual,
+				WorkbenchID: wb.ID,
+				Description: fmt.Sprintf("tmux session %s has no window for %s (run: orc tmux apply %s)", session.SessionName, wb.Name, wb.WorkshopID),
+			})
+		}
+	}
+
+	// Directories under the worktree roots that nothing accounts for
+	if input.ScopeAll {
+		dirs := append([]string(nil), input.Directories...)
+		sort.Strings(dirs)
+		for _, dir := range dirs {
+			path := filepath.Clean(dir)
+			if _, known := byPath[path]; known || registered[path] || holdsWorkbench(path, byPath) {
+				continue
+			}
+			manual = append(manual, ReconcileAction{
+				Type:        ReconcileManual,
+				Path:        dir,
+				Description: fmt.Sprintf("%s is not a workbench or a git worktree", dir),
+			})
+		}
+	}
+
+	plan := ReconcilePlan{}
+	plan.Actions = append(plan.Actions, prunes...)
+	plan.Actions = append(plan.Actions, removals...)
+	plan.Actions = append(plan.Actions, fixes...)
+	plan.Actions = append(plan.Actions, manual...)
+	plan.NothingToDo = len(prunes)+len(removals)+len(fixes) == 0
+	return plan
+}
+
+// isUnder reports whether path is strictly inside base.
+func isUnder(path, base string) bool {
+	if base == "" {
+		return false
+	}
+	rel, err := filepath.Rel(filepath.Clean(base), path)
+	return err == nil && rel != "." && !strings.HasPrefix(rel, "..")
+}
+
+// isUnderAny reports whether path is strictly inside any of bases.
+func isUnderAny(path string, bases []string) bool {
+	for _, base := range bases {
+		if isUnder(path, base) {
+			return true
+		}
+	}
+	return false
+}
+
+// holdsWorkbench reports whether dir contains a known workbench, as a repo directory
+// of the by-repo layout does when layouts share a root.
+func holdsWorkbench(dir string, byPath map[string]ReconcileWorkbench) bool {
+	for path := range byPath {
+		if isUnder(path, dir) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(items []string, s string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package workbench
+
+import (
+	"testing"
+)
+
+func healthyWorkbench() ReconcileWorkbench {
+	return ReconcileWorkbench{
+		ID:            "BENCH-001",
+		Name:          "auth-backend",
+		WorkshopID:    "WORK-001",
+		Status:        "active",
+		Path:          "/home/u/wb/auth-backend",
+		RepoPath:      "/home/u/src/app",
+		HomeBranch:    "ml/auth-backend",
+		CurrentBranch: "ml/auth-backend",
+		DirExists:     true,
+		ActualBranch:  "ml/auth-backend",
+		ConfigPlaceID: "BENCH-001",
+	}
+}
+
+func healthyWorktree() ReconcileWorktree {
+	return ReconcileWorktree{RepoPath: "/home/u/src/app", Path: "/home/u/wb/auth-backend", Branch: "ml/auth-backend"}
+}
+
+func TestGenerateReconcilePlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       func() ReconcileInput
+		wantTypes   []ReconcileActionType
+		wantNothing bool
+	}{
+		{
+			name: "healthy workbench needs nothing",
+			input: func() ReconcileInput {
+				return ReconcileInput{
+					BasePaths:   []string{"/home/u/wb"},
+					Workbenches: []ReconcileWorkbench{healthyWorkbench()},
+					Worktrees:   []ReconcileWorktree{healthyWorktree()},
+					Directories: []string{"/home/u/wb/auth-backend"},
+					Sessions:    []ReconcileSession{{WorkshopID: "WORK-001", SessionName: "WORK-001", Windows: []string{"orc-001", "auth-backend"}}},
+					ScopeAll:    true,
+				}
+			},
+			wantNothing: true,
+		},
+		{
+			name: "missing directory is recreated after pruning stale entry",
+			input: func() ReconcileInput {
+				wb := healthyWorkbench()
+				wb.DirExists = false
+				wb.ActualBranch = ""
+				wt := healthyWorktree()
+				wt.Prunable = true
+				return ReconcileInput{BasePaths: []string{"/home/u/wb"}, Workbenches: []ReconcileWorkbench{wb}, Worktrees: []ReconcileWorktree{wt}, ScopeAll: true}
+			},
+			wantTypes: []ReconcileActionType{ReconcilePruneWorktrees, ReconcileRecreateWorktree},
+		},
+		{
+			name: "stale current branch and wrong config are fixed",
+			input: func() ReconcileInput {
+				wb := healthyWorkbench()
+				wb.ActualBranch = "ml/SHIP-004-login"
+				wb.ConfigPlaceID = "BENCH-009"
+				return ReconcileInput{BasePaths: []string{"/home/u/wb"}, Workbenches: []ReconcileWorkbench{wb}, Worktrees: []ReconcileWorktree{healthyWorktree()}, ScopeAll: true}
+			},
+			wantTypes: []ReconcileActionType{ReconcileUpdateBranch, ReconcileWriteConfig},
+		},
+		{
+			name: "clean orphan worktree is removed",
+			input: func() ReconcileInput {
+				orphan := ReconcileWorktree{RepoPath: "/home/u/src/app", Path: "/home/u/wb/old-bench", Branch: "ml/old"}
+				return ReconcileInput{BasePaths: []string{"/home/u/wb"}, Worktrees: []ReconcileWorktree{orphan}, Directories: []string{"/home/u/wb/old-bench"}, ScopeAll: true}
+			},
+			wantTypes: []ReconcileActionType{ReconcileRemoveOrphan},
+		},
+		{
+			name: "dirty orphan worktree is only reported",
+			input: func() ReconcileInput {
+				orphan := ReconcileWorktree{RepoPath: "/home/u/src/app", Path: "/home/u/wb/old-bench", Dirty: true}
+				return ReconcileInput{BasePaths: []string{"/home/u/wb"}, Worktrees: []ReconcileWorktree{orphan}, Directories: []string{"/home/u/wb/old-bench"}, ScopeAll: true}
+			},
+			wantTypes:   []ReconcileActionType{ReconcileManual},
+			wantNothing: true,
+		},
+		{
+			name: "archived workbench worktree is an orphan",
+			input: func() ReconcileInput {
+				wb := healthyWorkbench()
+				wb.Status = "archived"
+				return ReconcileInput{BasePaths: []string{"/home/u/wb"}, Workbenches: []ReconcileWorkbench{wb}, Worktrees: []ReconcileWorktree{healthyWorktree()}, ScopeAll: true}
+			},
+			wantTypes: []ReconcileActionType{ReconcileRemoveOrphan},
+		},
+		{
+			name: "worktrees outside the workbench root are ignored",
+			input: func() ReconcileInput {
+				other := ReconcileWorktree{RepoPath: "/home/u/src/app", Path: "/tmp/scratch"}
+				return ReconcileInput{BasePaths: []string{"/home/u/wb"}, Worktrees: []ReconcileWorktree{other}, ScopeAll: true}
+			},
+			wantNothing: true,
+		},
+		{
+			name: "orphans are not detected when scoped to one workshop",
+			input: func() ReconcileInput {
+				orphan := ReconcileWorktree{RepoPath: "/home/u/src/app", Path: "/home/u/wb/old-bench"}
+				return ReconcileInput{BasePaths: []string{"/home/u/wb"}, Worktrees: []ReconcileWorktree{orphan}, Directories: []string{"/home/u/wb/stray"}}
+			},
+			wantNothing: true,
+		},
+		{
+			name: "unknown directory and missing window are reported",
+			input: func() ReconcileInput {
+				return ReconcileInput{
+					BasePaths:   []string{"/home/u/wb"},
+					Workbenches: []ReconcileWorkbench{healthyWorkbench()},
+					Worktrees:   []ReconcileWorktree{healthyWorktree()},
+					Directories: []string{"/home/u/wb/auth-backend", "/home/u/wb/stray"},
+					Sessions:    []ReconcileSession{{WorkshopID: "WORK-001", SessionName: "WORK-001", Windows: []string{"orc-001"}}},
+					ScopeAll:    true,
+				}
+			},
+			wantTypes:   []ReconcileActionType{ReconcileManual, ReconcileManual},
+			wantNothing: true,
+		},
+		{
+			name: "repo directory of the by-repo layout is not reported",
+			input: func() ReconcileInput {
+				wb := healthyWorkbench()
+				wb.Path = "/vol/wb/app/auth-backend"
+				wt := healthyWorktree()
+				wt.Path = wb.Path
+				return ReconcileInput{
+					BasePaths:   []string{"/vol/wb"},
+					Workbenches: []ReconcileWorkbench{wb},
+					Worktrees:   []ReconcileWorktree{wt},
+					Directories: []string{"/vol/wb/app", "/vol/wb/app/auth-backend"},
+					ScopeAll:    true,
+				}
+			},
+			wantNothing: true,
+		},
+		{
+			name: "directory that is not a git worktree is reported",
+			input: func() ReconcileInput {
+				wb := healthyWorkbench()
+				wb.ActualBranch = ""
+				return ReconcileInput{BasePaths: []string{"/home/u/wb"}, Workbenches: []ReconcileWorkbench{wb}, ScopeAll: true}
+			},
+			wantTypes:   []ReconcileActionType{ReconcileManual},
+			wantNothing: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := GenerateReconcilePlan(tt.input())
+
+			var got []ReconcileActionType
+			for _, a := range plan.Actions {
+				got = append(got, a.Type)
+			}
+			if len(got) != len(tt.wantTypes) {
+				t.Fatalf("actions = %v, want %v", got, tt.wantTypes)
+			}
+			for i := range got {
+				if got[i] != tt.wantTypes[i] {
+					t.Errorf("action[%d] = %s, want %s", i, got[i], tt.wantTypes[i])
+				}
+			}
+			if plan.NothingToDo != tt.wantNothing {
+				t.Errorf("NothingToDo = %v, want %v", plan.NothingToDo, tt.wantNothing)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package workbench
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// NormalizeSparsePaths validates cone-mode sparse checkout directories and returns them
+// cleaned, deduplicated and sorted. Paths are repository-relative directories using
+// forward slashes (e.g., "services/api"); the repository root itself is always included
+// by cone mode and cannot be listed.
+func NormalizeSparsePaths(paths []string) ([]string, error) {
+	seen := make(map[string]bool, len(paths))
+	var result []string
+	for _, p := range paths {
+		raw := strings.TrimSpace(p)
+		if raw == "" {
+			return nil, fmt.Errorf("sparse path is empty")
+		}
+		if strings.HasPrefix(raw, "/") {
+			return nil, fmt.Errorf("sparse path %q must be relative to the repository root", p)
+		}
+		clean := path.Clean(strings.ReplaceAll(raw, "\\", "/"))
+		if clean == "." {
+			return nil, fmt.Errorf("sparse path %q is the repository root; omit sparse paths for a full checkout", p)
+		}
+		if clean == ".." || strings.HasPrefix(clean, "../") {
+			return nil, fmt.Errorf("sparse path %q is outside the repository", p)
+		}
+		if strings.ContainsAny(clean, "*?[") {
+			return nil, fmt.Errorf("sparse path %q contains a pattern; cone mode takes directories", p)
+		}
+		if !seen[clean] {
+			seen[clean] = true
+			result = append(result, clean)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// EditSparsePaths adds and removes directories from a workbench's sparse paths.
+// Removing a path that is not listed is an error, so typos are not silently ignored.
+// An empty result means the workbench returns to a full checkout.
+func EditSparsePaths(current, add, remove []string) ([]string, error) {
+	add, err := NormalizeSparsePaths(add)
+	if err != nil {
+		return nil, err
+	}
+	remove, err = NormalizeSparsePaths(remove)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]bool, len(current)+len(add))
+	for _, p := range current {
+		set[p] = true
+	}
+	for _, p := range add {
+		set[p] = true
+	}
+	for _, p := range remove {
+		if !set[p] {
+			return nil, fmt.Errorf("sparse path %q is not checked out", p)
+		}
+		delete(set, p)
+	}
+
+	result := make([]string, 0, len(set))
+	for p := range set {
+		result = append(result, p)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// ParseSparsePaths decodes sparse paths stored on a workbench or repo record.
+// An empty string means a full checkout.
+func ParseSparsePaths(data string) ([]string, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var paths []string
+	if err := json.Unmarshal([]byte(data), &paths); err != nil {
+		return nil, fmt.Errorf("invalid sparse paths: %w", err)
+	}
+	return paths, nil
+}
+
+// FormatSparsePaths validates and encodes sparse paths for a record.
+// No paths encode to the empty string.
+func FormatSparsePaths(paths []string) (string, error) {
+	if len(paths) == 0 {
+		return "", nil
+	}
+	paths, err := NormalizeSparsePaths(paths)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(paths)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,90 @@
+package workbench
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeSparsePaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		paths   []string
+		want    []string
+		wantErr bool
+	}{
+		{"cleans, dedups and sorts", []string{"services/web/", "./services/api", "services/web"}, []string{"services/api", "services/web"}, false},
+		{"backslashes", []string{`libs\core`}, []string{"libs/core"}, false},
+		{"none", nil, nil, false},
+		{"empty", []string{" "}, nil, true},
+		{"absolute", []string{"/services/api"}, nil, true},
+		{"root", []string{"./"}, nil, true},
+		{"outside repo", []string{"services/../../x"}, nil, true},
+		{"pattern", []string{"services/*"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeSparsePaths(tt.paths)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeSparsePaths() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizeSparsePaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditSparsePaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []string
+		add     []string
+		remove  []string
+		want    []string
+		wantErr bool
+	}{
+		{"add to full checkout", nil, []string{"services/api"}, nil, []string{"services/api"}, false},
+		{"add existing", []string{"services/api"}, []string{"services/api/"}, nil, []string{"services/api"}, false},
+		{"add and remove", []string{"libs", "services/api"}, []string{"services/web"}, []string{"libs"}, []string{"services/api", "services/web"}, false},
+		{"remove last", []string{"services/api"}, nil, []string{"services/api"}, []string{}, false},
+		{"remove unknown", []string{"services/api"}, nil, []string{"services/web"}, nil, true},
+		{"invalid add", nil, []string{"../x"}, nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EditSparsePaths(tt.current, tt.add, tt.remove)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EditSparsePaths() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EditSparsePaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatAndParseSparsePaths(t *testing.T) {
+	data, err := FormatSparsePaths([]string{"services/web", "services/api/"})
+	if err != nil {
+		t.Fatalf("FormatSparsePaths() error = %v", err)
+	}
+	if data != `["services/api","services/web"]` {
+		t.Errorf("FormatSparsePaths() = %s", data)
+	}
+	paths, err := ParseSparsePaths(data)
+	if err != nil {
+		t.Fatalf("ParseSparsePaths() error = %v", err)
+	}
+	if !reflect.DeepEqual(paths, []string{"services/api", "services/web"}) {
+		t.Errorf("ParseSparsePaths() = %v", paths)
+	}
+
+	if data, _ := FormatSparsePaths(nil); data != "" {
+		t.Errorf("FormatSparsePaths(nil) = %q, want empty", data)
+	}
+	if paths, _ := ParseSparsePaths(""); paths != nil {
+		t.Errorf("ParseSparsePaths(\"\") = %v, want nil", paths)
+	}
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+var archiveDB *sql.DB
+
+// GetArchivePath returns the path to the archive ledger, a sibling of the main database.
+func GetArchivePath() (string, error) {
+	dbPath, err := GetDBPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(dbPath), "archive.db"), nil
+}
+
+// GetArchiveDB returns the archive ledger connection, initializing if needed.
+// The archive uses the same schema as the main database so rows can be copied verbatim.
+// SchemaSQL only creates missing tables; columns added later reach an existing
+// archive through Atlas (make schema-apply-archive).
+// Foreign keys are left off: archived rows may reference entities that stay in the main ledger.
+func GetArchiveDB() (*sql.DB, error) {
+	if archiveDB != nil {
+		return archiveDB, nil
+	}
+
+	path, err := GetArchivePath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve archive path: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create .orc directory: %w", err)
+	}
+
+	conn, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+
+	if _, err := conn.Exec("PRAGMA busy_timeout=5000"); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to set busy timeout: %w", err)
+	}
+
+	if _, err := conn.Exec(SchemaSQL); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to initialize archive schema: %w", err)
+	}
+
+	archiveDB = conn
+	return archiveDB, nil
+}
@@ -28,6 +28,9 @@ CREATE TABLE IF NOT EXISTS repos (
 	url TEXT,
 	local_path TEXT,
 	default_branch TEXT DEFAULT 'main',
+	bootstrap_json TEXT, -- JSON array of shell commands run in new worktrees; overrides .orc/repo.json
+	sparse_json TEXT, -- JSON array of cone-mode sparse checkout directories for new worktrees; NULL = full checkout
+	merge_json TEXT, -- JSON {"strategy", "verify"} for orc pr merge to land PRs locally; overrides .orc/repo.json
 	status TEXT NOT NULL CHECK(status IN ('active', 'archived')) DEFAULT 'active',
 	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
@@ -56,7 +59,7 @@ CREATE TABLE IF NOT EXISTS workshops (
 );
 
 -- Workbenches (Git worktrees within a workshop)
--- Path is computed dynamically as ~/wb/{name}, not stored
+-- Path is computed dynamically from the workspace layout (~/wb/{name} by default), not stored
 CREATE TABLE IF NOT EXISTS workbenches (
 	id TEXT PRIMARY KEY,
 	workshop_id TEXT NOT NULL,
@@ -66,6 +69,7 @@ CREATE TABLE IF NOT EXISTS workbenches (
 	home_branch TEXT,
 	current_branch TEXT,
 	focused_id TEXT,
+	sparse_json TEXT, -- JSON array of cone-mode sparse checkout directories; NULL = full checkout
 	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	FOREIGN KEY (workshop_id) REFERENCES workshops(id),
@@ -111,6 +115,19 @@ CREATE TABLE IF NOT EXISTS shipments (
 	FOREIGN KEY (repo_id) REFERENCES repos(id)
 );
 
+-- Shipment repos (additional repositories a shipment spans; the shipment's own repo_id is its primary repo)
+CREATE TABLE IF NOT EXISTS shipment_repos (
+	shipment_id TEXT NOT NULL,
+	repo_id TEXT NOT NULL,
+	branch TEXT NOT NULL,
+	assigned_workbench_id TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (shipment_id, repo_id),
+	FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE,
+	FOREIGN KEY (repo_id) REFERENCES repos(id),
+	FOREIGN KEY (assigned_workbench_id) REFERENCES workbenches(id)
+);
+
 -- Tomes (Knowledge containers)
 CREATE TABLE IF NOT EXISTS tomes (
 	id TEXT PRIMARY KEY,
@@ -145,6 +162,8 @@ CREATE TABLE IF NOT EXISTS tasks (
 	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	claimed_at DATETIME,
 	completed_at DATETIME,
+	start_commit TEXT,
+	end_commit TEXT,
 	FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE,
 	FOREIGN KEY (commission_id) REFERENCES commissions(id),
 	FOREIGN KEY (tome_id) REFERENCES tomes(id) ON DELETE SET NULL,
@@ -154,7 +173,7 @@ CREATE TABLE IF NOT EXISTS tasks (
 -- PRs (Pull requests)
 CREATE TABLE IF NOT EXISTS prs (
 	id TEXT PRIMARY KEY,
-	shipment_id TEXT NOT NULL UNIQUE,
+	shipment_id TEXT NOT NULL,
 	repo_id TEXT NOT NULL,
 	commission_id TEXT NOT NULL,
 	number INTEGER,
@@ -168,9 +187,46 @@ CREATE TABLE IF NOT EXISTS prs (
 	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	merged_at DATETIME,
 	closed_at DATETIME,
+	review_decision TEXT CHECK(review_decision IN ('approved', 'changes_requested', 'review_required')),
+	mergeable TEXT CHECK(mergeable IN ('mergeable', 'conflicting', 'unknown')),
+	synced_at DATETIME, -- Last pull from the PR provider (orc pr sync)
+	parent_pr_id TEXT, -- Stacked PRs: the PR whose branch this one targets
+	UNIQUE (shipment_id, repo_id),
 	FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE,
 	FOREIGN KEY (repo_id) REFERENCES repos(id),
-	FOREIGN KEY (commission_id) REFERENCES commissions(id)
+	FOREIGN KEY (commission_id) REFERENCES commissions(id),
+	FOREIGN KEY (parent_pr_id) REFERENCES prs(id) ON DELETE SET NULL
+);
+
+-- PR review threads imported as notes (orc pr reviews --import). resolved is the
+-- state the note and the provider agreed on at the last sync.
+CREATE TABLE IF NOT EXISTS pr_review_threads (
+	pr_id TEXT NOT NULL,
+	thread_id TEXT NOT NULL, -- Provider thread ID (GitHub node ID, GitLab discussion ID, Gitea comment ID)
+	note_id TEXT NOT NULL,
+	task_id TEXT,
+	url TEXT,
+	path TEXT,
+	line INTEGER,
+	resolved INTEGER NOT NULL DEFAULT 0,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (pr_id, thread_id),
+	FOREIGN KEY (pr_id) REFERENCES prs(id) ON DELETE CASCADE,
+	FOREIGN KEY (note_id) REFERENCES notes(id) ON DELETE CASCADE,
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL
+);
+
+-- PR CI checks as of the last sync (orc pr sync), replaced wholesale each sync
+CREATE TABLE IF NOT EXISTS pr_checks (
+	pr_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	status TEXT NOT NULL CHECK(status IN ('queued', 'in_progress', 'completed')),
+	conclusion TEXT, -- success, failure, neutral, cancelled, skipped, timed_out, action_required; NULL until completed
+	url TEXT,
+	required INTEGER NOT NULL DEFAULT 0,
+	updated_at DATETIME, -- Provider time of the check's last update
+	PRIMARY KEY (pr_id, name),
+	FOREIGN KEY (pr_id) REFERENCES prs(id) ON DELETE CASCADE
 );
 
 -- Plans (Implementation plans - 1:many with Task)
@@ -216,6 +272,64 @@ CREATE TABLE IF NOT EXISTS notes (
 	FOREIGN KEY (closed_by_note_id) REFERENCES notes(id) ON DELETE SET NULL
 );
 
+-- Commits (git commits linked to tasks and shipments via Orc-Task / Orc-Shipment trailers)
+CREATE TABLE IF NOT EXISTS commits (
+	sha TEXT PRIMARY KEY,
+	repo_id TEXT,
+	task_id TEXT,
+	shipment_id TEXT,
+	author TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	message TEXT,
+	files_changed INTEGER DEFAULT 0,
+	insertions INTEGER DEFAULT 0,
+	deletions INTEGER DEFAULT 0,
+	committed_at DATETIME,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (repo_id) REFERENCES repos(id) ON DELETE SET NULL,
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL,
+	FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE SET NULL
+);
+
+-- Shipment diff stats (cached diffstat of a shipment branch against its merge base, per repo, refreshed on demand)
+CREATE TABLE IF NOT EXISTS shipment_diff_stats (
+	shipment_id TEXT NOT NULL,
+	repo_id TEXT NOT NULL DEFAULT '',
+	base_ref TEXT NOT NULL,
+	merge_base TEXT NOT NULL,
+	head_commit TEXT NOT NULL,
+	files_changed INTEGER DEFAULT 0,
+	insertions INTEGER DEFAULT 0,
+	deletions INTEGER DEFAULT 0,
+	computed_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (shipment_id, repo_id),
+	FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE
+);
+
+-- Shipment diff files (per-file churn behind a shipment's diff stats)
+CREATE TABLE IF NOT EXISTS shipment_diff_files (
+	shipment_id TEXT NOT NULL,
+	repo_id TEXT NOT NULL DEFAULT '',
+	path TEXT NOT NULL,
+	insertions INTEGER DEFAULT 0,
+	deletions INTEGER DEFAULT 0,
+	PRIMARY KEY (shipment_id, repo_id, path),
+	FOREIGN KEY (shipment_id, repo_id) REFERENCES shipment_diff_stats(shipment_id, repo_id) ON DELETE CASCADE
+);
+
+-- Tmux snapshots (captured windows, panes, layouts and start commands of a workshop session, for orc tmux restore)
+CREATE TABLE IF NOT EXISTS tmux_snapshots (
+	id TEXT PRIMARY KEY,
+	workshop_id TEXT NOT NULL,
+	session_name TEXT NOT NULL,
+	window_count INTEGER DEFAULT 0,
+	pane_count INTEGER DEFAULT 0,
+	content_json TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (workshop_id) REFERENCES workshops(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_tmux_snapshots_workshop ON tmux_snapshots(workshop_id);
+
 -- Create indexes for common queries
 CREATE INDEX IF NOT EXISTS idx_tags_name ON tags(name);
 CREATE INDEX IF NOT EXISTS idx_entity_tags_entity ON entity_tags(entity_id, entity_type);
@@ -237,6 +351,7 @@ CREATE INDEX IF NOT EXISTS idx_commissions_status ON commissions(status);
 CREATE INDEX IF NOT EXISTS idx_shipments_commission ON shipments(commission_id);
 CREATE INDEX IF NOT EXISTS idx_shipments_status ON shipments(status);
 CREATE INDEX IF NOT EXISTS idx_shipments_workbench ON shipments(assigned_workbench_id);
+CREATE INDEX IF NOT EXISTS idx_shipment_repos_workbench ON shipment_repos(assigned_workbench_id);
 CREATE INDEX IF NOT EXISTS idx_tomes_commission ON tomes(commission_id);
 CREATE INDEX IF NOT EXISTS idx_tasks_shipment ON tasks(shipment_id);
 CREATE INDEX IF NOT EXISTS idx_tasks_commission ON tasks(commission_id);
@@ -247,11 +362,14 @@ CREATE INDEX IF NOT EXISTS idx_prs_shipment ON prs(shipment_id);
 CREATE INDEX IF NOT EXISTS idx_prs_repo ON prs(repo_id);
 CREATE INDEX IF NOT EXISTS idx_prs_commission ON prs(commission_id);
 CREATE INDEX IF NOT EXISTS idx_prs_status ON prs(status);
+CREATE INDEX IF NOT EXISTS idx_pr_review_threads_note ON pr_review_threads(note_id);
 CREATE INDEX IF NOT EXISTS idx_plans_commission ON plans(commission_id);
 CREATE INDEX IF NOT EXISTS idx_plans_task ON plans(task_id);
 CREATE INDEX IF NOT EXISTS idx_plans_status ON plans(status);
 CREATE INDEX IF NOT EXISTS idx_notes_commission ON notes(commission_id);
 CREATE INDEX IF NOT EXISTS idx_notes_shipment ON notes(shipment_id);
+CREATE INDEX IF NOT EXISTS idx_commits_task ON commits(task_id);
+CREATE INDEX IF NOT EXISTS idx_commits_shipment ON commits(shipment_id);
 -- Workshop Events (audit trail for workshop changes)
 CREATE TABLE IF NOT EXISTS workshop_events (
 	id TEXT PRIMARY KEY,
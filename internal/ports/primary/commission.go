@@ -40,6 +40,13 @@ type CommissionService interface {
 
 	// UnpinCommission unpins a commission.
 	UnpinCommission(ctx context.Context, commissionID string) error
+
+	// GetCommissionRollup returns aggregate metrics for a commission.
+	GetCommissionRollup(ctx context.Context, commissionID string) (*CommissionRollup, error)
+
+	// SyncCommissionLifecycle aligns commission status with its shipments and tomes
+	// (auto-activate when work starts, auto-complete when everything is closed).
+	SyncCommissionLifecycle(ctx context.Context, commissionID string) (*CommissionLifecycleResult, error)
 }
 
 // CreateCommissionRequest contains parameters for creating a commission.
@@ -105,3 +112,22 @@ type DeleteCommissionRequest struct {
 	CommissionID string
 	Force        bool
 }
+
+// CommissionRollup contains aggregate metrics for a commission.
+type CommissionRollup struct {
+	CommissionID      string
+	ShipmentsByStatus map[string]int
+	TomesByStatus     map[string]int
+	OpenNotesByType   map[string]int
+	LastActivity      string
+	ReadyToComplete   bool // All shipments and tomes are closed but the commission is still active
+}
+
+// CommissionLifecycleResult describes what SyncCommissionLifecycle did.
+type CommissionLifecycleResult struct {
+	CommissionID   string
+	Action         string // "", "activate", "complete", or "prompt-complete"
+	PreviousStatus string
+	NewStatus      string
+	Error          string // Set when the sync failed; the change that triggered it still applies
+}
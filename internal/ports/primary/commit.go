@@ -0,0 +1,59 @@
+package primary
+
+import "context"
+
+// CommitService defines the primary port for linking git commits to tasks and shipments.
+// Commits are linked through Orc-Task and Orc-Shipment trailers in their messages.
+type CommitService interface {
+	// ScanCommits reads trailer-carrying commits from the branches of workbench repos
+	// and stores them in the ledger.
+	ScanCommits(ctx context.Context, req ScanCommitsRequest) (*ScanCommitsResult, error)
+
+	// ListCommits lists stored commits with optional filters, oldest first.
+	ListCommits(ctx context.Context, filters CommitFilters) ([]*Commit, error)
+
+	// PrepareCommitMessage adds trailers for the workbench's focused shipment and claimed task
+	// to a commit message file. Called by the prepare-commit-msg hook.
+	PrepareCommitMessage(ctx context.Context, req PrepareCommitMessageRequest) error
+}
+
+// ScanCommitsRequest contains parameters for scanning commits.
+type ScanCommitsRequest struct {
+	WorkshopID string // Optional: only scan repos of this workshop's workbenches
+}
+
+// ScanCommitsResult reports what a scan found.
+type ScanCommitsResult struct {
+	Repos   int // Repositories scanned
+	Linked  int // Commits stored with at least one known task or shipment
+	Skipped int // Commits whose trailers reference nothing in the ledger
+}
+
+// PrepareCommitMessageRequest contains parameters for the prepare-commit-msg hook.
+type PrepareCommitMessageRequest struct {
+	WorkbenchID string
+	WorkDir     string // Worktree the commit is made in
+	MessageFile string // Absolute path of the commit message file
+	Source      string // Hook's second argument: message, template, merge, squash, commit, or empty
+}
+
+// CommitFilters contains filter options for querying commits.
+type CommitFilters struct {
+	TaskID     string
+	ShipmentID string
+}
+
+// Commit represents a git commit linked to ORC work.
+type Commit struct {
+	SHA          string
+	RepoID       string
+	TaskID       string
+	ShipmentID   string
+	Author       string
+	Subject      string
+	Message      string
+	FilesChanged int
+	Insertions   int
+	Deletions    int
+	CommittedAt  string
+}
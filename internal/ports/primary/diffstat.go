@@ -0,0 +1,71 @@
+package primary
+
+import "context"
+
+// DiffStatService defines the primary port for shipment diff statistics.
+// Stats compare a shipment's branch in each repo it spans against its merge base with
+// that repo's default branch; they are cached in the ledger and recomputed only on request.
+type DiffStatService interface {
+	// GetShipmentDiffStat returns the cached diff stats of a shipment.
+	// Returns nil, nil if they were never computed.
+	GetShipmentDiffStat(ctx context.Context, shipmentID string) (*ShipmentDiffStat, error)
+
+	// RefreshShipmentDiffStat recomputes a shipment's diff stats from git and caches them.
+	RefreshShipmentDiffStat(ctx context.Context, shipmentID string) (*ShipmentDiffStat, error)
+
+	// GetShipmentFiles returns the cached touched-file map of a shipment, with per-directory
+	// totals. Returns nil, nil if the stats were never computed.
+	GetShipmentFiles(ctx context.Context, req GetShipmentFilesRequest) (*ShipmentFiles, error)
+}
+
+// GetShipmentFilesRequest contains parameters for reading a shipment's touched files.
+type GetShipmentFilesRequest struct {
+	ShipmentID string
+	Depth      int // Directory levels to group by; 0 groups by full directory
+}
+
+// ShipmentDiffStat is a shipment's diffstat against its merge base, totalled across its repos.
+type ShipmentDiffStat struct {
+	ShipmentID   string
+	FilesChanged int
+	Insertions   int
+	Deletions    int
+	ComputedAt   string          // Oldest of the per-repo stats
+	Repos        []*RepoDiffStat // One per repo the shipment spans, ordered by repo
+}
+
+// RepoDiffStat is the diffstat of a shipment's branch in one repo.
+type RepoDiffStat struct {
+	RepoID       string
+	BaseRef      string // e.g., origin/main
+	MergeBase    string
+	HeadCommit   string
+	FilesChanged int
+	Insertions   int
+	Deletions    int
+	ComputedAt   string
+}
+
+// ShipmentFiles is the touched-file map behind a shipment's diff stats.
+type ShipmentFiles struct {
+	Stat        *ShipmentDiffStat
+	Files       []*DiffFileStat // Ordered by repo and path
+	Directories []*DiffDirStat  // Ordered by churn, largest first
+}
+
+// DiffFileStat is the line churn of one file.
+type DiffFileStat struct {
+	RepoID     string
+	Path       string
+	Insertions int
+	Deletions  int
+}
+
+// DiffDirStat is the line churn of the files under one directory of a repo.
+type DiffDirStat struct {
+	RepoID     string
+	Dir        string // "." for files at the repository root
+	Files      int
+	Insertions int
+	Deletions  int
+}
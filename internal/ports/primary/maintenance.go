@@ -0,0 +1,46 @@
+package primary
+
+import "context"
+
+// MaintenanceService defines the primary port for retention and archival.
+type MaintenanceService interface {
+	// RunMaintenance applies a retention policy: archives old closed shipments,
+	// prunes old events, and compacts hook payloads. Steps with 0 days are skipped.
+	RunMaintenance(ctx context.Context, req MaintenanceRequest) (*MaintenanceResult, error)
+
+	// ListArchivedShipments retrieves shipments from the archive ledger.
+	ListArchivedShipments(ctx context.Context, filters ShipmentFilters) ([]*Shipment, error)
+
+	// GetArchivedShipment retrieves a shipment from the archive ledger.
+	GetArchivedShipment(ctx context.Context, shipmentID string) (*Shipment, error)
+
+	// GetArchivedShipmentTasks retrieves the archived tasks of a shipment.
+	GetArchivedShipmentTasks(ctx context.Context, shipmentID string) ([]*Task, error)
+}
+
+// MaintenanceRequest contains the retention thresholds for a maintenance run.
+type MaintenanceRequest struct {
+	ArchiveShipmentsAfterDays    int
+	PruneEventsAfterDays         int // Operational events only
+	CompactHookPayloadsAfterDays int
+	DryRun                       bool // Report archivable shipments without changing anything
+}
+
+// MaintenanceResult reports what a maintenance run did (or would do, for a dry run).
+type MaintenanceResult struct {
+	DryRun                bool
+	ArchivedShipments     []string
+	SkippedShipments      []SkippedShipment
+	ArchivedTasks         int
+	ArchivedPlans         int
+	ArchivedNotes         int
+	ArchivedPRs           int
+	PrunedEvents          int
+	CompactedHookPayloads int
+}
+
+// SkippedShipment is an old closed shipment that was kept in the main ledger.
+type SkippedShipment struct {
+	ShipmentID string
+	Reason     string
+}
@@ -0,0 +1,75 @@
+package primary
+
+import "context"
+
+// Pane health states, as classified by PaneHealthService.
+const (
+	PaneStateCrashed = "crashed"
+	PaneStateWaiting = "waiting" // Waiting for input, e.g. on a permission prompt
+	PaneStateWorking = "working"
+	PaneStateIdle    = "idle"
+	PaneStateShell   = "shell"
+)
+
+// PaneHealthService defines the primary port for workbench pane health.
+// Callers poll tmux and pass what each pane shows; the service classifies the
+// panes and records state changes as operational events.
+type PaneHealthService interface {
+	// AssessPanes classifies a workshop's panes and records each transition
+	// from the previous poll as an operational event.
+	AssessPanes(ctx context.Context, req AssessPanesRequest) (*PaneHealthReport, error)
+}
+
+// AssessPanesRequest contains one poll of a workshop's panes.
+type AssessPanesRequest struct {
+	WorkshopID string
+	Panes      []PaneObservation
+	Known      []*PaneHealth // Panes from the previous report; those missing now may have crashed
+}
+
+// PaneObservation is what one pane showed at a poll.
+type PaneObservation struct {
+	PaneID         string
+	Window         string
+	Index          int
+	Role           string // @pane_role
+	BenchID        string // @bench_id
+	CurrentCommand string
+	StartCommand   string
+	Dead           bool
+	Content        string // Tail of the visible screen
+	PreviousState  string // State recorded at the previous poll, if any
+	PreviousDigest string // Content digest recorded at the previous poll, if any
+}
+
+// PaneHealth is the classified state of one pane.
+type PaneHealth struct {
+	PaneID         string
+	Window         string
+	Index          int
+	Role           string
+	BenchID        string
+	State          string
+	PreviousState  string
+	CurrentCommand string
+	Prompt         string // The prompt line of a waiting pane
+	Digest         string // Content digest, passed back as PreviousDigest next poll
+	Gone           bool   // The pane disappeared since the previous poll
+	Change         string // For transitions: "BENCH-001 goblin: working → waiting (Do you want to proceed?)"
+}
+
+// WindowHealth is the most urgent pane state of a window.
+type WindowHealth struct {
+	Window string
+	State  string
+	Alert  bool // Waiting or crashed: needs a human
+}
+
+// PaneHealthReport is the result of one poll.
+type PaneHealthReport struct {
+	WorkshopID  string
+	Panes       []*PaneHealth
+	Windows     []*WindowHealth
+	Transitions []*PaneHealth // Panes whose state changed since the previous poll
+	Summary     string        // e.g. "1 waiting, 4 working"
+}
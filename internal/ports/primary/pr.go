@@ -25,33 +25,76 @@ type PRService interface {
 	// ApprovePR marks a PR as approved.
 	ApprovePR(ctx context.Context, prID string) error
 
-	// MergePR merges a PR (cascades to complete the shipment).
-	MergePR(ctx context.Context, prID string) error
+	// MergePR merges a PR (cascades to complete the shipment). It refuses while
+	// required checks are failing, unless forced. When the repo has a merge
+	// strategy, the PR is first landed in the repo's main checkout, verified and
+	// pushed; the ledger only changes once that succeeded.
+	MergePR(ctx context.Context, req MergePRRequest) (*MergePRResponse, error)
 
 	// ClosePR closes a PR without merging.
 	ClosePR(ctx context.Context, prID string) error
 
 	// LinkPR links an existing external PR to a shipment.
 	LinkPR(ctx context.Context, shipmentID, url string, number int) (*PR, error)
+
+	// SyncPRs pulls state, number, review decision, mergeability, merged/closed
+	// timestamps and CI checks from each PR's provider into the ledger.
+	// It also reconciles imported review threads with the notes they became.
+	SyncPRs(ctx context.Context, req SyncPRsRequest) ([]*PRSyncResult, error)
+
+	// StackPR stacks a PR on another PR, retargeting it to the parent's branch, or
+	// unstacks it onto its stack's base branch when ParentPRID is empty.
+	StackPR(ctx context.Context, req StackPRRequest) error
+
+	// ListPRStacks lists a commission's stacks of PRs, bottom first.
+	ListPRStacks(ctx context.Context, commissionID string) ([]*PRStack, error)
+
+	// RestackPRs rebases each open PR of a stack onto its parent in the PR's
+	// workbench, moving PRs stacked on merged PRs down and retargeting them.
+	RestackPRs(ctx context.Context, req RestackPRsRequest) (*RestackPRsResponse, error)
+
+	// ListPRChecks retrieves the CI checks of a PR as of its last sync.
+	ListPRChecks(ctx context.Context, prID string) ([]*PRCheck, error)
+
+	// GetShipmentChecksState rolls up the CI checks of a shipment's PRs that are
+	// not merged or closed: one of the PRCheck* states, empty when none has checks.
+	GetShipmentChecksState(ctx context.Context, shipmentID string) (string, error)
+
+	// ListPRReviews fetches a PR's review threads from its provider, with the
+	// notes they were imported as.
+	ListPRReviews(ctx context.Context, prID string) ([]*PRReviewThread, error)
+
+	// ImportPRReviews turns a PR's unresolved review threads into notes on its
+	// shipment, optionally with a follow-up task each. Imported threads are skipped.
+	ImportPRReviews(ctx context.Context, req ImportPRReviewsRequest) (*ImportPRReviewsResponse, error)
 }
 
 // CreatePRRequest contains parameters for creating a pull request.
+// RepoID, Branch and Title default to the shipment's repo, branch and title.
 type CreatePRRequest struct {
 	ShipmentID   string
 	RepoID       string
 	Title        string
 	Description  string
 	Branch       string
-	TargetBranch string
+	TargetBranch string // Defaults to the repo's default branch when opening
 	Draft        bool
-	URL          string // For linking existing PRs
-	Number       int    // GitHub PR number
+	URL          string   // For linking existing PRs
+	Number       int      // Provider PR number
+	Open         bool     // Open the PR on the repo's provider and record its number and URL
+	Push         bool     // With Open: push the branch from the shipment's workbench first
+	Labels       []string // With Open: labels to add
+	Reviewers    []string // With Open: reviewers to request
+	ParentPRID   string   // Stack on this PR: target its branch instead of TargetBranch
 }
 
 // CreatePRResponse contains the result of creating a pull request.
 type CreatePRResponse struct {
-	PRID string
-	PR   *PR
+	PRID    string
+	PR      *PR
+	Pushed  bool   // The branch was pushed from the shipment's workbench
+	Adopted bool   // An open PR for the branch already existed on the provider and was recorded
+	Warning string // Set when the PR was opened but labels or reviewers could not be applied
 }
 
 // UpdatePRRequest contains parameters for updating a pull request.
@@ -65,21 +108,182 @@ type UpdatePRRequest struct {
 
 // PR represents a pull request entity at the port boundary.
 type PR struct {
-	ID           string
-	ShipmentID   string
-	RepoID       string
-	CommissionID string
-	Number       int
-	Title        string
-	Description  string
-	Branch       string
-	TargetBranch string
-	URL          string
-	Status       string
-	CreatedAt    string
-	UpdatedAt    string
-	MergedAt     string
-	ClosedAt     string
+	ID             string
+	ShipmentID     string
+	RepoID         string
+	CommissionID   string
+	Number         int
+	Title          string
+	Description    string
+	Branch         string
+	TargetBranch   string
+	URL            string
+	Status         string
+	CreatedAt      string
+	UpdatedAt      string
+	MergedAt       string
+	ClosedAt       string
+	ReviewDecision string // approved, changes_requested, review_required; empty when unknown
+	Mergeable      string // mergeable, conflicting, unknown; empty when unknown
+	SyncedAt       string // Last provider sync; empty means never synced
+	ParentPRID     string // PR this one is stacked on; empty when not stacked
+}
+
+// PRCheck is a CI check on a PR as of the last sync.
+type PRCheck struct {
+	Name       string
+	Status     string // queued, in_progress, completed
+	Conclusion string // success, failure, neutral, cancelled, skipped, timed_out, action_required; empty until completed
+	State      string // One of the PRCheck* states
+	URL        string
+	Required   bool   // Must pass before merging
+	UpdatedAt  string // Provider time of the check's last update
+}
+
+// CI check states
+const (
+	PRCheckPassing = "passing"
+	PRCheckFailing = "failing"
+	PRCheckPending = "pending"
+)
+
+// MergePRRequest contains parameters for merging a pull request.
+type MergePRRequest struct {
+	PRID       string
+	Force      bool   // Merge despite failing required checks
+	Strategy   string // Land with this strategy instead of the repo's (merge, squash or rebase)
+	LedgerOnly bool   // Only record the merge, even if the repo has a merge strategy
+}
+
+// MergePRResponse contains the result of merging a pull request.
+type MergePRResponse struct {
+	Strategy          string // Strategy the PR was landed with; empty when only recorded
+	Source            string // Where the strategy came from: "repo record", ".orc/repo.json" or "--strategy"
+	TargetBranch      string
+	Commit            string // Target branch head after landing
+	Verify            []*PRVerifyStep
+	PendingVerify     []string // Verify commands checked in to .orc/repo.json, not run until adopted
+	Pushed            bool
+	ShipmentCompleted bool                       // True when this was the shipment's last open PR
+	Commission        *CommissionLifecycleResult // Set when merging completed the shipment
+}
+
+// PRVerifyStep is one verify command run on a locally merged PR.
+type PRVerifyStep struct {
+	Command  string
+	Success  bool
+	Output   string // Combined output, truncated to its tail
+	Duration string
+}
+
+// StackPRRequest contains parameters for stacking a PR.
+type StackPRRequest struct {
+	PRID       string
+	ParentPRID string // Empty unstacks the PR
+}
+
+// PRStack is a stack of PRs, each targeting the branch of the PR below it.
+type PRStack struct {
+	RepoID     string
+	BaseBranch string         // Branch the bottom PR targets
+	Entries    []PRStackEntry // Bottom first; each PR is followed by the PRs stacked on it
+}
+
+// PRStackEntry is a PR in a stack.
+type PRStackEntry struct {
+	PR    *PR
+	Depth int // 0 for the bottom PR
+}
+
+// RestackPRsRequest selects the stack to restack by one of its PRs.
+type RestackPRsRequest struct {
+	PRID string
+	Push bool // Force-push (with lease) each rebased branch
+}
+
+// RestackPRsResponse reports each PR moved by a restack, bottom first.
+type RestackPRsResponse struct {
+	Steps []PRRestackStep
+}
+
+// PRRestackStep is one PR branch moved by a restack.
+type PRRestackStep struct {
+	PRID       string
+	Branch     string
+	Onto       string // Branch it was rebased onto
+	ParentPRID string // New parent; empty when it now targets the stack's base branch
+	Rebased    bool
+	Pushed     bool
+	Retargeted bool     // The ledger (and the provider, for opened PRs) target changed
+	Conflicts  []string // Set when the rebase stopped on conflicts and was aborted
+}
+
+// SyncPRsRequest selects the PRs to sync: one PR, or every PR not yet merged or closed.
+type SyncPRsRequest struct {
+	PRID string
+	All  bool
+}
+
+// PRSyncResult reports what a sync changed on one PR.
+type PRSyncResult struct {
+	PRID              string
+	Number            int
+	ShipmentID        string
+	ShipmentCompleted bool                       // True when a merge completed the shipment
+	Changes           []PRFieldChange            // Empty when the ledger already matched the provider
+	Threads           []PRThreadChange           // Imported review threads brought back in agreement with their notes
+	Commission        *CommissionLifecycleResult // Set when a merge completed the shipment
+	Error             string                     // Set when this PR could not be synced
+}
+
+// PRThreadChange is one review thread or note updated by a sync.
+type PRThreadChange struct {
+	NoteID   string
+	ThreadID string
+	Action   string // One of the PRThread* constants
+}
+
+// Review thread sync actions
+const (
+	PRThreadResolved     = "resolve_remote" // Note was resolved, so the thread was resolved
+	PRThreadReopened     = "reopen_remote"  // Note was reopened, so the thread was reopened
+	PRThreadNoteResolved = "resolve_note"   // Thread was resolved, so the note was closed
+	PRThreadNoteReopened = "reopen_note"    // Thread was reopened, so the note was reopened
+)
+
+// PRReviewThread is a review conversation on a PR.
+type PRReviewThread struct {
+	ThreadID string
+	URL      string // Link to the comment that started the thread
+	Path     string // Empty for PR-level threads
+	Line     int
+	Author   string
+	Body     string // First comment
+	Replies  int
+	Resolved bool
+	Outdated bool
+	NoteID   string // Empty until imported
+	TaskID   string // Follow-up task, if one was created
+}
+
+// ImportPRReviewsRequest contains parameters for importing review threads.
+type ImportPRReviewsRequest struct {
+	PRID        string
+	NoteType    string // concern (default) or bug
+	CreateTasks bool   // Also create a follow-up task per imported thread
+}
+
+// ImportPRReviewsResponse contains the result of importing review threads.
+type ImportPRReviewsResponse struct {
+	Imported []*PRReviewThread
+	Skipped  int // Threads already resolved or imported earlier
+}
+
+// PRFieldChange is one ledger field updated by a sync.
+type PRFieldChange struct {
+	Field string
+	Old   string
+	New   string
 }
 
 // PRFilters contains filter options for listing pull requests.
@@ -0,0 +1,53 @@
+package primary
+
+import "context"
+
+// ReconcileService defines the primary port for ledger/workbench drift reconciliation.
+// It compares workbench rows with the filesystem, git worktrees, .orc/config.json, and tmux.
+type ReconcileService interface {
+	// PlanReconcile gathers current state and computes the fixes needed, without executing them.
+	PlanReconcile(ctx context.Context, req ReconcileRequest) (*ReconcilePlan, error)
+
+	// ApplyReconcile executes a previously generated plan. Manual actions are skipped.
+	ApplyReconcile(ctx context.Context, plan *ReconcilePlan) (*ReconcileResult, error)
+}
+
+// ReconcileRequest contains parameters for planning a reconcile.
+type ReconcileRequest struct {
+	// WorkshopID limits the plan to one workshop's workbenches.
+	// Orphan worktrees and unknown directories are only detected when empty.
+	WorkshopID string
+}
+
+// Reconcile action types.
+const (
+	ReconcilePruneWorktrees   = "prune-worktrees"
+	ReconcileRemoveOrphan     = "remove-orphan"
+	ReconcileRecreateWorktree = "recreate-worktree"
+	ReconcileUpdateBranch     = "update-branch"
+	ReconcileWriteConfig      = "write-config"
+	ReconcileManual           = "manual" // Reported only, never applied
+)
+
+// ReconcilePlan describes the fixes a reconcile would apply.
+type ReconcilePlan struct {
+	WorkshopID  string
+	Actions     []ReconcileAction
+	NothingToDo bool // True when nothing can be applied (manual items may remain)
+}
+
+// ReconcileAction is a single planned fix.
+type ReconcileAction struct {
+	Type        string
+	WorkbenchID string
+	Path        string
+	RepoPath    string
+	Branch      string
+	Description string
+}
+
+// ReconcileResult reports what an apply did.
+type ReconcileResult struct {
+	Applied []ReconcileAction
+	Skipped []ReconcileAction // Manual actions left for the user
+}
@@ -27,6 +27,33 @@ type RepoService interface {
 
 	// DeleteRepo hard-deletes a repository.
 	DeleteRepo(ctx context.Context, repoID string) error
+
+	// GetRepoHealth inspects a repository's local clone: fetch age, default branch
+	// drift against origin, linked worktrees and uncommitted changes.
+	GetRepoHealth(ctx context.Context, repoID string) (*RepoHealth, error)
+
+	// FetchRepo fetches origin in a repository's local clone and returns its health afterwards.
+	FetchRepo(ctx context.Context, repoID string) (*RepoHealth, error)
+
+	// SetBootstrapSteps replaces the commands run in each new worktree of a repository.
+	// They override the repo's checked-in .orc/repo.json; no steps falls back to it.
+	SetBootstrapSteps(ctx context.Context, repoID string, steps []string) error
+
+	// AdoptBootstrapFile stores the bootstrap steps checked in to the clone's .orc/repo.json
+	// on the repository record, so they run in new worktrees. Returns the adopted steps.
+	AdoptBootstrapFile(ctx context.Context, repoID string) ([]string, error)
+
+	// SetSparsePaths replaces the cone-mode sparse checkout directories used for new
+	// worktrees of a repository. No paths means new worktrees get a full checkout.
+	SetSparsePaths(ctx context.Context, repoID string, paths []string) error
+
+	// SetMergeConfig sets how orc pr merge lands PRs of a repository locally.
+	// nil clears it, leaving any checked-in .orc/repo.json config pending adoption.
+	SetMergeConfig(ctx context.Context, repoID string, cfg *RepoMergeConfig) error
+
+	// AdoptMergeFile stores the merge config checked in to the clone's .orc/repo.json
+	// on the repository record, so orc pr merge uses it. Returns the adopted config.
+	AdoptMergeFile(ctx context.Context, repoID string) (*RepoMergeConfig, error)
 }
 
 // CreateRepoRequest contains parameters for creating a repository.
@@ -34,7 +61,8 @@ type CreateRepoRequest struct {
 	Name          string
 	URL           string
 	LocalPath     string
-	DefaultBranch string
+	DefaultBranch string // Detected from the clone when empty and Clone is set
+	Clone         bool   // Clone URL into LocalPath (default: the workspace repo path) first
 }
 
 // CreateRepoResponse contains the result of creating a repository.
@@ -58,11 +86,45 @@ type Repo struct {
 	URL           string
 	LocalPath     string
 	DefaultBranch string
+	Bootstrap     []string         // Worktree bootstrap commands stored on the record
+	SparsePaths   []string         // Sparse checkout directories for new worktrees; empty means full checkout
+	Merge         *RepoMergeConfig // Local merge config stored on the record; nil when unset
 	Status        string
 	CreatedAt     string
 	UpdatedAt     string
 }
 
+// RepoMergeConfig tells orc pr merge to land a repository's PRs in its main checkout.
+type RepoMergeConfig struct {
+	Strategy string   // merge, squash or rebase
+	Verify   []string // Commands run on the merged result before pushing
+}
+
+// Local merge strategies.
+const (
+	MergeStrategyMerge  = "merge"
+	MergeStrategySquash = "squash"
+	MergeStrategyRebase = "rebase"
+)
+
+// RepoHealth describes the state of a repository's local clone.
+type RepoHealth struct {
+	RepoID                string
+	Name                  string
+	LocalPath             string
+	Cloned                bool
+	HasOrigin             bool
+	DefaultBranch         string // As recorded in the ledger
+	DetectedDefaultBranch string // As advertised by origin; empty when unknown
+	LastFetchedAt         string // Empty if never fetched
+	FetchAge              string // e.g., "3d"; empty if never fetched
+	Ahead                 int    // Local default branch commits not on origin
+	Behind                int    // origin/<default> commits missing locally
+	Worktrees             int    // Linked worktrees (workbenches) of the clone
+	DirtyFiles            int
+	Issues                []string // Empty when healthy
+}
+
 // RepoFilters contains filter options for listing repositories.
 type RepoFilters struct {
 	Status string
@@ -0,0 +1,124 @@
+package primary
+
+import "context"
+
+// ReportService defines the primary port for report generation.
+// Reports assemble what the ledger already knows about a shipment into a single document.
+type ReportService interface {
+	// GetShipmentReport gathers the data for a shipment report.
+	GetShipmentReport(ctx context.Context, shipmentID string) (*ShipmentReport, error)
+
+	// RenderShipmentReport renders a shipment report in the requested format.
+	RenderShipmentReport(ctx context.Context, req RenderReportRequest) (string, error)
+
+	// RenderPRBody renders the pull request description for a shipment from its
+	// spec note and tasks.
+	RenderPRBody(ctx context.Context, shipmentID string) (string, error)
+
+	// GetChangelog gathers the work shipped by merged PRs and closed shipments,
+	// grouped by task type.
+	GetChangelog(ctx context.Context, req ChangelogRequest) (*Changelog, error)
+
+	// RenderChangelog renders a changelog as Keep a Changelog markdown or JSON.
+	RenderChangelog(ctx context.Context, req ChangelogRequest) (string, error)
+
+	// PrependChangelog renders a changelog as markdown and prepends it to the
+	// CHANGELOG.md in a workbench, returning the file's path.
+	PrependChangelog(ctx context.Context, req ChangelogRequest, workbenchID string) (string, error)
+}
+
+// RenderReportRequest contains parameters for rendering a shipment report.
+type RenderReportRequest struct {
+	ShipmentID   string
+	Format       string // markdown (default), json, or html
+	TemplatePath string // Optional user template overriding the default (markdown/html only)
+}
+
+// ShipmentReport is the assembled view of a shipment used to render reports.
+type ShipmentReport struct {
+	Shipment       *Shipment
+	Spec           *Note
+	Decisions      []*Note
+	Tasks          []*ReportTask
+	TasksCompleted int
+	TasksTotal     int
+	Plans          []*Plan
+	Repos          []*ReportRepo // Branch commits per repository the shipment spans, primary first
+	PRs            []*PR         // Pull requests of every repository, oldest first
+	GeneratedAt    string
+}
+
+// ReportRepo is the shipment branch in one repository as shown in a report.
+type ReportRepo struct {
+	RepoID     string // Empty for a shipment without a repo
+	Branch     string
+	BaseBranch string
+	Commits    []ReportCommit
+}
+
+// ReportTask is a task with its completion state as shown in a report.
+type ReportTask struct {
+	ID        string
+	Title     string
+	Type      string
+	Status    string
+	Completed bool
+}
+
+// ReportCommit is a commit on the shipment branch.
+type ReportCommit struct {
+	Hash    string
+	Author  string
+	Date    string
+	Subject string
+}
+
+// ChangelogRequest selects the shipped work for a changelog. At least one of
+// CommissionID and Since is required.
+type ChangelogRequest struct {
+	CommissionID string
+	Since        string // YYYY-MM-DD or RFC3339; work shipped before it is left out
+	Version      string // Release heading; empty for [Unreleased]
+	Format       string // markdown (default) or json
+}
+
+// Changelog is shipped work grouped into Keep a Changelog sections.
+type Changelog struct {
+	CommissionID string
+	Since        string
+	Version      string
+	Date         string
+	Shipments    []string // IDs of the shipments included, oldest first
+	Sections     []*ChangelogSection
+}
+
+// ChangelogSection is one group of changelog entries, e.g. "Added" for
+// implementation tasks.
+type ChangelogSection struct {
+	Type    string
+	Heading string
+	Entries []*ChangelogEntry
+}
+
+// ChangelogEntry is one changelog line: a closed task, or a whole shipment when it
+// has no changelog-worthy tasks.
+type ChangelogEntry struct {
+	Title      string
+	TaskID     string
+	ShipmentID string
+	PRs        []*ChangelogPR
+}
+
+// ChangelogPR is a merged PR referenced by a changelog entry.
+type ChangelogPR struct {
+	ID     string
+	Number int
+	URL    string
+}
+
+// Report format constants
+const (
+	ReportFormatMarkdown = "markdown"
+	ReportFormatJSON     = "json"
+	ReportFormatHTML     = "html"
+)
@@ -15,7 +15,8 @@ type ShipmentService interface {
 
 	// CompleteShipment marks a shipment as closed.
 	// If force is true, closes even if tasks are not closed.
-	CompleteShipment(ctx context.Context, shipmentID string, force bool) error
+	// Returns what closing it did to the shipment's commission.
+	CompleteShipment(ctx context.Context, shipmentID string, force bool) (*CommissionLifecycleResult, error)
 
 	// UpdateShipment updates a shipment's title and/or description.
 	UpdateShipment(ctx context.Context, req UpdateShipmentRequest) error
@@ -27,7 +28,19 @@ type ShipmentService interface {
 	UnpinShipment(ctx context.Context, shipmentID string) error
 
 	// AssignShipmentToWorkbench assigns a shipment to a workbench.
-	AssignShipmentToWorkbench(ctx context.Context, shipmentID, workbenchID string) error
+	// For shipments spanning several repos, the workbench's repo decides which part it works on.
+	// When the branch cannot be checked out, the assignment is still recorded and a warning returned.
+	AssignShipmentToWorkbench(ctx context.Context, shipmentID, workbenchID string) (*AssignShipmentResponse, error)
+
+	// AddShipmentRepo adds a repository to a shipment with its own branch.
+	// The first repo added to a shipment without one becomes its primary repo.
+	AddShipmentRepo(ctx context.Context, req AddShipmentRepoRequest) (*ShipmentRepo, error)
+
+	// RemoveShipmentRepo removes an additional repository from a shipment.
+	RemoveShipmentRepo(ctx context.Context, shipmentID, repoID string) error
+
+	// ListShipmentRepos lists the repositories a shipment spans, primary first.
+	ListShipmentRepos(ctx context.Context, shipmentID string) ([]*ShipmentRepo, error)
 
 	// GetShipmentsByWorkbench retrieves shipments assigned to a workbench.
 	GetShipmentsByWorkbench(ctx context.Context, workbenchID string) ([]*Shipment, error)
@@ -43,10 +56,51 @@ type ShipmentService interface {
 
 	// SetStatus sets a shipment's status with escape hatch protection.
 	// If force is true, allows backwards transitions.
-	SetStatus(ctx context.Context, shipmentID, status string, force bool) error
+	// Returns what the change did to the shipment's commission.
+	SetStatus(ctx context.Context, shipmentID, status string, force bool) (*CommissionLifecycleResult, error)
 
 	// MoveShipmentToCommission moves a shipment and its children to a different commission.
 	MoveShipmentToCommission(ctx context.Context, shipmentID, targetCommissionID string) (*MoveShipmentResult, error)
+
+	// ImportPlan creates tasks and notes from a structured markdown plan in one transaction.
+	// With DryRun set, the document is parsed and validated but nothing is written.
+	ImportPlan(ctx context.Context, req ImportPlanRequest) (*ImportPlanResponse, error)
+}
+
+// ImportPlanRequest contains parameters for importing a plan document into a shipment.
+type ImportPlanRequest struct {
+	ShipmentID string
+	Content    string // Markdown plan document
+	DryRun     bool
+}
+
+// ImportPlanResponse describes the tasks and notes created (or that would be created).
+type ImportPlanResponse struct {
+	ShipmentID string
+	DryRun     bool
+	Tasks      []ImportedTask
+	Notes      []ImportedNote
+}
+
+// ImportedTask describes a task created by a plan import.
+// ID is empty for dry runs; Ref is the task's 1-based position in the document.
+type ImportedTask struct {
+	Ref          int
+	ID           string
+	Title        string
+	Type         string
+	Priority     string
+	DependsOn    []string // Resolved task IDs (or "#N" references in dry runs)
+	SubtaskCount int
+	Done         bool // Imported as closed (checked top-level checklist item)
+}
+
+// ImportedNote describes a note created by a plan import.
+// ID is empty for dry runs.
+type ImportedNote struct {
+	ID    string
+	Title string
+	Type  string
 }
 
 // MoveShipmentResult contains the counts of cascaded children updated during a move.
@@ -71,6 +125,13 @@ type CreateShipmentResponse struct {
 	Shipment   *Shipment
 }
 
+// AssignShipmentResponse contains the result of assigning a shipment to a workbench.
+type AssignShipmentResponse struct {
+	RepoID  string // Repo the workbench works on ("" when it has none)
+	Branch  string // Shipment branch for that repo ("" when no branch applies)
+	Warning string // Set when the branch was not checked out; the assignment is ledger-only
+}
+
 // UpdateShipmentRequest contains parameters for updating a shipment.
 type UpdateShipmentRequest struct {
 	ShipmentID  string
@@ -96,6 +157,22 @@ type Shipment struct {
 	CompletedAt         string
 }
 
+// AddShipmentRepoRequest contains parameters for adding a repository to a shipment.
+type AddShipmentRepoRequest struct {
+	ShipmentID string
+	RepoID     string
+	Branch     string // Optional - defaults to the generated shipment branch name
+}
+
+// ShipmentRepo is one repository a shipment spans, with its branch and workbench.
+type ShipmentRepo struct {
+	ShipmentID          string
+	RepoID              string
+	Branch              string
+	AssignedWorkbenchID string
+	Primary             bool // The shipment's own repo (shipment.RepoID)
+}
+
 // ShipmentFilters contains filter options for listing shipments.
 type ShipmentFilters struct {
 	CommissionID string
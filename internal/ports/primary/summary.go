@@ -57,26 +57,30 @@ type NoteSummary struct {
 
 // ShipmentSummary represents a shipment with task progress.
 type ShipmentSummary struct {
-	ID         string
-	Title      string
-	Status     string
-	IsFocused  bool
-	Pinned     bool
-	BenchID    string // Assigned workbench ID (empty if unassigned)
-	BenchName  string // Assigned workbench name (for display)
-	TasksDone  int
-	TasksTotal int
-	NoteCount  int
-	Tasks      []TaskSummary // Populated only for focused shipment
-	Notes      []NoteSummary // Populated only for focused shipment
+	ID          string
+	Title       string
+	Status      string
+	IsFocused   bool
+	Pinned      bool
+	BenchID     string // Assigned workbench ID (empty if unassigned)
+	BenchName   string // Assigned workbench name (for display)
+	TasksDone   int
+	TasksTotal  int
+	NoteCount   int
+	CommitCount int           // Commits linked via Orc-Shipment / Orc-Task trailers
+	DiffBadge   string        // Cached branch size (e.g., "+120/-40"); empty if never computed
+	ChecksState string        // CI checks of the shipment's open PRs: passing, failing, pending; empty if none synced
+	Tasks       []TaskSummary // Populated only for focused shipment
+	Notes       []NoteSummary // Populated only for focused shipment
 }
 
 // TaskSummary represents a task in the summary view.
 type TaskSummary struct {
-	ID     string
-	Title  string
-	Status string
-	Plans  []PlanSummary
+	ID          string
+	Title       string
+	Status      string
+	CommitCount int
+	Plans       []PlanSummary
 }
 
 // PlanSummary represents a plan in the summary view.
@@ -16,8 +16,9 @@ type TaskService interface {
 	// ClaimTask claims a task for a workbench (sets to in_progress).
 	ClaimTask(ctx context.Context, req ClaimTaskRequest) error
 
-	// CompleteTask marks a task as complete.
-	CompleteTask(ctx context.Context, taskID string) error
+	// CompleteTask marks a task as complete, checkpointing its workbench first when asked.
+	// Returns the checkpoint, or nil when none was requested.
+	CompleteTask(ctx context.Context, req CompleteTaskRequest) (*TaskCheckpoint, error)
 
 	// PauseTask pauses an in_progress task.
 	PauseTask(ctx context.Context, taskID string) error
@@ -55,6 +56,22 @@ type TaskService interface {
 
 	// MoveTask moves a task to a different container.
 	MoveTask(ctx context.Context, req MoveTaskRequest) error
+
+	// CheckpointTask records the end of a task's commit range, optionally committing
+	// uncommitted work or tagging the workbench HEAD first.
+	CheckpointTask(ctx context.Context, req CheckpointTaskRequest) (*TaskCheckpoint, error)
+
+	// GetTaskCommits lists the commits made in the workbench while the task was worked on.
+	GetTaskCommits(ctx context.Context, taskID string) ([]*TaskCommit, error)
+
+	// GetTaskDiff returns the diff of a task's commit range (full patch or diffstat).
+	GetTaskDiff(ctx context.Context, taskID string, stat bool) (string, error)
+
+	// PreviewTaskRollback describes what RollbackTask would discard.
+	PreviewTaskRollback(ctx context.Context, taskID string) (*TaskRollbackPreview, error)
+
+	// RollbackTask resets the task's workbench to the checkpoint recorded when it was claimed.
+	RollbackTask(ctx context.Context, taskID string) error
 }
 
 // CreateTaskRequest contains parameters for creating a task.
@@ -79,6 +96,12 @@ type ClaimTaskRequest struct {
 	WorkbenchID string // Optional, can be derived from context
 }
 
+// CompleteTaskRequest contains parameters for completing a task.
+type CompleteTaskRequest struct {
+	TaskID     string
+	Checkpoint string // Optional: a checkpoint mode, run only once the task may be closed
+}
+
 // UpdateTaskRequest contains parameters for updating a task.
 type UpdateTaskRequest struct {
 	TaskID      string
@@ -112,6 +135,8 @@ type Task struct {
 	UpdatedAt           string
 	ClaimedAt           string
 	CompletedAt         string
+	StartCommit         string   // Workbench HEAD when the task was claimed
+	EndCommit           string   // Workbench HEAD when the task was completed
 	Tag                 *TaskTag // Populated when retrieving task details
 }
 
@@ -128,3 +153,42 @@ type TaskFilters struct {
 	CommissionID string
 	TagName      string
 }
+
+// Checkpoint modes for CheckpointTask.
+const (
+	CheckpointModeCommit = "commit" // Commit all uncommitted work as the checkpoint
+	CheckpointModeTag    = "tag"    // Tag the workbench HEAD as orc/<task-id>
+)
+
+// CheckpointTaskRequest contains parameters for checkpointing a task.
+type CheckpointTaskRequest struct {
+	TaskID string
+	Mode   string // Optional: CheckpointModeCommit or CheckpointModeTag; empty only records HEAD
+}
+
+// TaskCheckpoint is the commit range recorded for a task.
+type TaskCheckpoint struct {
+	TaskID      string
+	StartCommit string
+	EndCommit   string
+	Committed   bool   // A checkpoint commit was created
+	Tag         string // Tag created, if any
+}
+
+// TaskCommit describes a commit made while working on a task.
+type TaskCommit struct {
+	Hash    string
+	Author  string
+	Date    string
+	Subject string
+}
+
+// TaskRollbackPreview describes what rolling back a task would discard.
+type TaskRollbackPreview struct {
+	TaskID        string
+	WorkbenchID   string
+	WorkbenchPath string
+	StartCommit   string
+	Discarded     []*TaskCommit // Commits after the checkpoint, including any from later tasks
+	DirtyFiles    int           // Modified tracked files that would be lost
+}
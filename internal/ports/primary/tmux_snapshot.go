@@ -0,0 +1,42 @@
+package primary
+
+import "context"
+
+// TmuxSnapshotService defines the primary port for tmux session snapshots.
+// A snapshot records a workshop session's windows, panes, layouts, working
+// directories, start commands and user options, so the session can be rebuilt
+// after the tmux server is lost.
+type TmuxSnapshotService interface {
+	// SaveSnapshot stores a captured session in the ledger.
+	SaveSnapshot(ctx context.Context, req SaveTmuxSnapshotRequest) (*TmuxSnapshot, error)
+
+	// GetSnapshot retrieves a snapshot by ID.
+	GetSnapshot(ctx context.Context, snapshotID string) (*TmuxSnapshot, error)
+
+	// GetLatestSnapshot retrieves the newest snapshot of a workshop.
+	// Returns nil, nil if the workshop has none.
+	GetLatestSnapshot(ctx context.Context, workshopID string) (*TmuxSnapshot, error)
+
+	// ListSnapshots lists the snapshots of a workshop, newest first.
+	ListSnapshots(ctx context.Context, workshopID string) ([]*TmuxSnapshot, error)
+}
+
+// SaveTmuxSnapshotRequest contains the parameters for storing a snapshot.
+type SaveTmuxSnapshotRequest struct {
+	WorkshopID  string
+	SessionName string
+	WindowCount int
+	PaneCount   int
+	Content     string // JSON-encoded session snapshot
+}
+
+// TmuxSnapshot is a stored tmux session snapshot.
+type TmuxSnapshot struct {
+	ID          string
+	WorkshopID  string
+	SessionName string
+	WindowCount int
+	PaneCount   int
+	Content     string // JSON-encoded session snapshot
+	CreatedAt   string
+}
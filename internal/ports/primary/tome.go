@@ -14,7 +14,8 @@ type TomeService interface {
 	ListTomes(ctx context.Context, filters TomeFilters) ([]*Tome, error)
 
 	// CloseTome marks a tome as closed.
-	CloseTome(ctx context.Context, tomeID string) error
+	// Returns what closing it did to the tome's commission.
+	CloseTome(ctx context.Context, tomeID string) (*CommissionLifecycleResult, error)
 
 	// UpdateTome updates a tome's title and/or description.
 	UpdateTome(ctx context.Context, req UpdateTomeRequest) error
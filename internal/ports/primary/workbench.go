@@ -29,9 +29,21 @@ type WorkbenchService interface {
 	// CheckoutBranch switches to a target branch using stash dance (stash, checkout, pop).
 	CheckoutBranch(ctx context.Context, req CheckoutBranchRequest) (*CheckoutBranchResponse, error)
 
+	// StartBranch creates a branch from the repo's default branch (unless it already exists)
+	// and checks it out in the workbench using stash dance.
+	StartBranch(ctx context.Context, req StartBranchRequest) (*CheckoutBranchResponse, error)
+
+	// ReturnToHomeBranch checks out the workbench's home branch and optionally deletes
+	// a branch that has been merged into the default branch.
+	ReturnToHomeBranch(ctx context.Context, req ReturnToHomeBranchRequest) (*ReturnToHomeBranchResponse, error)
+
 	// GetWorkbenchStatus returns the current git status of a workbench.
 	GetWorkbenchStatus(ctx context.Context, workbenchID string) (*WorkbenchGitStatus, error)
 
+	// SyncWorkbenches fetches and rebases (or merges) each active workbench's branch
+	// onto its repo's default branch, reporting a result per workbench.
+	SyncWorkbenches(ctx context.Context, req SyncWorkbenchesRequest) ([]*WorkbenchSyncResult, error)
+
 	// UpdateFocusedID sets or clears the focused container ID for a workbench.
 	// Pass empty string to clear focus.
 	UpdateFocusedID(ctx context.Context, workbenchID, focusedID string) error
@@ -43,6 +55,20 @@ type WorkbenchService interface {
 	// Used for focus exclusivity checks (IMP cannot focus on container already focused by another IMP).
 	GetWorkbenchesByFocusedID(ctx context.Context, focusedID string) ([]*Workbench, error)
 
+	// BootstrapWorkbench re-runs the repo's bootstrap steps in a workbench.
+	// Steps also run automatically when a workbench's worktree is first created.
+	BootstrapWorkbench(ctx context.Context, workbenchID string) (*BootstrapResult, error)
+
+	// RelocateWorkbenches moves workbench directories to where a new workspace layout
+	// puts them, using git worktree move for worktrees. Workbenches already in place are
+	// left alone, so a partially failed relocation can be re-run.
+	RelocateWorkbenches(ctx context.Context, req RelocateWorkbenchesRequest) ([]*WorkbenchRelocation, error)
+
+	// UpdateSparsePaths adds and removes directories from a workbench's cone-mode sparse
+	// checkout and applies the result to its worktree. Removing the last path restores a
+	// full checkout. Returns the resulting paths.
+	UpdateSparsePaths(ctx context.Context, req UpdateSparsePathsRequest) ([]string, error)
+
 	// ArchiveWorkbench soft-deletes a workbench by setting status to 'archived'.
 	// The record remains in DB so infra plan can detect it as a DELETE target.
 	ArchiveWorkbench(ctx context.Context, workbenchID string) error
@@ -50,17 +76,45 @@ type WorkbenchService interface {
 
 // CreateWorkbenchRequest contains parameters for creating a workbench.
 type CreateWorkbenchRequest struct {
-	Name       string   // Optional - auto-generated as {repo}-{number} if empty and RepoID is set
-	WorkshopID string   // Required
-	RepoID     string   // Optional - link to repo (required for auto-generated name)
-	Repos      []string // Optional repository names for worktree creation
+	Name        string   // Optional - auto-generated as {repo}-{number} if empty and RepoID is set
+	WorkshopID  string   // Required
+	RepoID      string   // Optional - link to repo (required for auto-generated name)
+	Repos       []string // Optional repository names for worktree creation
+	SparsePaths []string // Optional - cone-mode sparse checkout directories; defaults to the repo's sparse paths
+}
+
+// UpdateSparsePathsRequest contains parameters for adjusting a workbench's sparse checkout.
+type UpdateSparsePathsRequest struct {
+	WorkbenchID string
+	Add         []string // Repository-relative directories to check out
+	Remove      []string // Directories to drop; each must currently be checked out
 }
 
 // CreateWorkbenchResponse contains the result of workbench creation.
 type CreateWorkbenchResponse struct {
 	WorkbenchID string
 	Workbench   *Workbench
-	Path        string // Materialized workbench path
+	Path        string           // Materialized workbench path
+	Bootstrap   *BootstrapResult // Nil when the repo defines no bootstrap steps
+}
+
+// BootstrapResult reports a run of a repo's bootstrap steps in a workbench.
+// Steps run in order and stop at the first failure.
+type BootstrapResult struct {
+	WorkbenchID string
+	RepoID      string
+	Source      string // "repo record" or ".orc/repo.json"
+	Steps       []*BootstrapStepResult
+	Failed      bool
+	Pending     []string // Steps checked in to .orc/repo.json, not run until adopted onto the repo record
+}
+
+// BootstrapStepResult is the outcome of one bootstrap command.
+type BootstrapStepResult struct {
+	Command  string
+	Success  bool
+	Output   string // Tail of combined stdout/stderr
+	Duration string
 }
 
 // RenameWorkbenchRequest contains parameters for renaming a workbench.
@@ -85,8 +139,9 @@ type Workbench struct {
 	RepoID        string
 	Path          string
 	Status        string
-	HomeBranch    string // Git home branch (e.g., ml/BENCH-name)
-	CurrentBranch string // Currently checked out branch
+	HomeBranch    string   // Git home branch (e.g., ml/BENCH-name)
+	CurrentBranch string   // Currently checked out branch
+	SparsePaths   []string // Cone-mode sparse checkout directories; empty means a full checkout
 	CreatedAt     string
 	UpdatedAt     string
 }
@@ -112,6 +167,27 @@ type CheckoutBranchResponse struct {
 	StashApplied   bool // True if changes were stashed and reapplied
 }
 
+// StartBranchRequest contains parameters for starting a branch in a workbench.
+type StartBranchRequest struct {
+	WorkbenchID string
+	Branch      string
+}
+
+// ReturnToHomeBranchRequest contains parameters for returning a workbench to its home branch.
+type ReturnToHomeBranchRequest struct {
+	WorkbenchID  string
+	DeleteBranch string // Optional - deleted only if merged into the default branch
+}
+
+// ReturnToHomeBranchResponse contains the result of returning to the home branch.
+type ReturnToHomeBranchResponse struct {
+	PreviousBranch string
+	CurrentBranch  string
+	StashApplied   bool
+	BranchDeleted  bool
+	KeptReason     string // Why DeleteBranch was kept, if it was
+}
+
 // WorkbenchGitStatus represents the git status of a workbench.
 type WorkbenchGitStatus struct {
 	WorkbenchID   string
@@ -122,3 +198,74 @@ type WorkbenchGitStatus struct {
 	AheadBy       int  // Commits ahead of remote
 	BehindBy      int  // Commits behind remote
 }
+
+// Sync strategies for SyncWorkbenches.
+const (
+	SyncStrategyRebase = "rebase"
+	SyncStrategyMerge  = "merge"
+)
+
+// Per-workbench outcomes of SyncWorkbenches.
+const (
+	SyncStatusUpToDate     = "up-to-date"
+	SyncStatusRebased      = "rebased"
+	SyncStatusMerged       = "merged"
+	SyncStatusConflicts    = "conflicts"
+	SyncStatusSkippedDirty = "skipped-dirty"
+	SyncStatusSkipped      = "skipped"
+	SyncStatusFailed       = "failed"
+)
+
+// SyncWorkbenchesRequest contains parameters for syncing workbenches.
+type SyncWorkbenchesRequest struct {
+	WorkshopID string // Empty syncs active workbenches in every workshop
+	Strategy   string // SyncStrategyRebase (default) or SyncStrategyMerge
+	Autostash  bool   // Stash uncommitted changes around the sync instead of skipping dirty workbenches
+}
+
+// WorkbenchLayout describes where workbenches are created and how they are named.
+type WorkbenchLayout struct {
+	WorktreeRoot string // Absolute directory holding workbenches
+	Layout       string // "flat" ({root}/{name}) or "by-repo" ({root}/{repo}/{name})
+	NamePattern  string // Pattern for new workbench names, e.g. {repo}-{number}
+}
+
+// RelocateWorkbenchesRequest contains the target layout of a relocation.
+type RelocateWorkbenchesRequest struct {
+	Default   WorkbenchLayout            // Target global layout
+	Factories map[string]WorkbenchLayout // Target effective layout per factory ID, for factories with overrides
+	DryRun    bool                       // Report the moves without making them
+}
+
+// Per-workbench outcomes of RelocateWorkbenches.
+const (
+	RelocateMoved     = "moved"
+	RelocateWouldMove = "would-move" // Dry run
+	RelocateUnchanged = "unchanged"
+	RelocateDone      = "already-moved"
+	RelocateMissing   = "missing"
+	RelocateConflict  = "conflict"
+	RelocateFailed    = "failed"
+)
+
+// WorkbenchRelocation reports what happened to one workbench during a relocation.
+type WorkbenchRelocation struct {
+	WorkbenchID   string
+	WorkbenchName string
+	From          string
+	To            string
+	Status        string // One of the Relocate* values
+	Reason        string // Why the workbench was not moved
+}
+
+// WorkbenchSyncResult reports what happened to one workbench during a sync.
+type WorkbenchSyncResult struct {
+	WorkbenchID   string
+	WorkbenchName string
+	Branch        string
+	BaseRef       string   // Ref synced against (e.g., origin/main)
+	Status        string   // One of the SyncStatus* values
+	Behind        int      // Commits behind BaseRef before the sync
+	Conflicts     []string // Conflicting files when Status is SyncStatusConflicts
+	Reason        string   // Why the workbench was skipped or failed
+}
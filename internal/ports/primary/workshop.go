@@ -103,6 +103,7 @@ type OpenWorkshopResponse struct {
 	SessionName        string
 	SessionAlreadyOpen bool
 	AttachInstructions string
+	Bootstrap          []*BootstrapResult // Setup of the worktrees created while opening
 }
 
 // OpStatus represents the status of a planned operation.
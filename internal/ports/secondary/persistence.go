@@ -32,6 +32,9 @@ type CommissionRepository interface {
 
 	// CountShipments returns the number of shipments for a commission.
 	CountShipments(ctx context.Context, commissionID string) (int, error)
+
+	// GetRollup returns aggregate container and note counts for a commission.
+	GetRollup(ctx context.Context, commissionID string) (*CommissionRollupRecord, error)
 }
 
 // CommissionRecord represents a commission as stored in persistence.
@@ -54,6 +57,14 @@ type CommissionFilters struct {
 	Limit  int
 }
 
+// CommissionRollupRecord contains aggregate metrics for a commission.
+type CommissionRollupRecord struct {
+	ShipmentsByStatus map[string]int // shipment status -> count
+	TomesByStatus     map[string]int // tome status -> count
+	OpenNotesByType   map[string]int // note type -> count of open/in-flight notes
+	LastActivity      string         // Most recent update across the commission and its contents; empty if unknown
+}
+
 // AgentIdentityProvider defines the secondary port for agent identity resolution.
 // This abstracts the detection of current agent context (ORC vs IMP).
 type AgentIdentityProvider interface {
@@ -123,6 +134,24 @@ type ShipmentRepository interface {
 	// the commission_id update to tasks, notes, and PRs.
 	// Returns the counts of cascaded children updated.
 	MoveToCommission(ctx context.Context, shipmentID, targetCommissionID string) (tasksUpdated, notesUpdated, prsUpdated int, err error)
+
+	// RepoExists checks if a repository exists (for validation).
+	RepoExists(ctx context.Context, repoID string) (bool, error)
+
+	// AddRepo links an additional repository to a shipment.
+	AddRepo(ctx context.Context, link *ShipmentRepoRecord) error
+
+	// RemoveRepo unlinks an additional repository from a shipment.
+	RemoveRepo(ctx context.Context, shipmentID, repoID string) error
+
+	// ListRepos retrieves the additional repositories of a shipment, oldest first.
+	ListRepos(ctx context.Context, shipmentID string) ([]*ShipmentRepoRecord, error)
+
+	// AssignRepoWorkbench assigns the workbench working on one additional repository of a shipment.
+	AssignRepoWorkbench(ctx context.Context, shipmentID, repoID, workbenchID string) error
+
+	// ListPRSummaries retrieves the ID, repo, and status of every PR of a shipment (for guards).
+	ListPRSummaries(ctx context.Context, shipmentID string) ([]*ShipmentPRSummaryRecord, error)
 }
 
 // ShipmentRecord represents a shipment as stored in persistence.
@@ -141,6 +170,23 @@ type ShipmentRecord struct {
 	CompletedAt         string // Empty string means null
 }
 
+// ShipmentRepoRecord represents an additional repository of a shipment as stored in persistence.
+// The shipment's own RepoID and Branch describe its primary repository.
+type ShipmentRepoRecord struct {
+	ShipmentID          string
+	RepoID              string
+	Branch              string
+	AssignedWorkbenchID string // Empty string means null
+	CreatedAt           string
+}
+
+// ShipmentPRSummaryRecord is the minimal PR info needed by shipment guards.
+type ShipmentPRSummaryRecord struct {
+	ID     string
+	RepoID string
+	Status string
+}
+
 // ShipmentFilters contains filter options for querying shipments.
 type ShipmentFilters struct {
 	CommissionID string
@@ -185,9 +231,15 @@ type TaskRepository interface {
 	// Claim claims a task for a workbench.
 	Claim(ctx context.Context, id, workbenchID string) error
 
+	// UpdateDependsOn replaces the depends_on JSON array for a task.
+	UpdateDependsOn(ctx context.Context, id, dependsOn string) error
+
 	// AssignWorkbenchByShipment assigns all tasks of a shipment to a workbench.
 	AssignWorkbenchByShipment(ctx context.Context, shipmentID, workbenchID string) error
 
+	// UpdateCommits replaces the git commit range recorded for a task.
+	UpdateCommits(ctx context.Context, id, startCommit, endCommit string) error
+
 	// CommissionExists checks if a commission exists (for validation).
 	CommissionExists(ctx context.Context, commissionID string) (bool, error)
 
@@ -231,6 +283,8 @@ type TaskRecord struct {
 	UpdatedAt           string
 	ClaimedAt           string // Empty string means null
 	CompletedAt         string // Empty string means null
+	StartCommit         string // Workbench HEAD when claimed; empty string means null
+	EndCommit           string // Workbench HEAD when completed; empty string means null
 }
 
 // TaskFilters contains filter options for querying tasks.
@@ -238,6 +292,7 @@ type TaskFilters struct {
 	ShipmentID   string
 	Status       string
 	CommissionID string
+	WorkbenchID  string
 }
 
 // TagRecord represents a tag as stored in persistence.
@@ -509,6 +564,15 @@ type RepoRepository interface {
 
 	// HasActivePRs checks if a repository has active (non-terminal) PRs.
 	HasActivePRs(ctx context.Context, repoID string) (bool, error)
+
+	// UpdateBootstrap replaces a repository's worktree bootstrap steps (JSON; empty clears them).
+	UpdateBootstrap(ctx context.Context, id, bootstrapJSON string) error
+
+	// UpdateSparse replaces the sparse checkout paths used for a repository's new worktrees (JSON; empty clears them).
+	UpdateSparse(ctx context.Context, id, sparseJSON string) error
+
+	// UpdateMerge replaces a repository's local merge config for orc pr merge (JSON; empty clears it).
+	UpdateMerge(ctx context.Context, id, mergeJSON string) error
 }
 
 // RepoRecord represents a repository as stored in persistence.
@@ -518,6 +582,9 @@ type RepoRecord struct {
 	URL           string // Empty string means null
 	LocalPath     string // Empty string means null
 	DefaultBranch string
+	BootstrapJSON string // JSON array of bootstrap commands; empty string means null
+	SparseJSON    string // JSON array of sparse checkout directories for new worktrees; empty string means null
+	MergeJSON     string // JSON local merge config (strategy, verify commands); empty string means null
 	Status        string
 	CreatedAt     string
 	UpdatedAt     string
@@ -536,7 +603,7 @@ type PRRepository interface {
 	// GetByID retrieves a pull request by its ID.
 	GetByID(ctx context.Context, id string) (*PRRecord, error)
 
-	// GetByShipment retrieves a pull request by shipment ID.
+	// GetByShipment retrieves the first pull request of a shipment.
 	GetByShipment(ctx context.Context, shipmentID string) (*PRRecord, error)
 
 	// List retrieves pull requests matching the given filters.
@@ -554,44 +621,190 @@ type PRRepository interface {
 	// UpdateStatus updates the status of a PR with optional timestamps.
 	UpdateStatus(ctx context.Context, id, status string, setMerged, setClosed bool) error
 
+	// UpdateSync stores the provider-owned fields of a PR (number, URL, status, review
+	// decision, mergeability, merged/closed timestamps) and stamps synced_at.
+	UpdateSync(ctx context.Context, pr *PRRecord) error
+
+	// UpdateStack sets the PR a PR is stacked on (empty means none) and its target branch.
+	UpdateStack(ctx context.Context, id, parentPRID, targetBranch string) error
+
 	// ShipmentExists checks if a shipment exists (for validation).
 	ShipmentExists(ctx context.Context, shipmentID string) (bool, error)
 
 	// RepoExists checks if a repository exists (for validation).
 	RepoExists(ctx context.Context, repoID string) (bool, error)
 
-	// ShipmentHasPR checks if a shipment already has a PR.
-	ShipmentHasPR(ctx context.Context, shipmentID string) (bool, error)
+	// ShipmentHasPR checks if a shipment already has a PR for a repository.
+	ShipmentHasPR(ctx context.Context, shipmentID, repoID string) (bool, error)
 
 	// GetShipmentStatus retrieves the status of a shipment.
 	GetShipmentStatus(ctx context.Context, shipmentID string) (string, error)
+
+	// CreateReviewThread links a provider review thread to the note it was imported as.
+	CreateReviewThread(ctx context.Context, thread *PRReviewThreadRecord) error
+
+	// ListReviewThreads retrieves the imported review threads of a PR, oldest first.
+	ListReviewThreads(ctx context.Context, prID string) ([]*PRReviewThreadRecord, error)
+
+	// UpdateReviewThreadResolved records the resolution state both sides agreed on.
+	UpdateReviewThreadResolved(ctx context.Context, prID, threadID string, resolved bool) error
+
+	// ListChecks retrieves the CI checks last synced for a PR, ordered by name.
+	ListChecks(ctx context.Context, prID string) ([]*PRCheckRecord, error)
+
+	// ReplaceChecks replaces the CI checks of a PR with those from a sync.
+	ReplaceChecks(ctx context.Context, prID string, checks []*PRCheckRecord) error
+}
+
+// PRCheckRecord represents a CI check on a PR as of the last sync.
+type PRCheckRecord struct {
+	PRID       string
+	Name       string
+	Status     string // queued, in_progress, completed
+	Conclusion string // Empty until completed
+	URL        string
+	Required   bool
+	UpdatedAt  string // Provider time of the check's last update
+}
+
+// PRReviewThreadRecord links a provider review thread to a ledger note.
+type PRReviewThreadRecord struct {
+	PRID      string
+	ThreadID  string
+	NoteID    string
+	TaskID    string // Empty string means null (no follow-up task)
+	URL       string // Empty string means null
+	Path      string // Empty string means null (PR-level thread)
+	Line      int    // 0 means null
+	Resolved  bool
+	CreatedAt string
 }
 
 // PRRecord represents a pull request as stored in persistence.
 type PRRecord struct {
-	ID           string
+	ID             string
+	ShipmentID     string
+	RepoID         string
+	CommissionID   string
+	Number         int // 0 means null (for draft PRs without GitHub PR number)
+	Title          string
+	Description    string // Empty string means null
+	Branch         string
+	TargetBranch   string // Empty string means null (defaults to repo default)
+	URL            string // Empty string means null
+	Status         string
+	CreatedAt      string
+	UpdatedAt      string
+	MergedAt       string // Empty string means null
+	ClosedAt       string // Empty string means null
+	ReviewDecision string // "approved", "changes_requested", "review_required"; empty string means null
+	Mergeable      string // "mergeable", "conflicting", "unknown"; empty string means null
+	SyncedAt       string // Empty string means never synced
+	ParentPRID     string // PR this one is stacked on; empty string means null
+}
+
+// PRFilters contains filter options for querying pull requests.
+type PRFilters struct {
 	ShipmentID   string
 	RepoID       string
 	CommissionID string
-	Number       int // 0 means null (for draft PRs without GitHub PR number)
-	Title        string
-	Description  string // Empty string means null
-	Branch       string
-	TargetBranch string // Empty string means null (defaults to repo default)
-	URL          string // Empty string means null
 	Status       string
+}
+
+// CommitRepository defines the secondary port for linked git commit persistence.
+type CommitRepository interface {
+	// Upsert stores a commit, replacing any existing row with the same SHA.
+	Upsert(ctx context.Context, commit *CommitRecord) error
+
+	// List retrieves commits matching the given filters, oldest first.
+	List(ctx context.Context, filters CommitFilters) ([]*CommitRecord, error)
+}
+
+// CommitRecord represents a linked git commit as stored in persistence.
+type CommitRecord struct {
+	SHA          string
+	RepoID       string // Empty string means null
+	TaskID       string // Empty string means null
+	ShipmentID   string // Empty string means null
+	Author       string
+	Subject      string
+	Message      string // Full commit message; empty string means null
+	FilesChanged int
+	Insertions   int
+	Deletions    int
+	CommittedAt  string // Empty string means null
 	CreatedAt    string
-	UpdatedAt    string
-	MergedAt     string // Empty string means null
-	ClosedAt     string // Empty string means null
 }
 
-// PRFilters contains filter options for querying pull requests.
-type PRFilters struct {
+// CommitFilters contains filter options for querying commits.
+type CommitFilters struct {
+	TaskID     string
+	ShipmentID string
+}
+
+// ShipmentDiffStatRepository defines the secondary port for cached shipment diff stats.
+// Stats are kept per repo the shipment spans.
+type ShipmentDiffStatRepository interface {
+	// Save replaces the cached stats and file lists of a shipment, one stats record per repo.
+	Save(ctx context.Context, shipmentID string, stats []*ShipmentDiffStatRecord, files []*ShipmentDiffFileRecord) error
+
+	// List retrieves the cached per-repo stats of a shipment, ordered by repo.
+	// Returns an empty slice if none were computed.
+	List(ctx context.Context, shipmentID string) ([]*ShipmentDiffStatRecord, error)
+
+	// ListFiles retrieves the cached per-file churn of a shipment, ordered by repo and path.
+	ListFiles(ctx context.Context, shipmentID string) ([]*ShipmentDiffFileRecord, error)
+}
+
+// ShipmentDiffStatRecord represents the cached diff stats of a shipment's branch in one repo.
+type ShipmentDiffStatRecord struct {
 	ShipmentID   string
 	RepoID       string
-	CommissionID string
-	Status       string
+	BaseRef      string // Ref the branch was compared against (e.g., origin/main)
+	MergeBase    string
+	HeadCommit   string
+	FilesChanged int
+	Insertions   int
+	Deletions    int
+	ComputedAt   string
+}
+
+// ShipmentDiffFileRecord represents one file of a shipment's cached diff.
+type ShipmentDiffFileRecord struct {
+	ShipmentID string
+	RepoID     string
+	Path       string
+	Insertions int
+	Deletions  int
+}
+
+// TmuxSnapshotRepository defines the secondary port for tmux session snapshots.
+type TmuxSnapshotRepository interface {
+	// Create persists a new snapshot.
+	Create(ctx context.Context, snapshot *TmuxSnapshotRecord) error
+
+	// GetByID retrieves a snapshot by its ID.
+	GetByID(ctx context.Context, id string) (*TmuxSnapshotRecord, error)
+
+	// GetLatest retrieves the newest snapshot of a workshop. Returns nil, nil if there is none.
+	GetLatest(ctx context.Context, workshopID string) (*TmuxSnapshotRecord, error)
+
+	// List retrieves the snapshots of a workshop, newest first.
+	List(ctx context.Context, workshopID string) ([]*TmuxSnapshotRecord, error)
+
+	// GetNextID returns the next available snapshot ID.
+	GetNextID(ctx context.Context) (string, error)
+}
+
+// TmuxSnapshotRecord represents a tmux session snapshot as stored in persistence.
+type TmuxSnapshotRecord struct {
+	ID          string
+	WorkshopID  string
+	SessionName string
+	WindowCount int
+	PaneCount   int
+	ContentJSON string // Windows, panes, layouts, paths, start commands and options
+	CreatedAt   string
 }
 
 // FactoryRepository defines the secondary port for factory persistence.
@@ -741,6 +954,9 @@ type WorkbenchRepository interface {
 
 	// WorkshopExists checks if a workshop exists (for validation).
 	WorkshopExists(ctx context.Context, workshopID string) (bool, error)
+
+	// UpdateSparse replaces a workbench's sparse checkout paths (JSON; empty means a full checkout).
+	UpdateSparse(ctx context.Context, id, sparseJSON string) error
 }
 
 // WorkbenchRecord represents a workbench as stored in persistence.
@@ -754,6 +970,7 @@ type WorkbenchRecord struct {
 	HomeBranch    string // Git home branch for this workbench (e.g., ml/BENCH-name)
 	CurrentBranch string // Currently checked out branch
 	FocusedID     string // Empty string means null - IMP focus (CON-xxx or SHIP-xxx)
+	SparseJSON    string // JSON array of sparse checkout directories; empty string means a full checkout
 	CreatedAt     string
 	UpdatedAt     string
 }
@@ -862,6 +1079,10 @@ type HookEventRepository interface {
 
 	// GetNextID returns the next available hook event ID.
 	GetNextID(ctx context.Context) (string, error)
+
+	// CompactPayloadsOlderThan drops payload_json from hook events older than the given number of days.
+	// The summary columns (decision, reason, shipment status) are kept.
+	CompactPayloadsOlderThan(ctx context.Context, days int) (int, error)
 }
 
 // HookEventRecord represents a hook event as stored in persistence.
@@ -889,3 +1110,41 @@ type HookEventFilters struct {
 	HookType    string
 	Limit       int
 }
+
+// ArchiveRepository defines the secondary port for the archive ledger.
+// Archived rows are moved out of the main database into a separate database with the same schema.
+type ArchiveRepository interface {
+	// ListArchiveCandidates returns closed shipments completed more than the given number of days ago.
+	ListArchiveCandidates(ctx context.Context, olderThanDays int) ([]*ArchiveCandidateRecord, error)
+
+	// ArchiveShipment copies a shipment with its tasks, plans, notes, PR, and linked commits into the archive,
+	// then deletes them from the main database.
+	ArchiveShipment(ctx context.Context, shipmentID string) (*ArchivedCountsRecord, error)
+
+	// ListShipments retrieves archived shipments matching the given filters.
+	ListShipments(ctx context.Context, filters ShipmentFilters) ([]*ShipmentRecord, error)
+
+	// GetShipment retrieves an archived shipment by its ID.
+	GetShipment(ctx context.Context, id string) (*ShipmentRecord, error)
+
+	// GetShipmentTasks retrieves the archived tasks of a shipment.
+	GetShipmentTasks(ctx context.Context, shipmentID string) ([]*TaskRecord, error)
+}
+
+// ArchiveCandidateRecord is a closed shipment eligible for archival by age.
+type ArchiveCandidateRecord struct {
+	ShipmentID    string
+	Status        string
+	Pinned        bool
+	HasOpenPR     bool
+	HoldsNewestID bool // Shipment, or one of its tasks, notes, or plans, has the highest ID in its table
+	CompletedAt   string
+}
+
+// ArchivedCountsRecord reports how many rows were moved for one archived shipment.
+type ArchivedCountsRecord struct {
+	Tasks int
+	Plans int
+	Notes int
+	PRs   int
+}
@@ -0,0 +1,112 @@
+// Package secondary defines the secondary ports (driven adapters) for the application.
+package secondary
+
+import "context"
+
+// Provider-reported pull request states.
+const (
+	ProviderPRStateOpen   = "open"
+	ProviderPRStateClosed = "closed" // Closed without merging
+	ProviderPRStateMerged = "merged"
+)
+
+// PRProviders resolves the code hosting provider (GitHub, GitLab, Gitea) of a repository.
+type PRProviders interface {
+	// ForRemote returns the provider for the repository at remoteURL
+	// (https, ssh:// or scp-like git@host:owner/repo.git).
+	ForRemote(remoteURL string) (PRProvider, error)
+}
+
+// PRProvider reads the pull requests of one repository from its hosting provider.
+type PRProvider interface {
+	// Name returns the provider type (e.g., "github").
+	Name() string
+
+	// GetPullRequest fetches a pull request by number.
+	GetPullRequest(ctx context.Context, number int) (*ProviderPR, error)
+
+	// FindPullRequest returns the most recent pull request whose head is branch,
+	// or nil, nil when the branch has none.
+	FindPullRequest(ctx context.Context, branch string) (*ProviderPR, error)
+
+	// CreatePullRequest opens a pull request with its labels and reviewers. If they
+	// cannot be applied once the PR is open, the opened PR is returned with the error.
+	CreatePullRequest(ctx context.Context, req ProviderPRCreate) (*ProviderPR, error)
+
+	// UpdatePullRequestBase changes the branch a pull request targets.
+	UpdatePullRequestBase(ctx context.Context, number int, base string) error
+
+	// ListReviewThreads returns the review threads of a pull request.
+	ListReviewThreads(ctx context.Context, number int) ([]*ProviderReviewThread, error)
+
+	// SetReviewThreadResolved resolves or reopens a review thread. Providers whose
+	// API cannot do this return an error wrapping errors.ErrUnsupported.
+	SetReviewThreadResolved(ctx context.Context, number int, threadID string, resolved bool) error
+
+	// ListChecks returns the CI checks reported on a pull request's head commit
+	// (headSHA), the latest run of each.
+	ListChecks(ctx context.Context, number int, headSHA string) ([]*ProviderCheck, error)
+}
+
+// ProviderPRCreate describes a pull request to open.
+type ProviderPRCreate struct {
+	Title      string
+	Body       string
+	HeadBranch string
+	BaseBranch string
+	Draft      bool
+	Labels     []string
+	Reviewers  []string // Usernames
+}
+
+// ProviderPR is a pull request (or GitLab merge request) as reported by its provider.
+type ProviderPR struct {
+	Number         int
+	URL            string
+	Title          string
+	State          string // ProviderPRStateOpen, ProviderPRStateClosed or ProviderPRStateMerged
+	Draft          bool
+	ReviewDecision string // "approved", "changes_requested", "review_required"; empty when no review is pending or given
+	Mergeable      string // "mergeable", "conflicting", "unknown"; empty once closed or merged
+	HeadBranch     string
+	BaseBranch     string
+	HeadSHA        string
+	MergedAt       string // RFC3339; empty string means not merged
+	ClosedAt       string // RFC3339; empty string means not closed
+}
+
+// Provider-reported check statuses.
+const (
+	ProviderCheckQueued     = "queued"
+	ProviderCheckInProgress = "in_progress"
+	ProviderCheckCompleted  = "completed"
+)
+
+// ProviderCheck is one CI check (GitHub check run or commit status, GitLab job,
+// Gitea commit status) on a pull request.
+type ProviderCheck struct {
+	Name       string
+	Status     string // ProviderCheckQueued, ProviderCheckInProgress or ProviderCheckCompleted
+	Conclusion string // Once completed: success, failure, neutral, cancelled, skipped, timed_out, action_required
+	URL        string
+	Required   bool   // Must pass before merging (GitHub branch protection; GitLab jobs not allowed to fail)
+	UpdatedAt  string // RFC3339
+}
+
+// ProviderReviewThread is a review conversation on a pull request.
+type ProviderReviewThread struct {
+	ID       string // GitHub node ID, GitLab discussion ID or Gitea root comment ID
+	Path     string // File the thread is on; empty for PR-level threads
+	Line     int    // Line in Path; 0 when not on a line
+	Resolved bool
+	Outdated bool                    // The code under the thread changed since it was started
+	Comments []ProviderReviewComment // Oldest first; the first one started the thread
+}
+
+// ProviderReviewComment is one comment of a review thread.
+type ProviderReviewComment struct {
+	Author    string
+	Body      string
+	URL       string
+	CreatedAt string // RFC3339
+}
@@ -0,0 +1,13 @@
+package secondary
+
+import "context"
+
+// TemplateLoader defines the secondary port for the templates reports are rendered with.
+type TemplateLoader interface {
+	// LoadTemplate returns a named template (e.g. "shipment-report.md.tmpl",
+	// "pr-body.md.tmpl"): the user's override when one exists, else the built-in default.
+	LoadTemplate(ctx context.Context, name string) (string, error)
+
+	// ReadTemplateFile reads a template from an explicit path.
+	ReadTemplateFile(ctx context.Context, path string) (string, error)
+}
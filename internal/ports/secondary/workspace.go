@@ -7,16 +7,31 @@ import "context"
 type WorkspaceAdapter interface {
 	// Worktree operations
 	CreateWorktree(ctx context.Context, repoPath, branchName, targetPath string) error
+	CreateSparseWorktree(ctx context.Context, repoPath, branchName, targetPath string, paths []string) error // Cone-mode sparse checkout of paths only
+	SetSparseCheckout(ctx context.Context, worktreePath string, paths []string) error                        // No paths restores a full checkout
 	RemoveWorktree(ctx context.Context, path string) error
 	WorktreeExists(ctx context.Context, path string) (bool, error)
+	ListWorktrees(ctx context.Context, repoPath string) ([]WorktreeInfo, error) // Linked worktrees only (main worktree excluded)
+	PruneWorktrees(ctx context.Context, repoPath string) error
+	MoveWorktree(ctx context.Context, repoPath, from, to string) error // git worktree move, copying across filesystems
 
 	// Directory operations
 	CreateDirectory(ctx context.Context, path string) error
 	RemoveDirectory(ctx context.Context, path string) error
+	RemoveEmptyDirectory(ctx context.Context, path string) error // Leaves a missing or non-empty directory in place
 	DirectoryExists(ctx context.Context, path string) (bool, error)
+	MoveDirectory(ctx context.Context, from, to string) error
+	ListWorkbenchDirs(ctx context.Context, root string, depth int) ([]string, error) // Absolute paths of directories depth levels below root
 
 	// Path resolution
 	GetWorktreesBasePath() string
 	GetRepoPath(repoName string) string
 	ResolveWorkbenchPath(workbenchName string) string
 }
+
+// WorktreeInfo describes a linked git worktree as reported by `git worktree list`.
+type WorktreeInfo struct {
+	Path     string
+	Branch   string // Empty when HEAD is detached
+	Prunable bool   // Administrative entry points at a missing directory
+}
@@ -42,3 +42,24 @@ func GetWelcomeIMP() (string, error) {
 	}
 	return string(content), nil
 }
+
+//go:embed report/*.tmpl
+var reportTemplates embed.FS
+
+// GetShipmentReport returns the default shipment report template for a format ("md" or "html")
+func GetShipmentReport(ext string) (string, error) {
+	content, err := reportTemplates.ReadFile("report/shipment." + ext + ".tmpl")
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// GetPRBody returns the default pull request body template
+func GetPRBody() (string, error) {
+	content, err := reportTemplates.ReadFile("report/pr-body.md.tmpl")
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
@@ -2,9 +2,13 @@ package tmux
 
 import (
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/GianlucaP106/gotmux/gotmux"
+
+	"github.com/example/orc/internal/config"
 )
 
 // GotmuxAdapter wraps gotmux library for session lifecycle management.
@@ -33,21 +37,20 @@ func NewGotmuxAdapter(socket string) (*GotmuxAdapter, error) {
 	return &GotmuxAdapter{server: server}, nil
 }
 
-// CreateWorkbenchSession creates a tmux session with a single-pane workbench window.
-// The single pane runs "orc connect" (the goblin process).
-func (g *GotmuxAdapter) CreateWorkbenchSession(sessionName, workbenchName, workbenchPath, workbenchID, workshopID string) error {
+// CreateWorkbenchSession creates a tmux session whose first window is laid out for a workbench.
+func (g *GotmuxAdapter) CreateWorkbenchSession(sessionName string, wb DesiredWorkbench) error {
 	if g.tmux != nil {
-		return g.createWorkbenchSessionGotmux(sessionName, workbenchName, workbenchPath, workbenchID, workshopID)
+		return g.createWorkbenchSessionGotmux(sessionName, wb)
 	}
-	return g.createWorkbenchSessionCmd(sessionName, workbenchName, workbenchPath, workbenchID, workshopID)
+	return g.createWorkbenchSessionCmd(sessionName, wb)
 }
 
 // createWorkbenchSessionGotmux uses gotmux library (default server only).
-func (g *GotmuxAdapter) createWorkbenchSessionGotmux(sessionName, workbenchName, workbenchPath, workbenchID, workshopID string) error {
+func (g *GotmuxAdapter) createWorkbenchSessionGotmux(sessionName string, wb DesiredWorkbench) error {
 	// Create session with plain shell (setupWorkbenchPanes handles pane setup)
 	session, err := g.tmux.NewSession(&gotmux.SessionOptions{
 		Name:           sessionName,
-		StartDirectory: workbenchPath,
+		StartDirectory: wb.Path,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create session: %w", err)
@@ -63,62 +66,59 @@ func (g *GotmuxAdapter) createWorkbenchSessionGotmux(sessionName, workbenchName,
 	}
 	firstWindow := windows[0]
 
-	// Set up the single-pane goblin window
-	return g.setupWorkbenchPanesGotmux(firstWindow, workbenchName, workbenchPath, workbenchID, workshopID)
+	return g.setupWorkbenchPanesGotmux(firstWindow, wb)
 }
 
 // createWorkbenchSessionCmd uses Server.cmd() (works with any socket).
-func (g *GotmuxAdapter) createWorkbenchSessionCmd(sessionName, workbenchName, workbenchPath, workbenchID, workshopID string) error {
+func (g *GotmuxAdapter) createWorkbenchSessionCmd(sessionName string, wb DesiredWorkbench) error {
 	// Create session
-	if err := g.server.cmd("new-session", "-d", "-s", sessionName, "-c", workbenchPath).Run(); err != nil {
+	if err := g.server.cmd("new-session", "-d", "-s", sessionName, "-c", wb.Path).Run(); err != nil {
 		return fmt.Errorf("failed to create session: %w", err)
 	}
 
-	// Set up the single-pane goblin window
-	return g.setupWorkbenchPanesCmd(sessionName, workbenchName, workbenchID, workshopID)
+	return g.setupWorkbenchPanesCmd(sessionName, wb)
 }
 
-// AddWorkbenchWindow creates a new single-pane window on an existing session.
-// The pane runs "orc connect" (the goblin process).
-func (g *GotmuxAdapter) AddWorkbenchWindow(session interface{}, workbenchName, workbenchPath, workbenchID, workshopID string) error {
+// AddWorkbenchWindow creates a new window on an existing session, laid out for a workbench.
+func (g *GotmuxAdapter) AddWorkbenchWindow(session interface{}, wb DesiredWorkbench) error {
 	if g.tmux != nil {
 		if gotmuxSession, ok := session.(*gotmux.Session); ok {
-			return g.addWorkbenchWindowGotmux(gotmuxSession, workbenchName, workbenchPath, workbenchID, workshopID)
+			return g.addWorkbenchWindowGotmux(gotmuxSession, wb)
 		}
 	}
 	// For custom socket or string session name, use cmd-based approach
 	sessionName, _ := session.(string)
-	return g.addWorkbenchWindowCmd(sessionName, workbenchName, workbenchPath, workbenchID, workshopID)
+	return g.addWorkbenchWindowCmd(sessionName, wb)
 }
 
 // addWorkbenchWindowGotmux uses gotmux library (default server only).
-func (g *GotmuxAdapter) addWorkbenchWindowGotmux(session *gotmux.Session, workbenchName, workbenchPath, workbenchID, workshopID string) error {
+func (g *GotmuxAdapter) addWorkbenchWindowGotmux(session *gotmux.Session, wb DesiredWorkbench) error {
 	// Create new window
 	window, err := session.NewWindow(&gotmux.NewWindowOptions{
-		WindowName:     workbenchName,
-		StartDirectory: workbenchPath,
+		WindowName:     wb.Name,
+		StartDirectory: wb.Path,
 		DoNotAttach:    true,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create window %s: %w", workbenchName, err)
+		return fmt.Errorf("failed to create window %s: %w", wb.Name, err)
 	}
 
-	return g.setupWorkbenchPanesGotmux(window, workbenchName, workbenchPath, workbenchID, workshopID)
+	return g.setupWorkbenchPanesGotmux(window, wb)
 }
 
 // addWorkbenchWindowCmd uses Server.cmd() (works with any socket).
-func (g *GotmuxAdapter) addWorkbenchWindowCmd(sessionName, workbenchName, workbenchPath, workbenchID, workshopID string) error {
-	if err := g.server.cmd("new-window", "-t", sessionName, "-n", workbenchName, "-c", workbenchPath, "-d").Run(); err != nil {
-		return fmt.Errorf("failed to create window %s: %w", workbenchName, err)
+func (g *GotmuxAdapter) addWorkbenchWindowCmd(sessionName string, wb DesiredWorkbench) error {
+	if err := g.server.cmd("new-window", "-t", sessionName, "-n", wb.Name, "-c", wb.Path, "-d").Run(); err != nil {
+		return fmt.Errorf("failed to create window %s: %w", wb.Name, err)
 	}
 
-	return g.setupWorkbenchPanesCmd(sessionName+":"+workbenchName, workbenchName, workbenchID, workshopID)
+	return g.setupWorkbenchPanesCmd(sessionName+":"+wb.Name, wb)
 }
 
-// setupWorkbenchPanesGotmux configures a window as a single-pane goblin workspace using gotmux.
-func (g *GotmuxAdapter) setupWorkbenchPanesGotmux(window *gotmux.Window, workbenchName, _ string, workbenchID, workshopID string) error {
+// setupWorkbenchPanesGotmux lays out a new window for a workbench using gotmux.
+func (g *GotmuxAdapter) setupWorkbenchPanesGotmux(window *gotmux.Window, wb DesiredWorkbench) error {
 	// Rename window to workbench name
-	if err := window.Rename(workbenchName); err != nil {
+	if err := window.Rename(wb.Name); err != nil {
 		return fmt.Errorf("failed to rename window: %w", err)
 	}
 
@@ -127,32 +127,15 @@ func (g *GotmuxAdapter) setupWorkbenchPanesGotmux(window *gotmux.Window, workben
 	if err != nil || len(panes) == 0 {
 		return fmt.Errorf("failed to get initial pane: %w", err)
 	}
-	goblinPane := panes[0]
-
-	// Make "orc connect" the root process via respawn-pane -k
-	if err := g.server.cmd("respawn-pane", "-t", goblinPane.Id, "-k", "orc", "connect").Run(); err != nil {
-		return fmt.Errorf("failed to respawn goblin pane: %w", err)
-	}
-
-	// Set tmux pane options for identity
-	if err := goblinPane.SetOption("@pane_role", "goblin"); err != nil {
-		return fmt.Errorf("failed to set @pane_role=goblin: %w", err)
-	}
-	if err := goblinPane.SetOption("@bench_id", workbenchID); err != nil {
-		return fmt.Errorf("failed to set @bench_id on goblin pane: %w", err)
-	}
-	if err := goblinPane.SetOption("@workshop_id", workshopID); err != nil {
-		return fmt.Errorf("failed to set @workshop_id on goblin pane: %w", err)
-	}
 
-	return nil
+	return g.buildLayout(panes[0].Id, wb)
 }
 
-// setupWorkbenchPanesCmd configures a window as a single-pane goblin workspace using Server.cmd().
+// setupWorkbenchPanesCmd lays out a new window for a workbench using Server.cmd().
 // target is in format "session:window" (e.g., "WORK-005:orc-45")
-func (g *GotmuxAdapter) setupWorkbenchPanesCmd(target, workbenchName, workbenchID, workshopID string) error {
+func (g *GotmuxAdapter) setupWorkbenchPanesCmd(target string, wb DesiredWorkbench) error {
 	// Rename window
-	if err := g.server.cmd("rename-window", "-t", target, workbenchName).Run(); err != nil {
+	if err := g.server.cmd("rename-window", "-t", target, wb.Name).Run(); err != nil {
 		return fmt.Errorf("failed to rename window: %w", err)
 	}
 
@@ -166,19 +149,101 @@ func (g *GotmuxAdapter) setupWorkbenchPanesCmd(target, workbenchName, workbenchI
 		return fmt.Errorf("no panes found in window")
 	}
 
-	// Make "orc connect" the root process
-	if err := g.server.cmd("respawn-pane", "-t", paneID, "-k", "orc", "connect").Run(); err != nil {
-		return fmt.Errorf("failed to respawn goblin pane: %w", err)
+	return g.buildLayout(paneID, wb)
+}
+
+// buildLayout turns the first pane of a new window into the workbench's layout:
+// the first layout pane is respawned in place, and each later pane splits the
+// pane it targets.
+func (g *GotmuxAdapter) buildLayout(firstPaneID string, wb DesiredWorkbench) error {
+	layout := wb.layout()
+	paneIDs := make(map[string]string, len(layout.Panes))
+	for i, pane := range layout.Panes {
+		var paneID string
+		if i == 0 {
+			// Make the pane's command its root process via respawn-pane -k
+			paneID = firstPaneID
+			if err := g.respawnPane(paneID, pane, wb); err != nil {
+				return err
+			}
+		} else {
+			var err error
+			if paneID, err = g.splitPane(paneIDs[layout.SplitTarget(i)], pane, wb); err != nil {
+				return err
+			}
+		}
+		paneIDs[pane.Role] = paneID
+		if err := g.tagPane(paneID, pane.Role, wb); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	// Set tmux pane options
-	g.server.cmd("set-option", "-t", paneID, "-p", "@pane_role", "goblin").Run()
-	g.server.cmd("set-option", "-t", paneID, "-p", "@bench_id", workbenchID).Run()
-	g.server.cmd("set-option", "-t", paneID, "-p", "@workshop_id", workshopID).Run()
+// respawnPane restarts a pane with a layout pane's command, directory and environment.
+func (g *GotmuxAdapter) respawnPane(paneID string, pane config.TmuxPane, wb DesiredWorkbench) error {
+	args := []string{"respawn-pane", "-t", paneID, "-k", "-c", filepath.Join(wb.Path, pane.Dir)}
+	args = append(args, paneEnvArgs(pane)...)
+	if command := pane.PaneCommand(); command != "" {
+		args = append(args, command)
+	}
+	if err := g.server.cmd(args...).Run(); err != nil {
+		return fmt.Errorf("failed to respawn %s pane: %w", pane.Role, err)
+	}
+	return nil
+}
 
+// splitPane creates a layout pane by splitting targetID, returning the new pane's ID.
+func (g *GotmuxAdapter) splitPane(targetID string, pane config.TmuxPane, wb DesiredWorkbench) (string, error) {
+	args := []string{"split-window", "-d", "-t", targetID, "-c", filepath.Join(wb.Path, pane.Dir), "-P", "-F", "#{pane_id}"}
+	switch pane.Split {
+	case config.SplitLeft:
+		args = append(args, "-h", "-b")
+	case config.SplitBelow:
+		args = append(args, "-v")
+	case config.SplitAbove:
+		args = append(args, "-v", "-b")
+	default:
+		args = append(args, "-h")
+	}
+	if pane.Size != "" {
+		args = append(args, "-l", pane.Size)
+	}
+	args = append(args, paneEnvArgs(pane)...)
+	if command := pane.PaneCommand(); command != "" {
+		args = append(args, command)
+	}
+	output, err := g.server.cmd(args...).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to split %s pane: %w", pane.Role, err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// tagPane sets the tmux pane options identifying a workbench pane.
+func (g *GotmuxAdapter) tagPane(paneID, role string, wb DesiredWorkbench) error {
+	for _, opt := range [][2]string{{"@pane_role", role}, {"@bench_id", wb.ID}, {"@workshop_id", wb.WorkshopID}} {
+		if err := g.server.cmd("set-option", "-t", paneID, "-p", opt[0], opt[1]).Run(); err != nil {
+			return fmt.Errorf("failed to set %s=%s on %s pane: %w", opt[0], opt[1], role, err)
+		}
+	}
 	return nil
 }
 
+// paneEnvArgs returns the -e flags for a pane's environment, in key order.
+func paneEnvArgs(pane config.TmuxPane) []string {
+	keys := make([]string, 0, len(pane.Env))
+	for k := range pane.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var args []string
+	for _, k := range keys {
+		args = append(args, "-e", k+"="+pane.Env[k])
+	}
+	return args
+}
+
 // GetSession returns a gotmux Session by name, or nil if not found.
 // For custom sockets, returns nil (callers should use string-based session names).
 func (g *GotmuxAdapter) GetSession(name string) (*gotmux.Session, error) {
@@ -239,6 +304,7 @@ type ApplyActionType string
 const (
 	ActionCreateSession   ApplyActionType = "CreateSession"
 	ActionAddWindow       ApplyActionType = "AddWindow"
+	ActionAddPane         ApplyActionType = "AddPane"
 	ActionApplyEnrichment ApplyActionType = "ApplyEnrichment"
 )
 
@@ -253,14 +319,37 @@ type ApplyAction struct {
 	WorkbenchPath string
 	WorkbenchID   string
 	WorkshopID    string
+	Layout        *config.TmuxLayout
+	PaneIndex     int // Layout pane added by ActionAddPane
+}
+
+// workbench returns the workbench an action lays out.
+func (a ApplyAction) workbench() DesiredWorkbench {
+	return DesiredWorkbench{
+		Name:       a.WorkbenchName,
+		Path:       a.WorkbenchPath,
+		ID:         a.WorkbenchID,
+		WorkshopID: a.WorkshopID,
+		Layout:     a.Layout,
+	}
 }
 
 // DesiredWorkbench describes a workbench that should exist as a window.
 type DesiredWorkbench struct {
-	Name       string
-	Path       string
-	ID         string
-	WorkshopID string
+	Name         string
+	Path         string
+	ID           string
+	WorkshopID   string
+	Layout       *config.TmuxLayout // nil for config.DefaultTmuxLayout
+	LayoutSource string             // Where the layout came from, for the plan
+}
+
+// layout returns the workbench's window layout.
+func (wb DesiredWorkbench) layout() *config.TmuxLayout {
+	if wb.Layout == nil {
+		return config.DefaultTmuxLayout()
+	}
+	return wb.Layout
 }
 
 // ApplyPlan contains the full reconciliation plan.
@@ -273,9 +362,12 @@ type ApplyPlan struct {
 
 // WindowStatus summarizes a window's current state for display.
 type WindowStatus struct {
-	Name      string
-	PaneCount int
-	Healthy   bool
+	Name         string
+	PaneCount    int
+	Healthy      bool
+	Layout       string   // Layout source; empty for windows that aren't workbenches
+	MissingPanes []string // Layout roles without a pane; added by the plan
+	ExtraPanes   []string // Pane roles the layout doesn't have; left alone
 }
 
 // PlanApply compares desired state (workbenches) to actual tmux state and returns actions.
@@ -300,56 +392,16 @@ func (g *GotmuxAdapter) planApplyGotmux(sessionName string, workbenches []Desire
 		return g.planNoSession(sessionName, workbenches, plan), nil
 	}
 
-	// Session exists — reconcile windows
-	plan.SessionExists = true
-
 	windows, err := session.ListWindows()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list windows: %w", err)
 	}
-
-	// Build set of existing window names
-	existingWindows := make(map[string]*gotmux.Window, len(windows))
+	names := make([]string, 0, len(windows))
 	for _, w := range windows {
-		existingWindows[w.Name] = w
+		names = append(names, w.Name)
 	}
 
-	// Check which workbenches need windows
-	for _, wb := range workbenches {
-		if _, exists := existingWindows[wb.Name]; !exists {
-			plan.Actions = append(plan.Actions, ApplyAction{
-				Type:          ActionAddWindow,
-				Description:   fmt.Sprintf("Add window %s (%s)", wb.Name, wb.ID),
-				SessionName:   sessionName,
-				WorkbenchName: wb.Name,
-				WorkbenchPath: wb.Path,
-				WorkbenchID:   wb.ID,
-				WorkshopID:    wb.WorkshopID,
-			})
-		}
-	}
-
-	// Summarize existing windows
-	for _, w := range windows {
-		panes, err := w.ListPanes()
-		if err != nil {
-			continue
-		}
-		plan.WindowSummary = append(plan.WindowSummary, WindowStatus{
-			Name:      w.Name,
-			PaneCount: len(panes),
-			Healthy:   len(panes) > 0,
-		})
-	}
-
-	// Always enrich at the end
-	plan.Actions = append(plan.Actions, ApplyAction{
-		Type:        ActionApplyEnrichment,
-		Description: "Apply ORC enrichment (bindings, pane titles)",
-		SessionName: sessionName,
-	})
-
-	return plan, nil
+	return g.planSession(sessionName, names, workbenches, plan), nil
 }
 
 // planApplyCmd uses Server.cmd() for planning (works with any socket).
@@ -358,52 +410,59 @@ func (g *GotmuxAdapter) planApplyCmd(sessionName string, workbenches []DesiredWo
 		return g.planNoSession(sessionName, workbenches, plan), nil
 	}
 
-	// Session exists — reconcile windows
-	plan.SessionExists = true
-
 	windows, err := g.server.ListWindows(sessionName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list windows: %w", err)
 	}
 
+	return g.planSession(sessionName, windows, workbenches, plan), nil
+}
+
+// planSession builds a plan for an existing session: windows for missing
+// workbenches, and panes missing from the layouts of existing ones.
+func (g *GotmuxAdapter) planSession(sessionName string, windows []string, workbenches []DesiredWorkbench, plan *ApplyPlan) *ApplyPlan {
+	plan.SessionExists = true
+
 	existingWindows := make(map[string]bool, len(windows))
 	for _, w := range windows {
 		existingWindows[w] = true
 	}
+	byName := make(map[string]DesiredWorkbench, len(workbenches))
 
 	// Check which workbenches need windows
 	for _, wb := range workbenches {
+		byName[wb.Name] = wb
 		if !existingWindows[wb.Name] {
-			plan.Actions = append(plan.Actions, ApplyAction{
-				Type:          ActionAddWindow,
-				Description:   fmt.Sprintf("Add window %s (%s)", wb.Name, wb.ID),
-				SessionName:   sessionName,
-				WorkbenchName: wb.Name,
-				WorkbenchPath: wb.Path,
-				WorkbenchID:   wb.ID,
-				WorkshopID:    wb.WorkshopID,
-			})
+			plan.Actions = append(plan.Actions, addWindowAction(sessionName, wb))
 		}
 	}
 
-	// Summarize existing windows
+	// Summarize existing windows, diffing workbench windows against their layouts
 	for _, w := range windows {
-		paneCount := g.server.GetPaneCount(sessionName, w)
-		plan.WindowSummary = append(plan.WindowSummary, WindowStatus{
+		panes, _ := g.server.ListPanes(sessionName, w)
+		status := WindowStatus{
 			Name:      w,
-			PaneCount: paneCount,
-			Healthy:   paneCount > 0,
-		})
+			PaneCount: len(panes),
+			Healthy:   len(panes) > 0,
+		}
+		if wb, ok := byName[w]; ok {
+			layout := wb.layout()
+			status.Layout = wb.LayoutSource
+			missing, extra := diffLayout(layout, panes)
+			for _, i := range missing {
+				status.MissingPanes = append(status.MissingPanes, layout.Panes[i].Role)
+				plan.Actions = append(plan.Actions, addPaneAction(sessionName, wb, i))
+			}
+			status.ExtraPanes = extra
+			status.Healthy = status.Healthy && len(missing) == 0
+		}
+		plan.WindowSummary = append(plan.WindowSummary, status)
 	}
 
 	// Always enrich at the end
-	plan.Actions = append(plan.Actions, ApplyAction{
-		Type:        ActionApplyEnrichment,
-		Description: "Apply ORC enrichment (bindings, pane titles)",
-		SessionName: sessionName,
-	})
+	plan.Actions = append(plan.Actions, enrichmentAction(sessionName))
 
-	return plan, nil
+	return plan
 }
 
 // planNoSession builds a plan when no session exists.
@@ -418,35 +477,123 @@ func (g *GotmuxAdapter) planNoSession(sessionName string, workbenches []DesiredW
 	first := workbenches[0]
 	plan.Actions = append(plan.Actions, ApplyAction{
 		Type:          ActionCreateSession,
-		Description:   fmt.Sprintf("Create session %s with window %s", sessionName, first.Name),
+		Description:   fmt.Sprintf("Create session %s with window %s%s", sessionName, first.Name, describeLayout(first)),
 		SessionName:   sessionName,
 		WorkbenchName: first.Name,
 		WorkbenchPath: first.Path,
 		WorkbenchID:   first.ID,
 		WorkshopID:    first.WorkshopID,
+		Layout:        first.Layout,
 	})
 
 	// Remaining workbenches get added as windows
 	for _, wb := range workbenches[1:] {
-		plan.Actions = append(plan.Actions, ApplyAction{
-			Type:          ActionAddWindow,
-			Description:   fmt.Sprintf("Add window %s (%s)", wb.Name, wb.ID),
-			SessionName:   sessionName,
-			WorkbenchName: wb.Name,
-			WorkbenchPath: wb.Path,
-			WorkbenchID:   wb.ID,
-			WorkshopID:    wb.WorkshopID,
-		})
+		plan.Actions = append(plan.Actions, addWindowAction(sessionName, wb))
 	}
 
 	// Always enrich at the end
-	plan.Actions = append(plan.Actions, ApplyAction{
+	plan.Actions = append(plan.Actions, enrichmentAction(sessionName))
+
+	return plan
+}
+
+// addWindowAction plans a new window for a workbench.
+func addWindowAction(sessionName string, wb DesiredWorkbench) ApplyAction {
+	return ApplyAction{
+		Type:          ActionAddWindow,
+		Description:   fmt.Sprintf("Add window %s (%s)%s", wb.Name, wb.ID, describeLayout(wb)),
+		SessionName:   sessionName,
+		WorkbenchName: wb.Name,
+		WorkbenchPath: wb.Path,
+		WorkbenchID:   wb.ID,
+		WorkshopID:    wb.WorkshopID,
+		Layout:        wb.Layout,
+	}
+}
+
+// addPaneAction plans adding a layout pane to an existing workbench window.
+func addPaneAction(sessionName string, wb DesiredWorkbench, index int) ApplyAction {
+	layout := wb.layout()
+	pane := layout.Panes[index]
+	desc := fmt.Sprintf("Add pane %s to %s", pane.Role, wb.Name)
+	if target := layout.SplitTarget(index); target != "" {
+		desc += fmt.Sprintf(" (%s %s)", splitPhrases[splitDirection(pane)], target)
+	}
+	if command := pane.PaneCommand(); command != "" {
+		desc += ": " + command
+	}
+	return ApplyAction{
+		Type:          ActionAddPane,
+		Description:   desc,
+		SessionName:   sessionName,
+		WorkbenchName: wb.Name,
+		WorkbenchPath: wb.Path,
+		WorkbenchID:   wb.ID,
+		WorkshopID:    wb.WorkshopID,
+		Layout:        layout,
+		PaneIndex:     index,
+	}
+}
+
+// enrichmentAction plans the ORC enrichment that ends every plan.
+func enrichmentAction(sessionName string) ApplyAction {
+	return ApplyAction{
 		Type:        ActionApplyEnrichment,
 		Description: "Apply ORC enrichment (bindings, pane titles)",
 		SessionName: sessionName,
-	})
+	}
+}
 
-	return plan
+// describeLayout summarizes a multi-pane layout for an action description.
+func describeLayout(wb DesiredWorkbench) string {
+	layout := wb.layout()
+	if len(layout.Panes) < 2 {
+		return ""
+	}
+	return fmt.Sprintf(" with panes %s", strings.Join(layout.Roles(), ", "))
+}
+
+// splitPhrases describe where a split pane goes relative to the pane it splits.
+var splitPhrases = map[string]string{
+	config.SplitRight: "right of",
+	config.SplitLeft:  "left of",
+	config.SplitBelow: "below",
+	config.SplitAbove: "above",
+}
+
+// splitDirection is the direction a pane is split off in; new panes go right by default.
+func splitDirection(pane config.TmuxPane) string {
+	if pane.Split == "" {
+		return config.SplitRight
+	}
+	return pane.Split
+}
+
+// diffLayout compares a window's panes to its layout, returning the indexes of
+// layout panes with no pane of their role, and the roles of panes the layout
+// doesn't have. Panes without a role are hand-made and ignored.
+func diffLayout(layout *config.TmuxLayout, panes []PaneInfo) ([]int, []string) {
+	have := make(map[string]bool, len(panes))
+	for _, p := range panes {
+		if p.HasRole {
+			have[p.RoleValue] = true
+		}
+	}
+	want := make(map[string]bool, len(layout.Panes))
+	var missing []int
+	for i, pane := range layout.Panes {
+		want[pane.Role] = true
+		if !have[pane.Role] {
+			missing = append(missing, i)
+		}
+	}
+	var extra []string
+	for _, p := range panes {
+		if p.HasRole && !want[p.RoleValue] {
+			extra = append(extra, p.RoleValue)
+		}
+	}
+	return missing, extra
 }
 
 // ExecutePlan executes all actions in a plan sequentially.
@@ -463,7 +610,7 @@ func (g *GotmuxAdapter) ExecutePlan(plan *ApplyPlan) error {
 func (g *GotmuxAdapter) executeAction(action ApplyAction) error {
 	switch action.Type {
 	case ActionCreateSession:
-		return g.CreateWorkbenchSession(action.SessionName, action.WorkbenchName, action.WorkbenchPath, action.WorkbenchID, action.WorkshopID)
+		return g.CreateWorkbenchSession(action.SessionName, action.workbench())
 
 	case ActionAddWindow:
 		if g.tmux != nil {
@@ -474,9 +621,12 @@ func (g *GotmuxAdapter) executeAction(action ApplyAction) error {
 			if session == nil {
 				return fmt.Errorf("session %s not found", action.SessionName)
 			}
-			return g.AddWorkbenchWindow(session, action.WorkbenchName, action.WorkbenchPath, action.WorkbenchID, action.WorkshopID)
+			return g.AddWorkbenchWindow(session, action.workbench())
 		}
-		return g.AddWorkbenchWindow(action.SessionName, action.WorkbenchName, action.WorkbenchPath, action.WorkbenchID, action.WorkshopID)
+		return g.AddWorkbenchWindow(action.SessionName, action.workbench())
+
+	case ActionAddPane:
+		return g.addLayoutPane(action)
 
 	case ActionApplyEnrichment:
 		g.server.ApplyGlobalBindings()
@@ -487,6 +637,35 @@ func (g *GotmuxAdapter) executeAction(action ApplyAction) error {
 	}
 }
 
+// addLayoutPane adds a missing layout pane to an existing window by splitting
+// the pane it targets, or the window's first pane when that one is missing too.
+func (g *GotmuxAdapter) addLayoutPane(action ApplyAction) error {
+	wb := action.workbench()
+	panes, err := g.server.ListPanes(action.SessionName, action.WorkbenchName)
+	if err != nil {
+		return err
+	}
+	if len(panes) == 0 {
+		return fmt.Errorf("window %s has no panes", action.WorkbenchName)
+	}
+	targetID := panes[0].ID
+	target := action.Layout.SplitTarget(action.PaneIndex)
+	for _, p := range panes {
+		if p.HasRole && p.RoleValue == target {
+			targetID = p.ID
+			break
+		}
+	}
+
+	pane := action.Layout.Panes[action.PaneIndex]
+	pane.Split = splitDirection(pane)
+	paneID, err := g.splitPane(targetID, pane, wb)
+	if err != nil {
+		return err
+	}
+	return g.tagPane(paneID, pane.Role, wb)
+}
+
 // AttachInstructions returns instructions for attaching to a session
 func (g *GotmuxAdapter) AttachInstructions(sessionName string) string {
 	socketInfo := ""
@@ -495,7 +674,7 @@ func (g *GotmuxAdapter) AttachInstructions(sessionName string) string {
 	}
 	return fmt.Sprintf("Attach to session: tmux%s attach -t %s\n\n"+
 		"Window Layout:\n"+
-		"  Each window has a single goblin pane (orc connect)\n\n"+
+		"  Each window follows its tmux.json layout (default: a single goblin pane running orc connect)\n\n"+
 		"TMux Commands:\n"+
 		"  Switch windows: Ctrl+b then window number (1, 2, 3...)\n"+
 		"  Detach session: Ctrl+b then d\n"+
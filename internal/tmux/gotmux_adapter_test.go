@@ -1,8 +1,13 @@
 package tmux
 
 import (
+	"fmt"
+	"os"
+	"os/exec"
 	"strings"
 	"testing"
+
+	"github.com/example/orc/internal/config"
 )
 
 func TestNewGotmuxAdapter(t *testing.T) {
@@ -270,15 +275,16 @@ func TestDeskServerInfo_Fields(t *testing.T) {
 }
 
 func TestApplyActionTypes(t *testing.T) {
-	// Verify the three action types are the only ones (no guest pane or imps actions)
+	// Verify the four action types are the only ones (no guest pane or imps actions)
 	validTypes := map[ApplyActionType]bool{
 		ActionCreateSession:   true,
 		ActionAddWindow:       true,
+		ActionAddPane:         true,
 		ActionApplyEnrichment: true,
 	}
 
-	if len(validTypes) != 3 {
-		t.Errorf("expected exactly 3 action types, got %d", len(validTypes))
+	if len(validTypes) != 4 {
+		t.Errorf("expected exactly 4 action types, got %d", len(validTypes))
 	}
 
 	// Verify no removed action types exist
@@ -380,3 +386,119 @@ func TestGotmuxAdapter_Server(t *testing.T) {
 		t.Errorf("expected empty socket, got %q", srv.Socket)
 	}
 }
+
+// --- Layout tests ---
+
+func testLayout() *config.TmuxLayout {
+	return &config.TmuxLayout{Panes: []config.TmuxPane{
+		{Role: "goblin", Command: "sleep 600"},
+		{Role: "tests", Split: "right", Size: "40%", Command: "sleep 601", Env: map[string]string{"ORC_TEST_PANE": "tests"}},
+		{Role: "logs", Split: "below", Target: "goblin", Size: "30%"},
+	}}
+}
+
+func TestDiffLayout(t *testing.T) {
+	panes := []PaneInfo{
+		{ID: "%1", HasRole: true, RoleValue: "goblin"},
+		{ID: "%2", HasRole: true, RoleValue: "editor"},
+		{ID: "%3"},
+	}
+
+	missing, extra := diffLayout(testLayout(), panes)
+	if len(missing) != 2 || missing[0] != 1 || missing[1] != 2 {
+		t.Errorf("expected tests and logs to be missing, got %v", missing)
+	}
+	if len(extra) != 1 || extra[0] != "editor" {
+		t.Errorf("expected editor to be extra, got %v", extra)
+	}
+}
+
+func TestPlanApply_LayoutDescriptions(t *testing.T) {
+	adapter, err := NewGotmuxAdapter("")
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+
+	plan, err := adapter.PlanApply("nonexistent-test-session-99999", []DesiredWorkbench{
+		{Name: "bench-1", Path: "/tmp/bench-1", ID: "BENCH-001", WorkshopID: "WORK-001", Layout: testLayout()},
+	})
+	if err != nil {
+		t.Fatalf("PlanApply failed: %v", err)
+	}
+	if !strings.Contains(plan.Actions[0].Description, "with panes goblin, tests, logs") {
+		t.Errorf("expected the layout in the description, got %q", plan.Actions[0].Description)
+	}
+	if plan.Actions[0].Layout == nil {
+		t.Error("expected the action to carry the layout")
+	}
+}
+
+// newTestServerAdapter returns an adapter on a private tmux socket, killed when the test ends.
+func newTestServerAdapter(t *testing.T) *GotmuxAdapter {
+	t.Helper()
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not available")
+	}
+	socket := fmt.Sprintf("orc-test-layout-%d", os.Getpid())
+	adapter, err := NewGotmuxAdapter(socket)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+	t.Cleanup(func() { _ = adapter.server.cmd("kill-server").Run() })
+	return adapter
+}
+
+func TestExecutePlan_Layout(t *testing.T) {
+	adapter := newTestServerAdapter(t)
+	dir := t.TempDir()
+	wb := DesiredWorkbench{Name: "bench-1", Path: dir, ID: "BENCH-001", WorkshopID: "WORK-001", Layout: testLayout(), LayoutSource: "global"}
+
+	plan, err := adapter.PlanApply("layout-test", []DesiredWorkbench{wb})
+	if err != nil {
+		t.Fatalf("PlanApply failed: %v", err)
+	}
+	if err := adapter.ExecutePlan(plan); err != nil {
+		t.Fatalf("ExecutePlan failed: %v", err)
+	}
+
+	panes, err := adapter.server.ListPanes("layout-test", "bench-1")
+	if err != nil {
+		t.Fatalf("ListPanes failed: %v", err)
+	}
+	roles := map[string]string{}
+	for _, p := range panes {
+		roles[p.RoleValue] = p.ID
+	}
+	for _, role := range []string{"goblin", "tests", "logs"} {
+		if roles[role] == "" {
+			t.Fatalf("expected a %s pane, got %+v", role, panes)
+		}
+	}
+	if bench, _ := adapter.server.GetPaneOption(roles["tests"], "#{@bench_id}"); bench != "BENCH-001" {
+		t.Errorf("expected @bench_id on the tests pane, got %q", bench)
+	}
+	if cmd, _ := adapter.server.GetPaneOption(roles["tests"], "#{pane_start_command}"); !strings.Contains(cmd, "sleep 601") {
+		t.Errorf("expected the tests pane to run its command, got %q", cmd)
+	}
+
+	// Lose a pane, then apply again: only that pane is planned
+	if err := adapter.server.cmd("kill-pane", "-t", roles["logs"]).Run(); err != nil {
+		t.Fatal(err)
+	}
+	plan, err = adapter.PlanApply("layout-test", []DesiredWorkbench{wb})
+	if err != nil {
+		t.Fatalf("PlanApply failed: %v", err)
+	}
+	if len(plan.WindowSummary) != 1 || strings.Join(plan.WindowSummary[0].MissingPanes, ",") != "logs" || plan.WindowSummary[0].Healthy {
+		t.Fatalf("expected logs to be missing, got %+v", plan.WindowSummary)
+	}
+	if plan.Actions[0].Type != ActionAddPane || !strings.Contains(plan.Actions[0].Description, "(below goblin)") {
+		t.Errorf("expected an AddPane action for logs, got %+v", plan.Actions[0])
+	}
+	if err := adapter.ExecutePlan(plan); err != nil {
+		t.Fatalf("ExecutePlan failed: %v", err)
+	}
+	if n := adapter.server.GetPaneCount("layout-test", "bench-1"); n != 3 {
+		t.Errorf("expected 3 panes after re-applying, got %d", n)
+	}
+}
@@ -0,0 +1,184 @@
+package tmux
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Options the pane monitor writes, so the state survives monitor restarts and
+// can be shown by the status bar and orc status.
+const (
+	PaneStateOption   = "@orc_pane_state"  // Pane: working, waiting, idle, crashed or shell
+	PaneDigestOption  = "@orc_pane_digest" // Pane: screen digest at the last poll
+	WindowAlertOption = "@orc_alert"       // Window: waiting or crashed when a pane needs a human, else unset
+	HealthOption      = "@orc_health"      // Session: summary, e.g. "1 waiting, 4 working"
+)
+
+// monitorOptions are left out of snapshots: they describe the panes at the last
+// poll, and a restored pane starts fresh.
+var monitorOptions = map[string]bool{
+	PaneStateOption:   true,
+	PaneDigestOption:  true,
+	WindowAlertOption: true,
+	HealthOption:      true,
+}
+
+// PaneInspection is what a pane shows at one poll, with the state recorded at
+// the previous one.
+type PaneInspection struct {
+	PaneID         string
+	Window         string
+	WindowIndex    int
+	Index          int
+	Role           string // @pane_role
+	BenchID        string // @bench_id
+	CurrentCommand string
+	StartCommand   string
+	Dead           bool
+	Content        string
+	State          string // @orc_pane_state
+	Digest         string // @orc_pane_digest
+}
+
+// inspectPaneFormat ends with pane_id: ListAllPanes trims its output, which
+// would drop trailing empty fields of the last pane.
+var inspectPaneFormat = strings.Join([]string{
+	"#{window_index}", "#{window_name}", "#{pane_index}", "#{pane_dead}", "#{@pane_role}", "#{@bench_id}",
+	"#{" + PaneStateOption + "}", "#{" + PaneDigestOption + "}", "#{pane_start_command}", "#{pane_id}",
+}, snapshotFieldSep)
+
+// InspectPanes reads every pane of a session: its options, its current command
+// and the last lines of its screen. With lines 0 the screen is not captured.
+func (g *GotmuxAdapter) InspectPanes(sessionName string, lines int) ([]PaneInspection, error) {
+	if !g.server.SessionExists(sessionName) {
+		return nil, fmt.Errorf("no tmux session %s", sessionName)
+	}
+	output, err := g.server.ListAllPanes(fmt.Sprintf("#{==:#{session_name},%s}", sessionName), inspectPaneFormat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list panes of %s: %w", sessionName, err)
+	}
+
+	var panes []PaneInspection
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, snapshotFieldSep)
+		if len(fields) != 10 {
+			continue
+		}
+		windowIndex, _ := strconv.Atoi(fields[0])
+		paneIndex, _ := strconv.Atoi(fields[2])
+		pane := PaneInspection{
+			PaneID:         fields[9],
+			Window:         fields[1],
+			WindowIndex:    windowIndex,
+			Index:          paneIndex,
+			Dead:           fields[3] == "1",
+			Role:           fields[4],
+			BenchID:        fields[5],
+			State:          fields[6],
+			Digest:         fields[7],
+			StartCommand:   fields[8],
+			CurrentCommand: g.server.GetPaneCommand(sessionName, fields[0], paneIndex),
+		}
+		if lines > 0 {
+			target := fmt.Sprintf("%s.%d", exactTarget(sessionName, fields[0]), paneIndex)
+			// A pane can close between listing and capture; it is then reported
+			// with an empty screen and dropped at the next poll
+			pane.Content, _ = g.server.CapturePaneContent(target, lines)
+		}
+		panes = append(panes, pane)
+	}
+	return panes, nil
+}
+
+// PaneHealthUpdate is the state the monitor assigned to a pane.
+type PaneHealthUpdate struct {
+	PaneID string
+	State  string
+	Digest string
+}
+
+// WindowAlert is the alert of a window; empty clears it.
+type WindowAlert struct {
+	Window string
+	Alert  string
+}
+
+// SetPaneHealth records pane states, window alerts and the session summary as
+// tmux options.
+func (g *GotmuxAdapter) SetPaneHealth(sessionName string, panes []PaneHealthUpdate, windows []WindowAlert, summary string) error {
+	for _, p := range panes {
+		if err := g.server.cmd("set-option", "-p", "-t", p.PaneID, PaneStateOption, p.State).Run(); err != nil {
+			continue // Closed since the poll
+		}
+		_ = g.server.cmd("set-option", "-p", "-t", p.PaneID, PaneDigestOption, p.Digest).Run()
+	}
+	for _, w := range windows {
+		target := exactTarget(sessionName, w.Window)
+		if w.Alert == "" {
+			_ = g.server.cmd("set-option", "-w", "-u", "-t", target, WindowAlertOption).Run()
+			continue
+		}
+		_ = g.server.SetWindowOption(target, WindowAlertOption, w.Alert)
+	}
+	if err := g.server.cmd("set-option", "-t", exactSession(sessionName)+":", HealthOption, summary).Run(); err != nil {
+		return fmt.Errorf("failed to set %s on %s: %w", HealthOption, sessionName, err)
+	}
+	return nil
+}
+
+// healthStatusFormat shows the pane summary on the right of the status bar.
+const healthStatusFormat = "#{?" + HealthOption + ",[#{" + HealthOption + "}] ,}"
+
+// windowAlertFormat flags windows with a pane that needs a human.
+const windowAlertFormat = "#{?" + WindowAlertOption + ", !#{" + WindowAlertOption + "},}"
+
+// ShowPaneHealth adds the pane summary to the session's status-right and the
+// window alerts to the status formats of each of its windows. Formats that
+// already show them are left unchanged, so it is safe to call at every poll.
+func (g *GotmuxAdapter) ShowPaneHealth(sessionName string) error {
+	session := exactSession(sessionName) + ":"
+	if err := g.extendFormat(session, "", "status-right", healthStatusFormat, true); err != nil {
+		return err
+	}
+	windows, err := g.server.ListWindows(sessionName)
+	if err != nil {
+		return fmt.Errorf("failed to list windows: %w", err)
+	}
+	for _, w := range windows {
+		target := exactTarget(sessionName, w)
+		for _, option := range []string{"window-status-format", "window-status-current-format"} {
+			if err := g.extendFormat(target, "-w", option, windowAlertFormat, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// extendFormat adds a fragment to a format option (as inherited by the target)
+// unless it is already there, in front or at the end.
+func (g *GotmuxAdapter) extendFormat(target, scope, option, fragment string, prepend bool) error {
+	show := []string{"show-options", "-v", "-A", "-t", target, option}
+	set := []string{"set-option", "-t", target, option}
+	if scope != "" {
+		show = append([]string{"show-options", scope}, show[1:]...)
+		set = append([]string{"set-option", scope}, set[1:]...)
+	}
+	output, err := g.server.cmd(show...).Output()
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", option, err)
+	}
+	current := strings.TrimRight(string(output), "\n")
+	if strings.Contains(current, fragment) {
+		return nil
+	}
+	value := current + fragment
+	if prepend {
+		value = fragment + current
+	}
+	if err := g.server.cmd(append(set, value)...).Run(); err != nil {
+		return fmt.Errorf("failed to set %s: %w", option, err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package tmux
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInspectAndSetPaneHealth(t *testing.T) {
+	adapter := newTestServerAdapter(t)
+	dir := t.TempDir()
+	wb := DesiredWorkbench{Name: "bench-1", Path: dir, ID: "BENCH-001", WorkshopID: "WORK-001", Layout: testLayout(), LayoutSource: "global"}
+
+	plan, err := adapter.PlanApply("health-test", []DesiredWorkbench{wb})
+	if err != nil {
+		t.Fatalf("PlanApply failed: %v", err)
+	}
+	if err := adapter.ExecutePlan(plan); err != nil {
+		t.Fatalf("ExecutePlan failed: %v", err)
+	}
+	// A pane that shows a prompt and waits
+	if err := adapter.server.cmd("split-window", "-d", "-t", "=health-test:bench-1.0", "-c", dir,
+		"printf 'Do you want to proceed? (y/n) '; sleep 600").Run(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(200 * time.Millisecond)
+
+	panes, err := adapter.InspectPanes("health-test", 20)
+	if err != nil {
+		t.Fatalf("InspectPanes failed: %v", err)
+	}
+	if len(panes) != 4 {
+		t.Fatalf("expected 4 panes, got %+v", panes)
+	}
+	var prompt *PaneInspection
+	roles := map[string]PaneInspection{}
+	for i, p := range panes {
+		roles[p.Role] = p
+		if strings.Contains(p.Content, "Do you want to proceed?") {
+			prompt = &panes[i]
+		}
+	}
+	if prompt == nil || prompt.CurrentCommand == "" {
+		t.Fatalf("expected the prompt pane with its command, got %+v", panes)
+	}
+	if tests := roles["tests"]; tests.BenchID != "BENCH-001" || !strings.Contains(tests.StartCommand, "sleep 601") {
+		t.Errorf("expected the tests pane with its options and start command, got %+v", tests)
+	}
+
+	err = adapter.SetPaneHealth("health-test",
+		[]PaneHealthUpdate{{PaneID: prompt.PaneID, State: "waiting", Digest: "abc"}},
+		[]WindowAlert{{Window: "bench-1", Alert: "waiting"}},
+		"1 waiting, 3 idle")
+	if err != nil {
+		t.Fatalf("SetPaneHealth failed: %v", err)
+	}
+	if err := adapter.ShowPaneHealth("health-test"); err != nil {
+		t.Fatalf("ShowPaneHealth failed: %v", err)
+	}
+	if err := adapter.ShowPaneHealth("health-test"); err != nil {
+		t.Fatalf("ShowPaneHealth failed the second time: %v", err)
+	}
+
+	panes, err = adapter.InspectPanes("health-test", 0)
+	if err != nil {
+		t.Fatalf("InspectPanes failed: %v", err)
+	}
+	for _, p := range panes {
+		if p.PaneID == prompt.PaneID && (p.State != "waiting" || p.Digest != "abc") {
+			t.Errorf("expected the recorded state on the prompt pane, got %+v", p)
+		}
+	}
+	if alert := adapter.server.GetWindowOption("=health-test:bench-1", WindowAlertOption); alert != "waiting" {
+		t.Errorf("expected a window alert, got %q", alert)
+	}
+	status, _ := adapter.server.cmd("show-options", "-v", "-t", "=health-test:", "status-right").Output()
+	if strings.Count(string(status), HealthOption) != 2 {
+		t.Errorf("expected the summary in status-right once, got %q", status)
+	}
+	if rendered, _ := adapter.server.cmd("display-message", "-p", "-t", "=health-test:bench-1", "#{E:status-right}").Output(); !strings.Contains(string(rendered), "[1 waiting, 3 idle]") {
+		t.Errorf("expected the summary to render, got %q", rendered)
+	}
+
+	// Clearing the alert unsets the option
+	if err := adapter.SetPaneHealth("health-test", nil, []WindowAlert{{Window: "bench-1"}}, ""); err != nil {
+		t.Fatalf("SetPaneHealth failed: %v", err)
+	}
+	if alert := adapter.server.GetWindowOption("=health-test:bench-1", WindowAlertOption); alert != "" {
+		t.Errorf("expected the alert cleared, got %q", alert)
+	}
+}
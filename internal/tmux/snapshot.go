@@ -0,0 +1,317 @@
+package tmux
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SessionSnapshot is the captured shape of a tmux session: its windows, their
+// layouts, and each pane's directory, start command and user options.
+type SessionSnapshot struct {
+	Session string           `json:"session"`
+	Windows []WindowSnapshot `json:"windows"`
+}
+
+// WindowSnapshot is a captured window.
+type WindowSnapshot struct {
+	Index   int               `json:"index"`
+	Name    string            `json:"name"`
+	Layout  string            `json:"layout"` // window_layout, as accepted by select-layout
+	Active  bool              `json:"active,omitempty"`
+	Options map[string]string `json:"options,omitempty"` // User (@) window options, e.g. @orc_focus
+	Panes   []PaneSnapshot    `json:"panes"`
+}
+
+// PaneSnapshot is a captured pane.
+type PaneSnapshot struct {
+	Index          int               `json:"index"`
+	Path           string            `json:"path"`                    // pane_current_path
+	StartCommand   string            `json:"start_command,omitempty"` // pane_start_command, relaunched on restore
+	CurrentCommand string            `json:"current_command,omitempty"`
+	Active         bool              `json:"active,omitempty"`
+	Options        map[string]string `json:"options,omitempty"` // User (@) pane options, e.g. @pane_role, @bench_id
+}
+
+// PaneCount returns the number of panes across all windows.
+func (s *SessionSnapshot) PaneCount() int {
+	n := 0
+	for _, w := range s.Windows {
+		n += len(w.Panes)
+	}
+	return n
+}
+
+// snapshotFieldSep separates fields in the list-panes format; tabs don't occur in
+// tmux names or paths in practice.
+const snapshotFieldSep = "\t"
+
+var snapshotPaneFormat = strings.Join([]string{
+	"#{window_index}", "#{window_name}", "#{window_layout}", "#{window_active}",
+	"#{pane_index}", "#{pane_id}", "#{pane_active}", "#{pane_current_path}", "#{pane_current_command}",
+}, snapshotFieldSep)
+
+// Snapshot captures a session's windows and panes.
+func (g *GotmuxAdapter) Snapshot(sessionName string) (*SessionSnapshot, error) {
+	if !g.server.SessionExists(sessionName) {
+		return nil, fmt.Errorf("no tmux session %s", sessionName)
+	}
+	output, err := g.server.ListAllPanes(fmt.Sprintf("#{==:#{session_name},%s}", sessionName), snapshotPaneFormat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list panes of %s: %w", sessionName, err)
+	}
+
+	snapshot := &SessionSnapshot{Session: sessionName}
+	windows := make(map[int]*WindowSnapshot)
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, snapshotFieldSep)
+		if len(fields) != 9 {
+			continue
+		}
+		windowIndex, _ := strconv.Atoi(fields[0])
+		window, ok := windows[windowIndex]
+		if !ok {
+			window = &WindowSnapshot{
+				Index:   windowIndex,
+				Name:    fields[1],
+				Layout:  fields[2],
+				Active:  fields[3] == "1",
+				Options: g.userOptions("-w", exactTarget(sessionName, fields[0])),
+			}
+			windows[windowIndex] = window
+		}
+		paneIndex, _ := strconv.Atoi(fields[4])
+		window.Panes = append(window.Panes, PaneSnapshot{
+			Index:          paneIndex,
+			Path:           fields[7],
+			StartCommand:   g.server.GetPaneStartCommand(sessionName, fields[0], paneIndex),
+			CurrentCommand: fields[8],
+			Active:         fields[6] == "1",
+			Options:        g.userOptions("-p", fields[5]),
+		})
+	}
+
+	indexes := make([]int, 0, len(windows))
+	for i := range windows {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	for _, i := range indexes {
+		w := windows[i]
+		sort.Slice(w.Panes, func(a, b int) bool { return w.Panes[a].Index < w.Panes[b].Index })
+		snapshot.Windows = append(snapshot.Windows, *w)
+	}
+	return snapshot, nil
+}
+
+// userOptions reads the user (@) options set on a window (-w) or pane (-p),
+// except the pane monitor's.
+func (g *GotmuxAdapter) userOptions(scope, target string) map[string]string {
+	output, err := g.server.cmd("show-options", scope, "-t", target).Output()
+	if err != nil {
+		return nil
+	}
+	var options map[string]string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if !strings.HasPrefix(line, "@") {
+			continue
+		}
+		name, value, _ := strings.Cut(line, " ")
+		if monitorOptions[name] {
+			continue
+		}
+		if args := splitTmuxArgs(value); len(args) == 1 {
+			value = args[0]
+		}
+		if options == nil {
+			options = make(map[string]string)
+		}
+		options[name] = value
+	}
+	return options
+}
+
+// RestorePlan lists the windows a restore recreates and the ones already there.
+type RestorePlan struct {
+	Snapshot      *SessionSnapshot
+	SessionExists bool
+	Create        []WindowSnapshot // Missing windows, rebuilt from the snapshot
+	Existing      []string         // Windows already in the session, left alone
+}
+
+// PlanRestore compares a snapshot with the running session. Windows are matched
+// by name; only missing ones are recreated.
+func (g *GotmuxAdapter) PlanRestore(snapshot *SessionSnapshot) (*RestorePlan, error) {
+	plan := &RestorePlan{Snapshot: snapshot}
+	existing := make(map[string]bool)
+	if g.server.SessionExists(snapshot.Session) {
+		plan.SessionExists = true
+		windows, err := g.server.ListWindows(snapshot.Session)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list windows: %w", err)
+		}
+		for _, w := range windows {
+			existing[w] = true
+		}
+	}
+	for _, w := range snapshot.Windows {
+		if existing[w.Name] {
+			plan.Existing = append(plan.Existing, w.Name)
+			continue
+		}
+		plan.Create = append(plan.Create, w)
+	}
+	return plan, nil
+}
+
+// ExecuteRestore rebuilds the planned windows: panes are split to the captured
+// count, the captured layout is applied, each pane is respawned with its start
+// command in its directory, and the user options are set again. The session is
+// enriched afterwards.
+func (g *GotmuxAdapter) ExecuteRestore(plan *RestorePlan) error {
+	session := plan.Snapshot.Session
+	sessionExists := plan.SessionExists
+	var activeWindow string
+	for _, w := range plan.Create {
+		if len(w.Panes) == 0 {
+			continue
+		}
+		firstPaneID, err := g.newSnapshotWindow(session, w, sessionExists)
+		if err != nil {
+			return err
+		}
+		sessionExists = true
+		if err := g.restoreWindow(session, w, firstPaneID); err != nil {
+			return err
+		}
+		if w.Active {
+			activeWindow = w.Name
+		}
+	}
+	if activeWindow != "" {
+		_ = g.server.cmd("select-window", "-t", exactTarget(session, activeWindow)).Run()
+	}
+
+	g.server.ApplyGlobalBindings()
+	return g.server.EnrichSession(session)
+}
+
+// newSnapshotWindow creates a window for a snapshot window (creating the session
+// with it when needed), returning its first pane's ID. The window keeps its
+// captured index when that index is free.
+func (g *GotmuxAdapter) newSnapshotWindow(session string, w WindowSnapshot, sessionExists bool) (string, error) {
+	path := w.Panes[0].Path
+	if !sessionExists {
+		output, err := g.server.cmd("new-session", "-d", "-s", session, "-n", w.Name, "-c", path, "-P", "-F", "#{pane_id}").Output()
+		if err != nil {
+			return "", fmt.Errorf("failed to create session %s: %w", session, err)
+		}
+		return strings.TrimSpace(string(output)), nil
+	}
+	output, err := g.server.cmd("new-window", "-d", "-t", fmt.Sprintf("%s:%d", exactSession(session), w.Index), "-n", w.Name, "-c", path, "-P", "-F", "#{pane_id}").Output()
+	if err != nil {
+		// Index taken: append instead
+		output, err = g.server.cmd("new-window", "-d", "-t", exactSession(session)+":", "-n", w.Name, "-c", path, "-P", "-F", "#{pane_id}").Output()
+		if err != nil {
+			return "", fmt.Errorf("failed to create window %s: %w", w.Name, err)
+		}
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// restoreWindow splits a new window into the snapshot's panes and restores them.
+func (g *GotmuxAdapter) restoreWindow(session string, w WindowSnapshot, firstPaneID string) error {
+	// Splitting the newest pane keeps pane indexes in snapshot order, which is
+	// the order select-layout assigns cells in
+	paneIDs := []string{firstPaneID}
+	for _, p := range w.Panes[1:] {
+		output, err := g.server.cmd("split-window", "-d", "-t", paneIDs[len(paneIDs)-1], "-c", p.Path, "-P", "-F", "#{pane_id}").Output()
+		if err != nil {
+			return fmt.Errorf("failed to split window %s: %w", w.Name, err)
+		}
+		paneIDs = append(paneIDs, strings.TrimSpace(string(output)))
+		// Even out the space so repeated splits don't run out of room
+		_ = g.server.cmd("select-layout", "-t", firstPaneID, "tiled").Run()
+	}
+	// The captured layout restores the geometry; it only fails when the pane
+	// count differs, which the splits above rule out
+	if w.Layout != "" {
+		_ = g.server.cmd("select-layout", "-t", exactTarget(session, w.Name), w.Layout).Run()
+	}
+
+	for i, p := range w.Panes {
+		paneID := paneIDs[i]
+		if args := splitTmuxArgs(p.StartCommand); len(args) > 0 {
+			respawn := append([]string{"respawn-pane", "-k", "-t", paneID, "-c", p.Path}, args...)
+			if err := g.server.cmd(respawn...).Run(); err != nil {
+				return fmt.Errorf("failed to relaunch %q in %s: %w", p.StartCommand, w.Name, err)
+			}
+		}
+		for _, name := range sortedKeys(p.Options) {
+			if monitorOptions[name] {
+				continue // Captured by snapshots taken before they were left out
+			}
+			if err := g.server.cmd("set-option", "-p", "-t", paneID, name, p.Options[name]).Run(); err != nil {
+				return fmt.Errorf("failed to set %s on a pane of %s: %w", name, w.Name, err)
+			}
+		}
+		if p.Active {
+			_ = g.server.cmd("select-pane", "-t", paneID).Run()
+		}
+	}
+	for _, name := range sortedKeys(w.Options) {
+		if monitorOptions[name] {
+			continue
+		}
+		if err := g.server.cmd("set-option", "-w", "-t", exactTarget(session, w.Name), name, w.Options[name]).Run(); err != nil {
+			return fmt.Errorf("failed to set %s on %s: %w", name, w.Name, err)
+		}
+	}
+	return nil
+}
+
+// splitTmuxArgs splits a command as tmux prints it (pane_start_command, option
+// values) back into arguments: words separated by spaces, with double-quoted
+// words unescaped.
+func splitTmuxArgs(s string) []string {
+	var args []string
+	var cur strings.Builder
+	inWord, quoted, escaped := false, false, false
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+			inWord = true
+		case r == '"':
+			quoted = !quoted
+			inWord = true
+		case r == ' ' && !quoted:
+			if inWord {
+				args = append(args, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inWord {
+		args = append(args, cur.String())
+	}
+	return args
+}
+
+// sortedKeys returns the keys of an option map in order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
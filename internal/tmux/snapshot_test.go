@@ -0,0 +1,144 @@
+package tmux
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitTmuxArgs(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"orc connect", []string{"orc", "connect"}},
+		{`"sleep 601"`, []string{"sleep 601"}},
+		{`"sh -c \"echo hi\""`, []string{`sh -c "echo hi"`}},
+		{`vim "my file.txt"`, []string{"vim", "my file.txt"}},
+		{`a\ b c`, []string{"a b", "c"}},
+	}
+	for _, tt := range tests {
+		if got := splitTmuxArgs(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitTmuxArgs(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	adapter := newTestServerAdapter(t)
+	dir := t.TempDir()
+	wb := DesiredWorkbench{Name: "bench-1", Path: dir, ID: "BENCH-001", WorkshopID: "WORK-001", Layout: testLayout(), LayoutSource: "global"}
+
+	plan, err := adapter.PlanApply("snap-test", []DesiredWorkbench{wb})
+	if err != nil {
+		t.Fatalf("PlanApply failed: %v", err)
+	}
+	if err := adapter.ExecutePlan(plan); err != nil {
+		t.Fatalf("ExecutePlan failed: %v", err)
+	}
+	// A hand-made split that apply knows nothing about
+	if err := adapter.server.cmd("split-window", "-d", "-t", "=snap-test:bench-1.0", "-c", dir, "sleep 602").Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Pane monitor state is not part of the snapshot
+	if err := adapter.server.cmd("set-option", "-p", "-t", "=snap-test:bench-1.0", PaneStateOption, "waiting").Run(); err != nil {
+		t.Fatal(err)
+	}
+	if err := adapter.server.cmd("set-option", "-w", "-t", "=snap-test:bench-1", WindowAlertOption, "waiting").Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := adapter.Snapshot("snap-test")
+	if err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+	if len(snapshot.Windows) != 1 || snapshot.PaneCount() != 4 {
+		t.Fatalf("expected 1 window with 4 panes, got %+v", snapshot)
+	}
+	window := snapshot.Windows[0]
+	if _, ok := window.Options[WindowAlertOption]; ok {
+		t.Errorf("expected no %s in the snapshot, got %v", WindowAlertOption, window.Options)
+	}
+	if _, ok := window.Panes[0].Options[PaneStateOption]; ok {
+		t.Errorf("expected no %s in the snapshot, got %v", PaneStateOption, window.Panes[0].Options)
+	}
+	if window.Name != "bench-1" || window.Layout == "" {
+		t.Errorf("expected bench-1 with a layout, got %+v", window)
+	}
+	var roles, commands []string
+	for _, p := range window.Panes {
+		roles = append(roles, p.Options["@pane_role"])
+		commands = append(commands, p.StartCommand)
+	}
+	if !strings.Contains(strings.Join(roles, ","), "tests") || !strings.Contains(strings.Join(commands, ","), "sleep 602") {
+		t.Errorf("expected roles and start commands, got %q / %q", roles, commands)
+	}
+
+	// The snapshot survives a JSON round trip, as it is stored in the ledger
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stored SessionSnapshot
+	if err := json.Unmarshal(data, &stored); err != nil {
+		t.Fatal(err)
+	}
+
+	// Snapshots taken before monitor options were left out don't restore them
+	stored.Windows[0].Options = map[string]string{WindowAlertOption: "crashed"}
+	stored.Windows[0].Panes[0].Options[PaneStateOption] = "crashed"
+	stored.Windows[0].Panes[0].Options[PaneDigestOption] = "abc123"
+
+	// Lose the server, then restore
+	if err := adapter.server.cmd("kill-session", "-t", "=snap-test").Run(); err != nil {
+		t.Fatal(err)
+	}
+	restore, err := adapter.PlanRestore(&stored)
+	if err != nil {
+		t.Fatalf("PlanRestore failed: %v", err)
+	}
+	if restore.SessionExists || len(restore.Create) != 1 {
+		t.Fatalf("expected the window to be recreated, got %+v", restore)
+	}
+	if err := adapter.ExecuteRestore(restore); err != nil {
+		t.Fatalf("ExecuteRestore failed: %v", err)
+	}
+
+	restored, err := adapter.Snapshot("snap-test")
+	if err != nil {
+		t.Fatalf("Snapshot after restore failed: %v", err)
+	}
+	if restored.PaneCount() != 4 {
+		t.Fatalf("expected 4 restored panes, got %d", restored.PaneCount())
+	}
+	for _, args := range [][]string{{"-w", "=snap-test:bench-1", WindowAlertOption}, {"-p", "=snap-test:bench-1.0", PaneStateOption}} {
+		output, err := adapter.server.cmd("show-options", args[0], "-t", args[1]).Output()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(output), args[2]) {
+			t.Errorf("expected %s not to be restored on %s, got %q", args[2], args[1], output)
+		}
+	}
+	for i, p := range restored.Windows[0].Panes {
+		want := window.Panes[i]
+		if p.Options["@pane_role"] != want.Options["@pane_role"] || p.Options["@bench_id"] != want.Options["@bench_id"] {
+			t.Errorf("pane %d: expected options %v, got %v", i, want.Options, p.Options)
+		}
+		if p.StartCommand != want.StartCommand {
+			t.Errorf("pane %d: expected start command %q, got %q", i, want.StartCommand, p.StartCommand)
+		}
+	}
+
+	// Restoring again leaves the existing window alone
+	restore, err = adapter.PlanRestore(&stored)
+	if err != nil {
+		t.Fatalf("PlanRestore failed: %v", err)
+	}
+	if len(restore.Create) != 0 || strings.Join(restore.Existing, ",") != "bench-1" {
+		t.Errorf("expected nothing to recreate, got %+v", restore)
+	}
+}
@@ -6,14 +6,17 @@ import (
 	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"sync"
 
 	cliadapter "github.com/example/orc/internal/adapters/cli"
 	"github.com/example/orc/internal/adapters/filesystem"
 	"github.com/example/orc/internal/adapters/persistence"
+	"github.com/example/orc/internal/adapters/prprovider"
 	"github.com/example/orc/internal/adapters/sqlite"
 	tmuxadapter "github.com/example/orc/internal/adapters/tmux"
 	"github.com/example/orc/internal/app"
+	"github.com/example/orc/internal/config"
 	"github.com/example/orc/internal/db"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
@@ -34,8 +37,15 @@ var (
 	workshopService                primary.WorkshopService
 	workbenchService               primary.WorkbenchService
 	summaryService                 primary.SummaryService
+	reportService                  primary.ReportService
 	eventService                   primary.EventService
 	hookEventService               primary.HookEventService
+	maintenanceService             primary.MaintenanceService
+	reconcileService               primary.ReconcileService
+	commitService                  primary.CommitService
+	diffStatService                primary.DiffStatService
+	tmuxSnapshotService            primary.TmuxSnapshotService
+	paneHealthService              primary.PaneHealthService
 	commissionOrchestrationService *app.CommissionOrchestrationService
 	tmuxService                    secondary.TMuxAdapter
 	parentTmuxService              secondary.TMuxAdapter
@@ -122,6 +132,12 @@ func SummaryService() primary.SummaryService {
 	return summaryService
 }
 
+// ReportService returns the singleton ReportService instance.
+func ReportService() primary.ReportService {
+	once.Do(initServices)
+	return reportService
+}
+
 // EventService returns the singleton EventService instance.
 func EventService() primary.EventService {
 	once.Do(initServices)
@@ -134,6 +150,42 @@ func HookEventService() primary.HookEventService {
 	return hookEventService
 }
 
+// ReconcileService returns the singleton ReconcileService instance.
+func ReconcileService() primary.ReconcileService {
+	once.Do(initServices)
+	return reconcileService
+}
+
+// CommitService returns the singleton CommitService instance.
+func CommitService() primary.CommitService {
+	once.Do(initServices)
+	return commitService
+}
+
+// DiffStatService returns the singleton DiffStatService instance.
+func DiffStatService() primary.DiffStatService {
+	once.Do(initServices)
+	return diffStatService
+}
+
+// TmuxSnapshotService returns the singleton TmuxSnapshotService instance.
+func TmuxSnapshotService() primary.TmuxSnapshotService {
+	once.Do(initServices)
+	return tmuxSnapshotService
+}
+
+// PaneHealthService returns the singleton PaneHealthService instance.
+func PaneHealthService() primary.PaneHealthService {
+	once.Do(initServices)
+	return paneHealthService
+}
+
+// MaintenanceService returns the singleton MaintenanceService instance.
+func MaintenanceService() primary.MaintenanceService {
+	once.Do(initServices)
+	return maintenanceService
+}
+
 // CommissionOrchestrationService returns the singleton CommissionOrchestrationService instance.
 func CommissionOrchestrationService() *app.CommissionOrchestrationService {
 	once.Do(initServices)
@@ -191,8 +243,13 @@ func initServices() {
 	parentTmuxService = tmuxadapter.NewParentAdapter() // always targets default server (strips TMUX env)
 
 	// Create workspace adapter (needed by effect executor and workshop service)
+	// Roots come from workspace.json next to the database (default ~/wb for worktrees, ~/src for repos)
 	home, _ := os.UserHomeDir()
-	workspaceAdapter, err := filesystem.NewWorkspaceAdapter(home+"/wb", home+"/src") // ~/wb for worktrees, ~/src for repos
+	workspaceConfig, err := loadWorkspaceConfig()
+	if err != nil {
+		log.Fatalf("failed to load workspace config: %v", err)
+	}
+	workspaceAdapter, err := filesystem.NewWorkspaceAdapter(workspaceConfig.LayoutFor("").WorktreeRoot, config.ExpandHome(workspaceConfig.RepoRoot))
 	if err != nil {
 		log.Fatalf("failed to create workspace adapter: %v", err)
 	}
@@ -207,16 +264,31 @@ func initServices() {
 	shipmentRepo = sqlite.NewShipmentRepository(database, eventWriter)
 	taskRepo := sqlite.NewTaskRepository(database, eventWriter)
 	tagRepo := sqlite.NewTagRepository(database)
-	taskService = app.NewTaskService(taskRepo, tagRepo, shipmentRepo, transactor)
 
 	// Create note and tome services
 	noteRepo := sqlite.NewNoteRepository(database, eventWriter)
 	tomeRepo := sqlite.NewTomeRepository(database, eventWriter)
 	noteService = app.NewNoteService(noteRepo, transactor)
 
+	// Create factory, workshop, and workbench services (workbench service is needed by shipment service)
+	repoRepo := sqlite.NewRepoRepository(database)
+	factoryRepo := sqlite.NewFactoryRepository(database)
+	workshopRepo := sqlite.NewWorkshopRepository(database)
+	// workbenchRepo already created early for EventWriter (with nil EventWriter due to circular dependency)
+	workbenchLocator, err := app.NewWorkbenchLocator(workspaceConfig, workshopRepo, repoRepo)
+	if err != nil {
+		log.Fatalf("failed to load workspace layout: %v", err)
+	}
+	factoryService = app.NewFactoryService(factoryRepo, transactor)
+	workshopService = app.NewWorkshopService(factoryRepo, workshopRepo, workbenchRepo, repoRepo, tmuxService, workspaceAdapter, executor, transactor, eventWriter, workbenchLocator)
+	workbenchService = app.NewWorkbenchService(workbenchRepo, workshopRepo, repoRepo, agentProvider, executor, workspaceAdapter, transactor, eventWriter, workbenchLocator)
+
+	// Create task service (uses workbench service to locate checkpoints)
+	taskService = app.NewTaskService(taskRepo, tagRepo, shipmentRepo, workbenchService, workspaceAdapter, transactor)
+
 	// Create tome and shipment services
-	tomeService = app.NewTomeService(tomeRepo, noteService, transactor)
-	shipmentService = app.NewShipmentService(shipmentRepo, taskRepo, noteService, transactor)
+	tomeService = app.NewTomeService(tomeRepo, noteService, commissionService, transactor)
+	shipmentService = app.NewShipmentService(shipmentRepo, taskRepo, noteRepo, noteService, commissionService, workbenchService, transactor)
 
 	// Create plan repository
 	planRepo := sqlite.NewPlanRepository(database, eventWriter)
@@ -224,23 +296,37 @@ func initServices() {
 	// Create tag service
 	tagService = app.NewTagService(tagRepo, transactor)
 
-	// Create repo and PR services
-	repoRepo := sqlite.NewRepoRepository(database)
+	// Create repo and PR services (repoRepo created above)
 	prRepo := sqlite.NewPRRepository(database)
-	repoService = app.NewRepoService(repoRepo, transactor)
-	prService = app.NewPRService(prRepo, shipmentService, transactor)
-
-	// Create factory, workshop, and workbench services
-	factoryRepo := sqlite.NewFactoryRepository(database)
-	workshopRepo := sqlite.NewWorkshopRepository(database)
-	// workbenchRepo already created early for EventWriter (with nil EventWriter due to circular dependency)
-	factoryService = app.NewFactoryService(factoryRepo, transactor)
-	workshopService = app.NewWorkshopService(factoryRepo, workshopRepo, workbenchRepo, repoRepo, tmuxService, workspaceAdapter, executor, transactor)
-	workbenchService = app.NewWorkbenchService(workbenchRepo, workshopRepo, repoRepo, agentProvider, executor, workspaceAdapter, transactor)
+	repoService = app.NewRepoService(repoRepo, transactor, workspaceAdapter, app.NewGitService())
+	prConfig, err := loadPRConfig()
+	if err != nil {
+		log.Fatalf("failed to load PR config: %v", err)
+	}
+	prProviders := prprovider.NewRegistry(prProviderHosts(prConfig))
+	prService = app.NewPRService(prRepo, shipmentService, transactor, repoRepo, prProviders, eventWriter, workbenchService, noteService, taskService)
 
 	// Create plan service
 	planService = app.NewPlanService(planRepo, transactor)
 
+	// Create reconcile service (compares workbench rows with disk, git worktrees, and tmux)
+	reconcileService = app.NewReconcileService(workbenchRepo, repoRepo, tmuxAdapter, workspaceAdapter, executor, app.NewGitService(), workbenchLocator)
+
+	// Create commit service (links git commits to tasks and shipments via trailers)
+	commitRepo := sqlite.NewCommitRepository(database)
+	commitService = app.NewCommitService(commitRepo, workbenchRepo, repoRepo, taskRepo, shipmentRepo, workspaceAdapter, app.NewGitService())
+
+	// Create diff stat service (cached shipment branch size and touched files)
+	diffStatRepo := sqlite.NewShipmentDiffStatRepository(database)
+	diffStatService = app.NewDiffStatService(diffStatRepo, shipmentRepo, repoRepo, workbenchRepo, workspaceAdapter, app.NewGitService(), workbenchLocator)
+
+	// Create tmux snapshot service (saved workshop sessions for orc tmux restore)
+	tmuxSnapshotRepo := sqlite.NewTmuxSnapshotRepository(database)
+	tmuxSnapshotService = app.NewTmuxSnapshotService(tmuxSnapshotRepo, workshopRepo, transactor)
+
+	// Create pane health service (classifies workbench panes for orc tmux monitor)
+	paneHealthService = app.NewPaneHealthService(eventWriter)
+
 	// Create event service (unified audit + operational events)
 	eventService = app.NewEventService(workshopEventRepo, operationalEventRepo)
 
@@ -248,6 +334,14 @@ func initServices() {
 	hookEventRepo := sqlite.NewHookEventRepository(database)
 	hookEventService = app.NewHookEventService(hookEventRepo, transactor)
 
+	// Create maintenance service (archive ledger lives next to the main database)
+	archiveDB, err := db.GetArchiveDB()
+	if err != nil {
+		log.Fatalf("failed to initialize archive: %v", err)
+	}
+	archiveRepo := sqlite.NewArchiveRepository(database, archiveDB)
+	maintenanceService = app.NewMaintenanceService(archiveRepo, hookEventRepo, operationalEventRepo)
+
 	// Create orchestration services
 	commissionOrchestrationService = app.NewCommissionOrchestrationService(commissionService, agentProvider)
 
@@ -260,6 +354,21 @@ func initServices() {
 		noteService,
 		workbenchService,
 		planService,
+		commitService,
+		diffStatService,
+		prService,
+	)
+
+	// Create report service (reads across services; user templates live in ~/.orc/templates)
+	reportService = app.NewReportService(
+		shipmentService,
+		noteService,
+		planService,
+		prService,
+		workbenchService,
+		repoService,
+		app.NewGitService(),
+		filesystem.NewTemplateLoaderAdapter(home+"/.orc/templates"),
 	)
 }
 
@@ -304,6 +413,21 @@ type DesiredWorkbench = tmuxadapter.DesiredWorkbench
 // ApplyPlan re-exports the reconciliation plan type.
 type ApplyPlan = tmuxadapter.ApplyPlan
 
+// SessionSnapshot re-exports the tmux session snapshot type.
+type SessionSnapshot = tmuxadapter.SessionSnapshot
+
+// RestorePlan re-exports the snapshot restore plan type.
+type RestorePlan = tmuxadapter.RestorePlan
+
+// PaneInspection re-exports the pane poll type of the pane monitor.
+type PaneInspection = tmuxadapter.PaneInspection
+
+// PaneHealthUpdate re-exports the pane state type written by the pane monitor.
+type PaneHealthUpdate = tmuxadapter.PaneHealthUpdate
+
+// WindowAlert re-exports the window alert type written by the pane monitor.
+type WindowAlert = tmuxadapter.WindowAlert
+
 // NewGotmuxAdapter creates a new gotmux adapter for the default tmux server.
 func NewGotmuxAdapter() (*GotmuxAdapter, error) {
 	return tmuxadapter.NewGotmuxAdapter("")
@@ -337,3 +461,46 @@ func KillDeskServer(benchName string) error {
 func KillAllDeskServers() (int, error) {
 	return tmuxadapter.KillAllDeskServers()
 }
+
+// loadPRConfig reads pr.json from the directory holding the ORC database.
+func loadPRConfig() (*config.PRConfig, error) {
+	path, err := db.GetDBPath()
+	if err != nil {
+		return nil, err
+	}
+	return config.LoadPRConfig(filepath.Dir(path))
+}
+
+// prProviderHosts resolves configured PR providers, reading each token from its
+// environment variable.
+func prProviderHosts(cfg *config.PRConfig) []prprovider.Host {
+	var hosts []prprovider.Host
+	for _, p := range cfg.ResolvedProviders() {
+		hosts = append(hosts, prprovider.Host{
+			Host:   p.Host,
+			Type:   p.Type,
+			APIURL: p.APIURL,
+			Token:  os.Getenv(p.TokenEnv),
+		})
+	}
+	return hosts
+}
+
+// loadWorkspaceConfig reads workspace.json from the directory holding the ORC database.
+func loadWorkspaceConfig() (*config.WorkspaceConfig, error) {
+	path, err := db.GetDBPath()
+	if err != nil {
+		return nil, err
+	}
+	return config.LoadWorkspaceConfig(filepath.Dir(path))
+}
+
+// LoadTmuxConfig reads tmux.json (workbench window layouts) from the directory
+// holding the ORC database.
+func LoadTmuxConfig() (*config.TmuxConfig, error) {
+	path, err := db.GetDBPath()
+	if err != nil {
+		return nil, err
+	}
+	return config.LoadTmuxConfig(filepath.Dir(path))
+}